@@ -10,6 +10,7 @@ import (
 	"math/rand"
 	"net"
 	"net/http"
+	"net/netip"
 	"os"
 	"os/signal"
 	"os/user"
@@ -20,13 +21,16 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/climblive/platform/backend/cmd/api/migrations"
 	"github.com/climblive/platform/backend/internal/authorizer"
 	"github.com/climblive/platform/backend/internal/domain"
 	"github.com/climblive/platform/backend/internal/events"
 	"github.com/climblive/platform/backend/internal/handlers/rest"
+	"github.com/climblive/platform/backend/internal/metrics"
 	"github.com/climblive/platform/backend/internal/repository"
 	"github.com/climblive/platform/backend/internal/scores"
 	"github.com/climblive/platform/backend/internal/scrubber"
+	"github.com/climblive/platform/backend/internal/tracing"
 	"github.com/climblive/platform/backend/internal/usecases"
 	"github.com/climblive/platform/backend/internal/utils"
 	"github.com/go-errors/errors"
@@ -37,13 +41,12 @@ import (
 	"github.com/pressly/goose/v3"
 )
 
-//go:embed migrations/*.sql
-var embedMigrations embed.FS
-
 //go:embed all:web
 var webAssets embed.FS
 
 const defaultScoreEngineMaxLifetime = 24 * time.Hour
+const defaultIdempotencyWindow = 24 * time.Hour
+const defaultMetricsAddr = "127.0.0.1:9090"
 
 const appCSP = "default-src 'self'; connect-src 'self' clmb.auth.eu-west-1.amazoncognito.com *.fontawesome.com *.sentry.io data:; style-src 'self' https://fonts.googleapis.com 'unsafe-inline'; font-src 'self' https://fonts.gstatic.com; object-src 'none'; frame-ancestors 'none'; form-action 'none'; base-uri 'self'; img-src 'self' data:; report-uri https://o4509937603641344.ingest.de.sentry.io/api/4509937616093264/security/?sentry_key=019099d850441f60cea5d465e217f768"
 
@@ -73,7 +76,7 @@ func (g *uuidGenerator) Generate() uuid.UUID {
 func HandleCORSPreFlight(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Access-Control-Allow-Origin", "*")
 	w.Header().Set("Access-Control-Allow-Methods", "POST, GET, PUT, DELETE, PATCH")
-	w.Header().Set("Access-Control-Allow-Headers", "Authorization, Content-Type")
+	w.Header().Set("Access-Control-Allow-Headers", "Authorization, Content-Type, If-Match, Idempotency-Key")
 	w.WriteHeader(http.StatusOK)
 }
 
@@ -113,11 +116,31 @@ func main() {
 		listenPort = 443
 	}
 
+	shutdownTracing, err := tracing.Setup(ctx)
+	if err != nil {
+		if stack := utils.GetErrorStack(err); stack != "" {
+			log.Println(stack)
+		}
+
+		panic(err)
+	}
+
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		if err := shutdownTracing(ctx); err != nil {
+			slog.Error("failed to shut down tracing", "error", err)
+		}
+	}()
+
 	var barriers []*sync.WaitGroup
 
+	dbDriver := os.Getenv("DB_DRIVER")
 	dbPort, _ := strconv.Atoi(os.Getenv("DB_PORT"))
 
-	database, err := repository.NewDatabase(
+	database, err := repository.Open(
+		dbDriver,
 		os.Getenv("DB_USERNAME"),
 		os.Getenv("DB_PASSWORD"),
 		os.Getenv("DB_HOST"),
@@ -131,13 +154,18 @@ func main() {
 		panic(err)
 	}
 
-	goose.SetBaseFS(embedMigrations)
+	migrationsDialect, migrationsDir, err := migrations.ForDriver(dbDriver)
+	if err != nil {
+		panic(err)
+	}
+
+	goose.SetBaseFS(migrations.FS)
 
-	if err := goose.SetDialect("mysql"); err != nil {
+	if err := goose.SetDialect(migrationsDialect); err != nil {
 		panic(err)
 	}
 
-	if err := goose.Up(database.Handle, "migrations"); err != nil {
+	if err := goose.Up(database.Handle, migrationsDir); err != nil {
 		panic(err)
 	}
 
@@ -167,8 +195,13 @@ func main() {
 		ScoreKeeper:               scoreKeeper,
 		RegistrationCodeGenerator: &registrationCodeGenerator{}}
 
+	retentionUseCase := usecases.RetentionUseCase{
+		Repo:       database,
+		Authorizer: authorizer,
+	}
+
 	scrubInterval := time.Hour
-	scrubberRunner := scrubber.New(&contenderUseCase, scrubInterval)
+	scrubberRunner := scrubber.New(&contenderUseCase, &retentionUseCase, scrubInterval)
 
 	barriers = append(barriers,
 		scoreKeeper.Run(ctx, scores.WithPanicRecovery()),
@@ -220,6 +253,41 @@ func main() {
 		panic(err)
 	}
 
+	metricsMux := http.NewServeMux()
+	metricsMux.Handle("GET /metrics", metrics.Handler())
+
+	metricsServer := &http.Server{
+		Addr:                         getMetricsAddr(),
+		Handler:                      metricsMux,
+		DisableGeneralOptionsHandler: false,
+		TLSConfig:                    nil,
+		ReadTimeout:                  0,
+		ReadHeaderTimeout:            10 * time.Second,
+		WriteTimeout:                 0,
+		IdleTimeout:                  0,
+		MaxHeaderBytes:               0,
+		TLSNextProto:                 nil,
+		ConnState:                    nil,
+		ErrorLog:                     nil,
+		BaseContext:                  nil,
+		ConnContext:                  nil,
+		HTTP2:                        nil,
+		Protocols:                    nil,
+	}
+
+	context.AfterFunc(ctx, func() {
+		_ = metricsServer.Shutdown(context.Background())
+	})
+
+	metricsListener, err := net.Listen("tcp", metricsServer.Addr)
+	if err != nil {
+		if stack := utils.GetErrorStack(err); stack != "" {
+			log.Println(stack)
+		}
+
+		panic(err)
+	}
+
 	runAsUser := os.Getenv("RUN_AS_USER")
 	if runAsUser == "" {
 		panic("RUN_AS_USER is required")
@@ -228,6 +296,14 @@ func main() {
 		panic(err)
 	}
 
+	slog.Info("serving metrics", "addr", metricsListener.Addr().String())
+
+	go func() {
+		if err := metricsServer.Serve(metricsListener); err != http.ErrServerClosed {
+			slog.Error("metrics server stopped", "error", err)
+		}
+	}()
+
 	if httpServer.TLSConfig != nil {
 		err = httpServer.ServeTLS(listener, "", "")
 	} else {
@@ -265,6 +341,57 @@ func getScoreEngineMaxLifetime() time.Duration {
 	return maxLifetime
 }
 
+func getMetricsAddr() string {
+	if addr, present := os.LookupEnv("METRICS_ADDR"); present {
+		return addr
+	}
+
+	return defaultMetricsAddr
+}
+
+func getIdempotencyWindow() time.Duration {
+	env := "IDEMPOTENCY_WINDOW"
+	window := defaultIdempotencyWindow
+
+	if value, present := os.LookupEnv(env); present {
+		seconds, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			slog.Warn("discarding non-numeric environment variable", "env", env, "error", err)
+		} else {
+			window = time.Duration(seconds) * time.Second
+		}
+	}
+
+	return window
+}
+
+func getTrustedProxies() []netip.Prefix {
+	env := "TRUSTED_PROXIES"
+	proxies := make([]netip.Prefix, 0)
+
+	for value := range strings.SplitSeq(os.Getenv(env), ",") {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+
+		if addr, err := netip.ParseAddr(value); err == nil {
+			proxies = append(proxies, netip.PrefixFrom(addr, addr.BitLen()))
+			continue
+		}
+
+		prefix, err := netip.ParsePrefix(value)
+		if err != nil {
+			slog.Warn("discarding invalid trusted proxy", "env", env, "value", value, "error", err)
+			continue
+		}
+
+		proxies = append(proxies, prefix.Masked())
+	}
+
+	return proxies
+}
+
 func setupMux(
 	repo *repository.Database,
 	authorizer *authorizer.Authorizer,
@@ -290,20 +417,29 @@ func setupMux(
 	}
 
 	compClassUseCase := usecases.CompClassUseCase{
-		Authorizer: authorizer,
-		Repo:       repo,
+		Authorizer:  authorizer,
+		Repo:        repo,
+		EventBroker: eventBroker,
 	}
 
 	problemUseCase := usecases.ProblemUseCase{
+		Repo:               repo,
+		Authorizer:         authorizer,
+		EventBroker:        eventBroker,
+		ScoreEngineManager: scoreEngineManager,
+	}
+
+	tickUseCase := usecases.TickUseCase{
 		Repo:        repo,
 		Authorizer:  authorizer,
 		EventBroker: eventBroker,
 	}
 
-	tickUseCase := usecases.TickUseCase{
+	tickDisputeUseCase := usecases.TickDisputeUseCase{
 		Repo:        repo,
 		Authorizer:  authorizer,
 		EventBroker: eventBroker,
+		TickUseCase: &tickUseCase,
 	}
 
 	scoreEngineUseCase := usecases.ScoreEngineUseCase{
@@ -312,6 +448,19 @@ func setupMux(
 		ScoreEngineManager: scoreEngineManager,
 	}
 
+	roundUseCase := usecases.RoundUseCase{
+		Repo:        repo,
+		Authorizer:  authorizer,
+		ScoreKeeper: scoreKeeper,
+		EventBroker: eventBroker,
+	}
+
+	teamUseCase := usecases.TeamUseCase{
+		Repo:        repo,
+		Authorizer:  authorizer,
+		EventBroker: eventBroker,
+	}
+
 	raffleUseCase := usecases.RaffleUseCase{
 		Repo:        repo,
 		Authorizer:  authorizer,
@@ -329,29 +478,48 @@ func setupMux(
 		UUIDGenerator: &uuidGenerator{},
 	}
 
+	retentionUseCase := usecases.RetentionUseCase{
+		Repo:       repo,
+		Authorizer: authorizer,
+	}
+
 	healthUseCase := usecases.HealthUseCase{
+		Authorizer:         authorizer,
 		ScoreEngineManager: scoreEngineManager,
 		ScoreKeeper:        scoreKeeper,
 		Scrubber:           scrubber,
+		RetentionPolicies:  scrubber,
 	}
 
 	mux := rest.NewMux()
+	mux.RegisterMiddleware(rest.Tracing)
+	mux.RegisterMiddleware(rest.Metrics)
 	mux.RegisterMiddleware(rest.CORS)
 	mux.RegisterMiddleware(authorizer.Middleware)
+	mux.RegisterMiddleware(rest.NewIdempotencyStore(authorizer, getIdempotencyWindow()).Middleware)
 
 	mux.HandleFunc("OPTIONS /", HandleCORSPreFlight)
 
-	rest.InstallContenderHandler(mux, &contenderUseCase)
-	rest.InstallContestHandler(mux, &contestUseCase, &compClassUseCase, &tickUseCase, &problemUseCase)
-	rest.InstallCompClassHandler(mux, &compClassUseCase)
-	rest.InstallProblemHandler(mux, &problemUseCase)
-	rest.InstallTickHandler(mux, &tickUseCase)
-	rest.InstallEventHandler(mux, eventBroker, 10*time.Second)
-	rest.InstallScoreEngineHandler(mux, &scoreEngineUseCase)
-	rest.InstallRaffleHandler(mux, &raffleUseCase)
-	rest.InstallUserHandler(mux, &userUseCase)
-	rest.InstallOrganizerHandler(mux, &organizerUseCase)
-	rest.InstallHealthHandler(mux, &healthUseCase)
+	rest.InstallHandlers(mux, rest.Dependencies{
+		ContenderUseCase:   &contenderUseCase,
+		ContestUseCase:     &contestUseCase,
+		CompClassUseCase:   &compClassUseCase,
+		ProblemUseCase:     &problemUseCase,
+		RoundUseCase:       &roundUseCase,
+		TeamUseCase:        &teamUseCase,
+		TickUseCase:        &tickUseCase,
+		TickDisputeUseCase: &tickDisputeUseCase,
+		ScoreEngineUseCase: &scoreEngineUseCase,
+		RaffleUseCase:      &raffleUseCase,
+		UserUseCase:        &userUseCase,
+		OrganizerUseCase:   &organizerUseCase,
+		RetentionUseCase:   &retentionUseCase,
+		HealthUseCase:      &healthUseCase,
+		LiveScoreUseCase:   &contestUseCase,
+		EventBroker:        eventBroker,
+		PingInterval:       10 * time.Second,
+		TrustedProxies:     getTrustedProxies(),
+	})
 
 	return mux
 }
@@ -0,0 +1,59 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS `round` (
+  `id` INT NOT NULL AUTO_INCREMENT,
+  `organizer_id` INT NOT NULL,
+  `contest_id` INT NOT NULL,
+  `number` INT NOT NULL,
+  `name` VARCHAR(32) NOT NULL,
+  `qualifying_problems` INT NOT NULL,
+  `finalists` INT NOT NULL,
+  PRIMARY KEY (`id`),
+  CONSTRAINT `fk_round_1`
+    FOREIGN KEY (`contest_id` , `organizer_id`)
+    REFERENCES `contest` (`id` , `organizer_id`)
+    ON DELETE RESTRICT
+    ON UPDATE RESTRICT)
+ENGINE = InnoDB
+DEFAULT CHARACTER SET = utf8mb4
+COLLATE = utf8mb4_unicode_ci;
+
+CREATE INDEX `fk_round_1_idx` ON `round` (`contest_id` ASC, `organizer_id` ASC);
+
+CREATE UNIQUE INDEX `index3` ON `round` (`number` ASC, `contest_id` ASC);
+
+CREATE TABLE IF NOT EXISTS `round_contender` (
+  `round_id` INT NOT NULL,
+  `contender_id` INT NOT NULL,
+  `previous_placement` INT NOT NULL,
+  `timestamp` TIMESTAMP NULL DEFAULT NULL,
+  `score` INT NULL DEFAULT NULL,
+  `placement` INT NULL DEFAULT NULL,
+  `finalist` TINYINT(1) NULL DEFAULT NULL,
+  `rank_order` INT NULL DEFAULT NULL,
+  PRIMARY KEY (`round_id`, `contender_id`),
+  CONSTRAINT `fk_round_contender_1`
+    FOREIGN KEY (`round_id`)
+    REFERENCES `round` (`id`)
+    ON DELETE CASCADE
+    ON UPDATE CASCADE,
+  CONSTRAINT `fk_round_contender_2`
+    FOREIGN KEY (`contender_id`)
+    REFERENCES `contender` (`id`)
+    ON DELETE CASCADE
+    ON UPDATE CASCADE)
+ENGINE = InnoDB
+DEFAULT CHARACTER SET = utf8mb4
+COLLATE = utf8mb4_unicode_ci;
+
+CREATE INDEX `fk_round_contender_2_idx` ON `round_contender` (`contender_id` ASC);
+
+ALTER TABLE problem ADD COLUMN `round_id` INT NULL DEFAULT NULL AFTER `contest_id`;
+ALTER TABLE problem ADD CONSTRAINT `fk_problem_2` FOREIGN KEY (`round_id`) REFERENCES `round` (`id`) ON DELETE RESTRICT ON UPDATE RESTRICT;
+CREATE INDEX `fk_problem_2_idx` ON `problem` (`round_id` ASC);
+
+-- +goose Down
+ALTER TABLE problem DROP FOREIGN KEY `fk_problem_2`;
+DROP INDEX `fk_problem_2_idx` ON `problem`;
+ALTER TABLE problem DROP COLUMN `round_id`;
+DROP TABLE `round_contender`;
+DROP TABLE `round`;
@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE `contest` ADD COLUMN `scoreboard_freeze` INT NOT NULL DEFAULT 0 AFTER `name_retention_time`;
+ALTER TABLE `contest` ADD COLUMN `scoreboard_revealed_at` TIMESTAMP NULL DEFAULT NULL AFTER `scoreboard_freeze`;
+
+-- +goose Down
+ALTER TABLE `contest` DROP COLUMN `scoreboard_revealed_at`;
+ALTER TABLE `contest` DROP COLUMN `scoreboard_freeze`;
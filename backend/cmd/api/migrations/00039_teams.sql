@@ -0,0 +1,30 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS `team` (
+  `id` INT NOT NULL AUTO_INCREMENT,
+  `organizer_id` INT NOT NULL,
+  `contest_id` INT NOT NULL,
+  `name` VARCHAR(32) NOT NULL,
+  `counted_members` INT NOT NULL,
+  PRIMARY KEY (`id`),
+  CONSTRAINT `fk_team_1`
+    FOREIGN KEY (`contest_id` , `organizer_id`)
+    REFERENCES `contest` (`id` , `organizer_id`)
+    ON DELETE RESTRICT
+    ON UPDATE RESTRICT)
+ENGINE = InnoDB
+DEFAULT CHARACTER SET = utf8mb4
+COLLATE = utf8mb4_unicode_ci;
+
+CREATE INDEX `fk_team_1_idx` ON `team` (`contest_id` ASC, `organizer_id` ASC);
+
+CREATE INDEX `index3` ON `team` (`id` ASC, `contest_id` ASC);
+
+ALTER TABLE contender ADD COLUMN `team_id` INT NULL DEFAULT NULL AFTER `class_id`;
+ALTER TABLE contender ADD CONSTRAINT `fk_contender_3` FOREIGN KEY (`team_id`, `contest_id`) REFERENCES `team` (`id`, `contest_id`) ON DELETE RESTRICT ON UPDATE RESTRICT;
+CREATE INDEX `fk_contender_3_idx` ON `contender` (`team_id` ASC, `contest_id` ASC);
+
+-- +goose Down
+ALTER TABLE contender DROP FOREIGN KEY `fk_contender_3`;
+DROP INDEX `fk_contender_3_idx` ON `contender`;
+ALTER TABLE contender DROP COLUMN `team_id`;
+DROP TABLE `team`;
@@ -0,0 +1,28 @@
+-- +goose Up
+ALTER TABLE problem ADD COLUMN `sector` VARCHAR(32) NULL DEFAULT NULL AFTER `description`;
+ALTER TABLE problem ADD COLUMN `tags` JSON NULL DEFAULT NULL AFTER `sector`;
+
+CREATE TABLE IF NOT EXISTS `problem_comp_class` (
+  `problem_id` INT NOT NULL,
+  `comp_class_id` INT NOT NULL,
+  PRIMARY KEY (`problem_id`, `comp_class_id`),
+  CONSTRAINT `fk_problem_comp_class_1`
+    FOREIGN KEY (`problem_id`)
+    REFERENCES `problem` (`id`)
+    ON DELETE CASCADE
+    ON UPDATE CASCADE,
+  CONSTRAINT `fk_problem_comp_class_2`
+    FOREIGN KEY (`comp_class_id`)
+    REFERENCES `comp_class` (`id`)
+    ON DELETE CASCADE
+    ON UPDATE CASCADE)
+ENGINE = InnoDB
+DEFAULT CHARACTER SET = utf8mb4
+COLLATE = utf8mb4_unicode_ci;
+
+CREATE INDEX `fk_problem_comp_class_2_idx` ON `problem_comp_class` (`comp_class_id` ASC);
+
+-- +goose Down
+DROP TABLE `problem_comp_class`;
+ALTER TABLE problem DROP COLUMN `tags`;
+ALTER TABLE problem DROP COLUMN `sector`;
@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE raffle ADD COLUMN `rules` JSON NULL DEFAULT NULL AFTER `contest_id`;
+ALTER TABLE raffle_winner ADD COLUMN `rules` JSON NULL DEFAULT NULL AFTER `timestamp`;
+
+-- +goose Down
+ALTER TABLE raffle_winner DROP COLUMN `rules`;
+ALTER TABLE raffle DROP COLUMN `rules`;
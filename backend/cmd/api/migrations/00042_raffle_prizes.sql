@@ -0,0 +1,32 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS `raffle_prize` (
+  `id` INT NOT NULL AUTO_INCREMENT,
+  `organizer_id` INT NOT NULL,
+  `raffle_id` INT NOT NULL,
+  `name` VARCHAR(64) NOT NULL,
+  `quantity` INT NOT NULL,
+  PRIMARY KEY (`id`),
+  CONSTRAINT `fk_raffle_prize_1`
+    FOREIGN KEY (`raffle_id` , `organizer_id`)
+    REFERENCES `raffle` (`id` , `organizer_id`)
+    ON DELETE NO ACTION
+    ON UPDATE NO ACTION)
+ENGINE = InnoDB
+DEFAULT CHARACTER SET = utf8mb4
+COLLATE = utf8mb4_unicode_ci;
+
+CREATE INDEX `fk_raffle_prize_1_idx` ON `raffle_prize` (`raffle_id` ASC, `organizer_id` ASC);
+
+ALTER TABLE raffle_winner ADD COLUMN `prize_id` INT NULL DEFAULT NULL AFTER `contender_id`;
+ALTER TABLE raffle_winner ADD COLUMN `claimed_at` TIMESTAMP NULL DEFAULT NULL AFTER `rules`;
+ALTER TABLE raffle_winner ADD COLUMN `voided_at` TIMESTAMP NULL DEFAULT NULL AFTER `claimed_at`;
+ALTER TABLE raffle_winner ADD CONSTRAINT `fk_raffle_winner_3` FOREIGN KEY (`prize_id`) REFERENCES `raffle_prize` (`id`) ON DELETE NO ACTION ON UPDATE NO ACTION;
+CREATE INDEX `fk_raffle_winner_3_idx` ON `raffle_winner` (`prize_id` ASC);
+
+-- +goose Down
+ALTER TABLE raffle_winner DROP FOREIGN KEY `fk_raffle_winner_3`;
+DROP INDEX `fk_raffle_winner_3_idx` ON `raffle_winner`;
+ALTER TABLE raffle_winner DROP COLUMN `voided_at`;
+ALTER TABLE raffle_winner DROP COLUMN `claimed_at`;
+ALTER TABLE raffle_winner DROP COLUMN `prize_id`;
+DROP TABLE `raffle_prize`;
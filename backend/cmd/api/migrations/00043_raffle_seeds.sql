@@ -0,0 +1,11 @@
+-- +goose Up
+ALTER TABLE raffle ADD COLUMN `seed` CHAR(64) NULL DEFAULT NULL AFTER `rules`;
+ALTER TABLE raffle ADD COLUMN `seed_commitment` CHAR(64) NULL DEFAULT NULL AFTER `seed`;
+ALTER TABLE raffle ADD COLUMN `seed_revealed_at` TIMESTAMP NULL DEFAULT NULL AFTER `seed_commitment`;
+ALTER TABLE raffle_winner ADD COLUMN `draw` JSON NULL DEFAULT NULL AFTER `voided_at`;
+
+-- +goose Down
+ALTER TABLE raffle_winner DROP COLUMN `draw`;
+ALTER TABLE raffle DROP COLUMN `seed_revealed_at`;
+ALTER TABLE raffle DROP COLUMN `seed_commitment`;
+ALTER TABLE raffle DROP COLUMN `seed`;
@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE `contest` ADD COLUMN `self_registration` TINYINT(1) NOT NULL DEFAULT 0 AFTER `scoreboard_revealed_at`;
+ALTER TABLE `comp_class` ADD COLUMN `capacity` INT NOT NULL DEFAULT 0 AFTER `time_end`;
+
+-- +goose Down
+ALTER TABLE `comp_class` DROP COLUMN `capacity`;
+ALTER TABLE `contest` DROP COLUMN `self_registration`;
@@ -0,0 +1,11 @@
+-- +goose Up
+ALTER TABLE `contender` ADD COLUMN `waitlist_class_id` INT NULL DEFAULT NULL AFTER `scrub_before`;
+ALTER TABLE `contender` ADD COLUMN `waitlisted_at` TIMESTAMP NULL DEFAULT NULL AFTER `waitlist_class_id`;
+ALTER TABLE `contender` ADD CONSTRAINT `fk_contender_4` FOREIGN KEY (`waitlist_class_id` , `contest_id`) REFERENCES `comp_class` (`id` , `contest_id`) ON DELETE RESTRICT ON UPDATE RESTRICT;
+CREATE INDEX `fk_contender_4_idx` ON `contender` (`waitlist_class_id` ASC, `contest_id` ASC);
+
+-- +goose Down
+ALTER TABLE `contender` DROP FOREIGN KEY `fk_contender_4`;
+DROP INDEX `fk_contender_4_idx` ON `contender`;
+ALTER TABLE `contender` DROP COLUMN `waitlisted_at`;
+ALTER TABLE `contender` DROP COLUMN `waitlist_class_id`;
@@ -0,0 +1,24 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS `audit_entry` (
+  `id` INT NOT NULL AUTO_INCREMENT,
+  `organizer_id` INT NOT NULL,
+  `contest_id` INT NOT NULL,
+  `contender_id` INT NULL DEFAULT NULL,
+  `action` VARCHAR(32) NOT NULL,
+  `timestamp` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  CONSTRAINT `fk_audit_entry_1`
+    FOREIGN KEY (`contest_id` , `organizer_id`)
+    REFERENCES `contest` (`id` , `organizer_id`)
+    ON DELETE CASCADE
+    ON UPDATE NO ACTION)
+ENGINE = InnoDB
+DEFAULT CHARACTER SET = utf8mb4
+COLLATE = utf8mb4_unicode_ci;
+
+CREATE INDEX `fk_audit_entry_1_idx` ON `audit_entry` (`contest_id` ASC, `organizer_id` ASC);
+
+CREATE INDEX `index3` ON `audit_entry` (`contender_id` ASC);
+
+-- +goose Down
+DROP TABLE `audit_entry`;
@@ -0,0 +1,44 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS `retention_policy` (
+  `id` INT NOT NULL AUTO_INCREMENT,
+  `organizer_id` INT NOT NULL,
+  `target` VARCHAR(32) NOT NULL,
+  `retention_period` INT NOT NULL,
+  `enabled` TINYINT(1) NOT NULL DEFAULT 1,
+  `created` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  CONSTRAINT `fk_retention_policy_1`
+    FOREIGN KEY (`organizer_id`)
+    REFERENCES `organizer` (`id`)
+    ON DELETE CASCADE
+    ON UPDATE NO ACTION)
+ENGINE = InnoDB
+DEFAULT CHARACTER SET = utf8mb4
+COLLATE = utf8mb4_unicode_ci;
+
+CREATE INDEX `fk_retention_policy_1_idx` ON `retention_policy` (`organizer_id` ASC);
+
+CREATE TABLE IF NOT EXISTS `retention_policy_execution` (
+  `id` INT NOT NULL AUTO_INCREMENT,
+  `organizer_id` INT NOT NULL,
+  `retention_policy_id` INT NOT NULL,
+  `timestamp` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `contests` INT NOT NULL,
+  `contenders` INT NOT NULL,
+  `ticks` INT NOT NULL,
+  `error` TEXT NULL DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  CONSTRAINT `fk_retention_policy_execution_1`
+    FOREIGN KEY (`retention_policy_id`)
+    REFERENCES `retention_policy` (`id`)
+    ON DELETE CASCADE
+    ON UPDATE NO ACTION)
+ENGINE = InnoDB
+DEFAULT CHARACTER SET = utf8mb4
+COLLATE = utf8mb4_unicode_ci;
+
+CREATE INDEX `fk_retention_policy_execution_1_idx` ON `retention_policy_execution` (`retention_policy_id` ASC);
+
+-- +goose Down
+DROP TABLE `retention_policy_execution`;
+DROP TABLE `retention_policy`;
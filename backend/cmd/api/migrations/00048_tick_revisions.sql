@@ -0,0 +1,28 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS `tick_revision` (
+  `id` INT NOT NULL AUTO_INCREMENT,
+  `contest_id` INT NOT NULL,
+  `contender_id` INT NOT NULL,
+  `problem_id` INT NOT NULL,
+  `tick_id` INT NOT NULL,
+  `action` VARCHAR(16) NOT NULL,
+  `timestamp` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `actor_role` VARCHAR(16) NOT NULL,
+  `actor_username` VARCHAR(64) NULL DEFAULT NULL,
+  `zone_1` TINYINT(1) NOT NULL DEFAULT 0,
+  `attempts_zone_1` INT NOT NULL DEFAULT 0,
+  `zone_2` TINYINT(1) NOT NULL DEFAULT 0,
+  `attempts_zone_2` INT NOT NULL DEFAULT 0,
+  `top` TINYINT(1) NOT NULL DEFAULT 0,
+  `attempts_top` INT NOT NULL DEFAULT 0,
+  PRIMARY KEY (`id`))
+ENGINE = InnoDB
+DEFAULT CHARACTER SET = utf8mb4
+COLLATE = utf8mb4_unicode_ci;
+
+CREATE INDEX `index2` ON `tick_revision` (`contender_id` ASC, `problem_id` ASC);
+
+CREATE INDEX `index3` ON `tick_revision` (`contest_id` ASC);
+
+-- +goose Down
+DROP TABLE `tick_revision`;
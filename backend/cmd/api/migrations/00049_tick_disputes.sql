@@ -0,0 +1,42 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS `tick_dispute` (
+  `id` INT NOT NULL AUTO_INCREMENT,
+  `organizer_id` INT NOT NULL,
+  `contest_id` INT NOT NULL,
+  `contender_id` INT NOT NULL,
+  `problem_id` INT NOT NULL,
+  `status` VARCHAR(16) NOT NULL,
+  `comment` VARCHAR(1024) NOT NULL,
+  `response` VARCHAR(1024) NULL DEFAULT NULL,
+  `zone_1` TINYINT(1) NOT NULL DEFAULT 0,
+  `attempts_zone_1` INT NOT NULL DEFAULT 0,
+  `zone_2` TINYINT(1) NOT NULL DEFAULT 0,
+  `attempts_zone_2` INT NOT NULL DEFAULT 0,
+  `top` TINYINT(1) NOT NULL DEFAULT 0,
+  `attempts_top` INT NOT NULL DEFAULT 0,
+  `created` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `resolved` TIMESTAMP NULL DEFAULT NULL,
+  `resolved_by` VARCHAR(64) NULL DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  CONSTRAINT `fk_tick_dispute_1`
+    FOREIGN KEY (`contender_id` , `organizer_id` , `contest_id`)
+    REFERENCES `contender` (`id` , `organizer_id` , `contest_id`)
+    ON DELETE CASCADE
+    ON UPDATE NO ACTION,
+  CONSTRAINT `fk_tick_dispute_2`
+    FOREIGN KEY (`problem_id` , `organizer_id` , `contest_id`)
+    REFERENCES `problem` (`id` , `organizer_id` , `contest_id`)
+    ON DELETE CASCADE
+    ON UPDATE NO ACTION)
+ENGINE = InnoDB
+DEFAULT CHARACTER SET = utf8mb4
+COLLATE = utf8mb4_unicode_ci;
+
+CREATE INDEX `fk_tick_dispute_1_idx` ON `tick_dispute` (`contender_id` ASC, `organizer_id` ASC, `contest_id` ASC);
+
+CREATE INDEX `fk_tick_dispute_2_idx` ON `tick_dispute` (`problem_id` ASC, `organizer_id` ASC, `contest_id` ASC);
+
+CREATE INDEX `index3` ON `tick_dispute` (`contest_id` ASC, `status` ASC);
+
+-- +goose Down
+DROP TABLE `tick_dispute`;
@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE `tick_revision` ADD COLUMN `idempotency_key` VARCHAR(64) NULL DEFAULT NULL AFTER `actor_username`;
+CREATE UNIQUE INDEX `index4` ON `tick_revision` (`contender_id` ASC, `idempotency_key` ASC);
+
+-- +goose Down
+DROP INDEX `index4` ON `tick_revision`;
+ALTER TABLE `tick_revision` DROP COLUMN `idempotency_key`;
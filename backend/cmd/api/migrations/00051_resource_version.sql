@@ -0,0 +1,9 @@
+-- +goose Up
+ALTER TABLE `contest` ADD COLUMN `version` INT NOT NULL DEFAULT 1 AFTER `created`;
+ALTER TABLE `contender` ADD COLUMN `version` INT NOT NULL DEFAULT 1 AFTER `waitlisted_at`;
+ALTER TABLE `problem` ADD COLUMN `version` INT NOT NULL DEFAULT 1 AFTER `flash_bonus`;
+
+-- +goose Down
+ALTER TABLE `problem` DROP COLUMN `version`;
+ALTER TABLE `contender` DROP COLUMN `version`;
+ALTER TABLE `contest` DROP COLUMN `version`;
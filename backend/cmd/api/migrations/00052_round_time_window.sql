@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE `round` ADD COLUMN `time_begin` TIMESTAMP NULL DEFAULT NULL AFTER `finalists`;
+ALTER TABLE `round` ADD COLUMN `time_end` TIMESTAMP NULL DEFAULT NULL AFTER `time_begin`;
+
+-- +goose Down
+ALTER TABLE `round` DROP COLUMN `time_end`;
+ALTER TABLE `round` DROP COLUMN `time_begin`;
@@ -0,0 +1,6 @@
+-- +goose Up
+ALTER TABLE `tick_revision` ADD COLUMN `recorded_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP AFTER `timestamp`;
+UPDATE `tick_revision` SET `recorded_at` = `timestamp`;
+
+-- +goose Down
+ALTER TABLE `tick_revision` DROP COLUMN `recorded_at`;
@@ -0,0 +1,25 @@
+package migrations
+
+import (
+	"embed"
+
+	"github.com/go-errors/errors"
+)
+
+//go:embed *.sql sqlite/*.sql postgres/*.sql
+var FS embed.FS
+
+// ForDriver returns the goose dialect and the directory in FS holding the
+// migrations for the given database driver.
+func ForDriver(driver string) (string, string, error) {
+	switch driver {
+	case "", "mysql":
+		return "mysql", ".", nil
+	case "sqlite":
+		return "sqlite3", "sqlite", nil
+	case "postgres":
+		return "postgres", "postgres", nil
+	default:
+		return "", "", errors.Errorf("unsupported database driver: %s", driver)
+	}
+}
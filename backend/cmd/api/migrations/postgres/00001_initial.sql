@@ -0,0 +1,513 @@
+-- +goose Up
+CREATE TABLE organizer (
+  id INTEGER GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  name VARCHAR(32) NOT NULL
+);
+
+CREATE TABLE series (
+  id INTEGER GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  organizer_id INT NOT NULL,
+  name VARCHAR(64) NOT NULL,
+  CONSTRAINT fk_series_1
+    FOREIGN KEY (organizer_id)
+    REFERENCES organizer (id)
+    ON DELETE RESTRICT
+    ON UPDATE RESTRICT
+);
+
+CREATE INDEX series_fk_series_1 ON series (organizer_id);
+CREATE UNIQUE INDEX series_index3 ON series (id, organizer_id);
+
+CREATE TABLE contest (
+  id INTEGER GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  organizer_id INT NOT NULL,
+  archived_at TIMESTAMPTZ NULL DEFAULT NULL,
+  series_id INT NULL,
+  name VARCHAR(64) NOT NULL,
+  description TEXT NULL,
+  location VARCHAR(1024) NULL DEFAULT NULL,
+  country VARCHAR(2) NOT NULL DEFAULT 'AQ',
+  qualifying_problems INT NOT NULL,
+  finalists INT NOT NULL,
+  info TEXT NULL,
+  grace_period INT NOT NULL DEFAULT 300,
+  name_retention_time INT NOT NULL DEFAULT 20160,
+  scoreboard_freeze INT NOT NULL DEFAULT 0,
+  scoreboard_revealed_at TIMESTAMPTZ NULL DEFAULT NULL,
+  self_registration BOOLEAN NOT NULL DEFAULT FALSE,
+  created TIMESTAMPTZ NOT NULL DEFAULT '1970-01-01 00:00:01+00',
+  version INT NOT NULL DEFAULT 1,
+  CONSTRAINT fk_contest_2
+    FOREIGN KEY (organizer_id)
+    REFERENCES organizer (id)
+    ON DELETE RESTRICT
+    ON UPDATE RESTRICT,
+  CONSTRAINT fk_contest_3
+    FOREIGN KEY (series_id, organizer_id)
+    REFERENCES series (id, organizer_id)
+    ON DELETE RESTRICT
+    ON UPDATE RESTRICT
+);
+
+CREATE INDEX contest_fk_contest_2_idx ON contest (organizer_id);
+CREATE INDEX contest_fk_contest_3 ON contest (series_id, organizer_id);
+CREATE UNIQUE INDEX contest_index5 ON contest (id, organizer_id);
+
+CREATE TABLE comp_class (
+  id INTEGER GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  organizer_id INT NOT NULL,
+  contest_id INT NOT NULL,
+  name VARCHAR(45) NOT NULL,
+  description VARCHAR(255) NULL,
+  color VARCHAR(7) NULL,
+  time_begin TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  time_end TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  capacity INT NOT NULL DEFAULT 0,
+  CONSTRAINT fk_comp_class_1
+    FOREIGN KEY (contest_id, organizer_id)
+    REFERENCES contest (id, organizer_id)
+    ON DELETE CASCADE
+    ON UPDATE RESTRICT
+);
+
+CREATE UNIQUE INDEX comp_class_index3 ON comp_class (id, contest_id);
+CREATE INDEX comp_class_fk_comp_class_1_idx ON comp_class (contest_id, organizer_id);
+
+CREATE TABLE team (
+  id INTEGER GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  organizer_id INT NOT NULL,
+  contest_id INT NOT NULL,
+  name VARCHAR(32) NOT NULL,
+  counted_members INT NOT NULL,
+  CONSTRAINT fk_team_1
+    FOREIGN KEY (contest_id, organizer_id)
+    REFERENCES contest (id, organizer_id)
+    ON DELETE RESTRICT
+    ON UPDATE RESTRICT
+);
+
+CREATE INDEX team_fk_team_1_idx ON team (contest_id, organizer_id);
+CREATE UNIQUE INDEX team_index3 ON team (id, contest_id);
+
+CREATE TABLE contender (
+  id INTEGER GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  organizer_id INT NOT NULL,
+  contest_id INT NOT NULL,
+  registration_code VARCHAR(16) NOT NULL,
+  name VARCHAR(64) NULL,
+  class_id INT NULL,
+  team_id INT NULL DEFAULT NULL,
+  entered TIMESTAMPTZ NULL DEFAULT CURRENT_TIMESTAMP,
+  disqualified BOOLEAN NOT NULL DEFAULT FALSE,
+  withdrawn_from_finals BOOLEAN NOT NULL DEFAULT FALSE,
+  scrubbed_at TIMESTAMPTZ NULL DEFAULT NULL,
+  scrub_before TIMESTAMPTZ NULL DEFAULT NULL,
+  waitlist_class_id INT NULL DEFAULT NULL,
+  waitlisted_at TIMESTAMPTZ NULL DEFAULT NULL,
+  version INT NOT NULL DEFAULT 1,
+  CONSTRAINT fk_contender_1
+    FOREIGN KEY (class_id, contest_id)
+    REFERENCES comp_class (id, contest_id)
+    ON DELETE RESTRICT
+    ON UPDATE RESTRICT,
+  CONSTRAINT fk_contender_2
+    FOREIGN KEY (contest_id, organizer_id)
+    REFERENCES contest (id, organizer_id)
+    ON DELETE RESTRICT
+    ON UPDATE RESTRICT,
+  CONSTRAINT fk_contender_3
+    FOREIGN KEY (team_id, contest_id)
+    REFERENCES team (id, contest_id)
+    ON DELETE RESTRICT
+    ON UPDATE RESTRICT,
+  CONSTRAINT fk_contender_4
+    FOREIGN KEY (waitlist_class_id, contest_id)
+    REFERENCES comp_class (id, contest_id)
+    ON DELETE RESTRICT
+    ON UPDATE RESTRICT
+);
+
+CREATE INDEX contender_fk_contender_1_idx ON contender (class_id, contest_id);
+CREATE UNIQUE INDEX contender_registration_code_UNIQUE ON contender (registration_code);
+CREATE INDEX contender_fk_contender_2_idx ON contender (contest_id, organizer_id);
+CREATE INDEX contender_fk_contender_4_idx ON contender (waitlist_class_id, contest_id);
+CREATE UNIQUE INDEX contender_index5 ON contender (id, organizer_id, contest_id);
+CREATE UNIQUE INDEX contender_index7 ON contender (id, organizer_id);
+CREATE INDEX contender_index6 ON contender (scrub_before, scrubbed_at, name);
+CREATE INDEX contender_fk_contender_3_idx ON contender (team_id, contest_id);
+
+CREATE TABLE round (
+  id INTEGER GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  organizer_id INT NOT NULL,
+  contest_id INT NOT NULL,
+  number INT NOT NULL,
+  name VARCHAR(32) NOT NULL,
+  qualifying_problems INT NOT NULL,
+  finalists INT NOT NULL,
+  CONSTRAINT fk_round_1
+    FOREIGN KEY (contest_id, organizer_id)
+    REFERENCES contest (id, organizer_id)
+    ON DELETE RESTRICT
+    ON UPDATE RESTRICT
+);
+
+CREATE INDEX round_fk_round_1_idx ON round (contest_id, organizer_id);
+CREATE UNIQUE INDEX round_index3 ON round (number, contest_id);
+
+CREATE TABLE problem (
+  id INTEGER GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  organizer_id INT NOT NULL,
+  contest_id INT NOT NULL,
+  round_id INT NULL DEFAULT NULL,
+  number INT NOT NULL,
+  hold_color_primary VARCHAR(7) NOT NULL,
+  hold_color_secondary VARCHAR(7) NULL DEFAULT NULL,
+  zone_1_enabled BOOLEAN NOT NULL DEFAULT FALSE,
+  zone_2_enabled BOOLEAN NOT NULL DEFAULT FALSE,
+  description VARCHAR(1024) NULL DEFAULT NULL,
+  sector VARCHAR(32) NULL DEFAULT NULL,
+  tags JSONB NULL DEFAULT NULL,
+  points_zone_1 INT NULL,
+  points_zone_2 INT NULL,
+  points_top INT NOT NULL,
+  flash_bonus INT NULL,
+  version INT NOT NULL DEFAULT 1,
+  CONSTRAINT fk_problem_1
+    FOREIGN KEY (contest_id, organizer_id)
+    REFERENCES contest (id, organizer_id)
+    ON DELETE RESTRICT
+    ON UPDATE RESTRICT,
+  CONSTRAINT fk_problem_2
+    FOREIGN KEY (round_id)
+    REFERENCES round (id)
+    ON DELETE RESTRICT
+    ON UPDATE RESTRICT
+);
+
+CREATE INDEX problem_fk_problem_1_idx ON problem (contest_id, organizer_id);
+CREATE INDEX problem_fk_problem_2_idx ON problem (round_id);
+CREATE UNIQUE INDEX problem_index3 ON problem (number, contest_id);
+CREATE UNIQUE INDEX problem_index5 ON problem (id, organizer_id, contest_id);
+
+CREATE TABLE problem_comp_class (
+  problem_id INT NOT NULL,
+  comp_class_id INT NOT NULL,
+  PRIMARY KEY (problem_id, comp_class_id),
+  CONSTRAINT fk_problem_comp_class_1
+    FOREIGN KEY (problem_id)
+    REFERENCES problem (id)
+    ON DELETE CASCADE
+    ON UPDATE CASCADE,
+  CONSTRAINT fk_problem_comp_class_2
+    FOREIGN KEY (comp_class_id)
+    REFERENCES comp_class (id)
+    ON DELETE CASCADE
+    ON UPDATE CASCADE
+);
+
+CREATE INDEX problem_comp_class_fk_problem_comp_class_2_idx ON problem_comp_class (comp_class_id);
+
+CREATE TABLE tick (
+  id INTEGER GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  organizer_id INT NOT NULL,
+  contest_id INT NOT NULL,
+  contender_id INT NOT NULL,
+  problem_id INT NOT NULL,
+  timestamp TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  zone_1 BOOLEAN NOT NULL DEFAULT FALSE,
+  attempts_zone_1 INT NOT NULL DEFAULT 0,
+  zone_2 BOOLEAN NOT NULL DEFAULT FALSE,
+  attempts_zone_2 INT NOT NULL DEFAULT 0,
+  top BOOLEAN NOT NULL DEFAULT FALSE,
+  attempts_top INT NOT NULL DEFAULT 0,
+  CONSTRAINT fk_tick_1
+    FOREIGN KEY (problem_id, organizer_id, contest_id)
+    REFERENCES problem (id, organizer_id, contest_id)
+    ON DELETE RESTRICT
+    ON UPDATE RESTRICT,
+  CONSTRAINT fk_tick_2
+    FOREIGN KEY (contender_id, organizer_id, contest_id)
+    REFERENCES contender (id, organizer_id, contest_id)
+    ON DELETE RESTRICT
+    ON UPDATE RESTRICT
+);
+
+CREATE INDEX tick_fk_tick_1_idx ON tick (problem_id, organizer_id, contest_id);
+CREATE INDEX tick_fk_tick_2_idx ON tick (contender_id, organizer_id, contest_id);
+CREATE UNIQUE INDEX tick_index4 ON tick (contender_id, problem_id);
+
+CREATE TABLE "user" (
+  id INTEGER GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  username VARCHAR(64) NOT NULL,
+  admin BOOLEAN NOT NULL DEFAULT FALSE
+);
+
+CREATE UNIQUE INDEX user_username_UNIQUE ON "user" (username);
+
+CREATE TABLE user_organizer (
+  user_id INT NOT NULL,
+  organizer_id INT NOT NULL,
+  PRIMARY KEY (user_id, organizer_id),
+  CONSTRAINT fk_user_organizer_1
+    FOREIGN KEY (user_id)
+    REFERENCES "user" (id)
+    ON DELETE CASCADE
+    ON UPDATE RESTRICT,
+  CONSTRAINT fk_user_organizer_2
+    FOREIGN KEY (organizer_id)
+    REFERENCES organizer (id)
+    ON DELETE CASCADE
+    ON UPDATE RESTRICT
+);
+
+CREATE INDEX user_organizer_fk_user_organizer_2_idx ON user_organizer (organizer_id);
+
+CREATE TABLE raffle (
+  id INTEGER GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  organizer_id INT NOT NULL,
+  contest_id INT NOT NULL,
+  rules JSONB NULL DEFAULT NULL,
+  seed CHAR(64) NULL DEFAULT NULL,
+  seed_commitment CHAR(64) NULL DEFAULT NULL,
+  seed_revealed_at TIMESTAMPTZ NULL DEFAULT NULL,
+  CONSTRAINT fk_raffle_1
+    FOREIGN KEY (contest_id, organizer_id)
+    REFERENCES contest (id, organizer_id)
+    ON DELETE NO ACTION
+    ON UPDATE NO ACTION
+);
+
+CREATE INDEX raffle_fk_raffle_1_idx ON raffle (contest_id, organizer_id);
+CREATE UNIQUE INDEX raffle_index3 ON raffle (id, organizer_id);
+
+CREATE TABLE raffle_prize (
+  id INTEGER GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  organizer_id INT NOT NULL,
+  raffle_id INT NOT NULL,
+  name VARCHAR(64) NOT NULL,
+  quantity INT NOT NULL,
+  CONSTRAINT fk_raffle_prize_1
+    FOREIGN KEY (raffle_id, organizer_id)
+    REFERENCES raffle (id, organizer_id)
+    ON DELETE NO ACTION
+    ON UPDATE NO ACTION
+);
+
+CREATE INDEX raffle_prize_fk_raffle_prize_1_idx ON raffle_prize (raffle_id, organizer_id);
+
+CREATE TABLE raffle_winner (
+  id INTEGER GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  organizer_id INT NOT NULL,
+  raffle_id INT NOT NULL,
+  contender_id INT NOT NULL,
+  prize_id INT NULL DEFAULT NULL,
+  timestamp TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  rules JSONB NULL DEFAULT NULL,
+  claimed_at TIMESTAMPTZ NULL DEFAULT NULL,
+  voided_at TIMESTAMPTZ NULL DEFAULT NULL,
+  draw JSONB NULL DEFAULT NULL,
+  CONSTRAINT fk_raffle_winner_1
+    FOREIGN KEY (raffle_id, organizer_id)
+    REFERENCES raffle (id, organizer_id)
+    ON DELETE NO ACTION
+    ON UPDATE NO ACTION,
+  CONSTRAINT fk_raffle_winner_2
+    FOREIGN KEY (contender_id, organizer_id)
+    REFERENCES contender (id, organizer_id)
+    ON DELETE NO ACTION
+    ON UPDATE NO ACTION,
+  CONSTRAINT fk_raffle_winner_3
+    FOREIGN KEY (prize_id)
+    REFERENCES raffle_prize (id)
+    ON DELETE NO ACTION
+    ON UPDATE NO ACTION
+);
+
+CREATE INDEX raffle_winner_fk_raffle_winner_1_idx ON raffle_winner (raffle_id, organizer_id);
+CREATE INDEX raffle_winner_fk_raffle_winner_2_idx ON raffle_winner (contender_id, organizer_id);
+CREATE UNIQUE INDEX raffle_winner_index4 ON raffle_winner (raffle_id, contender_id);
+CREATE INDEX raffle_winner_fk_raffle_winner_3_idx ON raffle_winner (prize_id);
+
+CREATE TABLE score (
+  contender_id INT NOT NULL PRIMARY KEY,
+  timestamp TIMESTAMPTZ NOT NULL,
+  score INT NOT NULL,
+  placement INT NOT NULL,
+  finalist BOOLEAN NOT NULL,
+  rank_order INT NOT NULL,
+  CONSTRAINT fk_score_1
+    FOREIGN KEY (contender_id)
+    REFERENCES contender (id)
+    ON DELETE CASCADE
+    ON UPDATE CASCADE
+);
+
+CREATE TABLE organizer_invite (
+  id VARCHAR(36) NOT NULL PRIMARY KEY,
+  organizer_id INT NOT NULL,
+  expires_at TIMESTAMPTZ NOT NULL,
+  CONSTRAINT fk_organizer_invite_1
+    FOREIGN KEY (organizer_id)
+    REFERENCES organizer (id)
+    ON DELETE CASCADE
+    ON UPDATE CASCADE
+);
+
+CREATE INDEX organizer_invite_fk_organizer_invite_1_idx ON organizer_invite (organizer_id);
+
+CREATE TABLE round_contender (
+  round_id INT NOT NULL,
+  contender_id INT NOT NULL,
+  previous_placement INT NOT NULL,
+  timestamp TIMESTAMPTZ NULL DEFAULT NULL,
+  score INT NULL DEFAULT NULL,
+  placement INT NULL DEFAULT NULL,
+  finalist BOOLEAN NULL DEFAULT NULL,
+  rank_order INT NULL DEFAULT NULL,
+  PRIMARY KEY (round_id, contender_id),
+  CONSTRAINT fk_round_contender_1
+    FOREIGN KEY (round_id)
+    REFERENCES round (id)
+    ON DELETE CASCADE
+    ON UPDATE CASCADE,
+  CONSTRAINT fk_round_contender_2
+    FOREIGN KEY (contender_id)
+    REFERENCES contender (id)
+    ON DELETE CASCADE
+    ON UPDATE CASCADE
+);
+
+CREATE INDEX round_contender_fk_round_contender_2_idx ON round_contender (contender_id);
+
+CREATE TABLE audit_entry (
+  id INTEGER GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  organizer_id INT NOT NULL,
+  contest_id INT NOT NULL,
+  contender_id INT NULL DEFAULT NULL,
+  action VARCHAR(32) NOT NULL,
+  timestamp TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  CONSTRAINT fk_audit_entry_1
+    FOREIGN KEY (contest_id, organizer_id)
+    REFERENCES contest (id, organizer_id)
+    ON DELETE CASCADE
+    ON UPDATE NO ACTION
+);
+
+CREATE INDEX audit_entry_fk_audit_entry_1_idx ON audit_entry (contest_id, organizer_id);
+CREATE INDEX audit_entry_index3 ON audit_entry (contender_id);
+
+CREATE TABLE retention_policy (
+  id INTEGER GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  organizer_id INT NOT NULL,
+  target VARCHAR(32) NOT NULL,
+  retention_period INT NOT NULL,
+  enabled BOOLEAN NOT NULL DEFAULT TRUE,
+  created TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  CONSTRAINT fk_retention_policy_1
+    FOREIGN KEY (organizer_id)
+    REFERENCES organizer (id)
+    ON DELETE CASCADE
+    ON UPDATE NO ACTION
+);
+
+CREATE INDEX retention_policy_fk_retention_policy_1_idx ON retention_policy (organizer_id);
+
+CREATE TABLE retention_policy_execution (
+  id INTEGER GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  organizer_id INT NOT NULL,
+  retention_policy_id INT NOT NULL,
+  timestamp TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  contests INT NOT NULL,
+  contenders INT NOT NULL,
+  ticks INT NOT NULL,
+  error TEXT NULL DEFAULT NULL,
+  CONSTRAINT fk_retention_policy_execution_1
+    FOREIGN KEY (retention_policy_id)
+    REFERENCES retention_policy (id)
+    ON DELETE CASCADE
+    ON UPDATE NO ACTION
+);
+
+CREATE INDEX retention_policy_execution_fk_retention_policy_execution_1_idx ON retention_policy_execution (retention_policy_id);
+
+CREATE TABLE tick_revision (
+  id INTEGER GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  contest_id INT NOT NULL,
+  contender_id INT NOT NULL,
+  problem_id INT NOT NULL,
+  tick_id INT NOT NULL,
+  action VARCHAR(16) NOT NULL,
+  timestamp TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  actor_role VARCHAR(16) NOT NULL,
+  actor_username VARCHAR(64) NULL DEFAULT NULL,
+  idempotency_key VARCHAR(64) NULL DEFAULT NULL,
+  zone_1 BOOLEAN NOT NULL DEFAULT FALSE,
+  attempts_zone_1 INT NOT NULL DEFAULT 0,
+  zone_2 BOOLEAN NOT NULL DEFAULT FALSE,
+  attempts_zone_2 INT NOT NULL DEFAULT 0,
+  top BOOLEAN NOT NULL DEFAULT FALSE,
+  attempts_top INT NOT NULL DEFAULT 0
+);
+
+CREATE INDEX tick_revision_index2 ON tick_revision (contender_id, problem_id);
+CREATE INDEX tick_revision_index3 ON tick_revision (contest_id);
+CREATE UNIQUE INDEX tick_revision_index4 ON tick_revision (contender_id, idempotency_key);
+
+CREATE TABLE tick_dispute (
+  id INTEGER GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  organizer_id INT NOT NULL,
+  contest_id INT NOT NULL,
+  contender_id INT NOT NULL,
+  problem_id INT NOT NULL,
+  status VARCHAR(16) NOT NULL,
+  comment VARCHAR(1024) NOT NULL,
+  response VARCHAR(1024) NULL DEFAULT NULL,
+  zone_1 BOOLEAN NOT NULL DEFAULT FALSE,
+  attempts_zone_1 INT NOT NULL DEFAULT 0,
+  zone_2 BOOLEAN NOT NULL DEFAULT FALSE,
+  attempts_zone_2 INT NOT NULL DEFAULT 0,
+  top BOOLEAN NOT NULL DEFAULT FALSE,
+  attempts_top INT NOT NULL DEFAULT 0,
+  created TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  resolved TIMESTAMPTZ NULL DEFAULT NULL,
+  resolved_by VARCHAR(64) NULL DEFAULT NULL,
+  CONSTRAINT fk_tick_dispute_1
+    FOREIGN KEY (contender_id, organizer_id, contest_id)
+    REFERENCES contender (id, organizer_id, contest_id)
+    ON DELETE CASCADE
+    ON UPDATE NO ACTION,
+  CONSTRAINT fk_tick_dispute_2
+    FOREIGN KEY (problem_id, organizer_id, contest_id)
+    REFERENCES problem (id, organizer_id, contest_id)
+    ON DELETE CASCADE
+    ON UPDATE NO ACTION
+);
+
+CREATE INDEX tick_dispute_fk_tick_dispute_1_idx ON tick_dispute (contender_id, organizer_id, contest_id);
+CREATE INDEX tick_dispute_fk_tick_dispute_2_idx ON tick_dispute (problem_id, organizer_id, contest_id);
+CREATE INDEX tick_dispute_index3 ON tick_dispute (contest_id, status);
+
+-- +goose Down
+DROP TABLE tick_dispute;
+DROP TABLE tick_revision;
+DROP TABLE retention_policy_execution;
+DROP TABLE retention_policy;
+DROP TABLE audit_entry;
+DROP TABLE round_contender;
+DROP TABLE score;
+DROP TABLE raffle_winner;
+DROP TABLE raffle_prize;
+DROP TABLE raffle;
+DROP TABLE organizer_invite;
+DROP TABLE user_organizer;
+DROP TABLE "user";
+DROP TABLE tick;
+DROP TABLE problem_comp_class;
+DROP TABLE problem;
+DROP TABLE round;
+DROP TABLE contender;
+DROP TABLE team;
+DROP TABLE comp_class;
+DROP TABLE contest;
+DROP TABLE series;
+DROP TABLE organizer;
@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE round ADD COLUMN time_begin TIMESTAMPTZ NULL DEFAULT NULL;
+ALTER TABLE round ADD COLUMN time_end TIMESTAMPTZ NULL DEFAULT NULL;
+
+-- +goose Down
+ALTER TABLE round DROP COLUMN time_end;
+ALTER TABLE round DROP COLUMN time_begin;
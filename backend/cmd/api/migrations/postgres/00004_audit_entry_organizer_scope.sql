@@ -0,0 +1,10 @@
+-- +goose Up
+ALTER TABLE audit_entry DROP CONSTRAINT fk_audit_entry_1;
+ALTER TABLE audit_entry ADD CONSTRAINT fk_audit_entry_1 FOREIGN KEY (organizer_id) REFERENCES organizer (id) ON DELETE CASCADE ON UPDATE NO ACTION;
+CREATE INDEX audit_entry_fk_audit_entry_2_idx ON audit_entry (organizer_id);
+
+-- +goose Down
+DELETE FROM audit_entry WHERE contest_id NOT IN (SELECT id FROM contest);
+ALTER TABLE audit_entry DROP CONSTRAINT fk_audit_entry_1;
+DROP INDEX audit_entry_fk_audit_entry_2_idx;
+ALTER TABLE audit_entry ADD CONSTRAINT fk_audit_entry_1 FOREIGN KEY (contest_id, organizer_id) REFERENCES contest (id, organizer_id) ON DELETE CASCADE ON UPDATE NO ACTION;
@@ -0,0 +1,6 @@
+-- +goose Up
+ALTER TABLE tick_revision ADD COLUMN recorded_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP;
+UPDATE tick_revision SET recorded_at = timestamp;
+
+-- +goose Down
+ALTER TABLE tick_revision DROP COLUMN recorded_at;
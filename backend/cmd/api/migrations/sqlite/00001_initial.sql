@@ -0,0 +1,513 @@
+-- +goose Up
+CREATE TABLE "organizer" (
+  "id" INTEGER PRIMARY KEY AUTOINCREMENT,
+  "name" VARCHAR(32) NOT NULL
+);
+
+CREATE TABLE "series" (
+  "id" INTEGER PRIMARY KEY AUTOINCREMENT,
+  "organizer_id" INT NOT NULL,
+  "name" VARCHAR(64) NOT NULL,
+  CONSTRAINT "fk_series_1"
+    FOREIGN KEY ("organizer_id")
+    REFERENCES "organizer" ("id")
+    ON DELETE RESTRICT
+    ON UPDATE RESTRICT
+);
+
+CREATE INDEX "series_fk_series_1" ON "series" ("organizer_id");
+CREATE UNIQUE INDEX "series_index3" ON "series" ("id", "organizer_id");
+
+CREATE TABLE "contest" (
+  "id" INTEGER PRIMARY KEY AUTOINCREMENT,
+  "organizer_id" INT NOT NULL,
+  "archived_at" TIMESTAMP DEFAULT NULL,
+  "series_id" INT,
+  "name" VARCHAR(64) NOT NULL,
+  "description" TEXT,
+  "location" VARCHAR(1024) DEFAULT NULL,
+  "country" VARCHAR(2) NOT NULL DEFAULT 'AQ',
+  "qualifying_problems" INT NOT NULL,
+  "finalists" INT NOT NULL,
+  "info" TEXT,
+  "grace_period" INT NOT NULL DEFAULT 300,
+  "name_retention_time" INT NOT NULL DEFAULT 20160,
+  "scoreboard_freeze" INT NOT NULL DEFAULT 0,
+  "scoreboard_revealed_at" TIMESTAMP DEFAULT NULL,
+  "self_registration" TINYINT(1) NOT NULL DEFAULT 0,
+  "created" TIMESTAMP NOT NULL DEFAULT '1970-01-01 00:00:01',
+  "version" INT NOT NULL DEFAULT 1,
+  CONSTRAINT "fk_contest_2"
+    FOREIGN KEY ("organizer_id")
+    REFERENCES "organizer" ("id")
+    ON DELETE RESTRICT
+    ON UPDATE RESTRICT,
+  CONSTRAINT "fk_contest_3"
+    FOREIGN KEY ("series_id", "organizer_id")
+    REFERENCES "series" ("id", "organizer_id")
+    ON DELETE RESTRICT
+    ON UPDATE RESTRICT
+);
+
+CREATE INDEX "contest_fk_contest_2_idx" ON "contest" ("organizer_id");
+CREATE INDEX "contest_fk_contest_3" ON "contest" ("series_id", "organizer_id");
+CREATE UNIQUE INDEX "contest_index5" ON "contest" ("id", "organizer_id");
+
+CREATE TABLE "comp_class" (
+  "id" INTEGER PRIMARY KEY AUTOINCREMENT,
+  "organizer_id" INT NOT NULL,
+  "contest_id" INT NOT NULL,
+  "name" VARCHAR(45) NOT NULL,
+  "description" VARCHAR(255),
+  "color" VARCHAR(7),
+  "time_begin" TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  "time_end" TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  "capacity" INT NOT NULL DEFAULT 0,
+  CONSTRAINT "fk_comp_class_1"
+    FOREIGN KEY ("contest_id", "organizer_id")
+    REFERENCES "contest" ("id", "organizer_id")
+    ON DELETE CASCADE
+    ON UPDATE RESTRICT
+);
+
+CREATE UNIQUE INDEX "comp_class_index3" ON "comp_class" ("id", "contest_id");
+CREATE INDEX "comp_class_fk_comp_class_1_idx" ON "comp_class" ("contest_id", "organizer_id");
+
+CREATE TABLE "team" (
+  "id" INTEGER PRIMARY KEY AUTOINCREMENT,
+  "organizer_id" INT NOT NULL,
+  "contest_id" INT NOT NULL,
+  "name" VARCHAR(32) NOT NULL,
+  "counted_members" INT NOT NULL,
+  CONSTRAINT "fk_team_1"
+    FOREIGN KEY ("contest_id", "organizer_id")
+    REFERENCES "contest" ("id", "organizer_id")
+    ON DELETE RESTRICT
+    ON UPDATE RESTRICT
+);
+
+CREATE INDEX "team_fk_team_1_idx" ON "team" ("contest_id", "organizer_id");
+CREATE UNIQUE INDEX "team_index3" ON "team" ("id", "contest_id");
+
+CREATE TABLE "contender" (
+  "id" INTEGER PRIMARY KEY AUTOINCREMENT,
+  "organizer_id" INT NOT NULL,
+  "contest_id" INT NOT NULL,
+  "registration_code" VARCHAR(16) NOT NULL,
+  "name" VARCHAR(64),
+  "class_id" INT,
+  "team_id" INT DEFAULT NULL,
+  "entered" TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  "disqualified" TINYINT(1) NOT NULL DEFAULT 0,
+  "withdrawn_from_finals" TINYINT(1) NOT NULL DEFAULT 0,
+  "scrubbed_at" TIMESTAMP DEFAULT NULL,
+  "scrub_before" TIMESTAMP DEFAULT NULL,
+  "waitlist_class_id" INT DEFAULT NULL,
+  "waitlisted_at" TIMESTAMP DEFAULT NULL,
+  "version" INT NOT NULL DEFAULT 1,
+  CONSTRAINT "fk_contender_1"
+    FOREIGN KEY ("class_id", "contest_id")
+    REFERENCES "comp_class" ("id", "contest_id")
+    ON DELETE RESTRICT
+    ON UPDATE RESTRICT,
+  CONSTRAINT "fk_contender_2"
+    FOREIGN KEY ("contest_id", "organizer_id")
+    REFERENCES "contest" ("id", "organizer_id")
+    ON DELETE RESTRICT
+    ON UPDATE RESTRICT,
+  CONSTRAINT "fk_contender_3"
+    FOREIGN KEY ("team_id", "contest_id")
+    REFERENCES "team" ("id", "contest_id")
+    ON DELETE RESTRICT
+    ON UPDATE RESTRICT,
+  CONSTRAINT "fk_contender_4"
+    FOREIGN KEY ("waitlist_class_id", "contest_id")
+    REFERENCES "comp_class" ("id", "contest_id")
+    ON DELETE RESTRICT
+    ON UPDATE RESTRICT
+);
+
+CREATE INDEX "contender_fk_contender_1_idx" ON "contender" ("class_id", "contest_id");
+CREATE UNIQUE INDEX "contender_registration_code_UNIQUE" ON "contender" ("registration_code");
+CREATE INDEX "contender_fk_contender_2_idx" ON "contender" ("contest_id", "organizer_id");
+CREATE INDEX "contender_fk_contender_4_idx" ON "contender" ("waitlist_class_id", "contest_id");
+CREATE UNIQUE INDEX "contender_index5" ON "contender" ("id", "organizer_id", "contest_id");
+CREATE UNIQUE INDEX "contender_index7" ON "contender" ("id", "organizer_id");
+CREATE INDEX "contender_index6" ON "contender" ("scrub_before", "scrubbed_at", "name");
+CREATE INDEX "contender_fk_contender_3_idx" ON "contender" ("team_id", "contest_id");
+
+CREATE TABLE "problem" (
+  "id" INTEGER PRIMARY KEY AUTOINCREMENT,
+  "organizer_id" INT NOT NULL,
+  "contest_id" INT NOT NULL,
+  "round_id" INT DEFAULT NULL,
+  "number" INT NOT NULL,
+  "hold_color_primary" VARCHAR(7) NOT NULL,
+  "hold_color_secondary" VARCHAR(7) DEFAULT NULL,
+  "zone_1_enabled" TINYINT(1) NOT NULL DEFAULT 0,
+  "zone_2_enabled" TINYINT(1) NOT NULL DEFAULT 0,
+  "description" VARCHAR(1024) DEFAULT NULL,
+  "sector" VARCHAR(32) DEFAULT NULL,
+  "tags" JSON DEFAULT NULL,
+  "points_zone_1" INT,
+  "points_zone_2" INT,
+  "points_top" INT NOT NULL,
+  "flash_bonus" INT,
+  "version" INT NOT NULL DEFAULT 1,
+  CONSTRAINT "fk_problem_1"
+    FOREIGN KEY ("contest_id", "organizer_id")
+    REFERENCES "contest" ("id", "organizer_id")
+    ON DELETE RESTRICT
+    ON UPDATE RESTRICT,
+  CONSTRAINT "fk_problem_2"
+    FOREIGN KEY ("round_id")
+    REFERENCES "round" ("id")
+    ON DELETE RESTRICT
+    ON UPDATE RESTRICT
+);
+
+CREATE INDEX "problem_fk_problem_1_idx" ON "problem" ("contest_id", "organizer_id");
+CREATE INDEX "problem_fk_problem_2_idx" ON "problem" ("round_id");
+CREATE UNIQUE INDEX "problem_index3" ON "problem" ("number", "contest_id");
+CREATE UNIQUE INDEX "problem_index5" ON "problem" ("id", "organizer_id", "contest_id");
+
+CREATE TABLE "problem_comp_class" (
+  "problem_id" INT NOT NULL,
+  "comp_class_id" INT NOT NULL,
+  PRIMARY KEY ("problem_id", "comp_class_id"),
+  CONSTRAINT "fk_problem_comp_class_1"
+    FOREIGN KEY ("problem_id")
+    REFERENCES "problem" ("id")
+    ON DELETE CASCADE
+    ON UPDATE CASCADE,
+  CONSTRAINT "fk_problem_comp_class_2"
+    FOREIGN KEY ("comp_class_id")
+    REFERENCES "comp_class" ("id")
+    ON DELETE CASCADE
+    ON UPDATE CASCADE
+);
+
+CREATE INDEX "problem_comp_class_fk_problem_comp_class_2_idx" ON "problem_comp_class" ("comp_class_id");
+
+CREATE TABLE "tick" (
+  "id" INTEGER PRIMARY KEY AUTOINCREMENT,
+  "organizer_id" INT NOT NULL,
+  "contest_id" INT NOT NULL,
+  "contender_id" INT NOT NULL,
+  "problem_id" INT NOT NULL,
+  "timestamp" TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  "zone_1" TINYINT(1) NOT NULL DEFAULT 0,
+  "attempts_zone_1" INT NOT NULL DEFAULT 0,
+  "zone_2" TINYINT(1) NOT NULL DEFAULT 0,
+  "attempts_zone_2" INT NOT NULL DEFAULT 0,
+  "top" TINYINT(1) NOT NULL DEFAULT 0,
+  "attempts_top" INT NOT NULL DEFAULT 0,
+  CONSTRAINT "fk_tick_1"
+    FOREIGN KEY ("problem_id", "organizer_id", "contest_id")
+    REFERENCES "problem" ("id", "organizer_id", "contest_id")
+    ON DELETE RESTRICT
+    ON UPDATE RESTRICT,
+  CONSTRAINT "fk_tick_2"
+    FOREIGN KEY ("contender_id", "organizer_id", "contest_id")
+    REFERENCES "contender" ("id", "organizer_id", "contest_id")
+    ON DELETE RESTRICT
+    ON UPDATE RESTRICT
+);
+
+CREATE INDEX "tick_fk_tick_1_idx" ON "tick" ("problem_id", "organizer_id", "contest_id");
+CREATE INDEX "tick_fk_tick_2_idx" ON "tick" ("contender_id", "organizer_id", "contest_id");
+CREATE UNIQUE INDEX "tick_index4" ON "tick" ("contender_id", "problem_id");
+
+CREATE TABLE "user" (
+  "id" INTEGER PRIMARY KEY AUTOINCREMENT,
+  "username" VARCHAR(64) NOT NULL,
+  "admin" TINYINT(1) NOT NULL DEFAULT 0
+);
+
+CREATE UNIQUE INDEX "user_username_UNIQUE" ON "user" ("username");
+
+CREATE TABLE "user_organizer" (
+  "user_id" INT NOT NULL,
+  "organizer_id" INT NOT NULL,
+  PRIMARY KEY ("user_id", "organizer_id"),
+  CONSTRAINT "fk_user_organizer_1"
+    FOREIGN KEY ("user_id")
+    REFERENCES "user" ("id")
+    ON DELETE CASCADE
+    ON UPDATE RESTRICT,
+  CONSTRAINT "fk_user_organizer_2"
+    FOREIGN KEY ("organizer_id")
+    REFERENCES "organizer" ("id")
+    ON DELETE CASCADE
+    ON UPDATE RESTRICT
+);
+
+CREATE INDEX "user_organizer_fk_user_organizer_2_idx" ON "user_organizer" ("organizer_id");
+
+CREATE TABLE "raffle" (
+  "id" INTEGER PRIMARY KEY AUTOINCREMENT,
+  "organizer_id" INT NOT NULL,
+  "contest_id" INT NOT NULL,
+  "rules" JSON DEFAULT NULL,
+  "seed" CHAR(64) DEFAULT NULL,
+  "seed_commitment" CHAR(64) DEFAULT NULL,
+  "seed_revealed_at" TIMESTAMP DEFAULT NULL,
+  CONSTRAINT "fk_raffle_1"
+    FOREIGN KEY ("contest_id", "organizer_id")
+    REFERENCES "contest" ("id", "organizer_id")
+    ON DELETE NO ACTION
+    ON UPDATE NO ACTION
+);
+
+CREATE INDEX "raffle_fk_raffle_1_idx" ON "raffle" ("contest_id", "organizer_id");
+CREATE UNIQUE INDEX "raffle_index3" ON "raffle" ("id", "organizer_id");
+
+CREATE TABLE "raffle_prize" (
+  "id" INTEGER PRIMARY KEY AUTOINCREMENT,
+  "organizer_id" INT NOT NULL,
+  "raffle_id" INT NOT NULL,
+  "name" VARCHAR(64) NOT NULL,
+  "quantity" INT NOT NULL,
+  CONSTRAINT "fk_raffle_prize_1"
+    FOREIGN KEY ("raffle_id", "organizer_id")
+    REFERENCES "raffle" ("id", "organizer_id")
+    ON DELETE NO ACTION
+    ON UPDATE NO ACTION
+);
+
+CREATE INDEX "raffle_prize_fk_raffle_prize_1_idx" ON "raffle_prize" ("raffle_id", "organizer_id");
+
+CREATE TABLE "raffle_winner" (
+  "id" INTEGER PRIMARY KEY AUTOINCREMENT,
+  "organizer_id" INT NOT NULL,
+  "raffle_id" INT NOT NULL,
+  "contender_id" INT NOT NULL,
+  "prize_id" INT DEFAULT NULL,
+  "timestamp" TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  "rules" JSON DEFAULT NULL,
+  "claimed_at" TIMESTAMP DEFAULT NULL,
+  "voided_at" TIMESTAMP DEFAULT NULL,
+  "draw" JSON DEFAULT NULL,
+  CONSTRAINT "fk_raffle_winner_1"
+    FOREIGN KEY ("raffle_id", "organizer_id")
+    REFERENCES "raffle" ("id", "organizer_id")
+    ON DELETE NO ACTION
+    ON UPDATE NO ACTION,
+  CONSTRAINT "fk_raffle_winner_2"
+    FOREIGN KEY ("contender_id", "organizer_id")
+    REFERENCES "contender" ("id", "organizer_id")
+    ON DELETE NO ACTION
+    ON UPDATE NO ACTION,
+  CONSTRAINT "fk_raffle_winner_3"
+    FOREIGN KEY ("prize_id")
+    REFERENCES "raffle_prize" ("id")
+    ON DELETE NO ACTION
+    ON UPDATE NO ACTION
+);
+
+CREATE INDEX "raffle_winner_fk_raffle_winner_1_idx" ON "raffle_winner" ("raffle_id", "organizer_id");
+CREATE INDEX "raffle_winner_fk_raffle_winner_2_idx" ON "raffle_winner" ("contender_id", "organizer_id");
+CREATE UNIQUE INDEX "raffle_winner_index4" ON "raffle_winner" ("raffle_id", "contender_id");
+CREATE INDEX "raffle_winner_fk_raffle_winner_3_idx" ON "raffle_winner" ("prize_id");
+
+CREATE TABLE "score" (
+  "contender_id" INT NOT NULL PRIMARY KEY,
+  "timestamp" TIMESTAMP NOT NULL,
+  "score" INT NOT NULL,
+  "placement" INT NOT NULL,
+  "finalist" TINYINT(1) NOT NULL,
+  "rank_order" INT NOT NULL,
+  CONSTRAINT "fk_score_1"
+    FOREIGN KEY ("contender_id")
+    REFERENCES "contender" ("id")
+    ON DELETE CASCADE
+    ON UPDATE CASCADE
+);
+
+CREATE TABLE "organizer_invite" (
+  "id" VARCHAR(36) NOT NULL PRIMARY KEY,
+  "organizer_id" INT NOT NULL,
+  "expires_at" TIMESTAMP NOT NULL,
+  CONSTRAINT "fk_organizer_invite_1"
+    FOREIGN KEY ("organizer_id")
+    REFERENCES "organizer" ("id")
+    ON DELETE CASCADE
+    ON UPDATE CASCADE
+);
+
+CREATE INDEX "organizer_invite_fk_organizer_invite_1_idx" ON "organizer_invite" ("organizer_id");
+
+CREATE TABLE "round" (
+  "id" INTEGER PRIMARY KEY AUTOINCREMENT,
+  "organizer_id" INT NOT NULL,
+  "contest_id" INT NOT NULL,
+  "number" INT NOT NULL,
+  "name" VARCHAR(32) NOT NULL,
+  "qualifying_problems" INT NOT NULL,
+  "finalists" INT NOT NULL,
+  CONSTRAINT "fk_round_1"
+    FOREIGN KEY ("contest_id", "organizer_id")
+    REFERENCES "contest" ("id", "organizer_id")
+    ON DELETE RESTRICT
+    ON UPDATE RESTRICT
+);
+
+CREATE INDEX "round_fk_round_1_idx" ON "round" ("contest_id", "organizer_id");
+CREATE UNIQUE INDEX "round_index3" ON "round" ("number", "contest_id");
+
+CREATE TABLE "round_contender" (
+  "round_id" INT NOT NULL,
+  "contender_id" INT NOT NULL,
+  "previous_placement" INT NOT NULL,
+  "timestamp" TIMESTAMP DEFAULT NULL,
+  "score" INT DEFAULT NULL,
+  "placement" INT DEFAULT NULL,
+  "finalist" TINYINT(1) DEFAULT NULL,
+  "rank_order" INT DEFAULT NULL,
+  PRIMARY KEY ("round_id", "contender_id"),
+  CONSTRAINT "fk_round_contender_1"
+    FOREIGN KEY ("round_id")
+    REFERENCES "round" ("id")
+    ON DELETE CASCADE
+    ON UPDATE CASCADE,
+  CONSTRAINT "fk_round_contender_2"
+    FOREIGN KEY ("contender_id")
+    REFERENCES "contender" ("id")
+    ON DELETE CASCADE
+    ON UPDATE CASCADE
+);
+
+CREATE INDEX "round_contender_fk_round_contender_2_idx" ON "round_contender" ("contender_id");
+
+CREATE TABLE "audit_entry" (
+  "id" INTEGER PRIMARY KEY AUTOINCREMENT,
+  "organizer_id" INT NOT NULL,
+  "contest_id" INT NOT NULL,
+  "contender_id" INT DEFAULT NULL,
+  "action" VARCHAR(32) NOT NULL,
+  "timestamp" TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  CONSTRAINT "fk_audit_entry_1"
+    FOREIGN KEY ("contest_id", "organizer_id")
+    REFERENCES "contest" ("id", "organizer_id")
+    ON DELETE CASCADE
+    ON UPDATE NO ACTION
+);
+
+CREATE INDEX "audit_entry_fk_audit_entry_1_idx" ON "audit_entry" ("contest_id", "organizer_id");
+CREATE INDEX "audit_entry_index3" ON "audit_entry" ("contender_id");
+
+CREATE TABLE "retention_policy" (
+  "id" INTEGER PRIMARY KEY AUTOINCREMENT,
+  "organizer_id" INT NOT NULL,
+  "target" VARCHAR(32) NOT NULL,
+  "retention_period" INT NOT NULL,
+  "enabled" TINYINT(1) NOT NULL DEFAULT 1,
+  "created" TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  CONSTRAINT "fk_retention_policy_1"
+    FOREIGN KEY ("organizer_id")
+    REFERENCES "organizer" ("id")
+    ON DELETE CASCADE
+    ON UPDATE NO ACTION
+);
+
+CREATE INDEX "retention_policy_fk_retention_policy_1_idx" ON "retention_policy" ("organizer_id");
+
+CREATE TABLE "retention_policy_execution" (
+  "id" INTEGER PRIMARY KEY AUTOINCREMENT,
+  "organizer_id" INT NOT NULL,
+  "retention_policy_id" INT NOT NULL,
+  "timestamp" TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  "contests" INT NOT NULL,
+  "contenders" INT NOT NULL,
+  "ticks" INT NOT NULL,
+  "error" TEXT DEFAULT NULL,
+  CONSTRAINT "fk_retention_policy_execution_1"
+    FOREIGN KEY ("retention_policy_id")
+    REFERENCES "retention_policy" ("id")
+    ON DELETE CASCADE
+    ON UPDATE NO ACTION
+);
+
+CREATE INDEX "retention_policy_execution_fk_retention_policy_execution_1_idx" ON "retention_policy_execution" ("retention_policy_id");
+
+CREATE TABLE "tick_revision" (
+  "id" INTEGER PRIMARY KEY AUTOINCREMENT,
+  "contest_id" INT NOT NULL,
+  "contender_id" INT NOT NULL,
+  "problem_id" INT NOT NULL,
+  "tick_id" INT NOT NULL,
+  "action" VARCHAR(16) NOT NULL,
+  "timestamp" TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  "actor_role" VARCHAR(16) NOT NULL,
+  "actor_username" VARCHAR(64) DEFAULT NULL,
+  "idempotency_key" VARCHAR(64) DEFAULT NULL,
+  "zone_1" TINYINT(1) NOT NULL DEFAULT 0,
+  "attempts_zone_1" INT NOT NULL DEFAULT 0,
+  "zone_2" TINYINT(1) NOT NULL DEFAULT 0,
+  "attempts_zone_2" INT NOT NULL DEFAULT 0,
+  "top" TINYINT(1) NOT NULL DEFAULT 0,
+  "attempts_top" INT NOT NULL DEFAULT 0
+);
+
+CREATE INDEX "tick_revision_index2" ON "tick_revision" ("contender_id", "problem_id");
+CREATE INDEX "tick_revision_index3" ON "tick_revision" ("contest_id");
+CREATE UNIQUE INDEX "tick_revision_index4" ON "tick_revision" ("contender_id", "idempotency_key");
+
+CREATE TABLE "tick_dispute" (
+  "id" INTEGER PRIMARY KEY AUTOINCREMENT,
+  "organizer_id" INT NOT NULL,
+  "contest_id" INT NOT NULL,
+  "contender_id" INT NOT NULL,
+  "problem_id" INT NOT NULL,
+  "status" VARCHAR(16) NOT NULL,
+  "comment" VARCHAR(1024) NOT NULL,
+  "response" VARCHAR(1024) DEFAULT NULL,
+  "zone_1" TINYINT(1) NOT NULL DEFAULT 0,
+  "attempts_zone_1" INT NOT NULL DEFAULT 0,
+  "zone_2" TINYINT(1) NOT NULL DEFAULT 0,
+  "attempts_zone_2" INT NOT NULL DEFAULT 0,
+  "top" TINYINT(1) NOT NULL DEFAULT 0,
+  "attempts_top" INT NOT NULL DEFAULT 0,
+  "created" TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  "resolved" TIMESTAMP DEFAULT NULL,
+  "resolved_by" VARCHAR(64) DEFAULT NULL,
+  CONSTRAINT "fk_tick_dispute_1"
+    FOREIGN KEY ("contender_id", "organizer_id", "contest_id")
+    REFERENCES "contender" ("id", "organizer_id", "contest_id")
+    ON DELETE CASCADE
+    ON UPDATE NO ACTION,
+  CONSTRAINT "fk_tick_dispute_2"
+    FOREIGN KEY ("problem_id", "organizer_id", "contest_id")
+    REFERENCES "problem" ("id", "organizer_id", "contest_id")
+    ON DELETE CASCADE
+    ON UPDATE NO ACTION
+);
+
+CREATE INDEX "tick_dispute_fk_tick_dispute_1_idx" ON "tick_dispute" ("contender_id", "organizer_id", "contest_id");
+CREATE INDEX "tick_dispute_fk_tick_dispute_2_idx" ON "tick_dispute" ("problem_id", "organizer_id", "contest_id");
+CREATE INDEX "tick_dispute_index3" ON "tick_dispute" ("contest_id", "status");
+
+-- +goose Down
+DROP TABLE "tick_dispute";
+DROP TABLE "tick_revision";
+DROP TABLE "retention_policy_execution";
+DROP TABLE "retention_policy";
+DROP TABLE "audit_entry";
+DROP TABLE "round_contender";
+DROP TABLE "score";
+DROP TABLE "raffle_winner";
+DROP TABLE "raffle_prize";
+DROP TABLE "raffle";
+DROP TABLE "organizer_invite";
+DROP TABLE "user_organizer";
+DROP TABLE "user";
+DROP TABLE "tick";
+DROP TABLE "problem_comp_class";
+DROP TABLE "problem";
+DROP TABLE "round";
+DROP TABLE "contender";
+DROP TABLE "team";
+DROP TABLE "comp_class";
+DROP TABLE "contest";
+DROP TABLE "series";
+DROP TABLE "organizer";
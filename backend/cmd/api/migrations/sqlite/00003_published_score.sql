@@ -0,0 +1,17 @@
+-- +goose Up
+CREATE TABLE "published_score" (
+  "contender_id" INT NOT NULL PRIMARY KEY,
+  "timestamp" TIMESTAMP NOT NULL,
+  "score" INT NOT NULL,
+  "placement" INT NOT NULL,
+  "finalist" TINYINT(1) NOT NULL,
+  "rank_order" INT NOT NULL,
+  CONSTRAINT "fk_published_score_1"
+    FOREIGN KEY ("contender_id")
+    REFERENCES "contender" ("id")
+    ON DELETE CASCADE
+    ON UPDATE CASCADE
+);
+
+-- +goose Down
+DROP TABLE "published_score";
@@ -0,0 +1,44 @@
+-- +goose Up
+CREATE TABLE "audit_entry_new" (
+  "id" INTEGER PRIMARY KEY AUTOINCREMENT,
+  "organizer_id" INT NOT NULL,
+  "contest_id" INT NOT NULL,
+  "contender_id" INT DEFAULT NULL,
+  "action" VARCHAR(32) NOT NULL,
+  "timestamp" TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  CONSTRAINT "fk_audit_entry_1"
+    FOREIGN KEY ("organizer_id")
+    REFERENCES "organizer" ("id")
+    ON DELETE CASCADE
+    ON UPDATE NO ACTION
+);
+
+INSERT INTO "audit_entry_new" SELECT "id", "organizer_id", "contest_id", "contender_id", "action", "timestamp" FROM "audit_entry";
+DROP TABLE "audit_entry";
+ALTER TABLE "audit_entry_new" RENAME TO "audit_entry";
+
+CREATE INDEX "audit_entry_fk_audit_entry_1_idx" ON "audit_entry" ("contest_id", "organizer_id");
+CREATE INDEX "audit_entry_fk_audit_entry_2_idx" ON "audit_entry" ("organizer_id");
+CREATE INDEX "audit_entry_index3" ON "audit_entry" ("contender_id");
+
+-- +goose Down
+CREATE TABLE "audit_entry_old" (
+  "id" INTEGER PRIMARY KEY AUTOINCREMENT,
+  "organizer_id" INT NOT NULL,
+  "contest_id" INT NOT NULL,
+  "contender_id" INT DEFAULT NULL,
+  "action" VARCHAR(32) NOT NULL,
+  "timestamp" TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  CONSTRAINT "fk_audit_entry_1"
+    FOREIGN KEY ("contest_id", "organizer_id")
+    REFERENCES "contest" ("id", "organizer_id")
+    ON DELETE CASCADE
+    ON UPDATE NO ACTION
+);
+
+INSERT INTO "audit_entry_old" SELECT "id", "organizer_id", "contest_id", "contender_id", "action", "timestamp" FROM "audit_entry" WHERE "contest_id" IN (SELECT "id" FROM "contest");
+DROP TABLE "audit_entry";
+ALTER TABLE "audit_entry_old" RENAME TO "audit_entry";
+
+CREATE INDEX "audit_entry_fk_audit_entry_1_idx" ON "audit_entry" ("contest_id", "organizer_id");
+CREATE INDEX "audit_entry_index3" ON "audit_entry" ("contender_id");
@@ -0,0 +1,551 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"syscall"
+	"text/tabwriter"
+	"time"
+
+	"github.com/climblive/platform/backend/cmd/api/migrations"
+	"github.com/climblive/platform/backend/internal/domain"
+	"github.com/climblive/platform/backend/internal/events"
+	"github.com/climblive/platform/backend/internal/repository"
+	"github.com/climblive/platform/backend/internal/scores"
+	"github.com/climblive/platform/backend/internal/usecases"
+	"github.com/climblive/platform/backend/internal/utils"
+	"github.com/go-errors/errors"
+	"github.com/pressly/goose/v3"
+)
+
+const adminUsername = "climblive-admin"
+
+type command struct {
+	usage string
+	run   func(app *app, ctx context.Context, args []string) error
+}
+
+var commands = map[string]command{
+	"grant-admin": {
+		usage: "grant-admin USERNAME",
+		run: func(app *app, ctx context.Context, args []string) error {
+			return app.setAdmin(ctx, args, true)
+		},
+	},
+	"revoke-admin": {
+		usage: "revoke-admin USERNAME",
+		run: func(app *app, ctx context.Context, args []string) error {
+			return app.setAdmin(ctx, args, false)
+		},
+	},
+	"stop-engines": {
+		usage: "stop-engines -contest ID",
+		run:   (*app).stopEngines,
+	},
+	"scrub": {
+		usage: "scrub [-deadline RFC3339]",
+		run:   (*app).scrub,
+	},
+	"retention": {
+		usage: "retention [-dry-run]",
+		run:   (*app).retention,
+	},
+	"recompute-scores": {
+		usage: "recompute-scores -contest ID [-round ID] [-stop-engines]",
+		run:   (*app).recomputeScores,
+	},
+	"migrate": {
+		usage: "migrate up|down|status",
+		run:   (*app).migrate,
+	},
+}
+
+func main() {
+	format := flag.String("output", "table", "output format, either json or table")
+	flag.Usage = usage
+	flag.Parse()
+
+	if *format != "json" && *format != "table" {
+		log.Fatalf("unsupported output format: %s", *format)
+	}
+
+	cmd, found := commands[flag.Arg(0)]
+	if !found {
+		usage()
+		os.Exit(2)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	app := &app{
+		driver: os.Getenv("DB_DRIVER"),
+		out:    &printer{format: *format, w: os.Stdout},
+	}
+
+	if err := cmd.run(app, ctx, flag.Args()[1:]); err != nil {
+		if stack := utils.GetErrorStack(err); stack != "" {
+			log.Println(stack)
+		}
+
+		log.Fatalf("%s: %v", flag.Arg(0), err)
+	}
+}
+
+func usage() {
+	fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [-output json|table] COMMAND\n\nCommands:\n", os.Args[0])
+
+	for _, name := range []string{"grant-admin", "revoke-admin", "stop-engines", "scrub", "retention", "recompute-scores", "migrate"} {
+		fmt.Fprintf(flag.CommandLine.Output(), "  %s\n", commands[name].usage)
+	}
+
+	fmt.Fprintln(flag.CommandLine.Output(), "\nFlags:")
+	flag.PrintDefaults()
+}
+
+type app struct {
+	driver string
+	out    *printer
+	db     *repository.Database
+}
+
+func (a *app) database() (*repository.Database, error) {
+	if a.db != nil {
+		return a.db, nil
+	}
+
+	port, _ := strconv.Atoi(os.Getenv("DB_PORT"))
+
+	db, err := repository.Open(
+		a.driver,
+		os.Getenv("DB_USERNAME"),
+		os.Getenv("DB_PASSWORD"),
+		os.Getenv("DB_HOST"),
+		port,
+		os.Getenv("DB_DATABASE"))
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	a.db = db
+
+	return db, nil
+}
+
+func (a *app) setAdmin(ctx context.Context, args []string, admin bool) error {
+	if len(args) != 1 {
+		return errors.New("expected exactly one username")
+	}
+
+	db, err := a.database()
+	if err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	userUseCase := usecases.UserUseCase{
+		Authorizer: &adminAuthorizer{},
+		Repo:       db,
+	}
+
+	user, err := userUseCase.SetAdmin(ctx, args[0], admin)
+	if err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	return a.out.print(user, []string{"ID", "USERNAME", "ADMIN"}, [][]any{
+		{user.ID, user.Username, user.Admin},
+	})
+}
+
+func (a *app) stopEngines(ctx context.Context, args []string) error {
+	flags := flag.NewFlagSet("stop-engines", flag.ContinueOnError)
+	contestID := flags.Int("contest", 0, "contest whose score engines to stop")
+
+	if err := flags.Parse(args); err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	if *contestID == 0 {
+		return errors.New("missing -contest")
+	}
+
+	client, err := newAPIClient()
+	if err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	instanceIDs, err := client.scoreEngines(ctx, domain.ContestID(*contestID))
+	if err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	rows := make([][]any, 0, len(instanceIDs))
+
+	for _, instanceID := range instanceIDs {
+		if err := client.stopScoreEngine(ctx, instanceID); err != nil {
+			return errors.Wrap(err, 0)
+		}
+
+		rows = append(rows, []any{instanceID})
+	}
+
+	return a.out.print(instanceIDs, []string{"STOPPED INSTANCE"}, rows)
+}
+
+func (a *app) scrub(ctx context.Context, args []string) error {
+	flags := flag.NewFlagSet("scrub", flag.ContinueOnError)
+	deadline := flags.String("deadline", "", "scrub contenders of contests ended before this RFC3339 time (default now)")
+
+	if err := flags.Parse(args); err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	scrubDeadline := time.Now()
+
+	if *deadline != "" {
+		parsed, err := time.Parse(time.RFC3339, *deadline)
+		if err != nil {
+			return errors.Wrap(err, 0)
+		}
+
+		scrubDeadline = parsed
+	}
+
+	db, err := a.database()
+	if err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	contenderUseCase := usecases.ContenderUseCase{
+		Repo:                      db,
+		Authorizer:                &adminAuthorizer{},
+		EventBroker:               events.NewBroker(),
+		ScoreKeeper:               nil,
+		RegistrationCodeGenerator: nil,
+	}
+
+	scrubbed, err := contenderUseCase.ScrubContenders(ctx, scrubDeadline)
+	if err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	result := struct {
+		ScrubbedContenders int `json:"scrubbedContenders"`
+	}{
+		ScrubbedContenders: scrubbed,
+	}
+
+	return a.out.print(result, []string{"SCRUBBED CONTENDERS"}, [][]any{{scrubbed}})
+}
+
+func (a *app) retention(ctx context.Context, args []string) error {
+	flags := flag.NewFlagSet("retention", flag.ContinueOnError)
+	dryRun := flags.Bool("dry-run", false, "list the contests that would be purged without purging them")
+
+	if err := flags.Parse(args); err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	db, err := a.database()
+	if err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	retentionUseCase := usecases.RetentionUseCase{
+		Authorizer: &adminAuthorizer{},
+		Repo:       db,
+	}
+
+	if *dryRun {
+		policies, err := db.GetEnabledRetentionPolicies(ctx, nil)
+		if err != nil {
+			return errors.Wrap(err, 0)
+		}
+
+		type policyCandidate struct {
+			PolicyID domain.RetentionPolicyID `json:"policyId"`
+			domain.RetentionCandidate
+		}
+
+		candidates := make([]policyCandidate, 0)
+		rows := make([][]any, 0)
+
+		for _, policy := range policies {
+			policyCandidates, err := retentionUseCase.DryRunRetentionPolicy(ctx, policy.ID)
+			if err != nil {
+				return errors.Wrap(err, 0)
+			}
+
+			for _, candidate := range policyCandidates {
+				candidates = append(candidates, policyCandidate{PolicyID: policy.ID, RetentionCandidate: candidate})
+				rows = append(rows, []any{policy.ID, candidate.ContestID, candidate.ContestName, candidate.Deadline.Format(time.RFC3339), candidate.Contenders, candidate.Ticks})
+			}
+		}
+
+		return a.out.print(candidates, []string{"POLICY", "CONTEST", "NAME", "DEADLINE", "CONTENDERS", "TICKS"}, rows)
+	}
+
+	executions, err := retentionUseCase.ApplyRetentionPolicies(ctx)
+	if err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	rows := make([][]any, 0, len(executions))
+	for _, execution := range executions {
+		rows = append(rows, []any{execution.PolicyID, execution.Contests, execution.Contenders, execution.Ticks, execution.Error})
+	}
+
+	return a.out.print(executions, []string{"POLICY", "CONTESTS", "CONTENDERS", "TICKS", "ERROR"}, rows)
+}
+
+func (a *app) recomputeScores(ctx context.Context, args []string) error {
+	flags := flag.NewFlagSet("recompute-scores", flag.ContinueOnError)
+	contestID := flags.Int("contest", 0, "contest to recompute scores for")
+	roundID := flags.Int("round", 0, "round to recompute scores for (default the whole contest)")
+	stopEngines := flags.Bool("stop-engines", false, "stop running score engines of the contest before recomputing")
+
+	if err := flags.Parse(args); err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	if *contestID == 0 {
+		return errors.New("missing -contest")
+	}
+
+	client, err := newAPIClient()
+	if err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	instanceIDs, err := client.scoreEngines(ctx, domain.ContestID(*contestID))
+	if err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	if len(instanceIDs) > 0 && !*stopEngines {
+		return errors.Errorf("%d score engines are running for contest %d, stop them first or pass -stop-engines", len(instanceIDs), *contestID)
+	}
+
+	for _, instanceID := range instanceIDs {
+		if err := client.stopScoreEngine(ctx, instanceID); err != nil {
+			return errors.Wrap(err, 0)
+		}
+	}
+
+	db, err := a.database()
+	if err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	store := scores.NewMemoryStore()
+	hydrator := &scores.StandardEngineStoreHydrator{Repo: db}
+
+	err = hydrator.Hydrate(ctx, domain.ContestID(*contestID), domain.RoundID(*roundID), store)
+	if err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	engine := scores.NewDefaultScoreEngine(store)
+	engine.Start()
+	defer engine.Stop()
+
+	dirtyScores := engine.GetDirtyScores()
+	rows := make([][]any, 0, len(dirtyScores))
+
+	for _, score := range dirtyScores {
+		if *roundID == 0 {
+			err = db.StoreScore(ctx, nil, score)
+		} else {
+			err = db.StoreRoundScore(ctx, nil, domain.RoundID(*roundID), score)
+		}
+
+		if err != nil {
+			return errors.Wrap(err, 0)
+		}
+
+		rows = append(rows, []any{score.ContenderID, score.Score, score.Placement, score.Finalist, score.RankOrder})
+	}
+
+	return a.out.print(dirtyScores, []string{"CONTENDER", "SCORE", "PLACEMENT", "FINALIST", "RANK ORDER"}, rows)
+}
+
+func (a *app) migrate(ctx context.Context, args []string) error {
+	if len(args) != 1 {
+		return errors.New("expected one of up, down or status")
+	}
+
+	dialect, dir, err := migrations.ForDriver(a.driver)
+	if err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	db, err := a.database()
+	if err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	goose.SetBaseFS(migrations.FS)
+
+	if err := goose.SetDialect(dialect); err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	switch args[0] {
+	case "up":
+		err = goose.UpContext(ctx, db.Handle, dir)
+	case "down":
+		err = goose.DownContext(ctx, db.Handle, dir)
+	case "status":
+		err = goose.StatusContext(ctx, db.Handle, dir)
+	default:
+		return errors.Errorf("unknown migrate command: %s", args[0])
+	}
+
+	if err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	version, err := goose.GetDBVersionContext(ctx, db.Handle)
+	if err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	result := struct {
+		Version int64 `json:"version"`
+	}{
+		Version: version,
+	}
+
+	return a.out.print(result, []string{"VERSION"}, [][]any{{version}})
+}
+
+type adminAuthorizer struct{}
+
+func (a *adminAuthorizer) HasOwnership(ctx context.Context, resourceOwnership domain.OwnershipData) (domain.AuthRole, error) {
+	return domain.AdminRole, nil
+}
+
+func (a *adminAuthorizer) GetAuthentication(ctx context.Context) (domain.Authentication, error) {
+	return domain.Authentication{
+		Regcode:  "",
+		Username: adminUsername,
+	}, nil
+}
+
+type apiClient struct {
+	url   string
+	token string
+}
+
+func newAPIClient() (*apiClient, error) {
+	client := &apiClient{
+		url:   strings.TrimSuffix(os.Getenv("API_URL"), "/"),
+		token: os.Getenv("API_TOKEN"),
+	}
+
+	if client.url == "" {
+		return nil, errors.New("API_URL must point to the running API, e.g. https://example.com/api")
+	}
+
+	return client, nil
+}
+
+func (c *apiClient) scoreEngines(ctx context.Context, contestID domain.ContestID) ([]domain.ScoreEngineInstanceID, error) {
+	var instanceIDs []domain.ScoreEngineInstanceID
+
+	err := c.do(ctx, http.MethodGet, fmt.Sprintf("/contests/%d/score-engines", contestID), &instanceIDs)
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	return instanceIDs, nil
+}
+
+func (c *apiClient) stopScoreEngine(ctx context.Context, instanceID domain.ScoreEngineInstanceID) error {
+	err := c.do(ctx, http.MethodDelete, fmt.Sprintf("/score-engines/%s", instanceID), nil)
+	if err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	return nil
+}
+
+func (c *apiClient) do(ctx context.Context, method, path string, result any) error {
+	req, err := http.NewRequestWithContext(ctx, method, c.url+path, nil)
+	if err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return errors.Wrap(err, 0)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return errors.Errorf("%s %s: %s: %s", method, path, resp.Status, strings.TrimSpace(string(body)))
+	}
+
+	if result == nil {
+		return nil
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	return nil
+}
+
+type printer struct {
+	format string
+	w      io.Writer
+}
+
+func (p *printer) print(value any, headers []string, rows [][]any) error {
+	if p.format == "json" {
+		encoder := json.NewEncoder(p.w)
+		encoder.SetIndent("", "  ")
+
+		if err := encoder.Encode(value); err != nil {
+			return errors.Wrap(err, 0)
+		}
+
+		return nil
+	}
+
+	tw := tabwriter.NewWriter(p.w, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(tw, strings.Join(headers, "\t"))
+
+	for _, row := range rows {
+		cells := make([]string, len(row))
+		for i, cell := range row {
+			cells[i] = fmt.Sprint(cell)
+		}
+
+		fmt.Fprintln(tw, strings.Join(cells, "\t"))
+	}
+
+	if err := tw.Flush(); err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	return nil
+}
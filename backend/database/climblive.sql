@@ -62,7 +62,11 @@ CREATE TABLE IF NOT EXISTS `contest` (
   `info` TEXT NULL,
   `grace_period` INT NOT NULL DEFAULT 300,
   `name_retention_time` INT NOT NULL DEFAULT 20160,
+  `scoreboard_freeze` INT NOT NULL DEFAULT 0,
+  `scoreboard_revealed_at` TIMESTAMP NULL DEFAULT NULL,
+  `self_registration` TINYINT(1) NOT NULL DEFAULT 0,
   `created` TIMESTAMP NOT NULL DEFAULT '1970-01-01 00:00:01',
+  `version` INT NOT NULL DEFAULT 1,
   PRIMARY KEY (`id`),
   CONSTRAINT `fk_contest_2`
     FOREIGN KEY (`organizer_id`)
@@ -97,6 +101,7 @@ CREATE TABLE IF NOT EXISTS `comp_class` (
   `color` VARCHAR(7) NULL,
   `time_begin` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
   `time_end` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `capacity` INT NOT NULL DEFAULT 0,
   PRIMARY KEY (`id`),
   CONSTRAINT `fk_comp_class_1`
     FOREIGN KEY (`contest_id` , `organizer_id`)
@@ -112,6 +117,30 @@ CREATE INDEX `index3` ON `comp_class` (`id` ASC, `contest_id` ASC);
 CREATE INDEX `fk_comp_class_1_idx` ON `comp_class` (`contest_id` ASC, `organizer_id` ASC);
 
 
+-- -----------------------------------------------------
+-- Table `team`
+-- -----------------------------------------------------
+CREATE TABLE IF NOT EXISTS `team` (
+  `id` INT NOT NULL AUTO_INCREMENT,
+  `organizer_id` INT NOT NULL,
+  `contest_id` INT NOT NULL,
+  `name` VARCHAR(32) NOT NULL,
+  `counted_members` INT NOT NULL,
+  PRIMARY KEY (`id`),
+  CONSTRAINT `fk_team_1`
+    FOREIGN KEY (`contest_id` , `organizer_id`)
+    REFERENCES `contest` (`id` , `organizer_id`)
+    ON DELETE RESTRICT
+    ON UPDATE RESTRICT)
+ENGINE = InnoDB
+DEFAULT CHARACTER SET = utf8mb4
+COLLATE = utf8mb4_unicode_ci;
+
+CREATE INDEX `fk_team_1_idx` ON `team` (`contest_id` ASC, `organizer_id` ASC);
+
+CREATE INDEX `index3` ON `team` (`id` ASC, `contest_id` ASC);
+
+
 -- -----------------------------------------------------
 -- Table `contender`
 -- -----------------------------------------------------
@@ -122,11 +151,15 @@ CREATE TABLE IF NOT EXISTS `contender` (
   `registration_code` VARCHAR(16) NOT NULL,
   `name` VARCHAR(64) NULL,
   `class_id` INT NULL,
+  `team_id` INT NULL DEFAULT NULL,
   `entered` TIMESTAMP NULL DEFAULT CURRENT_TIMESTAMP,
   `disqualified` TINYINT(1) NOT NULL DEFAULT 0,
   `withdrawn_from_finals` TINYINT(1) NOT NULL DEFAULT 0,
   `scrubbed_at` TIMESTAMP NULL DEFAULT NULL,
   `scrub_before` TIMESTAMP NULL DEFAULT NULL,
+  `waitlist_class_id` INT NULL DEFAULT NULL,
+  `waitlisted_at` TIMESTAMP NULL DEFAULT NULL,
+  `version` INT NOT NULL DEFAULT 1,
   PRIMARY KEY (`id`),
   CONSTRAINT `fk_contender_1`
     FOREIGN KEY (`class_id` , `contest_id`)
@@ -137,6 +170,16 @@ CREATE TABLE IF NOT EXISTS `contender` (
     FOREIGN KEY (`contest_id` , `organizer_id`)
     REFERENCES `contest` (`id` , `organizer_id`)
     ON DELETE RESTRICT
+    ON UPDATE RESTRICT,
+  CONSTRAINT `fk_contender_3`
+    FOREIGN KEY (`team_id` , `contest_id`)
+    REFERENCES `team` (`id` , `contest_id`)
+    ON DELETE RESTRICT
+    ON UPDATE RESTRICT,
+  CONSTRAINT `fk_contender_4`
+    FOREIGN KEY (`waitlist_class_id` , `contest_id`)
+    REFERENCES `comp_class` (`id` , `contest_id`)
+    ON DELETE RESTRICT
     ON UPDATE RESTRICT)
 ENGINE = InnoDB
 DEFAULT CHARACTER SET = utf8mb4
@@ -148,10 +191,14 @@ CREATE UNIQUE INDEX `registration_code_UNIQUE` ON `contender` (`registration_cod
 
 CREATE INDEX `fk_contender_2_idx` ON `contender` (`contest_id` ASC, `organizer_id` ASC);
 
+CREATE INDEX `fk_contender_4_idx` ON `contender` (`waitlist_class_id` ASC, `contest_id` ASC);
+
 CREATE INDEX `index5` ON `contender` (`id` ASC, `organizer_id` ASC, `contest_id` ASC);
 
 CREATE INDEX `index6` ON `contender` (`scrub_before` ASC, `scrubbed_at` ASC, `name` ASC);
 
+CREATE INDEX `fk_contender_3_idx` ON `contender` (`team_id` ASC, `contest_id` ASC);
+
 
 -- -----------------------------------------------------
 -- Table `problem`
@@ -160,21 +207,30 @@ CREATE TABLE IF NOT EXISTS `problem` (
   `id` INT NOT NULL AUTO_INCREMENT,
   `organizer_id` INT NOT NULL,
   `contest_id` INT NOT NULL,
+  `round_id` INT NULL DEFAULT NULL,
   `number` INT NOT NULL,
   `hold_color_primary` VARCHAR(7) NOT NULL,
   `hold_color_secondary` VARCHAR(7) NULL DEFAULT NULL,
   `zone_1_enabled` TINYINT(1) NOT NULL DEFAULT 0,
   `zone_2_enabled` TINYINT(1) NOT NULL DEFAULT 0,
   `description` VARCHAR(1024) NULL DEFAULT NULL,
+  `sector` VARCHAR(32) NULL DEFAULT NULL,
+  `tags` JSON NULL DEFAULT NULL,
   `points_zone_1` INT NULL,
   `points_zone_2` INT NULL,
   `points_top` INT NOT NULL,
   `flash_bonus` INT NULL,
+  `version` INT NOT NULL DEFAULT 1,
   PRIMARY KEY (`id`),
   CONSTRAINT `fk_problem_1`
     FOREIGN KEY (`contest_id` , `organizer_id`)
     REFERENCES `contest` (`id` , `organizer_id`)
     ON DELETE RESTRICT
+    ON UPDATE RESTRICT,
+  CONSTRAINT `fk_problem_2`
+    FOREIGN KEY (`round_id`)
+    REFERENCES `round` (`id`)
+    ON DELETE RESTRICT
     ON UPDATE RESTRICT)
 ENGINE = InnoDB
 DEFAULT CHARACTER SET = utf8mb4
@@ -182,11 +238,37 @@ COLLATE = utf8mb4_unicode_ci;
 
 CREATE INDEX `fk_problem_1_idx` ON `problem` (`contest_id` ASC, `organizer_id` ASC);
 
+CREATE INDEX `fk_problem_2_idx` ON `problem` (`round_id` ASC);
+
 CREATE UNIQUE INDEX `index3` ON `problem` (`number` ASC, `contest_id` ASC);
 
 CREATE INDEX `index5` ON `problem` (`id` ASC, `organizer_id` ASC, `contest_id` ASC);
 
 
+-- -----------------------------------------------------
+-- Table `problem_comp_class`
+-- -----------------------------------------------------
+CREATE TABLE IF NOT EXISTS `problem_comp_class` (
+  `problem_id` INT NOT NULL,
+  `comp_class_id` INT NOT NULL,
+  PRIMARY KEY (`problem_id`, `comp_class_id`),
+  CONSTRAINT `fk_problem_comp_class_1`
+    FOREIGN KEY (`problem_id`)
+    REFERENCES `problem` (`id`)
+    ON DELETE CASCADE
+    ON UPDATE CASCADE,
+  CONSTRAINT `fk_problem_comp_class_2`
+    FOREIGN KEY (`comp_class_id`)
+    REFERENCES `comp_class` (`id`)
+    ON DELETE CASCADE
+    ON UPDATE CASCADE)
+ENGINE = InnoDB
+DEFAULT CHARACTER SET = utf8mb4
+COLLATE = utf8mb4_unicode_ci;
+
+CREATE INDEX `fk_problem_comp_class_2_idx` ON `problem_comp_class` (`comp_class_id` ASC);
+
+
 -- -----------------------------------------------------
 -- Table `tick`
 -- -----------------------------------------------------
@@ -271,6 +353,10 @@ CREATE TABLE IF NOT EXISTS `raffle` (
   `id` INT NOT NULL AUTO_INCREMENT,
   `organizer_id` INT NOT NULL,
   `contest_id` INT NOT NULL,
+  `rules` JSON NULL DEFAULT NULL,
+  `seed` CHAR(64) NULL DEFAULT NULL,
+  `seed_commitment` CHAR(64) NULL DEFAULT NULL,
+  `seed_revealed_at` TIMESTAMP NULL DEFAULT NULL,
   PRIMARY KEY (`id`),
   CONSTRAINT `fk_raffle_1`
     FOREIGN KEY (`contest_id` , `organizer_id`)
@@ -286,6 +372,28 @@ CREATE INDEX `fk_raffle_1_idx` ON `raffle` (`contest_id` ASC, `organizer_id` ASC
 CREATE INDEX `index3` ON `raffle` (`id` ASC, `organizer_id` ASC);
 
 
+-- -----------------------------------------------------
+-- Table `raffle_prize`
+-- -----------------------------------------------------
+CREATE TABLE IF NOT EXISTS `raffle_prize` (
+  `id` INT NOT NULL AUTO_INCREMENT,
+  `organizer_id` INT NOT NULL,
+  `raffle_id` INT NOT NULL,
+  `name` VARCHAR(64) NOT NULL,
+  `quantity` INT NOT NULL,
+  PRIMARY KEY (`id`),
+  CONSTRAINT `fk_raffle_prize_1`
+    FOREIGN KEY (`raffle_id` , `organizer_id`)
+    REFERENCES `raffle` (`id` , `organizer_id`)
+    ON DELETE NO ACTION
+    ON UPDATE NO ACTION)
+ENGINE = InnoDB
+DEFAULT CHARACTER SET = utf8mb4
+COLLATE = utf8mb4_unicode_ci;
+
+CREATE INDEX `fk_raffle_prize_1_idx` ON `raffle_prize` (`raffle_id` ASC, `organizer_id` ASC);
+
+
 -- -----------------------------------------------------
 -- Table `raffle_winner`
 -- -----------------------------------------------------
@@ -294,7 +402,12 @@ CREATE TABLE IF NOT EXISTS `raffle_winner` (
   `organizer_id` INT NOT NULL,
   `raffle_id` INT NOT NULL,
   `contender_id` INT NOT NULL,
+  `prize_id` INT NULL DEFAULT NULL,
   `timestamp` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `rules` JSON NULL DEFAULT NULL,
+  `claimed_at` TIMESTAMP NULL DEFAULT NULL,
+  `voided_at` TIMESTAMP NULL DEFAULT NULL,
+  `draw` JSON NULL DEFAULT NULL,
   PRIMARY KEY (`id`),
   CONSTRAINT `fk_raffle_winner_1`
     FOREIGN KEY (`raffle_id` , `organizer_id`)
@@ -305,6 +418,11 @@ CREATE TABLE IF NOT EXISTS `raffle_winner` (
     FOREIGN KEY (`contender_id` , `organizer_id`)
     REFERENCES `contender` (`id` , `organizer_id`)
     ON DELETE NO ACTION
+    ON UPDATE NO ACTION,
+  CONSTRAINT `fk_raffle_winner_3`
+    FOREIGN KEY (`prize_id`)
+    REFERENCES `raffle_prize` (`id`)
+    ON DELETE NO ACTION
     ON UPDATE NO ACTION)
 ENGINE = InnoDB
 DEFAULT CHARACTER SET = utf8mb4
@@ -316,6 +434,8 @@ CREATE INDEX `fk_raffle_winner_2_idx` ON `raffle_winner` (`contender_id` ASC, `o
 
 CREATE UNIQUE INDEX `index4` ON `raffle_winner` (`raffle_id` ASC, `contender_id` ASC);
 
+CREATE INDEX `fk_raffle_winner_3_idx` ON `raffle_winner` (`prize_id` ASC);
+
 
 -- -----------------------------------------------------
 -- Table `score`
@@ -338,6 +458,27 @@ DEFAULT CHARACTER SET = utf8mb4
 COLLATE = utf8mb4_unicode_ci;
 
 
+-- -----------------------------------------------------
+-- Table `published_score`
+-- -----------------------------------------------------
+CREATE TABLE IF NOT EXISTS `published_score` (
+  `contender_id` INT NOT NULL,
+  `timestamp` TIMESTAMP NOT NULL,
+  `score` INT NOT NULL,
+  `placement` INT NOT NULL,
+  `finalist` TINYINT(1) NOT NULL,
+  `rank_order` INT NOT NULL,
+  PRIMARY KEY (`contender_id`),
+  CONSTRAINT `fk_published_score_1`
+    FOREIGN KEY (`contender_id`)
+    REFERENCES `contender` (`id`)
+    ON DELETE CASCADE
+    ON UPDATE CASCADE)
+ENGINE = InnoDB
+DEFAULT CHARACTER SET = utf8mb4
+COLLATE = utf8mb4_unicode_ci;
+
+
 -- -----------------------------------------------------
 -- Table `organizer_invite`
 -- -----------------------------------------------------
@@ -358,6 +499,215 @@ COLLATE = utf8mb4_unicode_ci;
 CREATE INDEX `fk_organizer_invite_1_idx` ON `organizer_invite` (`organizer_id` ASC);
 
 
+-- -----------------------------------------------------
+-- Table `round`
+-- -----------------------------------------------------
+CREATE TABLE IF NOT EXISTS `round` (
+  `id` INT NOT NULL AUTO_INCREMENT,
+  `organizer_id` INT NOT NULL,
+  `contest_id` INT NOT NULL,
+  `number` INT NOT NULL,
+  `name` VARCHAR(32) NOT NULL,
+  `qualifying_problems` INT NOT NULL,
+  `finalists` INT NOT NULL,
+  `time_begin` TIMESTAMP NULL DEFAULT NULL,
+  `time_end` TIMESTAMP NULL DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  CONSTRAINT `fk_round_1`
+    FOREIGN KEY (`contest_id` , `organizer_id`)
+    REFERENCES `contest` (`id` , `organizer_id`)
+    ON DELETE RESTRICT
+    ON UPDATE RESTRICT)
+ENGINE = InnoDB
+DEFAULT CHARACTER SET = utf8mb4
+COLLATE = utf8mb4_unicode_ci;
+
+CREATE INDEX `fk_round_1_idx` ON `round` (`contest_id` ASC, `organizer_id` ASC);
+
+CREATE UNIQUE INDEX `index3` ON `round` (`number` ASC, `contest_id` ASC);
+
+
+-- -----------------------------------------------------
+-- Table `round_contender`
+-- -----------------------------------------------------
+CREATE TABLE IF NOT EXISTS `round_contender` (
+  `round_id` INT NOT NULL,
+  `contender_id` INT NOT NULL,
+  `previous_placement` INT NOT NULL,
+  `timestamp` TIMESTAMP NULL DEFAULT NULL,
+  `score` INT NULL DEFAULT NULL,
+  `placement` INT NULL DEFAULT NULL,
+  `finalist` TINYINT(1) NULL DEFAULT NULL,
+  `rank_order` INT NULL DEFAULT NULL,
+  PRIMARY KEY (`round_id`, `contender_id`),
+  CONSTRAINT `fk_round_contender_1`
+    FOREIGN KEY (`round_id`)
+    REFERENCES `round` (`id`)
+    ON DELETE CASCADE
+    ON UPDATE CASCADE,
+  CONSTRAINT `fk_round_contender_2`
+    FOREIGN KEY (`contender_id`)
+    REFERENCES `contender` (`id`)
+    ON DELETE CASCADE
+    ON UPDATE CASCADE)
+ENGINE = InnoDB
+DEFAULT CHARACTER SET = utf8mb4
+COLLATE = utf8mb4_unicode_ci;
+
+CREATE INDEX `fk_round_contender_2_idx` ON `round_contender` (`contender_id` ASC);
+
+
+-- -----------------------------------------------------
+-- Table `audit_entry`
+-- -----------------------------------------------------
+CREATE TABLE IF NOT EXISTS `audit_entry` (
+  `id` INT NOT NULL AUTO_INCREMENT,
+  `organizer_id` INT NOT NULL,
+  `contest_id` INT NOT NULL,
+  `contender_id` INT NULL DEFAULT NULL,
+  `action` VARCHAR(32) NOT NULL,
+  `timestamp` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  CONSTRAINT `fk_audit_entry_1`
+    FOREIGN KEY (`organizer_id`)
+    REFERENCES `organizer` (`id`)
+    ON DELETE CASCADE
+    ON UPDATE NO ACTION)
+ENGINE = InnoDB
+DEFAULT CHARACTER SET = utf8mb4
+COLLATE = utf8mb4_unicode_ci;
+
+CREATE INDEX `fk_audit_entry_1_idx` ON `audit_entry` (`contest_id` ASC, `organizer_id` ASC);
+
+CREATE INDEX `fk_audit_entry_2_idx` ON `audit_entry` (`organizer_id` ASC);
+
+CREATE INDEX `index3` ON `audit_entry` (`contender_id` ASC);
+
+
+-- -----------------------------------------------------
+-- Table `retention_policy`
+-- -----------------------------------------------------
+CREATE TABLE IF NOT EXISTS `retention_policy` (
+  `id` INT NOT NULL AUTO_INCREMENT,
+  `organizer_id` INT NOT NULL,
+  `target` VARCHAR(32) NOT NULL,
+  `retention_period` INT NOT NULL,
+  `enabled` TINYINT(1) NOT NULL DEFAULT 1,
+  `created` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  CONSTRAINT `fk_retention_policy_1`
+    FOREIGN KEY (`organizer_id`)
+    REFERENCES `organizer` (`id`)
+    ON DELETE CASCADE
+    ON UPDATE NO ACTION)
+ENGINE = InnoDB
+DEFAULT CHARACTER SET = utf8mb4
+COLLATE = utf8mb4_unicode_ci;
+
+CREATE INDEX `fk_retention_policy_1_idx` ON `retention_policy` (`organizer_id` ASC);
+
+
+-- -----------------------------------------------------
+-- Table `retention_policy_execution`
+-- -----------------------------------------------------
+CREATE TABLE IF NOT EXISTS `retention_policy_execution` (
+  `id` INT NOT NULL AUTO_INCREMENT,
+  `organizer_id` INT NOT NULL,
+  `retention_policy_id` INT NOT NULL,
+  `timestamp` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `contests` INT NOT NULL,
+  `contenders` INT NOT NULL,
+  `ticks` INT NOT NULL,
+  `error` TEXT NULL DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  CONSTRAINT `fk_retention_policy_execution_1`
+    FOREIGN KEY (`retention_policy_id`)
+    REFERENCES `retention_policy` (`id`)
+    ON DELETE CASCADE
+    ON UPDATE NO ACTION)
+ENGINE = InnoDB
+DEFAULT CHARACTER SET = utf8mb4
+COLLATE = utf8mb4_unicode_ci;
+
+CREATE INDEX `fk_retention_policy_execution_1_idx` ON `retention_policy_execution` (`retention_policy_id` ASC);
+
+
+-- -----------------------------------------------------
+-- Table `tick_revision`
+-- -----------------------------------------------------
+CREATE TABLE IF NOT EXISTS `tick_revision` (
+  `id` INT NOT NULL AUTO_INCREMENT,
+  `contest_id` INT NOT NULL,
+  `contender_id` INT NOT NULL,
+  `problem_id` INT NOT NULL,
+  `tick_id` INT NOT NULL,
+  `action` VARCHAR(16) NOT NULL,
+  `timestamp` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `recorded_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `actor_role` VARCHAR(16) NOT NULL,
+  `actor_username` VARCHAR(64) NULL DEFAULT NULL,
+  `idempotency_key` VARCHAR(64) NULL DEFAULT NULL,
+  `zone_1` TINYINT(1) NOT NULL DEFAULT 0,
+  `attempts_zone_1` INT NOT NULL DEFAULT 0,
+  `zone_2` TINYINT(1) NOT NULL DEFAULT 0,
+  `attempts_zone_2` INT NOT NULL DEFAULT 0,
+  `top` TINYINT(1) NOT NULL DEFAULT 0,
+  `attempts_top` INT NOT NULL DEFAULT 0,
+  PRIMARY KEY (`id`))
+ENGINE = InnoDB
+DEFAULT CHARACTER SET = utf8mb4
+COLLATE = utf8mb4_unicode_ci;
+
+CREATE INDEX `index2` ON `tick_revision` (`contender_id` ASC, `problem_id` ASC);
+
+CREATE INDEX `index3` ON `tick_revision` (`contest_id` ASC);
+
+CREATE UNIQUE INDEX `index4` ON `tick_revision` (`contender_id` ASC, `idempotency_key` ASC);
+
+
+-- -----------------------------------------------------
+-- Table `tick_dispute`
+-- -----------------------------------------------------
+CREATE TABLE IF NOT EXISTS `tick_dispute` (
+  `id` INT NOT NULL AUTO_INCREMENT,
+  `organizer_id` INT NOT NULL,
+  `contest_id` INT NOT NULL,
+  `contender_id` INT NOT NULL,
+  `problem_id` INT NOT NULL,
+  `status` VARCHAR(16) NOT NULL,
+  `comment` VARCHAR(1024) NOT NULL,
+  `response` VARCHAR(1024) NULL DEFAULT NULL,
+  `zone_1` TINYINT(1) NOT NULL DEFAULT 0,
+  `attempts_zone_1` INT NOT NULL DEFAULT 0,
+  `zone_2` TINYINT(1) NOT NULL DEFAULT 0,
+  `attempts_zone_2` INT NOT NULL DEFAULT 0,
+  `top` TINYINT(1) NOT NULL DEFAULT 0,
+  `attempts_top` INT NOT NULL DEFAULT 0,
+  `created` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `resolved` TIMESTAMP NULL DEFAULT NULL,
+  `resolved_by` VARCHAR(64) NULL DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  CONSTRAINT `fk_tick_dispute_1`
+    FOREIGN KEY (`contender_id` , `organizer_id` , `contest_id`)
+    REFERENCES `contender` (`id` , `organizer_id` , `contest_id`)
+    ON DELETE CASCADE
+    ON UPDATE NO ACTION,
+  CONSTRAINT `fk_tick_dispute_2`
+    FOREIGN KEY (`problem_id` , `organizer_id` , `contest_id`)
+    REFERENCES `problem` (`id` , `organizer_id` , `contest_id`)
+    ON DELETE CASCADE
+    ON UPDATE NO ACTION)
+ENGINE = InnoDB
+DEFAULT CHARACTER SET = utf8mb4
+COLLATE = utf8mb4_unicode_ci;
+
+CREATE INDEX `fk_tick_dispute_1_idx` ON `tick_dispute` (`contender_id` ASC, `organizer_id` ASC, `contest_id` ASC);
+
+CREATE INDEX `fk_tick_dispute_2_idx` ON `tick_dispute` (`problem_id` ASC, `organizer_id` ASC, `contest_id` ASC);
+
+CREATE INDEX `index3` ON `tick_dispute` (`contest_id` ASC, `status` ASC);
+
+
 SET SQL_MODE=@OLD_SQL_MODE;
 SET FOREIGN_KEY_CHECKS=@OLD_FOREIGN_KEY_CHECKS;
 SET UNIQUE_CHECKS=@OLD_UNIQUE_CHECKS;
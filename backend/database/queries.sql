@@ -16,12 +16,38 @@ FROM contender
 LEFT JOIN score ON score.contender_id = id
 WHERE class_id = ?;
 
+-- name: GetWaitlistedContenders :many
+SELECT sqlc.embed(contender), score.*
+FROM contender
+LEFT JOIN score ON score.contender_id = id
+WHERE waitlist_class_id = ?
+ORDER BY waitlisted_at, id;
+
+-- name: GetContendersByTeam :many
+SELECT sqlc.embed(contender), score.*
+FROM contender
+LEFT JOIN score ON score.contender_id = id
+WHERE team_id = ?;
+
 -- name: GetContendersByContest :many
 SELECT sqlc.embed(contender), score.*
 FROM contender
 LEFT JOIN score ON score.contender_id = id
 WHERE contest_id = ?;
 
+-- name: GetContendersByContestFiltered :many
+SELECT sqlc.embed(contender), score.*
+FROM contender
+LEFT JOIN score ON score.contender_id = id
+WHERE
+	contest_id = sqlc.arg(contest_id)
+	AND (sqlc.narg(class_id) IS NULL OR class_id = sqlc.narg(class_id))
+	AND (sqlc.narg(has_entered) IS NULL OR IF(sqlc.narg(has_entered), entered IS NOT NULL, entered IS NULL))
+	AND (sqlc.narg(disqualified) IS NULL OR disqualified = sqlc.narg(disqualified))
+	AND (sqlc.arg(after) = 0 OR IF(sqlc.arg(descending), id < sqlc.arg(after), id > sqlc.arg(after)))
+ORDER BY IF(sqlc.arg(descending), -id, id)
+LIMIT ?;
+
 -- name: DeleteContender :exec
 DELETE FROM contender
 WHERE id = ?;
@@ -31,22 +57,32 @@ SELECT COUNT(*)
 FROM contender
 WHERE contest_id = ?;
 
+-- name: GetContenderVersionForUpdate :one
+SELECT version
+FROM contender
+WHERE id = ?
+FOR UPDATE;
+
 -- name: UpsertContender :execlastid
 INSERT INTO 
-	contender (id, organizer_id, contest_id, registration_code, name, class_id, entered, disqualified, withdrawn_from_finals, scrubbed_at, scrub_before)
+	contender (id, organizer_id, contest_id, registration_code, name, class_id, team_id, entered, disqualified, withdrawn_from_finals, scrubbed_at, scrub_before, waitlist_class_id, waitlisted_at, version)
 VALUES 
-	(?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	(?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 ON DUPLICATE KEY UPDATE
     organizer_id = VALUES(organizer_id),
     contest_id = VALUES(contest_id),
     registration_code = VALUES(registration_code),
     name = VALUES(name),
     class_id = VALUES(class_id),
+    team_id = VALUES(team_id),
     entered = VALUES(entered),
     disqualified = VALUES(disqualified),
     withdrawn_from_finals = VALUES(withdrawn_from_finals),
     scrubbed_at = VALUES(scrubbed_at),
-    scrub_before = VALUES(scrub_before);
+    scrub_before = VALUES(scrub_before),
+    waitlist_class_id = VALUES(waitlist_class_id),
+    waitlisted_at = VALUES(waitlisted_at),
+    version = version + 1;
 
 -- name: UpsertScore :exec
 INSERT INTO
@@ -60,6 +96,24 @@ ON DUPLICATE KEY UPDATE
     finalist = VALUES(finalist),
     rank_order = VALUES(rank_order);
 
+-- name: UpsertPublishedScore :exec
+INSERT INTO
+    published_score (contender_id, timestamp, score, placement, finalist, rank_order)
+VALUES
+    (?, ?, ?, ?, ?, ?)
+ON DUPLICATE KEY UPDATE
+    timestamp = VALUES(timestamp),
+    score = VALUES(score),
+    placement = VALUES(placement),
+    finalist = VALUES(finalist),
+    rank_order = VALUES(rank_order);
+
+-- name: GetPublishedScoresByContest :many
+SELECT sqlc.embed(published_score)
+FROM published_score
+JOIN contender ON contender.id = published_score.contender_id
+WHERE contender.contest_id = ?;
+
 -- name: GetCompClass :one
 SELECT sqlc.embed(comp_class)
 FROM comp_class
@@ -76,9 +130,9 @@ WHERE id = ?;
 
 -- name: UpsertCompClass :execlastid
 INSERT INTO 
-	comp_class (id, organizer_id, contest_id, name, description, color, time_begin, time_end)
+	comp_class (id, organizer_id, contest_id, name, description, color, time_begin, time_end, capacity)
 VALUES 
-	(?, ?, ?, ?, ?, ?, ?, ?)
+	(?, ?, ?, ?, ?, ?, ?, ?, ?)
 ON DUPLICATE KEY UPDATE
     organizer_id = VALUES(organizer_id),
     contest_id = VALUES(contest_id),
@@ -86,7 +140,8 @@ ON DUPLICATE KEY UPDATE
     description = VALUES(description),
     color = VALUES(color),
     time_begin = VALUES(time_begin),
-    time_end = VALUES(time_end);
+    time_end = VALUES(time_end),
+    capacity = VALUES(capacity);
 
 -- name: GetContest :one
 SELECT sqlc.embed(contest), MIN(cc.time_begin) AS time_begin, MAX(cc.time_end) AS time_end, COUNT(DISTINCT CASE WHEN c.entered IS NOT NULL THEN c.id END) AS registered_contenders
@@ -96,18 +151,30 @@ LEFT JOIN contender c ON c.contest_id = contest.id
 WHERE contest.id = ?
 GROUP BY contest.id;
 
--- name: GetAllContests :many
+-- name: GetContests :many
 SELECT sqlc.embed(contest), MIN(cc.time_begin) AS time_begin, MAX(cc.time_end) AS time_end, COUNT(DISTINCT CASE WHEN c.entered IS NOT NULL THEN c.id END) AS registered_contenders
 FROM contest
 LEFT JOIN comp_class cc ON cc.contest_id = contest.id
 LEFT JOIN contender c ON c.contest_id = contest.id
-GROUP BY contest.id;
+WHERE
+	(sqlc.narg(country) IS NULL OR contest.country = sqlc.narg(country))
+	AND (sqlc.narg(is_archived) IS NULL OR IF(sqlc.narg(is_archived), contest.archived_at IS NOT NULL, contest.archived_at IS NULL))
+	AND (sqlc.arg(after) = 0 OR IF(sqlc.arg(descending), contest.id < sqlc.arg(after), contest.id > sqlc.arg(after)))
+GROUP BY contest.id
+ORDER BY IF(sqlc.arg(descending), -contest.id, contest.id)
+LIMIT ?;
+
+-- name: GetContestVersionForUpdate :one
+SELECT version
+FROM contest
+WHERE id = ?
+FOR UPDATE;
 
 -- name: UpsertContest :execlastid
 INSERT INTO 
-	contest (id, organizer_id, archived_at, series_id, name, description, location, country, qualifying_problems, finalists, info, grace_period, name_retention_time, created)
+	contest (id, organizer_id, archived_at, series_id, name, description, location, country, qualifying_problems, finalists, info, grace_period, name_retention_time, scoreboard_freeze, scoreboard_revealed_at, self_registration, created, version)
 VALUES 
-	(?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	(?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 ON DUPLICATE KEY UPDATE
     organizer_id = VALUES(organizer_id),
     archived_at = VALUES(archived_at),
@@ -121,7 +188,11 @@ ON DUPLICATE KEY UPDATE
     info = VALUES(info),
     grace_period = VALUES(grace_period),
     name_retention_time = VALUES(name_retention_time),
-    created = VALUES(created);
+    scoreboard_freeze = VALUES(scoreboard_freeze),
+    scoreboard_revealed_at = VALUES(scoreboard_revealed_at),
+    self_registration = VALUES(self_registration),
+    created = VALUES(created),
+    version = version + 1;
 
 -- name: DeleteContest :exec
 DELETE FROM contest
@@ -167,24 +238,55 @@ WHERE contest_id = ?;
 DELETE FROM problem
 WHERE id = ?;
 
+-- name: GetProblemVersionForUpdate :one
+SELECT version
+FROM problem
+WHERE id = ?
+FOR UPDATE;
+
 -- name: UpsertProblem :execlastid
 INSERT INTO 
-	problem (id, organizer_id, contest_id, number, hold_color_primary, hold_color_secondary, zone_1_enabled, zone_2_enabled, description, points_zone_1, points_zone_2, points_top, flash_bonus)
+	problem (id, organizer_id, contest_id, round_id, number, hold_color_primary, hold_color_secondary, zone_1_enabled, zone_2_enabled, description, sector, tags, points_zone_1, points_zone_2, points_top, flash_bonus, version)
 VALUES 
-	(?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	(?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 ON DUPLICATE KEY UPDATE
     organizer_id = VALUES(organizer_id),
     contest_id = VALUES(contest_id),
+    round_id = VALUES(round_id),
     number = VALUES(number),
     hold_color_primary = VALUES(hold_color_primary),
     hold_color_secondary = VALUES(hold_color_secondary),
     zone_1_enabled = VALUES(zone_1_enabled),
     zone_2_enabled = VALUES(zone_2_enabled),
     description = VALUES(description),
+    sector = VALUES(sector),
+    tags = VALUES(tags),
     points_zone_1 = VALUES(points_zone_1),
     points_zone_2 = VALUES(points_zone_2),
     points_top = VALUES(points_top),
-    flash_bonus = VALUES(flash_bonus);
+    flash_bonus = VALUES(flash_bonus),
+    version = version + 1;
+
+-- name: GetProblemCompClasses :many
+SELECT comp_class_id
+FROM problem_comp_class
+WHERE problem_id = ?;
+
+-- name: GetProblemCompClassesByContest :many
+SELECT sqlc.embed(problem_comp_class)
+FROM problem_comp_class
+JOIN problem ON problem.id = problem_comp_class.problem_id
+WHERE problem.contest_id = ?;
+
+-- name: DeleteProblemCompClasses :exec
+DELETE FROM problem_comp_class
+WHERE problem_id = ?;
+
+-- name: InsertProblemCompClass :exec
+INSERT INTO
+    problem_comp_class (problem_id, comp_class_id)
+VALUES
+    (?, ?);
 
 -- name: GetTick :one
 SELECT sqlc.embed(tick)
@@ -206,6 +308,16 @@ SELECT sqlc.embed(tick)
 FROM tick
 WHERE contest_id = ?;
 
+-- name: GetTicksByContestFiltered :many
+SELECT sqlc.embed(tick)
+FROM tick
+WHERE
+	contest_id = sqlc.arg(contest_id)
+	AND (sqlc.narg(since) IS NULL OR timestamp >= sqlc.narg(since))
+	AND (sqlc.arg(after) = 0 OR IF(sqlc.arg(descending), id < sqlc.arg(after), id > sqlc.arg(after)))
+ORDER BY IF(sqlc.arg(descending), -id, id)
+LIMIT ?;
+
 -- name: GetTicksByProblem :many
 SELECT sqlc.embed(tick)
 FROM tick
@@ -216,6 +328,57 @@ DELETE
 FROM tick
 WHERE id = ?;
 
+-- name: GetTickRevisionsByContender :many
+SELECT sqlc.embed(tick_revision)
+FROM tick_revision
+WHERE contender_id = ?
+ORDER BY recorded_at, id;
+
+-- name: GetTickRevisionsByContenderAndProblem :many
+SELECT sqlc.embed(tick_revision)
+FROM tick_revision
+WHERE contender_id = ? AND problem_id = ?
+ORDER BY recorded_at, id;
+
+-- name: GetTickRevisionByIdempotencyKey :one
+SELECT sqlc.embed(tick_revision)
+FROM tick_revision
+WHERE contender_id = ? AND idempotency_key = ?;
+
+-- name: InsertTickRevision :execlastid
+INSERT INTO
+    tick_revision (contest_id, contender_id, problem_id, tick_id, action, timestamp, recorded_at, actor_role, actor_username, idempotency_key, zone_1, attempts_zone_1, zone_2, attempts_zone_2, top, attempts_top)
+VALUES
+    (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);
+
+-- name: GetTickDispute :one
+SELECT sqlc.embed(tick_dispute)
+FROM tick_dispute
+WHERE id = ?;
+
+-- name: GetTickDisputesByContest :many
+SELECT sqlc.embed(tick_dispute)
+FROM tick_dispute
+WHERE contest_id = ?
+ORDER BY created, id;
+
+-- name: GetTickDisputesByContender :many
+SELECT sqlc.embed(tick_dispute)
+FROM tick_dispute
+WHERE contender_id = ?
+ORDER BY created, id;
+
+-- name: UpsertTickDispute :execlastid
+INSERT INTO
+    tick_dispute (id, organizer_id, contest_id, contender_id, problem_id, status, comment, response, zone_1, attempts_zone_1, zone_2, attempts_zone_2, top, attempts_top, created, resolved, resolved_by)
+VALUES
+    (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+ON DUPLICATE KEY UPDATE
+    status = VALUES(status),
+    response = VALUES(response),
+    resolved = VALUES(resolved),
+    resolved_by = VALUES(resolved_by);
+
 -- name: UpsertTick :execlastid
 INSERT INTO
     tick (id, organizer_id, contest_id, contender_id, problem_id, timestamp, top, attempts_top, zone_1, attempts_zone_1, zone_2, attempts_zone_2)
@@ -291,38 +454,88 @@ WHERE contest_id = ?;
 
 -- name: UpsertRaffle :execlastid
 INSERT INTO
-    raffle (id, organizer_id, contest_id)
+    raffle (id, organizer_id, contest_id, rules, seed, seed_commitment, seed_revealed_at)
 VALUES
-    (?, ?, ?)
+    (?, ?, ?, ?, ?, ?, ?)
 ON DUPLICATE KEY UPDATE
     organizer_id = VALUES(organizer_id),
-    contest_id = VALUES(contest_id);
+    contest_id = VALUES(contest_id),
+    rules = VALUES(rules),
+    seed = VALUES(seed),
+    seed_commitment = VALUES(seed_commitment),
+    seed_revealed_at = VALUES(seed_revealed_at);
 
 -- name: DeleteRaffle :exec
 DELETE FROM raffle
 WHERE id = ?;
 
+-- name: GetRaffleWinner :one
+SELECT sqlc.embed(raffle_winner), contender.name, contender.scrubbed_at, raffle_prize.name AS prize_name
+FROM raffle_winner
+JOIN contender ON contender.id = raffle_winner.contender_id
+LEFT JOIN raffle_prize ON raffle_prize.id = raffle_winner.prize_id
+WHERE raffle_winner.id = ?;
+
 -- name: GetRaffleWinners :many
-SELECT sqlc.embed(raffle_winner), contender.name, contender.scrubbed_at
+SELECT sqlc.embed(raffle_winner), contender.name, contender.scrubbed_at, raffle_prize.name AS prize_name
+FROM raffle_winner
+JOIN contender ON contender.id = raffle_winner.contender_id
+LEFT JOIN raffle_prize ON raffle_prize.id = raffle_winner.prize_id
+WHERE raffle_winner.raffle_id = ?;
+
+-- name: GetRaffleWinnersByContender :many
+SELECT sqlc.embed(raffle_winner), contender.name, contender.scrubbed_at, raffle_prize.name AS prize_name
 FROM raffle_winner
 JOIN contender ON contender.id = raffle_winner.contender_id
-WHERE raffle_id = ?;
+LEFT JOIN raffle_prize ON raffle_prize.id = raffle_winner.prize_id
+WHERE raffle_winner.contender_id = ?;
 
 -- name: UpsertRaffleWinner :execlastid
 INSERT INTO
-    raffle_winner (id, organizer_id, raffle_id, contender_id, timestamp)
+    raffle_winner (id, organizer_id, raffle_id, contender_id, prize_id, timestamp, rules, claimed_at, voided_at, draw)
 VALUES
-    (?, ?, ?, ?, ?)
+    (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 ON DUPLICATE KEY UPDATE
     organizer_id = VALUES(organizer_id),
     raffle_id = VALUES(raffle_id),
     contender_id = VALUES(contender_id),
-    timestamp = VALUES(timestamp); 
+    prize_id = VALUES(prize_id),
+    timestamp = VALUES(timestamp),
+    rules = VALUES(rules),
+    claimed_at = VALUES(claimed_at),
+    voided_at = VALUES(voided_at),
+    draw = VALUES(draw);
 
 -- name: DeleteRaffleWinner :exec
 DELETE FROM raffle_winner
 WHERE id = ?;
 
+-- name: GetRafflePrize :one
+SELECT sqlc.embed(raffle_prize)
+FROM raffle_prize
+WHERE id = ?;
+
+-- name: GetRafflePrizes :many
+SELECT sqlc.embed(raffle_prize)
+FROM raffle_prize
+WHERE raffle_id = ?
+ORDER BY id;
+
+-- name: UpsertRafflePrize :execlastid
+INSERT INTO
+    raffle_prize (id, organizer_id, raffle_id, name, quantity)
+VALUES
+    (?, ?, ?, ?, ?)
+ON DUPLICATE KEY UPDATE
+    organizer_id = VALUES(organizer_id),
+    raffle_id = VALUES(raffle_id),
+    name = VALUES(name),
+    quantity = VALUES(quantity);
+
+-- name: DeleteRafflePrize :exec
+DELETE FROM raffle_prize
+WHERE id = ?;
+
 -- name: GetOrganizerInvitesByOrganizer :many
 SELECT sqlc.embed(organizer_invite), organizer.name
 FROM organizer_invite
@@ -353,3 +566,197 @@ WHERE contender.name != ''
   AND contender.scrub_before IS NOT NULL
   AND contender.scrub_before < ?;
 
+-- name: GetRound :one
+SELECT sqlc.embed(round)
+FROM round
+WHERE id = ?;
+
+-- name: GetRoundsByContest :many
+SELECT sqlc.embed(round)
+FROM round
+WHERE contest_id = ?
+ORDER BY number;
+
+-- name: GetRoundsCurrentlyRunningOrByStartTime :many
+SELECT sqlc.embed(round)
+FROM round
+JOIN contest ON contest.id = round.contest_id
+WHERE contest.archived_at IS NULL
+  AND (NOW() BETWEEN round.time_begin AND DATE_ADD(round.time_end, INTERVAL (contest.grace_period + 15) MINUTE)
+    OR round.time_begin BETWEEN sqlc.arg(earliest_start_time) AND sqlc.arg(latest_start_time));
+
+-- name: UpsertRound :execlastid
+INSERT INTO
+    round (id, organizer_id, contest_id, number, name, qualifying_problems, finalists, time_begin, time_end)
+VALUES
+    (?, ?, ?, ?, ?, ?, ?, ?, ?)
+ON DUPLICATE KEY UPDATE
+    organizer_id = VALUES(organizer_id),
+    contest_id = VALUES(contest_id),
+    number = VALUES(number),
+    name = VALUES(name),
+    qualifying_problems = VALUES(qualifying_problems),
+    finalists = VALUES(finalists),
+    time_begin = VALUES(time_begin),
+    time_end = VALUES(time_end);
+
+-- name: DeleteRound :exec
+DELETE FROM round
+WHERE id = ?;
+
+-- name: GetStartList :many
+SELECT sqlc.embed(round_contender)
+FROM round_contender
+WHERE round_id = ?;
+
+-- name: GetStartListEntry :one
+SELECT sqlc.embed(round_contender)
+FROM round_contender
+WHERE round_id = ? AND contender_id = ?;
+
+-- name: InsertStartListEntry :exec
+INSERT INTO
+    round_contender (round_id, contender_id, previous_placement, timestamp, score, placement, finalist, rank_order)
+VALUES
+    (?, ?, ?, ?, ?, ?, ?, ?);
+
+-- name: DeleteStartList :exec
+DELETE FROM round_contender
+WHERE round_id = ?;
+
+-- name: UpdateRoundScore :execrows
+UPDATE round_contender
+SET
+    timestamp = ?,
+    score = ?,
+    placement = ?,
+    finalist = ?,
+    rank_order = ?
+WHERE round_id = ? AND contender_id = ?;
+
+-- name: GetTeam :one
+SELECT sqlc.embed(team)
+FROM team
+WHERE id = ?;
+
+-- name: GetTeamsByContest :many
+SELECT sqlc.embed(team)
+FROM team
+WHERE contest_id = ?
+ORDER BY name;
+
+-- name: UpsertTeam :execlastid
+INSERT INTO
+    team (id, organizer_id, contest_id, name, counted_members)
+VALUES
+    (?, ?, ?, ?, ?)
+ON DUPLICATE KEY UPDATE
+    organizer_id = VALUES(organizer_id),
+    contest_id = VALUES(contest_id),
+    name = VALUES(name),
+    counted_members = VALUES(counted_members);
+
+-- name: DeleteTeam :exec
+DELETE FROM team
+WHERE id = ?;
+
+-- name: InsertAuditEntry :execlastid
+INSERT INTO
+    audit_entry (organizer_id, contest_id, contender_id, action, timestamp)
+VALUES
+    (?, ?, ?, ?, ?);
+
+-- name: GetRetentionPolicy :one
+SELECT sqlc.embed(retention_policy)
+FROM retention_policy
+WHERE id = ?;
+
+-- name: GetRetentionPoliciesByOrganizer :many
+SELECT sqlc.embed(retention_policy)
+FROM retention_policy
+WHERE organizer_id = ?;
+
+-- name: GetEnabledRetentionPolicies :many
+SELECT sqlc.embed(retention_policy)
+FROM retention_policy
+WHERE enabled = TRUE;
+
+-- name: UpsertRetentionPolicy :execlastid
+INSERT INTO
+    retention_policy (id, organizer_id, target, retention_period, enabled, created)
+VALUES
+    (?, ?, ?, ?, ?, ?)
+ON DUPLICATE KEY UPDATE
+    organizer_id = VALUES(organizer_id),
+    target = VALUES(target),
+    retention_period = VALUES(retention_period),
+    enabled = VALUES(enabled);
+
+-- name: DeleteRetentionPolicy :exec
+DELETE FROM retention_policy
+WHERE id = ?;
+
+-- name: GetRetentionPolicyExecutions :many
+SELECT sqlc.embed(retention_policy_execution)
+FROM retention_policy_execution
+WHERE retention_policy_id = ?
+ORDER BY timestamp DESC, id DESC
+LIMIT 100;
+
+-- name: InsertRetentionPolicyExecution :execlastid
+INSERT INTO
+    retention_policy_execution (organizer_id, retention_policy_id, timestamp, contests, contenders, ticks, error)
+VALUES
+    (?, ?, ?, ?, ?, ?, ?);
+
+-- name: DeleteTicksByContest :execrows
+DELETE FROM tick
+WHERE contest_id = ?;
+
+-- name: DeleteTickRevisionsByContest :exec
+DELETE FROM tick_revision
+WHERE contest_id = ?;
+
+-- name: DeleteTickDisputesByContest :exec
+DELETE FROM tick_dispute
+WHERE contest_id = ?;
+
+-- name: DeleteScoresByContest :exec
+DELETE FROM score
+WHERE contender_id IN (SELECT id FROM contender WHERE contest_id = ?);
+
+-- name: DeletePublishedScoresByContest :exec
+DELETE FROM published_score
+WHERE contender_id IN (SELECT id FROM contender WHERE contest_id = ?);
+
+-- name: DeleteRaffleWinnersByContest :exec
+DELETE FROM raffle_winner
+WHERE raffle_id IN (SELECT id FROM raffle WHERE contest_id = ?);
+
+-- name: DeleteRafflePrizesByContest :exec
+DELETE FROM raffle_prize
+WHERE raffle_id IN (SELECT id FROM raffle WHERE contest_id = ?);
+
+-- name: DeleteRafflesByContest :exec
+DELETE FROM raffle
+WHERE contest_id = ?;
+
+-- name: DeleteContendersByContest :execrows
+DELETE FROM contender
+WHERE contest_id = ?;
+
+-- name: DeleteProblemsByContest :exec
+DELETE FROM problem
+WHERE contest_id = ?;
+
+-- name: DeleteRoundsByContest :exec
+DELETE FROM round
+WHERE contest_id = ?;
+
+-- name: DeleteTeamsByContest :exec
+DELETE FROM team
+WHERE contest_id = ?;
+
+-- name: DeleteCompClassesByContest :exec
+DELETE FROM comp_class
+WHERE contest_id = ?;
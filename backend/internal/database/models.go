@@ -6,9 +6,19 @@ package database
 
 import (
 	"database/sql"
+	"encoding/json"
 	"time"
 )
 
+type AuditEntry struct {
+	ID          int32
+	OrganizerID int32
+	ContestID   int32
+	ContenderID sql.NullInt32
+	Action      string
+	Timestamp   time.Time
+}
+
 type CompClass struct {
 	ID          int32
 	OrganizerID int32
@@ -18,6 +28,7 @@ type CompClass struct {
 	Color       sql.NullString
 	TimeBegin   time.Time
 	TimeEnd     time.Time
+	Capacity    int32
 }
 
 type Contender struct {
@@ -27,28 +38,36 @@ type Contender struct {
 	RegistrationCode    string
 	Name                sql.NullString
 	ClassID             sql.NullInt32
+	TeamID              sql.NullInt32
 	Entered             sql.NullTime
 	Disqualified        bool
 	WithdrawnFromFinals bool
 	ScrubbedAt          sql.NullTime
 	ScrubBefore         sql.NullTime
+	WaitlistClassID     sql.NullInt32
+	WaitlistedAt        sql.NullTime
+	Version             int32
 }
 
 type Contest struct {
-	ID                 int32
-	OrganizerID        int32
-	ArchivedAt         sql.NullTime
-	SeriesID           sql.NullInt32
-	Name               string
-	Description        sql.NullString
-	Location           sql.NullString
-	Country            string
-	QualifyingProblems int32
-	Finalists          int32
-	Info               sql.NullString
-	GracePeriod        int32
-	NameRetentionTime  int32
-	Created            time.Time
+	ID                   int32
+	OrganizerID          int32
+	ArchivedAt           sql.NullTime
+	SeriesID             sql.NullInt32
+	Name                 string
+	Description          sql.NullString
+	Location             sql.NullString
+	Country              string
+	QualifyingProblems   int32
+	Finalists            int32
+	Info                 sql.NullString
+	GracePeriod          int32
+	NameRetentionTime    int32
+	ScoreboardFreeze     int32
+	ScoreboardRevealedAt sql.NullTime
+	SelfRegistration     bool
+	Created              time.Time
+	Version              int32
 }
 
 type Organizer struct {
@@ -66,22 +85,52 @@ type Problem struct {
 	ID                 int32
 	OrganizerID        int32
 	ContestID          int32
+	RoundID            sql.NullInt32
 	Number             int32
 	HoldColorPrimary   string
 	HoldColorSecondary sql.NullString
 	Zone1Enabled       bool
 	Zone2Enabled       bool
 	Description        sql.NullString
+	Sector             sql.NullString
+	Tags               json.RawMessage
 	PointsZone1        sql.NullInt32
 	PointsZone2        sql.NullInt32
 	PointsTop          int32
 	FlashBonus         sql.NullInt32
+	Version            int32
+}
+
+type ProblemCompClass struct {
+	ProblemID   int32
+	CompClassID int32
+}
+
+type PublishedScore struct {
+	ContenderID int32
+	Timestamp   time.Time
+	Score       int32
+	Placement   int32
+	Finalist    bool
+	RankOrder   int32
 }
 
 type Raffle struct {
+	ID             int32
+	OrganizerID    int32
+	ContestID      int32
+	Rules          json.RawMessage
+	Seed           sql.NullString
+	SeedCommitment sql.NullString
+	SeedRevealedAt sql.NullTime
+}
+
+type RafflePrize struct {
 	ID          int32
 	OrganizerID int32
-	ContestID   int32
+	RaffleID    int32
+	Name        string
+	Quantity    int32
 }
 
 type RaffleWinner struct {
@@ -89,7 +138,55 @@ type RaffleWinner struct {
 	OrganizerID int32
 	RaffleID    int32
 	ContenderID int32
+	PrizeID     sql.NullInt32
 	Timestamp   time.Time
+	Rules       json.RawMessage
+	ClaimedAt   sql.NullTime
+	VoidedAt    sql.NullTime
+	Draw        json.RawMessage
+}
+
+type RetentionPolicy struct {
+	ID              int32
+	OrganizerID     int32
+	Target          string
+	RetentionPeriod int32
+	Enabled         bool
+	Created         time.Time
+}
+
+type RetentionPolicyExecution struct {
+	ID                int32
+	OrganizerID       int32
+	RetentionPolicyID int32
+	Timestamp         time.Time
+	Contests          int32
+	Contenders        int32
+	Ticks             int32
+	Error             sql.NullString
+}
+
+type Round struct {
+	ID                 int32
+	OrganizerID        int32
+	ContestID          int32
+	Number             int32
+	Name               string
+	QualifyingProblems int32
+	Finalists          int32
+	TimeBegin          sql.NullTime
+	TimeEnd            sql.NullTime
+}
+
+type RoundContender struct {
+	RoundID           int32
+	ContenderID       int32
+	PreviousPlacement int32
+	Timestamp         sql.NullTime
+	Score             sql.NullInt32
+	Placement         sql.NullInt32
+	Finalist          sql.NullBool
+	RankOrder         sql.NullInt32
 }
 
 type Score struct {
@@ -107,6 +204,14 @@ type Series struct {
 	Name        string
 }
 
+type Team struct {
+	ID             int32
+	OrganizerID    int32
+	ContestID      int32
+	Name           string
+	CountedMembers int32
+}
+
 type Tick struct {
 	ID            int32
 	OrganizerID   int32
@@ -122,6 +227,46 @@ type Tick struct {
 	AttemptsTop   int32
 }
 
+type TickDispute struct {
+	ID            int32
+	OrganizerID   int32
+	ContestID     int32
+	ContenderID   int32
+	ProblemID     int32
+	Status        string
+	Comment       string
+	Response      sql.NullString
+	Zone1         bool
+	AttemptsZone1 int32
+	Zone2         bool
+	AttemptsZone2 int32
+	Top           bool
+	AttemptsTop   int32
+	Created       time.Time
+	Resolved      sql.NullTime
+	ResolvedBy    sql.NullString
+}
+
+type TickRevision struct {
+	ID             int32
+	ContestID      int32
+	ContenderID    int32
+	ProblemID      int32
+	TickID         int32
+	Action         string
+	Timestamp      time.Time
+	RecordedAt     time.Time
+	ActorRole      string
+	ActorUsername  sql.NullString
+	IdempotencyKey sql.NullString
+	Zone1          bool
+	AttemptsZone1  int32
+	Zone2          bool
+	AttemptsZone2  int32
+	Top            bool
+	AttemptsTop    int32
+}
+
 type User struct {
 	ID       int32
 	Username string
@@ -8,6 +8,7 @@ package database
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"time"
 )
 
@@ -51,6 +52,16 @@ func (q *Queries) DeleteCompClass(ctx context.Context, id int32) error {
 	return err
 }
 
+const deleteCompClassesByContest = `-- name: DeleteCompClassesByContest :exec
+DELETE FROM comp_class
+WHERE contest_id = ?
+`
+
+func (q *Queries) DeleteCompClassesByContest(ctx context.Context, contestID int32) error {
+	_, err := q.db.ExecContext(ctx, deleteCompClassesByContest, contestID)
+	return err
+}
+
 const deleteContender = `-- name: DeleteContender :exec
 DELETE FROM contender
 WHERE id = ?
@@ -61,6 +72,19 @@ func (q *Queries) DeleteContender(ctx context.Context, id int32) error {
 	return err
 }
 
+const deleteContendersByContest = `-- name: DeleteContendersByContest :execrows
+DELETE FROM contender
+WHERE contest_id = ?
+`
+
+func (q *Queries) DeleteContendersByContest(ctx context.Context, contestID int32) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteContendersByContest, contestID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const deleteContest = `-- name: DeleteContest :exec
 DELETE FROM contest
 WHERE id = ?
@@ -91,6 +115,36 @@ func (q *Queries) DeleteProblem(ctx context.Context, id int32) error {
 	return err
 }
 
+const deleteProblemCompClasses = `-- name: DeleteProblemCompClasses :exec
+DELETE FROM problem_comp_class
+WHERE problem_id = ?
+`
+
+func (q *Queries) DeleteProblemCompClasses(ctx context.Context, problemID int32) error {
+	_, err := q.db.ExecContext(ctx, deleteProblemCompClasses, problemID)
+	return err
+}
+
+const deleteProblemsByContest = `-- name: DeleteProblemsByContest :exec
+DELETE FROM problem
+WHERE contest_id = ?
+`
+
+func (q *Queries) DeleteProblemsByContest(ctx context.Context, contestID int32) error {
+	_, err := q.db.ExecContext(ctx, deleteProblemsByContest, contestID)
+	return err
+}
+
+const deletePublishedScoresByContest = `-- name: DeletePublishedScoresByContest :exec
+DELETE FROM published_score
+WHERE contender_id IN (SELECT id FROM contender WHERE contest_id = ?)
+`
+
+func (q *Queries) DeletePublishedScoresByContest(ctx context.Context, contestID int32) error {
+	_, err := q.db.ExecContext(ctx, deletePublishedScoresByContest, contestID)
+	return err
+}
+
 const deleteRaffle = `-- name: DeleteRaffle :exec
 DELETE FROM raffle
 WHERE id = ?
@@ -101,6 +155,26 @@ func (q *Queries) DeleteRaffle(ctx context.Context, id int32) error {
 	return err
 }
 
+const deleteRafflePrize = `-- name: DeleteRafflePrize :exec
+DELETE FROM raffle_prize
+WHERE id = ?
+`
+
+func (q *Queries) DeleteRafflePrize(ctx context.Context, id int32) error {
+	_, err := q.db.ExecContext(ctx, deleteRafflePrize, id)
+	return err
+}
+
+const deleteRafflePrizesByContest = `-- name: DeleteRafflePrizesByContest :exec
+DELETE FROM raffle_prize
+WHERE raffle_id IN (SELECT id FROM raffle WHERE contest_id = ?)
+`
+
+func (q *Queries) DeleteRafflePrizesByContest(ctx context.Context, contestID int32) error {
+	_, err := q.db.ExecContext(ctx, deleteRafflePrizesByContest, contestID)
+	return err
+}
+
 const deleteRaffleWinner = `-- name: DeleteRaffleWinner :exec
 DELETE FROM raffle_winner
 WHERE id = ?
@@ -111,6 +185,96 @@ func (q *Queries) DeleteRaffleWinner(ctx context.Context, id int32) error {
 	return err
 }
 
+const deleteRaffleWinnersByContest = `-- name: DeleteRaffleWinnersByContest :exec
+DELETE FROM raffle_winner
+WHERE raffle_id IN (SELECT id FROM raffle WHERE contest_id = ?)
+`
+
+func (q *Queries) DeleteRaffleWinnersByContest(ctx context.Context, contestID int32) error {
+	_, err := q.db.ExecContext(ctx, deleteRaffleWinnersByContest, contestID)
+	return err
+}
+
+const deleteRafflesByContest = `-- name: DeleteRafflesByContest :exec
+DELETE FROM raffle
+WHERE contest_id = ?
+`
+
+func (q *Queries) DeleteRafflesByContest(ctx context.Context, contestID int32) error {
+	_, err := q.db.ExecContext(ctx, deleteRafflesByContest, contestID)
+	return err
+}
+
+const deleteRetentionPolicy = `-- name: DeleteRetentionPolicy :exec
+DELETE FROM retention_policy
+WHERE id = ?
+`
+
+func (q *Queries) DeleteRetentionPolicy(ctx context.Context, id int32) error {
+	_, err := q.db.ExecContext(ctx, deleteRetentionPolicy, id)
+	return err
+}
+
+const deleteRound = `-- name: DeleteRound :exec
+DELETE FROM round
+WHERE id = ?
+`
+
+func (q *Queries) DeleteRound(ctx context.Context, id int32) error {
+	_, err := q.db.ExecContext(ctx, deleteRound, id)
+	return err
+}
+
+const deleteRoundsByContest = `-- name: DeleteRoundsByContest :exec
+DELETE FROM round
+WHERE contest_id = ?
+`
+
+func (q *Queries) DeleteRoundsByContest(ctx context.Context, contestID int32) error {
+	_, err := q.db.ExecContext(ctx, deleteRoundsByContest, contestID)
+	return err
+}
+
+const deleteScoresByContest = `-- name: DeleteScoresByContest :exec
+DELETE FROM score
+WHERE contender_id IN (SELECT id FROM contender WHERE contest_id = ?)
+`
+
+func (q *Queries) DeleteScoresByContest(ctx context.Context, contestID int32) error {
+	_, err := q.db.ExecContext(ctx, deleteScoresByContest, contestID)
+	return err
+}
+
+const deleteStartList = `-- name: DeleteStartList :exec
+DELETE FROM round_contender
+WHERE round_id = ?
+`
+
+func (q *Queries) DeleteStartList(ctx context.Context, roundID int32) error {
+	_, err := q.db.ExecContext(ctx, deleteStartList, roundID)
+	return err
+}
+
+const deleteTeam = `-- name: DeleteTeam :exec
+DELETE FROM team
+WHERE id = ?
+`
+
+func (q *Queries) DeleteTeam(ctx context.Context, id int32) error {
+	_, err := q.db.ExecContext(ctx, deleteTeam, id)
+	return err
+}
+
+const deleteTeamsByContest = `-- name: DeleteTeamsByContest :exec
+DELETE FROM team
+WHERE contest_id = ?
+`
+
+func (q *Queries) DeleteTeamsByContest(ctx context.Context, contestID int32) error {
+	_, err := q.db.ExecContext(ctx, deleteTeamsByContest, contestID)
+	return err
+}
+
 const deleteTick = `-- name: DeleteTick :exec
 DELETE
 FROM tick
@@ -122,60 +286,37 @@ func (q *Queries) DeleteTick(ctx context.Context, id int32) error {
 	return err
 }
 
-const getAllContests = `-- name: GetAllContests :many
-SELECT contest.id, contest.organizer_id, contest.archived_at, contest.series_id, contest.name, contest.description, contest.location, contest.country, contest.qualifying_problems, contest.finalists, contest.info, contest.grace_period, contest.name_retention_time, contest.created, MIN(cc.time_begin) AS time_begin, MAX(cc.time_end) AS time_end, COUNT(DISTINCT CASE WHEN c.entered IS NOT NULL THEN c.id END) AS registered_contenders
-FROM contest
-LEFT JOIN comp_class cc ON cc.contest_id = contest.id
-LEFT JOIN contender c ON c.contest_id = contest.id
-GROUP BY contest.id
+const deleteTickDisputesByContest = `-- name: DeleteTickDisputesByContest :exec
+DELETE FROM tick_dispute
+WHERE contest_id = ?
 `
 
-type GetAllContestsRow struct {
-	Contest              Contest
-	TimeBegin            interface{}
-	TimeEnd              interface{}
-	RegisteredContenders int64
+func (q *Queries) DeleteTickDisputesByContest(ctx context.Context, contestID int32) error {
+	_, err := q.db.ExecContext(ctx, deleteTickDisputesByContest, contestID)
+	return err
+}
+
+const deleteTickRevisionsByContest = `-- name: DeleteTickRevisionsByContest :exec
+DELETE FROM tick_revision
+WHERE contest_id = ?
+`
+
+func (q *Queries) DeleteTickRevisionsByContest(ctx context.Context, contestID int32) error {
+	_, err := q.db.ExecContext(ctx, deleteTickRevisionsByContest, contestID)
+	return err
 }
 
-func (q *Queries) GetAllContests(ctx context.Context) ([]GetAllContestsRow, error) {
-	rows, err := q.db.QueryContext(ctx, getAllContests)
+const deleteTicksByContest = `-- name: DeleteTicksByContest :execrows
+DELETE FROM tick
+WHERE contest_id = ?
+`
+
+func (q *Queries) DeleteTicksByContest(ctx context.Context, contestID int32) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteTicksByContest, contestID)
 	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	var items []GetAllContestsRow
-	for rows.Next() {
-		var i GetAllContestsRow
-		if err := rows.Scan(
-			&i.Contest.ID,
-			&i.Contest.OrganizerID,
-			&i.Contest.ArchivedAt,
-			&i.Contest.SeriesID,
-			&i.Contest.Name,
-			&i.Contest.Description,
-			&i.Contest.Location,
-			&i.Contest.Country,
-			&i.Contest.QualifyingProblems,
-			&i.Contest.Finalists,
-			&i.Contest.Info,
-			&i.Contest.GracePeriod,
-			&i.Contest.NameRetentionTime,
-			&i.Contest.Created,
-			&i.TimeBegin,
-			&i.TimeEnd,
-			&i.RegisteredContenders,
-		); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
-	}
-	if err := rows.Close(); err != nil {
-		return nil, err
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
+		return 0, err
 	}
-	return items, nil
+	return result.RowsAffected()
 }
 
 const getAllOrganizers = `-- name: GetAllOrganizers :many
@@ -207,7 +348,7 @@ func (q *Queries) GetAllOrganizers(ctx context.Context) ([]Organizer, error) {
 }
 
 const getCompClass = `-- name: GetCompClass :one
-SELECT comp_class.id, comp_class.organizer_id, comp_class.contest_id, comp_class.name, comp_class.description, comp_class.color, comp_class.time_begin, comp_class.time_end
+SELECT comp_class.id, comp_class.organizer_id, comp_class.contest_id, comp_class.name, comp_class.description, comp_class.color, comp_class.time_begin, comp_class.time_end, comp_class.capacity
 FROM comp_class
 WHERE id = ?
 `
@@ -228,12 +369,13 @@ func (q *Queries) GetCompClass(ctx context.Context, id int32) (GetCompClassRow,
 		&i.CompClass.Color,
 		&i.CompClass.TimeBegin,
 		&i.CompClass.TimeEnd,
+		&i.CompClass.Capacity,
 	)
 	return i, err
 }
 
 const getCompClassesByContest = `-- name: GetCompClassesByContest :many
-SELECT comp_class.id, comp_class.organizer_id, comp_class.contest_id, comp_class.name, comp_class.description, comp_class.color, comp_class.time_begin, comp_class.time_end
+SELECT comp_class.id, comp_class.organizer_id, comp_class.contest_id, comp_class.name, comp_class.description, comp_class.color, comp_class.time_begin, comp_class.time_end, comp_class.capacity
 FROM comp_class
 WHERE contest_id = ?
 `
@@ -260,6 +402,7 @@ func (q *Queries) GetCompClassesByContest(ctx context.Context, contestID int32)
 			&i.CompClass.Color,
 			&i.CompClass.TimeBegin,
 			&i.CompClass.TimeEnd,
+			&i.CompClass.Capacity,
 		); err != nil {
 			return nil, err
 		}
@@ -275,7 +418,7 @@ func (q *Queries) GetCompClassesByContest(ctx context.Context, contestID int32)
 }
 
 const getContender = `-- name: GetContender :one
-SELECT contender.id, contender.organizer_id, contender.contest_id, contender.registration_code, contender.name, contender.class_id, contender.entered, contender.disqualified, contender.withdrawn_from_finals, contender.scrubbed_at, contender.scrub_before, score.contender_id, score.timestamp, score.score, score.placement, score.finalist, score.rank_order
+SELECT contender.id, contender.organizer_id, contender.contest_id, contender.registration_code, contender.name, contender.class_id, contender.team_id, contender.entered, contender.disqualified, contender.withdrawn_from_finals, contender.scrubbed_at, contender.scrub_before, contender.waitlist_class_id, contender.waitlisted_at, contender.version, score.contender_id, score.timestamp, score.score, score.placement, score.finalist, score.rank_order
 FROM contender
 LEFT JOIN score ON score.contender_id = id
 WHERE id = ?
@@ -301,11 +444,15 @@ func (q *Queries) GetContender(ctx context.Context, id int32) (GetContenderRow,
 		&i.Contender.RegistrationCode,
 		&i.Contender.Name,
 		&i.Contender.ClassID,
+		&i.Contender.TeamID,
 		&i.Contender.Entered,
 		&i.Contender.Disqualified,
 		&i.Contender.WithdrawnFromFinals,
 		&i.Contender.ScrubbedAt,
 		&i.Contender.ScrubBefore,
+		&i.Contender.WaitlistClassID,
+		&i.Contender.WaitlistedAt,
+		&i.Contender.Version,
 		&i.ContenderID,
 		&i.Timestamp,
 		&i.Score,
@@ -317,7 +464,7 @@ func (q *Queries) GetContender(ctx context.Context, id int32) (GetContenderRow,
 }
 
 const getContenderByCode = `-- name: GetContenderByCode :one
-SELECT contender.id, contender.organizer_id, contender.contest_id, contender.registration_code, contender.name, contender.class_id, contender.entered, contender.disqualified, contender.withdrawn_from_finals, contender.scrubbed_at, contender.scrub_before, score.contender_id, score.timestamp, score.score, score.placement, score.finalist, score.rank_order
+SELECT contender.id, contender.organizer_id, contender.contest_id, contender.registration_code, contender.name, contender.class_id, contender.team_id, contender.entered, contender.disqualified, contender.withdrawn_from_finals, contender.scrubbed_at, contender.scrub_before, contender.waitlist_class_id, contender.waitlisted_at, contender.version, score.contender_id, score.timestamp, score.score, score.placement, score.finalist, score.rank_order
 FROM contender
 LEFT JOIN score ON score.contender_id = id
 WHERE registration_code = ?
@@ -343,11 +490,15 @@ func (q *Queries) GetContenderByCode(ctx context.Context, registrationCode strin
 		&i.Contender.RegistrationCode,
 		&i.Contender.Name,
 		&i.Contender.ClassID,
+		&i.Contender.TeamID,
 		&i.Contender.Entered,
 		&i.Contender.Disqualified,
 		&i.Contender.WithdrawnFromFinals,
 		&i.Contender.ScrubbedAt,
 		&i.Contender.ScrubBefore,
+		&i.Contender.WaitlistClassID,
+		&i.Contender.WaitlistedAt,
+		&i.Contender.Version,
 		&i.ContenderID,
 		&i.Timestamp,
 		&i.Score,
@@ -358,8 +509,22 @@ func (q *Queries) GetContenderByCode(ctx context.Context, registrationCode strin
 	return i, err
 }
 
+const getContenderVersionForUpdate = `-- name: GetContenderVersionForUpdate :one
+SELECT version
+FROM contender
+WHERE id = ?
+FOR UPDATE
+`
+
+func (q *Queries) GetContenderVersionForUpdate(ctx context.Context, id int32) (int32, error) {
+	row := q.db.QueryRowContext(ctx, getContenderVersionForUpdate, id)
+	var version int32
+	err := row.Scan(&version)
+	return version, err
+}
+
 const getContendersByCompClass = `-- name: GetContendersByCompClass :many
-SELECT contender.id, contender.organizer_id, contender.contest_id, contender.registration_code, contender.name, contender.class_id, contender.entered, contender.disqualified, contender.withdrawn_from_finals, contender.scrubbed_at, contender.scrub_before, score.contender_id, score.timestamp, score.score, score.placement, score.finalist, score.rank_order
+SELECT contender.id, contender.organizer_id, contender.contest_id, contender.registration_code, contender.name, contender.class_id, contender.team_id, contender.entered, contender.disqualified, contender.withdrawn_from_finals, contender.scrubbed_at, contender.scrub_before, contender.waitlist_class_id, contender.waitlisted_at, contender.version, score.contender_id, score.timestamp, score.score, score.placement, score.finalist, score.rank_order
 FROM contender
 LEFT JOIN score ON score.contender_id = id
 WHERE class_id = ?
@@ -391,11 +556,15 @@ func (q *Queries) GetContendersByCompClass(ctx context.Context, classID sql.Null
 			&i.Contender.RegistrationCode,
 			&i.Contender.Name,
 			&i.Contender.ClassID,
+			&i.Contender.TeamID,
 			&i.Contender.Entered,
 			&i.Contender.Disqualified,
 			&i.Contender.WithdrawnFromFinals,
 			&i.Contender.ScrubbedAt,
 			&i.Contender.ScrubBefore,
+			&i.Contender.WaitlistClassID,
+			&i.Contender.WaitlistedAt,
+			&i.Contender.Version,
 			&i.ContenderID,
 			&i.Timestamp,
 			&i.Score,
@@ -417,7 +586,7 @@ func (q *Queries) GetContendersByCompClass(ctx context.Context, classID sql.Null
 }
 
 const getContendersByContest = `-- name: GetContendersByContest :many
-SELECT contender.id, contender.organizer_id, contender.contest_id, contender.registration_code, contender.name, contender.class_id, contender.entered, contender.disqualified, contender.withdrawn_from_finals, contender.scrubbed_at, contender.scrub_before, score.contender_id, score.timestamp, score.score, score.placement, score.finalist, score.rank_order
+SELECT contender.id, contender.organizer_id, contender.contest_id, contender.registration_code, contender.name, contender.class_id, contender.team_id, contender.entered, contender.disqualified, contender.withdrawn_from_finals, contender.scrubbed_at, contender.scrub_before, contender.waitlist_class_id, contender.waitlisted_at, contender.version, score.contender_id, score.timestamp, score.score, score.placement, score.finalist, score.rank_order
 FROM contender
 LEFT JOIN score ON score.contender_id = id
 WHERE contest_id = ?
@@ -449,11 +618,15 @@ func (q *Queries) GetContendersByContest(ctx context.Context, contestID int32) (
 			&i.Contender.RegistrationCode,
 			&i.Contender.Name,
 			&i.Contender.ClassID,
+			&i.Contender.TeamID,
 			&i.Contender.Entered,
 			&i.Contender.Disqualified,
 			&i.Contender.WithdrawnFromFinals,
 			&i.Contender.ScrubbedAt,
 			&i.Contender.ScrubBefore,
+			&i.Contender.WaitlistClassID,
+			&i.Contender.WaitlistedAt,
+			&i.Contender.Version,
 			&i.ContenderID,
 			&i.Timestamp,
 			&i.Score,
@@ -474,109 +647,371 @@ func (q *Queries) GetContendersByContest(ctx context.Context, contestID int32) (
 	return items, nil
 }
 
-const getContest = `-- name: GetContest :one
-SELECT contest.id, contest.organizer_id, contest.archived_at, contest.series_id, contest.name, contest.description, contest.location, contest.country, contest.qualifying_problems, contest.finalists, contest.info, contest.grace_period, contest.name_retention_time, contest.created, MIN(cc.time_begin) AS time_begin, MAX(cc.time_end) AS time_end, COUNT(DISTINCT CASE WHEN c.entered IS NOT NULL THEN c.id END) AS registered_contenders
-FROM contest
-LEFT JOIN comp_class cc ON cc.contest_id = contest.id
-LEFT JOIN contender c ON c.contest_id = contest.id
-WHERE contest.id = ?
-GROUP BY contest.id
+const getContendersByContestFiltered = `-- name: GetContendersByContestFiltered :many
+SELECT contender.id, contender.organizer_id, contender.contest_id, contender.registration_code, contender.name, contender.class_id, contender.team_id, contender.entered, contender.disqualified, contender.withdrawn_from_finals, contender.scrubbed_at, contender.scrub_before, contender.waitlist_class_id, contender.waitlisted_at, contender.version, score.contender_id, score.timestamp, score.score, score.placement, score.finalist, score.rank_order
+FROM contender
+LEFT JOIN score ON score.contender_id = id
+WHERE
+	contest_id = ?
+	AND (? IS NULL OR class_id = ?)
+	AND (? IS NULL OR IF(?, entered IS NOT NULL, entered IS NULL))
+	AND (? IS NULL OR disqualified = ?)
+	AND (? = 0 OR IF(?, id < ?, id > ?))
+ORDER BY IF(?, -id, id)
+LIMIT ?
 `
 
-type GetContestRow struct {
-	Contest              Contest
-	TimeBegin            interface{}
-	TimeEnd              interface{}
-	RegisteredContenders int64
-}
-
-func (q *Queries) GetContest(ctx context.Context, id int32) (GetContestRow, error) {
-	row := q.db.QueryRowContext(ctx, getContest, id)
-	var i GetContestRow
-	err := row.Scan(
-		&i.Contest.ID,
-		&i.Contest.OrganizerID,
-		&i.Contest.ArchivedAt,
-		&i.Contest.SeriesID,
-		&i.Contest.Name,
-		&i.Contest.Description,
-		&i.Contest.Location,
-		&i.Contest.Country,
-		&i.Contest.QualifyingProblems,
-		&i.Contest.Finalists,
-		&i.Contest.Info,
-		&i.Contest.GracePeriod,
-		&i.Contest.NameRetentionTime,
-		&i.Contest.Created,
-		&i.TimeBegin,
-		&i.TimeEnd,
-		&i.RegisteredContenders,
-	)
-	return i, err
+type GetContendersByContestFilteredParams struct {
+	ContestID    int32
+	ClassID      sql.NullInt32
+	HasEntered   interface{}
+	Disqualified sql.NullBool
+	After        int32
+	Descending   interface{}
+	Limit        int32
 }
 
-const getContestsByOrganizer = `-- name: GetContestsByOrganizer :many
-SELECT contest.id, contest.organizer_id, contest.archived_at, contest.series_id, contest.name, contest.description, contest.location, contest.country, contest.qualifying_problems, contest.finalists, contest.info, contest.grace_period, contest.name_retention_time, contest.created, MIN(cc.time_begin) AS time_begin, MAX(cc.time_end) AS time_end, COUNT(DISTINCT CASE WHEN c.entered IS NOT NULL THEN c.id END) AS registered_contenders
-FROM contest
-LEFT JOIN comp_class cc ON cc.contest_id = contest.id
-LEFT JOIN contender c ON c.contest_id = contest.id
-WHERE contest.organizer_id = ?
-GROUP BY contest.id
-`
-
-type GetContestsByOrganizerRow struct {
-	Contest              Contest
-	TimeBegin            interface{}
-	TimeEnd              interface{}
-	RegisteredContenders int64
+type GetContendersByContestFilteredRow struct {
+	Contender   Contender
+	ContenderID sql.NullInt32
+	Timestamp   sql.NullTime
+	Score       sql.NullInt32
+	Placement   sql.NullInt32
+	Finalist    sql.NullBool
+	RankOrder   sql.NullInt32
 }
 
-func (q *Queries) GetContestsByOrganizer(ctx context.Context, organizerID int32) ([]GetContestsByOrganizerRow, error) {
-	rows, err := q.db.QueryContext(ctx, getContestsByOrganizer, organizerID)
+func (q *Queries) GetContendersByContestFiltered(ctx context.Context, arg GetContendersByContestFilteredParams) ([]GetContendersByContestFilteredRow, error) {
+	rows, err := q.db.QueryContext(ctx, getContendersByContestFiltered,
+		arg.ContestID,
+		arg.ClassID,
+		arg.ClassID,
+		arg.HasEntered,
+		arg.HasEntered,
+		arg.Disqualified,
+		arg.Disqualified,
+		arg.After,
+		arg.Descending,
+		arg.After,
+		arg.After,
+		arg.Descending,
+		arg.Limit,
+	)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []GetContestsByOrganizerRow
+	var items []GetContendersByContestFilteredRow
 	for rows.Next() {
-		var i GetContestsByOrganizerRow
+		var i GetContendersByContestFilteredRow
 		if err := rows.Scan(
-			&i.Contest.ID,
-			&i.Contest.OrganizerID,
-			&i.Contest.ArchivedAt,
-			&i.Contest.SeriesID,
-			&i.Contest.Name,
-			&i.Contest.Description,
-			&i.Contest.Location,
-			&i.Contest.Country,
-			&i.Contest.QualifyingProblems,
-			&i.Contest.Finalists,
-			&i.Contest.Info,
-			&i.Contest.GracePeriod,
-			&i.Contest.NameRetentionTime,
-			&i.Contest.Created,
-			&i.TimeBegin,
-			&i.TimeEnd,
-			&i.RegisteredContenders,
-		); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
-	}
-	if err := rows.Close(); err != nil {
-		return nil, err
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-	return items, nil
-}
+			&i.Contender.ID,
+			&i.Contender.OrganizerID,
+			&i.Contender.ContestID,
+			&i.Contender.RegistrationCode,
+			&i.Contender.Name,
+			&i.Contender.ClassID,
+			&i.Contender.TeamID,
+			&i.Contender.Entered,
+			&i.Contender.Disqualified,
+			&i.Contender.WithdrawnFromFinals,
+			&i.Contender.ScrubbedAt,
+			&i.Contender.ScrubBefore,
+			&i.Contender.WaitlistClassID,
+			&i.Contender.WaitlistedAt,
+			&i.Contender.Version,
+			&i.ContenderID,
+			&i.Timestamp,
+			&i.Score,
+			&i.Placement,
+			&i.Finalist,
+			&i.RankOrder,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getContendersByTeam = `-- name: GetContendersByTeam :many
+SELECT contender.id, contender.organizer_id, contender.contest_id, contender.registration_code, contender.name, contender.class_id, contender.team_id, contender.entered, contender.disqualified, contender.withdrawn_from_finals, contender.scrubbed_at, contender.scrub_before, contender.waitlist_class_id, contender.waitlisted_at, contender.version, score.contender_id, score.timestamp, score.score, score.placement, score.finalist, score.rank_order
+FROM contender
+LEFT JOIN score ON score.contender_id = id
+WHERE team_id = ?
+`
+
+type GetContendersByTeamRow struct {
+	Contender   Contender
+	ContenderID sql.NullInt32
+	Timestamp   sql.NullTime
+	Score       sql.NullInt32
+	Placement   sql.NullInt32
+	Finalist    sql.NullBool
+	RankOrder   sql.NullInt32
+}
+
+func (q *Queries) GetContendersByTeam(ctx context.Context, teamID sql.NullInt32) ([]GetContendersByTeamRow, error) {
+	rows, err := q.db.QueryContext(ctx, getContendersByTeam, teamID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetContendersByTeamRow
+	for rows.Next() {
+		var i GetContendersByTeamRow
+		if err := rows.Scan(
+			&i.Contender.ID,
+			&i.Contender.OrganizerID,
+			&i.Contender.ContestID,
+			&i.Contender.RegistrationCode,
+			&i.Contender.Name,
+			&i.Contender.ClassID,
+			&i.Contender.TeamID,
+			&i.Contender.Entered,
+			&i.Contender.Disqualified,
+			&i.Contender.WithdrawnFromFinals,
+			&i.Contender.ScrubbedAt,
+			&i.Contender.ScrubBefore,
+			&i.Contender.WaitlistClassID,
+			&i.Contender.WaitlistedAt,
+			&i.Contender.Version,
+			&i.ContenderID,
+			&i.Timestamp,
+			&i.Score,
+			&i.Placement,
+			&i.Finalist,
+			&i.RankOrder,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getContest = `-- name: GetContest :one
+SELECT contest.id, contest.organizer_id, contest.archived_at, contest.series_id, contest.name, contest.description, contest.location, contest.country, contest.qualifying_problems, contest.finalists, contest.info, contest.grace_period, contest.name_retention_time, contest.scoreboard_freeze, contest.scoreboard_revealed_at, contest.self_registration, contest.created, contest.version, MIN(cc.time_begin) AS time_begin, MAX(cc.time_end) AS time_end, COUNT(DISTINCT CASE WHEN c.entered IS NOT NULL THEN c.id END) AS registered_contenders
+FROM contest
+LEFT JOIN comp_class cc ON cc.contest_id = contest.id
+LEFT JOIN contender c ON c.contest_id = contest.id
+WHERE contest.id = ?
+GROUP BY contest.id
+`
+
+type GetContestRow struct {
+	Contest              Contest
+	TimeBegin            interface{}
+	TimeEnd              interface{}
+	RegisteredContenders int64
+}
+
+func (q *Queries) GetContest(ctx context.Context, id int32) (GetContestRow, error) {
+	row := q.db.QueryRowContext(ctx, getContest, id)
+	var i GetContestRow
+	err := row.Scan(
+		&i.Contest.ID,
+		&i.Contest.OrganizerID,
+		&i.Contest.ArchivedAt,
+		&i.Contest.SeriesID,
+		&i.Contest.Name,
+		&i.Contest.Description,
+		&i.Contest.Location,
+		&i.Contest.Country,
+		&i.Contest.QualifyingProblems,
+		&i.Contest.Finalists,
+		&i.Contest.Info,
+		&i.Contest.GracePeriod,
+		&i.Contest.NameRetentionTime,
+		&i.Contest.ScoreboardFreeze,
+		&i.Contest.ScoreboardRevealedAt,
+		&i.Contest.SelfRegistration,
+		&i.Contest.Created,
+		&i.Contest.Version,
+		&i.TimeBegin,
+		&i.TimeEnd,
+		&i.RegisteredContenders,
+	)
+	return i, err
+}
+
+const getContestVersionForUpdate = `-- name: GetContestVersionForUpdate :one
+SELECT version
+FROM contest
+WHERE id = ?
+FOR UPDATE
+`
+
+func (q *Queries) GetContestVersionForUpdate(ctx context.Context, id int32) (int32, error) {
+	row := q.db.QueryRowContext(ctx, getContestVersionForUpdate, id)
+	var version int32
+	err := row.Scan(&version)
+	return version, err
+}
+
+const getContests = `-- name: GetContests :many
+SELECT contest.id, contest.organizer_id, contest.archived_at, contest.series_id, contest.name, contest.description, contest.location, contest.country, contest.qualifying_problems, contest.finalists, contest.info, contest.grace_period, contest.name_retention_time, contest.scoreboard_freeze, contest.scoreboard_revealed_at, contest.self_registration, contest.created, contest.version, MIN(cc.time_begin) AS time_begin, MAX(cc.time_end) AS time_end, COUNT(DISTINCT CASE WHEN c.entered IS NOT NULL THEN c.id END) AS registered_contenders
+FROM contest
+LEFT JOIN comp_class cc ON cc.contest_id = contest.id
+LEFT JOIN contender c ON c.contest_id = contest.id
+WHERE
+	(? IS NULL OR contest.country = ?)
+	AND (? IS NULL OR IF(?, contest.archived_at IS NOT NULL, contest.archived_at IS NULL))
+	AND (? = 0 OR IF(?, contest.id < ?, contest.id > ?))
+GROUP BY contest.id
+ORDER BY IF(?, -contest.id, contest.id)
+LIMIT ?
+`
+
+type GetContestsParams struct {
+	Country    sql.NullString
+	IsArchived interface{}
+	After      int32
+	Descending interface{}
+	Limit      int32
+}
+
+type GetContestsRow struct {
+	Contest              Contest
+	TimeBegin            interface{}
+	TimeEnd              interface{}
+	RegisteredContenders int64
+}
+
+func (q *Queries) GetContests(ctx context.Context, arg GetContestsParams) ([]GetContestsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getContests,
+		arg.Country,
+		arg.Country,
+		arg.IsArchived,
+		arg.IsArchived,
+		arg.After,
+		arg.Descending,
+		arg.After,
+		arg.After,
+		arg.Descending,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetContestsRow
+	for rows.Next() {
+		var i GetContestsRow
+		if err := rows.Scan(
+			&i.Contest.ID,
+			&i.Contest.OrganizerID,
+			&i.Contest.ArchivedAt,
+			&i.Contest.SeriesID,
+			&i.Contest.Name,
+			&i.Contest.Description,
+			&i.Contest.Location,
+			&i.Contest.Country,
+			&i.Contest.QualifyingProblems,
+			&i.Contest.Finalists,
+			&i.Contest.Info,
+			&i.Contest.GracePeriod,
+			&i.Contest.NameRetentionTime,
+			&i.Contest.ScoreboardFreeze,
+			&i.Contest.ScoreboardRevealedAt,
+			&i.Contest.SelfRegistration,
+			&i.Contest.Created,
+			&i.Contest.Version,
+			&i.TimeBegin,
+			&i.TimeEnd,
+			&i.RegisteredContenders,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getContestsByOrganizer = `-- name: GetContestsByOrganizer :many
+SELECT contest.id, contest.organizer_id, contest.archived_at, contest.series_id, contest.name, contest.description, contest.location, contest.country, contest.qualifying_problems, contest.finalists, contest.info, contest.grace_period, contest.name_retention_time, contest.scoreboard_freeze, contest.scoreboard_revealed_at, contest.self_registration, contest.created, contest.version, MIN(cc.time_begin) AS time_begin, MAX(cc.time_end) AS time_end, COUNT(DISTINCT CASE WHEN c.entered IS NOT NULL THEN c.id END) AS registered_contenders
+FROM contest
+LEFT JOIN comp_class cc ON cc.contest_id = contest.id
+LEFT JOIN contender c ON c.contest_id = contest.id
+WHERE contest.organizer_id = ?
+GROUP BY contest.id
+`
+
+type GetContestsByOrganizerRow struct {
+	Contest              Contest
+	TimeBegin            interface{}
+	TimeEnd              interface{}
+	RegisteredContenders int64
+}
+
+func (q *Queries) GetContestsByOrganizer(ctx context.Context, organizerID int32) ([]GetContestsByOrganizerRow, error) {
+	rows, err := q.db.QueryContext(ctx, getContestsByOrganizer, organizerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetContestsByOrganizerRow
+	for rows.Next() {
+		var i GetContestsByOrganizerRow
+		if err := rows.Scan(
+			&i.Contest.ID,
+			&i.Contest.OrganizerID,
+			&i.Contest.ArchivedAt,
+			&i.Contest.SeriesID,
+			&i.Contest.Name,
+			&i.Contest.Description,
+			&i.Contest.Location,
+			&i.Contest.Country,
+			&i.Contest.QualifyingProblems,
+			&i.Contest.Finalists,
+			&i.Contest.Info,
+			&i.Contest.GracePeriod,
+			&i.Contest.NameRetentionTime,
+			&i.Contest.ScoreboardFreeze,
+			&i.Contest.ScoreboardRevealedAt,
+			&i.Contest.SelfRegistration,
+			&i.Contest.Created,
+			&i.Contest.Version,
+			&i.TimeBegin,
+			&i.TimeEnd,
+			&i.RegisteredContenders,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
 
 const getContestsCurrentlyRunningOrByStartTime = `-- name: GetContestsCurrentlyRunningOrByStartTime :many
 SELECT
-	id, organizer_id, archived_at, series_id, name, description, location, country, qualifying_problems, finalists, info, grace_period, name_retention_time, created, time_begin, time_end
+	id, organizer_id, archived_at, series_id, name, description, location, country, qualifying_problems, finalists, info, grace_period, name_retention_time, scoreboard_freeze, scoreboard_revealed_at, self_registration, created, version, time_begin, time_end
 FROM (
-    SELECT contest.id, contest.organizer_id, contest.archived_at, contest.series_id, contest.name, contest.description, contest.location, contest.country, contest.qualifying_problems, contest.finalists, contest.info, contest.grace_period, contest.name_retention_time, contest.created, MIN(cc.time_begin) AS time_begin, MAX(cc.time_end) AS time_end
+    SELECT contest.id, contest.organizer_id, contest.archived_at, contest.series_id, contest.name, contest.description, contest.location, contest.country, contest.qualifying_problems, contest.finalists, contest.info, contest.grace_period, contest.name_retention_time, contest.scoreboard_freeze, contest.scoreboard_revealed_at, contest.self_registration, contest.created, contest.version, MIN(cc.time_begin) AS time_begin, MAX(cc.time_end) AS time_end
     FROM contest
     JOIN comp_class cc ON cc.contest_id = contest.id
     WHERE archived_at IS NULL
@@ -592,22 +1027,26 @@ type GetContestsCurrentlyRunningOrByStartTimeParams struct {
 }
 
 type GetContestsCurrentlyRunningOrByStartTimeRow struct {
-	ID                 int32
-	OrganizerID        int32
-	ArchivedAt         sql.NullTime
-	SeriesID           sql.NullInt32
-	Name               string
-	Description        sql.NullString
-	Location           sql.NullString
-	Country            string
-	QualifyingProblems int32
-	Finalists          int32
-	Info               sql.NullString
-	GracePeriod        int32
-	NameRetentionTime  int32
-	Created            time.Time
-	TimeBegin          interface{}
-	TimeEnd            interface{}
+	ID                   int32
+	OrganizerID          int32
+	ArchivedAt           sql.NullTime
+	SeriesID             sql.NullInt32
+	Name                 string
+	Description          sql.NullString
+	Location             sql.NullString
+	Country              string
+	QualifyingProblems   int32
+	Finalists            int32
+	Info                 sql.NullString
+	GracePeriod          int32
+	NameRetentionTime    int32
+	ScoreboardFreeze     int32
+	ScoreboardRevealedAt sql.NullTime
+	SelfRegistration     bool
+	Created              time.Time
+	Version              int32
+	TimeBegin            interface{}
+	TimeEnd              interface{}
 }
 
 func (q *Queries) GetContestsCurrentlyRunningOrByStartTime(ctx context.Context, arg GetContestsCurrentlyRunningOrByStartTimeParams) ([]GetContestsCurrentlyRunningOrByStartTimeRow, error) {
@@ -633,7 +1072,11 @@ func (q *Queries) GetContestsCurrentlyRunningOrByStartTime(ctx context.Context,
 			&i.Info,
 			&i.GracePeriod,
 			&i.NameRetentionTime,
+			&i.ScoreboardFreeze,
+			&i.ScoreboardRevealedAt,
+			&i.SelfRegistration,
 			&i.Created,
+			&i.Version,
 			&i.TimeBegin,
 			&i.TimeEnd,
 		); err != nil {
@@ -650,69 +1093,958 @@ func (q *Queries) GetContestsCurrentlyRunningOrByStartTime(ctx context.Context,
 	return items, nil
 }
 
-const getOrganizer = `-- name: GetOrganizer :one
-SELECT id, name
-FROM organizer
-WHERE id = ?
-`
-
-func (q *Queries) GetOrganizer(ctx context.Context, id int32) (Organizer, error) {
-	row := q.db.QueryRowContext(ctx, getOrganizer, id)
-	var i Organizer
-	err := row.Scan(&i.ID, &i.Name)
-	return i, err
-}
-
-const getOrganizerInvite = `-- name: GetOrganizerInvite :one
-SELECT organizer_invite.id, organizer_invite.organizer_id, organizer_invite.expires_at, organizer.name
-FROM organizer_invite
-JOIN organizer ON organizer.id = organizer_invite.organizer_id
-WHERE organizer_invite.id = ?
-`
-
-type GetOrganizerInviteRow struct {
-	OrganizerInvite OrganizerInvite
-	Name            string
-}
-
-func (q *Queries) GetOrganizerInvite(ctx context.Context, id string) (GetOrganizerInviteRow, error) {
-	row := q.db.QueryRowContext(ctx, getOrganizerInvite, id)
-	var i GetOrganizerInviteRow
-	err := row.Scan(
-		&i.OrganizerInvite.ID,
-		&i.OrganizerInvite.OrganizerID,
-		&i.OrganizerInvite.ExpiresAt,
-		&i.Name,
-	)
-	return i, err
-}
-
-const getOrganizerInvitesByOrganizer = `-- name: GetOrganizerInvitesByOrganizer :many
-SELECT organizer_invite.id, organizer_invite.organizer_id, organizer_invite.expires_at, organizer.name
-FROM organizer_invite
-JOIN organizer ON organizer.id = organizer_invite.organizer_id
-WHERE organizer_id = ?
+const getEnabledRetentionPolicies = `-- name: GetEnabledRetentionPolicies :many
+SELECT retention_policy.id, retention_policy.organizer_id, retention_policy.target, retention_policy.retention_period, retention_policy.enabled, retention_policy.created
+FROM retention_policy
+WHERE enabled = TRUE
+`
+
+type GetEnabledRetentionPoliciesRow struct {
+	RetentionPolicy RetentionPolicy
+}
+
+func (q *Queries) GetEnabledRetentionPolicies(ctx context.Context) ([]GetEnabledRetentionPoliciesRow, error) {
+	rows, err := q.db.QueryContext(ctx, getEnabledRetentionPolicies)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetEnabledRetentionPoliciesRow
+	for rows.Next() {
+		var i GetEnabledRetentionPoliciesRow
+		if err := rows.Scan(
+			&i.RetentionPolicy.ID,
+			&i.RetentionPolicy.OrganizerID,
+			&i.RetentionPolicy.Target,
+			&i.RetentionPolicy.RetentionPeriod,
+			&i.RetentionPolicy.Enabled,
+			&i.RetentionPolicy.Created,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getOrganizer = `-- name: GetOrganizer :one
+SELECT id, name
+FROM organizer
+WHERE id = ?
+`
+
+func (q *Queries) GetOrganizer(ctx context.Context, id int32) (Organizer, error) {
+	row := q.db.QueryRowContext(ctx, getOrganizer, id)
+	var i Organizer
+	err := row.Scan(&i.ID, &i.Name)
+	return i, err
+}
+
+const getOrganizerInvite = `-- name: GetOrganizerInvite :one
+SELECT organizer_invite.id, organizer_invite.organizer_id, organizer_invite.expires_at, organizer.name
+FROM organizer_invite
+JOIN organizer ON organizer.id = organizer_invite.organizer_id
+WHERE organizer_invite.id = ?
+`
+
+type GetOrganizerInviteRow struct {
+	OrganizerInvite OrganizerInvite
+	Name            string
+}
+
+func (q *Queries) GetOrganizerInvite(ctx context.Context, id string) (GetOrganizerInviteRow, error) {
+	row := q.db.QueryRowContext(ctx, getOrganizerInvite, id)
+	var i GetOrganizerInviteRow
+	err := row.Scan(
+		&i.OrganizerInvite.ID,
+		&i.OrganizerInvite.OrganizerID,
+		&i.OrganizerInvite.ExpiresAt,
+		&i.Name,
+	)
+	return i, err
+}
+
+const getOrganizerInvitesByOrganizer = `-- name: GetOrganizerInvitesByOrganizer :many
+SELECT organizer_invite.id, organizer_invite.organizer_id, organizer_invite.expires_at, organizer.name
+FROM organizer_invite
+JOIN organizer ON organizer.id = organizer_invite.organizer_id
+WHERE organizer_id = ?
+`
+
+type GetOrganizerInvitesByOrganizerRow struct {
+	OrganizerInvite OrganizerInvite
+	Name            string
+}
+
+func (q *Queries) GetOrganizerInvitesByOrganizer(ctx context.Context, organizerID int32) ([]GetOrganizerInvitesByOrganizerRow, error) {
+	rows, err := q.db.QueryContext(ctx, getOrganizerInvitesByOrganizer, organizerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetOrganizerInvitesByOrganizerRow
+	for rows.Next() {
+		var i GetOrganizerInvitesByOrganizerRow
+		if err := rows.Scan(
+			&i.OrganizerInvite.ID,
+			&i.OrganizerInvite.OrganizerID,
+			&i.OrganizerInvite.ExpiresAt,
+			&i.Name,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getProblem = `-- name: GetProblem :one
+SELECT problem.id, problem.organizer_id, problem.contest_id, problem.round_id, problem.number, problem.hold_color_primary, problem.hold_color_secondary, problem.zone_1_enabled, problem.zone_2_enabled, problem.description, problem.sector, problem.tags, problem.points_zone_1, problem.points_zone_2, problem.points_top, problem.flash_bonus, problem.version
+FROM problem
+WHERE id = ?
+`
+
+type GetProblemRow struct {
+	Problem Problem
+}
+
+func (q *Queries) GetProblem(ctx context.Context, id int32) (GetProblemRow, error) {
+	row := q.db.QueryRowContext(ctx, getProblem, id)
+	var i GetProblemRow
+	err := row.Scan(
+		&i.Problem.ID,
+		&i.Problem.OrganizerID,
+		&i.Problem.ContestID,
+		&i.Problem.RoundID,
+		&i.Problem.Number,
+		&i.Problem.HoldColorPrimary,
+		&i.Problem.HoldColorSecondary,
+		&i.Problem.Zone1Enabled,
+		&i.Problem.Zone2Enabled,
+		&i.Problem.Description,
+		&i.Problem.Sector,
+		&i.Problem.Tags,
+		&i.Problem.PointsZone1,
+		&i.Problem.PointsZone2,
+		&i.Problem.PointsTop,
+		&i.Problem.FlashBonus,
+		&i.Problem.Version,
+	)
+	return i, err
+}
+
+const getProblemByNumber = `-- name: GetProblemByNumber :one
+SELECT problem.id, problem.organizer_id, problem.contest_id, problem.round_id, problem.number, problem.hold_color_primary, problem.hold_color_secondary, problem.zone_1_enabled, problem.zone_2_enabled, problem.description, problem.sector, problem.tags, problem.points_zone_1, problem.points_zone_2, problem.points_top, problem.flash_bonus, problem.version
+FROM problem
+WHERE contest_id = ? AND number = ?
+`
+
+type GetProblemByNumberParams struct {
+	ContestID int32
+	Number    int32
+}
+
+type GetProblemByNumberRow struct {
+	Problem Problem
+}
+
+func (q *Queries) GetProblemByNumber(ctx context.Context, arg GetProblemByNumberParams) (GetProblemByNumberRow, error) {
+	row := q.db.QueryRowContext(ctx, getProblemByNumber, arg.ContestID, arg.Number)
+	var i GetProblemByNumberRow
+	err := row.Scan(
+		&i.Problem.ID,
+		&i.Problem.OrganizerID,
+		&i.Problem.ContestID,
+		&i.Problem.RoundID,
+		&i.Problem.Number,
+		&i.Problem.HoldColorPrimary,
+		&i.Problem.HoldColorSecondary,
+		&i.Problem.Zone1Enabled,
+		&i.Problem.Zone2Enabled,
+		&i.Problem.Description,
+		&i.Problem.Sector,
+		&i.Problem.Tags,
+		&i.Problem.PointsZone1,
+		&i.Problem.PointsZone2,
+		&i.Problem.PointsTop,
+		&i.Problem.FlashBonus,
+		&i.Problem.Version,
+	)
+	return i, err
+}
+
+const getProblemCompClasses = `-- name: GetProblemCompClasses :many
+SELECT comp_class_id
+FROM problem_comp_class
+WHERE problem_id = ?
+`
+
+func (q *Queries) GetProblemCompClasses(ctx context.Context, problemID int32) ([]int32, error) {
+	rows, err := q.db.QueryContext(ctx, getProblemCompClasses, problemID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int32
+	for rows.Next() {
+		var comp_class_id int32
+		if err := rows.Scan(&comp_class_id); err != nil {
+			return nil, err
+		}
+		items = append(items, comp_class_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getProblemCompClassesByContest = `-- name: GetProblemCompClassesByContest :many
+SELECT problem_comp_class.problem_id, problem_comp_class.comp_class_id
+FROM problem_comp_class
+JOIN problem ON problem.id = problem_comp_class.problem_id
+WHERE problem.contest_id = ?
+`
+
+type GetProblemCompClassesByContestRow struct {
+	ProblemCompClass ProblemCompClass
+}
+
+func (q *Queries) GetProblemCompClassesByContest(ctx context.Context, contestID int32) ([]GetProblemCompClassesByContestRow, error) {
+	rows, err := q.db.QueryContext(ctx, getProblemCompClassesByContest, contestID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetProblemCompClassesByContestRow
+	for rows.Next() {
+		var i GetProblemCompClassesByContestRow
+		if err := rows.Scan(&i.ProblemCompClass.ProblemID, &i.ProblemCompClass.CompClassID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getProblemVersionForUpdate = `-- name: GetProblemVersionForUpdate :one
+SELECT version
+FROM problem
+WHERE id = ?
+FOR UPDATE
+`
+
+func (q *Queries) GetProblemVersionForUpdate(ctx context.Context, id int32) (int32, error) {
+	row := q.db.QueryRowContext(ctx, getProblemVersionForUpdate, id)
+	var version int32
+	err := row.Scan(&version)
+	return version, err
+}
+
+const getProblemsByContest = `-- name: GetProblemsByContest :many
+SELECT problem.id, problem.organizer_id, problem.contest_id, problem.round_id, problem.number, problem.hold_color_primary, problem.hold_color_secondary, problem.zone_1_enabled, problem.zone_2_enabled, problem.description, problem.sector, problem.tags, problem.points_zone_1, problem.points_zone_2, problem.points_top, problem.flash_bonus, problem.version
+FROM problem
+WHERE contest_id = ?
+`
+
+type GetProblemsByContestRow struct {
+	Problem Problem
+}
+
+func (q *Queries) GetProblemsByContest(ctx context.Context, contestID int32) ([]GetProblemsByContestRow, error) {
+	rows, err := q.db.QueryContext(ctx, getProblemsByContest, contestID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetProblemsByContestRow
+	for rows.Next() {
+		var i GetProblemsByContestRow
+		if err := rows.Scan(
+			&i.Problem.ID,
+			&i.Problem.OrganizerID,
+			&i.Problem.ContestID,
+			&i.Problem.RoundID,
+			&i.Problem.Number,
+			&i.Problem.HoldColorPrimary,
+			&i.Problem.HoldColorSecondary,
+			&i.Problem.Zone1Enabled,
+			&i.Problem.Zone2Enabled,
+			&i.Problem.Description,
+			&i.Problem.Sector,
+			&i.Problem.Tags,
+			&i.Problem.PointsZone1,
+			&i.Problem.PointsZone2,
+			&i.Problem.PointsTop,
+			&i.Problem.FlashBonus,
+			&i.Problem.Version,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getPublishedScoresByContest = `-- name: GetPublishedScoresByContest :many
+SELECT published_score.contender_id, published_score.timestamp, published_score.score, published_score.placement, published_score.finalist, published_score.rank_order
+FROM published_score
+JOIN contender ON contender.id = published_score.contender_id
+WHERE contender.contest_id = ?
+`
+
+type GetPublishedScoresByContestRow struct {
+	PublishedScore PublishedScore
+}
+
+func (q *Queries) GetPublishedScoresByContest(ctx context.Context, contestID int32) ([]GetPublishedScoresByContestRow, error) {
+	rows, err := q.db.QueryContext(ctx, getPublishedScoresByContest, contestID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetPublishedScoresByContestRow
+	for rows.Next() {
+		var i GetPublishedScoresByContestRow
+		if err := rows.Scan(
+			&i.PublishedScore.ContenderID,
+			&i.PublishedScore.Timestamp,
+			&i.PublishedScore.Score,
+			&i.PublishedScore.Placement,
+			&i.PublishedScore.Finalist,
+			&i.PublishedScore.RankOrder,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getRaffle = `-- name: GetRaffle :one
+SELECT raffle.id, raffle.organizer_id, raffle.contest_id, raffle.rules, raffle.seed, raffle.seed_commitment, raffle.seed_revealed_at
+FROM raffle
+WHERE id = ?
+`
+
+type GetRaffleRow struct {
+	Raffle Raffle
+}
+
+func (q *Queries) GetRaffle(ctx context.Context, id int32) (GetRaffleRow, error) {
+	row := q.db.QueryRowContext(ctx, getRaffle, id)
+	var i GetRaffleRow
+	err := row.Scan(
+		&i.Raffle.ID,
+		&i.Raffle.OrganizerID,
+		&i.Raffle.ContestID,
+		&i.Raffle.Rules,
+		&i.Raffle.Seed,
+		&i.Raffle.SeedCommitment,
+		&i.Raffle.SeedRevealedAt,
+	)
+	return i, err
+}
+
+const getRafflePrize = `-- name: GetRafflePrize :one
+SELECT raffle_prize.id, raffle_prize.organizer_id, raffle_prize.raffle_id, raffle_prize.name, raffle_prize.quantity
+FROM raffle_prize
+WHERE id = ?
+`
+
+type GetRafflePrizeRow struct {
+	RafflePrize RafflePrize
+}
+
+func (q *Queries) GetRafflePrize(ctx context.Context, id int32) (GetRafflePrizeRow, error) {
+	row := q.db.QueryRowContext(ctx, getRafflePrize, id)
+	var i GetRafflePrizeRow
+	err := row.Scan(
+		&i.RafflePrize.ID,
+		&i.RafflePrize.OrganizerID,
+		&i.RafflePrize.RaffleID,
+		&i.RafflePrize.Name,
+		&i.RafflePrize.Quantity,
+	)
+	return i, err
+}
+
+const getRafflePrizes = `-- name: GetRafflePrizes :many
+SELECT raffle_prize.id, raffle_prize.organizer_id, raffle_prize.raffle_id, raffle_prize.name, raffle_prize.quantity
+FROM raffle_prize
+WHERE raffle_id = ?
+ORDER BY id
+`
+
+type GetRafflePrizesRow struct {
+	RafflePrize RafflePrize
+}
+
+func (q *Queries) GetRafflePrizes(ctx context.Context, raffleID int32) ([]GetRafflePrizesRow, error) {
+	rows, err := q.db.QueryContext(ctx, getRafflePrizes, raffleID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetRafflePrizesRow
+	for rows.Next() {
+		var i GetRafflePrizesRow
+		if err := rows.Scan(
+			&i.RafflePrize.ID,
+			&i.RafflePrize.OrganizerID,
+			&i.RafflePrize.RaffleID,
+			&i.RafflePrize.Name,
+			&i.RafflePrize.Quantity,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getRaffleWinner = `-- name: GetRaffleWinner :one
+SELECT raffle_winner.id, raffle_winner.organizer_id, raffle_winner.raffle_id, raffle_winner.contender_id, raffle_winner.prize_id, raffle_winner.timestamp, raffle_winner.rules, raffle_winner.claimed_at, raffle_winner.voided_at, raffle_winner.draw, contender.name, contender.scrubbed_at, raffle_prize.name AS prize_name
+FROM raffle_winner
+JOIN contender ON contender.id = raffle_winner.contender_id
+LEFT JOIN raffle_prize ON raffle_prize.id = raffle_winner.prize_id
+WHERE raffle_winner.id = ?
+`
+
+type GetRaffleWinnerRow struct {
+	RaffleWinner RaffleWinner
+	Name         sql.NullString
+	ScrubbedAt   sql.NullTime
+	PrizeName    sql.NullString
+}
+
+func (q *Queries) GetRaffleWinner(ctx context.Context, id int32) (GetRaffleWinnerRow, error) {
+	row := q.db.QueryRowContext(ctx, getRaffleWinner, id)
+	var i GetRaffleWinnerRow
+	err := row.Scan(
+		&i.RaffleWinner.ID,
+		&i.RaffleWinner.OrganizerID,
+		&i.RaffleWinner.RaffleID,
+		&i.RaffleWinner.ContenderID,
+		&i.RaffleWinner.PrizeID,
+		&i.RaffleWinner.Timestamp,
+		&i.RaffleWinner.Rules,
+		&i.RaffleWinner.ClaimedAt,
+		&i.RaffleWinner.VoidedAt,
+		&i.RaffleWinner.Draw,
+		&i.Name,
+		&i.ScrubbedAt,
+		&i.PrizeName,
+	)
+	return i, err
+}
+
+const getRaffleWinners = `-- name: GetRaffleWinners :many
+SELECT raffle_winner.id, raffle_winner.organizer_id, raffle_winner.raffle_id, raffle_winner.contender_id, raffle_winner.prize_id, raffle_winner.timestamp, raffle_winner.rules, raffle_winner.claimed_at, raffle_winner.voided_at, raffle_winner.draw, contender.name, contender.scrubbed_at, raffle_prize.name AS prize_name
+FROM raffle_winner
+JOIN contender ON contender.id = raffle_winner.contender_id
+LEFT JOIN raffle_prize ON raffle_prize.id = raffle_winner.prize_id
+WHERE raffle_winner.raffle_id = ?
+`
+
+type GetRaffleWinnersRow struct {
+	RaffleWinner RaffleWinner
+	Name         sql.NullString
+	ScrubbedAt   sql.NullTime
+	PrizeName    sql.NullString
+}
+
+func (q *Queries) GetRaffleWinners(ctx context.Context, raffleID int32) ([]GetRaffleWinnersRow, error) {
+	rows, err := q.db.QueryContext(ctx, getRaffleWinners, raffleID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetRaffleWinnersRow
+	for rows.Next() {
+		var i GetRaffleWinnersRow
+		if err := rows.Scan(
+			&i.RaffleWinner.ID,
+			&i.RaffleWinner.OrganizerID,
+			&i.RaffleWinner.RaffleID,
+			&i.RaffleWinner.ContenderID,
+			&i.RaffleWinner.PrizeID,
+			&i.RaffleWinner.Timestamp,
+			&i.RaffleWinner.Rules,
+			&i.RaffleWinner.ClaimedAt,
+			&i.RaffleWinner.VoidedAt,
+			&i.RaffleWinner.Draw,
+			&i.Name,
+			&i.ScrubbedAt,
+			&i.PrizeName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getRaffleWinnersByContender = `-- name: GetRaffleWinnersByContender :many
+SELECT raffle_winner.id, raffle_winner.organizer_id, raffle_winner.raffle_id, raffle_winner.contender_id, raffle_winner.prize_id, raffle_winner.timestamp, raffle_winner.rules, raffle_winner.claimed_at, raffle_winner.voided_at, raffle_winner.draw, contender.name, contender.scrubbed_at, raffle_prize.name AS prize_name
+FROM raffle_winner
+JOIN contender ON contender.id = raffle_winner.contender_id
+LEFT JOIN raffle_prize ON raffle_prize.id = raffle_winner.prize_id
+WHERE raffle_winner.contender_id = ?
+`
+
+type GetRaffleWinnersByContenderRow struct {
+	RaffleWinner RaffleWinner
+	Name         sql.NullString
+	ScrubbedAt   sql.NullTime
+	PrizeName    sql.NullString
+}
+
+func (q *Queries) GetRaffleWinnersByContender(ctx context.Context, contenderID int32) ([]GetRaffleWinnersByContenderRow, error) {
+	rows, err := q.db.QueryContext(ctx, getRaffleWinnersByContender, contenderID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetRaffleWinnersByContenderRow
+	for rows.Next() {
+		var i GetRaffleWinnersByContenderRow
+		if err := rows.Scan(
+			&i.RaffleWinner.ID,
+			&i.RaffleWinner.OrganizerID,
+			&i.RaffleWinner.RaffleID,
+			&i.RaffleWinner.ContenderID,
+			&i.RaffleWinner.PrizeID,
+			&i.RaffleWinner.Timestamp,
+			&i.RaffleWinner.Rules,
+			&i.RaffleWinner.ClaimedAt,
+			&i.RaffleWinner.VoidedAt,
+			&i.RaffleWinner.Draw,
+			&i.Name,
+			&i.ScrubbedAt,
+			&i.PrizeName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getRafflesByContest = `-- name: GetRafflesByContest :many
+SELECT raffle.id, raffle.organizer_id, raffle.contest_id, raffle.rules, raffle.seed, raffle.seed_commitment, raffle.seed_revealed_at
+FROM raffle
+WHERE contest_id = ?
+`
+
+type GetRafflesByContestRow struct {
+	Raffle Raffle
+}
+
+func (q *Queries) GetRafflesByContest(ctx context.Context, contestID int32) ([]GetRafflesByContestRow, error) {
+	rows, err := q.db.QueryContext(ctx, getRafflesByContest, contestID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetRafflesByContestRow
+	for rows.Next() {
+		var i GetRafflesByContestRow
+		if err := rows.Scan(
+			&i.Raffle.ID,
+			&i.Raffle.OrganizerID,
+			&i.Raffle.ContestID,
+			&i.Raffle.Rules,
+			&i.Raffle.Seed,
+			&i.Raffle.SeedCommitment,
+			&i.Raffle.SeedRevealedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getRetentionPoliciesByOrganizer = `-- name: GetRetentionPoliciesByOrganizer :many
+SELECT retention_policy.id, retention_policy.organizer_id, retention_policy.target, retention_policy.retention_period, retention_policy.enabled, retention_policy.created
+FROM retention_policy
+WHERE organizer_id = ?
+`
+
+type GetRetentionPoliciesByOrganizerRow struct {
+	RetentionPolicy RetentionPolicy
+}
+
+func (q *Queries) GetRetentionPoliciesByOrganizer(ctx context.Context, organizerID int32) ([]GetRetentionPoliciesByOrganizerRow, error) {
+	rows, err := q.db.QueryContext(ctx, getRetentionPoliciesByOrganizer, organizerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetRetentionPoliciesByOrganizerRow
+	for rows.Next() {
+		var i GetRetentionPoliciesByOrganizerRow
+		if err := rows.Scan(
+			&i.RetentionPolicy.ID,
+			&i.RetentionPolicy.OrganizerID,
+			&i.RetentionPolicy.Target,
+			&i.RetentionPolicy.RetentionPeriod,
+			&i.RetentionPolicy.Enabled,
+			&i.RetentionPolicy.Created,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getRetentionPolicy = `-- name: GetRetentionPolicy :one
+SELECT retention_policy.id, retention_policy.organizer_id, retention_policy.target, retention_policy.retention_period, retention_policy.enabled, retention_policy.created
+FROM retention_policy
+WHERE id = ?
+`
+
+type GetRetentionPolicyRow struct {
+	RetentionPolicy RetentionPolicy
+}
+
+func (q *Queries) GetRetentionPolicy(ctx context.Context, id int32) (GetRetentionPolicyRow, error) {
+	row := q.db.QueryRowContext(ctx, getRetentionPolicy, id)
+	var i GetRetentionPolicyRow
+	err := row.Scan(
+		&i.RetentionPolicy.ID,
+		&i.RetentionPolicy.OrganizerID,
+		&i.RetentionPolicy.Target,
+		&i.RetentionPolicy.RetentionPeriod,
+		&i.RetentionPolicy.Enabled,
+		&i.RetentionPolicy.Created,
+	)
+	return i, err
+}
+
+const getRetentionPolicyExecutions = `-- name: GetRetentionPolicyExecutions :many
+SELECT retention_policy_execution.id, retention_policy_execution.organizer_id, retention_policy_execution.retention_policy_id, retention_policy_execution.timestamp, retention_policy_execution.contests, retention_policy_execution.contenders, retention_policy_execution.ticks, retention_policy_execution.error
+FROM retention_policy_execution
+WHERE retention_policy_id = ?
+ORDER BY timestamp DESC, id DESC
+LIMIT 100
+`
+
+type GetRetentionPolicyExecutionsRow struct {
+	RetentionPolicyExecution RetentionPolicyExecution
+}
+
+func (q *Queries) GetRetentionPolicyExecutions(ctx context.Context, retentionPolicyID int32) ([]GetRetentionPolicyExecutionsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getRetentionPolicyExecutions, retentionPolicyID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetRetentionPolicyExecutionsRow
+	for rows.Next() {
+		var i GetRetentionPolicyExecutionsRow
+		if err := rows.Scan(
+			&i.RetentionPolicyExecution.ID,
+			&i.RetentionPolicyExecution.OrganizerID,
+			&i.RetentionPolicyExecution.RetentionPolicyID,
+			&i.RetentionPolicyExecution.Timestamp,
+			&i.RetentionPolicyExecution.Contests,
+			&i.RetentionPolicyExecution.Contenders,
+			&i.RetentionPolicyExecution.Ticks,
+			&i.RetentionPolicyExecution.Error,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getRound = `-- name: GetRound :one
+SELECT round.id, round.organizer_id, round.contest_id, round.number, round.name, round.qualifying_problems, round.finalists, round.time_begin, round.time_end
+FROM round
+WHERE id = ?
+`
+
+type GetRoundRow struct {
+	Round Round
+}
+
+func (q *Queries) GetRound(ctx context.Context, id int32) (GetRoundRow, error) {
+	row := q.db.QueryRowContext(ctx, getRound, id)
+	var i GetRoundRow
+	err := row.Scan(
+		&i.Round.ID,
+		&i.Round.OrganizerID,
+		&i.Round.ContestID,
+		&i.Round.Number,
+		&i.Round.Name,
+		&i.Round.QualifyingProblems,
+		&i.Round.Finalists,
+		&i.Round.TimeBegin,
+		&i.Round.TimeEnd,
+	)
+	return i, err
+}
+
+const getRoundsByContest = `-- name: GetRoundsByContest :many
+SELECT round.id, round.organizer_id, round.contest_id, round.number, round.name, round.qualifying_problems, round.finalists, round.time_begin, round.time_end
+FROM round
+WHERE contest_id = ?
+ORDER BY number
+`
+
+type GetRoundsByContestRow struct {
+	Round Round
+}
+
+func (q *Queries) GetRoundsByContest(ctx context.Context, contestID int32) ([]GetRoundsByContestRow, error) {
+	rows, err := q.db.QueryContext(ctx, getRoundsByContest, contestID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetRoundsByContestRow
+	for rows.Next() {
+		var i GetRoundsByContestRow
+		if err := rows.Scan(
+			&i.Round.ID,
+			&i.Round.OrganizerID,
+			&i.Round.ContestID,
+			&i.Round.Number,
+			&i.Round.Name,
+			&i.Round.QualifyingProblems,
+			&i.Round.Finalists,
+			&i.Round.TimeBegin,
+			&i.Round.TimeEnd,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getRoundsCurrentlyRunningOrByStartTime = `-- name: GetRoundsCurrentlyRunningOrByStartTime :many
+SELECT round.id, round.organizer_id, round.contest_id, round.number, round.name, round.qualifying_problems, round.finalists, round.time_begin, round.time_end
+FROM round
+JOIN contest ON contest.id = round.contest_id
+WHERE contest.archived_at IS NULL
+  AND (NOW() BETWEEN round.time_begin AND DATE_ADD(round.time_end, INTERVAL (contest.grace_period + 15) MINUTE)
+    OR round.time_begin BETWEEN ? AND ?)
+`
+
+type GetRoundsCurrentlyRunningOrByStartTimeParams struct {
+	EarliestStartTime sql.NullTime
+	LatestStartTime   sql.NullTime
+}
+
+type GetRoundsCurrentlyRunningOrByStartTimeRow struct {
+	Round Round
+}
+
+func (q *Queries) GetRoundsCurrentlyRunningOrByStartTime(ctx context.Context, arg GetRoundsCurrentlyRunningOrByStartTimeParams) ([]GetRoundsCurrentlyRunningOrByStartTimeRow, error) {
+	rows, err := q.db.QueryContext(ctx, getRoundsCurrentlyRunningOrByStartTime, arg.EarliestStartTime, arg.LatestStartTime)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetRoundsCurrentlyRunningOrByStartTimeRow
+	for rows.Next() {
+		var i GetRoundsCurrentlyRunningOrByStartTimeRow
+		if err := rows.Scan(
+			&i.Round.ID,
+			&i.Round.OrganizerID,
+			&i.Round.ContestID,
+			&i.Round.Number,
+			&i.Round.Name,
+			&i.Round.QualifyingProblems,
+			&i.Round.Finalists,
+			&i.Round.TimeBegin,
+			&i.Round.TimeEnd,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getScrubEligibleContenders = `-- name: GetScrubEligibleContenders :many
+SELECT contender.id, contender.organizer_id, contender.contest_id, contender.registration_code, contender.name, contender.class_id, contender.team_id, contender.entered, contender.disqualified, contender.withdrawn_from_finals, contender.scrubbed_at, contender.scrub_before, contender.waitlist_class_id, contender.waitlisted_at, contender.version, score.contender_id, score.timestamp, score.score, score.placement, score.finalist, score.rank_order
+FROM contender
+LEFT JOIN score ON score.contender_id = id
+WHERE contender.name != ''
+  AND contender.scrub_before IS NOT NULL
+  AND contender.scrub_before < ?
+`
+
+type GetScrubEligibleContendersRow struct {
+	Contender   Contender
+	ContenderID sql.NullInt32
+	Timestamp   sql.NullTime
+	Score       sql.NullInt32
+	Placement   sql.NullInt32
+	Finalist    sql.NullBool
+	RankOrder   sql.NullInt32
+}
+
+func (q *Queries) GetScrubEligibleContenders(ctx context.Context, scrubBefore sql.NullTime) ([]GetScrubEligibleContendersRow, error) {
+	rows, err := q.db.QueryContext(ctx, getScrubEligibleContenders, scrubBefore)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetScrubEligibleContendersRow
+	for rows.Next() {
+		var i GetScrubEligibleContendersRow
+		if err := rows.Scan(
+			&i.Contender.ID,
+			&i.Contender.OrganizerID,
+			&i.Contender.ContestID,
+			&i.Contender.RegistrationCode,
+			&i.Contender.Name,
+			&i.Contender.ClassID,
+			&i.Contender.TeamID,
+			&i.Contender.Entered,
+			&i.Contender.Disqualified,
+			&i.Contender.WithdrawnFromFinals,
+			&i.Contender.ScrubbedAt,
+			&i.Contender.ScrubBefore,
+			&i.Contender.WaitlistClassID,
+			&i.Contender.WaitlistedAt,
+			&i.Contender.Version,
+			&i.ContenderID,
+			&i.Timestamp,
+			&i.Score,
+			&i.Placement,
+			&i.Finalist,
+			&i.RankOrder,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getStartList = `-- name: GetStartList :many
+SELECT round_contender.round_id, round_contender.contender_id, round_contender.previous_placement, round_contender.timestamp, round_contender.score, round_contender.placement, round_contender.finalist, round_contender.rank_order
+FROM round_contender
+WHERE round_id = ?
 `
 
-type GetOrganizerInvitesByOrganizerRow struct {
-	OrganizerInvite OrganizerInvite
-	Name            string
+type GetStartListRow struct {
+	RoundContender RoundContender
 }
 
-func (q *Queries) GetOrganizerInvitesByOrganizer(ctx context.Context, organizerID int32) ([]GetOrganizerInvitesByOrganizerRow, error) {
-	rows, err := q.db.QueryContext(ctx, getOrganizerInvitesByOrganizer, organizerID)
+func (q *Queries) GetStartList(ctx context.Context, roundID int32) ([]GetStartListRow, error) {
+	rows, err := q.db.QueryContext(ctx, getStartList, roundID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []GetOrganizerInvitesByOrganizerRow
+	var items []GetStartListRow
 	for rows.Next() {
-		var i GetOrganizerInvitesByOrganizerRow
+		var i GetStartListRow
 		if err := rows.Scan(
-			&i.OrganizerInvite.ID,
-			&i.OrganizerInvite.OrganizerID,
-			&i.OrganizerInvite.ExpiresAt,
-			&i.Name,
+			&i.RoundContender.RoundID,
+			&i.RoundContender.ContenderID,
+			&i.RoundContender.PreviousPlacement,
+			&i.RoundContender.Timestamp,
+			&i.RoundContender.Score,
+			&i.RoundContender.Placement,
+			&i.RoundContender.Finalist,
+			&i.RoundContender.RankOrder,
 		); err != nil {
 			return nil, err
 		}
@@ -727,106 +2059,86 @@ func (q *Queries) GetOrganizerInvitesByOrganizer(ctx context.Context, organizerI
 	return items, nil
 }
 
-const getProblem = `-- name: GetProblem :one
-SELECT problem.id, problem.organizer_id, problem.contest_id, problem.number, problem.hold_color_primary, problem.hold_color_secondary, problem.zone_1_enabled, problem.zone_2_enabled, problem.description, problem.points_zone_1, problem.points_zone_2, problem.points_top, problem.flash_bonus
-FROM problem
-WHERE id = ?
+const getStartListEntry = `-- name: GetStartListEntry :one
+SELECT round_contender.round_id, round_contender.contender_id, round_contender.previous_placement, round_contender.timestamp, round_contender.score, round_contender.placement, round_contender.finalist, round_contender.rank_order
+FROM round_contender
+WHERE round_id = ? AND contender_id = ?
 `
 
-type GetProblemRow struct {
-	Problem Problem
+type GetStartListEntryParams struct {
+	RoundID     int32
+	ContenderID int32
 }
 
-func (q *Queries) GetProblem(ctx context.Context, id int32) (GetProblemRow, error) {
-	row := q.db.QueryRowContext(ctx, getProblem, id)
-	var i GetProblemRow
+type GetStartListEntryRow struct {
+	RoundContender RoundContender
+}
+
+func (q *Queries) GetStartListEntry(ctx context.Context, arg GetStartListEntryParams) (GetStartListEntryRow, error) {
+	row := q.db.QueryRowContext(ctx, getStartListEntry, arg.RoundID, arg.ContenderID)
+	var i GetStartListEntryRow
 	err := row.Scan(
-		&i.Problem.ID,
-		&i.Problem.OrganizerID,
-		&i.Problem.ContestID,
-		&i.Problem.Number,
-		&i.Problem.HoldColorPrimary,
-		&i.Problem.HoldColorSecondary,
-		&i.Problem.Zone1Enabled,
-		&i.Problem.Zone2Enabled,
-		&i.Problem.Description,
-		&i.Problem.PointsZone1,
-		&i.Problem.PointsZone2,
-		&i.Problem.PointsTop,
-		&i.Problem.FlashBonus,
+		&i.RoundContender.RoundID,
+		&i.RoundContender.ContenderID,
+		&i.RoundContender.PreviousPlacement,
+		&i.RoundContender.Timestamp,
+		&i.RoundContender.Score,
+		&i.RoundContender.Placement,
+		&i.RoundContender.Finalist,
+		&i.RoundContender.RankOrder,
 	)
 	return i, err
 }
 
-const getProblemByNumber = `-- name: GetProblemByNumber :one
-SELECT problem.id, problem.organizer_id, problem.contest_id, problem.number, problem.hold_color_primary, problem.hold_color_secondary, problem.zone_1_enabled, problem.zone_2_enabled, problem.description, problem.points_zone_1, problem.points_zone_2, problem.points_top, problem.flash_bonus
-FROM problem
-WHERE contest_id = ? AND number = ?
+const getTeam = `-- name: GetTeam :one
+SELECT team.id, team.organizer_id, team.contest_id, team.name, team.counted_members
+FROM team
+WHERE id = ?
 `
 
-type GetProblemByNumberParams struct {
-	ContestID int32
-	Number    int32
-}
-
-type GetProblemByNumberRow struct {
-	Problem Problem
+type GetTeamRow struct {
+	Team Team
 }
 
-func (q *Queries) GetProblemByNumber(ctx context.Context, arg GetProblemByNumberParams) (GetProblemByNumberRow, error) {
-	row := q.db.QueryRowContext(ctx, getProblemByNumber, arg.ContestID, arg.Number)
-	var i GetProblemByNumberRow
+func (q *Queries) GetTeam(ctx context.Context, id int32) (GetTeamRow, error) {
+	row := q.db.QueryRowContext(ctx, getTeam, id)
+	var i GetTeamRow
 	err := row.Scan(
-		&i.Problem.ID,
-		&i.Problem.OrganizerID,
-		&i.Problem.ContestID,
-		&i.Problem.Number,
-		&i.Problem.HoldColorPrimary,
-		&i.Problem.HoldColorSecondary,
-		&i.Problem.Zone1Enabled,
-		&i.Problem.Zone2Enabled,
-		&i.Problem.Description,
-		&i.Problem.PointsZone1,
-		&i.Problem.PointsZone2,
-		&i.Problem.PointsTop,
-		&i.Problem.FlashBonus,
+		&i.Team.ID,
+		&i.Team.OrganizerID,
+		&i.Team.ContestID,
+		&i.Team.Name,
+		&i.Team.CountedMembers,
 	)
 	return i, err
 }
 
-const getProblemsByContest = `-- name: GetProblemsByContest :many
-SELECT problem.id, problem.organizer_id, problem.contest_id, problem.number, problem.hold_color_primary, problem.hold_color_secondary, problem.zone_1_enabled, problem.zone_2_enabled, problem.description, problem.points_zone_1, problem.points_zone_2, problem.points_top, problem.flash_bonus
-FROM problem
+const getTeamsByContest = `-- name: GetTeamsByContest :many
+SELECT team.id, team.organizer_id, team.contest_id, team.name, team.counted_members
+FROM team
 WHERE contest_id = ?
+ORDER BY name
 `
 
-type GetProblemsByContestRow struct {
-	Problem Problem
+type GetTeamsByContestRow struct {
+	Team Team
 }
 
-func (q *Queries) GetProblemsByContest(ctx context.Context, contestID int32) ([]GetProblemsByContestRow, error) {
-	rows, err := q.db.QueryContext(ctx, getProblemsByContest, contestID)
+func (q *Queries) GetTeamsByContest(ctx context.Context, contestID int32) ([]GetTeamsByContestRow, error) {
+	rows, err := q.db.QueryContext(ctx, getTeamsByContest, contestID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []GetProblemsByContestRow
+	var items []GetTeamsByContestRow
 	for rows.Next() {
-		var i GetProblemsByContestRow
+		var i GetTeamsByContestRow
 		if err := rows.Scan(
-			&i.Problem.ID,
-			&i.Problem.OrganizerID,
-			&i.Problem.ContestID,
-			&i.Problem.Number,
-			&i.Problem.HoldColorPrimary,
-			&i.Problem.HoldColorSecondary,
-			&i.Problem.Zone1Enabled,
-			&i.Problem.Zone2Enabled,
-			&i.Problem.Description,
-			&i.Problem.PointsZone1,
-			&i.Problem.PointsZone2,
-			&i.Problem.PointsTop,
-			&i.Problem.FlashBonus,
+			&i.Team.ID,
+			&i.Team.OrganizerID,
+			&i.Team.ContestID,
+			&i.Team.Name,
+			&i.Team.CountedMembers,
 		); err != nil {
 			return nil, err
 		}
@@ -841,53 +2153,144 @@ func (q *Queries) GetProblemsByContest(ctx context.Context, contestID int32) ([]
 	return items, nil
 }
 
-const getRaffle = `-- name: GetRaffle :one
-SELECT raffle.id, raffle.organizer_id, raffle.contest_id
-FROM raffle
+const getTick = `-- name: GetTick :one
+SELECT tick.id, tick.organizer_id, tick.contest_id, tick.contender_id, tick.problem_id, tick.timestamp, tick.zone_1, tick.attempts_zone_1, tick.zone_2, tick.attempts_zone_2, tick.top, tick.attempts_top
+FROM tick
 WHERE id = ?
 `
 
-type GetRaffleRow struct {
-	Raffle Raffle
+type GetTickRow struct {
+	Tick Tick
 }
 
-func (q *Queries) GetRaffle(ctx context.Context, id int32) (GetRaffleRow, error) {
-	row := q.db.QueryRowContext(ctx, getRaffle, id)
-	var i GetRaffleRow
-	err := row.Scan(&i.Raffle.ID, &i.Raffle.OrganizerID, &i.Raffle.ContestID)
+func (q *Queries) GetTick(ctx context.Context, id int32) (GetTickRow, error) {
+	row := q.db.QueryRowContext(ctx, getTick, id)
+	var i GetTickRow
+	err := row.Scan(
+		&i.Tick.ID,
+		&i.Tick.OrganizerID,
+		&i.Tick.ContestID,
+		&i.Tick.ContenderID,
+		&i.Tick.ProblemID,
+		&i.Tick.Timestamp,
+		&i.Tick.Zone1,
+		&i.Tick.AttemptsZone1,
+		&i.Tick.Zone2,
+		&i.Tick.AttemptsZone2,
+		&i.Tick.Top,
+		&i.Tick.AttemptsTop,
+	)
 	return i, err
 }
 
-const getRaffleWinners = `-- name: GetRaffleWinners :many
-SELECT raffle_winner.id, raffle_winner.organizer_id, raffle_winner.raffle_id, raffle_winner.contender_id, raffle_winner.timestamp, contender.name, contender.scrubbed_at
-FROM raffle_winner
-JOIN contender ON contender.id = raffle_winner.contender_id
-WHERE raffle_id = ?
+const getTickByContenderAndProblem = `-- name: GetTickByContenderAndProblem :one
+SELECT tick.id, tick.organizer_id, tick.contest_id, tick.contender_id, tick.problem_id, tick.timestamp, tick.zone_1, tick.attempts_zone_1, tick.zone_2, tick.attempts_zone_2, tick.top, tick.attempts_top
+FROM tick
+WHERE contender_id = ? AND problem_id = ?
 `
 
-type GetRaffleWinnersRow struct {
-	RaffleWinner RaffleWinner
-	Name         sql.NullString
-	ScrubbedAt   sql.NullTime
+type GetTickByContenderAndProblemParams struct {
+	ContenderID int32
+	ProblemID   int32
 }
 
-func (q *Queries) GetRaffleWinners(ctx context.Context, raffleID int32) ([]GetRaffleWinnersRow, error) {
-	rows, err := q.db.QueryContext(ctx, getRaffleWinners, raffleID)
+type GetTickByContenderAndProblemRow struct {
+	Tick Tick
+}
+
+func (q *Queries) GetTickByContenderAndProblem(ctx context.Context, arg GetTickByContenderAndProblemParams) (GetTickByContenderAndProblemRow, error) {
+	row := q.db.QueryRowContext(ctx, getTickByContenderAndProblem, arg.ContenderID, arg.ProblemID)
+	var i GetTickByContenderAndProblemRow
+	err := row.Scan(
+		&i.Tick.ID,
+		&i.Tick.OrganizerID,
+		&i.Tick.ContestID,
+		&i.Tick.ContenderID,
+		&i.Tick.ProblemID,
+		&i.Tick.Timestamp,
+		&i.Tick.Zone1,
+		&i.Tick.AttemptsZone1,
+		&i.Tick.Zone2,
+		&i.Tick.AttemptsZone2,
+		&i.Tick.Top,
+		&i.Tick.AttemptsTop,
+	)
+	return i, err
+}
+
+const getTickDispute = `-- name: GetTickDispute :one
+SELECT tick_dispute.id, tick_dispute.organizer_id, tick_dispute.contest_id, tick_dispute.contender_id, tick_dispute.problem_id, tick_dispute.status, tick_dispute.comment, tick_dispute.response, tick_dispute.zone_1, tick_dispute.attempts_zone_1, tick_dispute.zone_2, tick_dispute.attempts_zone_2, tick_dispute.top, tick_dispute.attempts_top, tick_dispute.created, tick_dispute.resolved, tick_dispute.resolved_by
+FROM tick_dispute
+WHERE id = ?
+`
+
+type GetTickDisputeRow struct {
+	TickDispute TickDispute
+}
+
+func (q *Queries) GetTickDispute(ctx context.Context, id int32) (GetTickDisputeRow, error) {
+	row := q.db.QueryRowContext(ctx, getTickDispute, id)
+	var i GetTickDisputeRow
+	err := row.Scan(
+		&i.TickDispute.ID,
+		&i.TickDispute.OrganizerID,
+		&i.TickDispute.ContestID,
+		&i.TickDispute.ContenderID,
+		&i.TickDispute.ProblemID,
+		&i.TickDispute.Status,
+		&i.TickDispute.Comment,
+		&i.TickDispute.Response,
+		&i.TickDispute.Zone1,
+		&i.TickDispute.AttemptsZone1,
+		&i.TickDispute.Zone2,
+		&i.TickDispute.AttemptsZone2,
+		&i.TickDispute.Top,
+		&i.TickDispute.AttemptsTop,
+		&i.TickDispute.Created,
+		&i.TickDispute.Resolved,
+		&i.TickDispute.ResolvedBy,
+	)
+	return i, err
+}
+
+const getTickDisputesByContender = `-- name: GetTickDisputesByContender :many
+SELECT tick_dispute.id, tick_dispute.organizer_id, tick_dispute.contest_id, tick_dispute.contender_id, tick_dispute.problem_id, tick_dispute.status, tick_dispute.comment, tick_dispute.response, tick_dispute.zone_1, tick_dispute.attempts_zone_1, tick_dispute.zone_2, tick_dispute.attempts_zone_2, tick_dispute.top, tick_dispute.attempts_top, tick_dispute.created, tick_dispute.resolved, tick_dispute.resolved_by
+FROM tick_dispute
+WHERE contender_id = ?
+ORDER BY created, id
+`
+
+type GetTickDisputesByContenderRow struct {
+	TickDispute TickDispute
+}
+
+func (q *Queries) GetTickDisputesByContender(ctx context.Context, contenderID int32) ([]GetTickDisputesByContenderRow, error) {
+	rows, err := q.db.QueryContext(ctx, getTickDisputesByContender, contenderID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []GetRaffleWinnersRow
+	var items []GetTickDisputesByContenderRow
 	for rows.Next() {
-		var i GetRaffleWinnersRow
+		var i GetTickDisputesByContenderRow
 		if err := rows.Scan(
-			&i.RaffleWinner.ID,
-			&i.RaffleWinner.OrganizerID,
-			&i.RaffleWinner.RaffleID,
-			&i.RaffleWinner.ContenderID,
-			&i.RaffleWinner.Timestamp,
-			&i.Name,
-			&i.ScrubbedAt,
+			&i.TickDispute.ID,
+			&i.TickDispute.OrganizerID,
+			&i.TickDispute.ContestID,
+			&i.TickDispute.ContenderID,
+			&i.TickDispute.ProblemID,
+			&i.TickDispute.Status,
+			&i.TickDispute.Comment,
+			&i.TickDispute.Response,
+			&i.TickDispute.Zone1,
+			&i.TickDispute.AttemptsZone1,
+			&i.TickDispute.Zone2,
+			&i.TickDispute.AttemptsZone2,
+			&i.TickDispute.Top,
+			&i.TickDispute.AttemptsTop,
+			&i.TickDispute.Created,
+			&i.TickDispute.Resolved,
+			&i.TickDispute.ResolvedBy,
 		); err != nil {
 			return nil, err
 		}
@@ -902,26 +2305,45 @@ func (q *Queries) GetRaffleWinners(ctx context.Context, raffleID int32) ([]GetRa
 	return items, nil
 }
 
-const getRafflesByContest = `-- name: GetRafflesByContest :many
-SELECT raffle.id, raffle.organizer_id, raffle.contest_id
-FROM raffle
+const getTickDisputesByContest = `-- name: GetTickDisputesByContest :many
+SELECT tick_dispute.id, tick_dispute.organizer_id, tick_dispute.contest_id, tick_dispute.contender_id, tick_dispute.problem_id, tick_dispute.status, tick_dispute.comment, tick_dispute.response, tick_dispute.zone_1, tick_dispute.attempts_zone_1, tick_dispute.zone_2, tick_dispute.attempts_zone_2, tick_dispute.top, tick_dispute.attempts_top, tick_dispute.created, tick_dispute.resolved, tick_dispute.resolved_by
+FROM tick_dispute
 WHERE contest_id = ?
+ORDER BY created, id
 `
 
-type GetRafflesByContestRow struct {
-	Raffle Raffle
+type GetTickDisputesByContestRow struct {
+	TickDispute TickDispute
 }
 
-func (q *Queries) GetRafflesByContest(ctx context.Context, contestID int32) ([]GetRafflesByContestRow, error) {
-	rows, err := q.db.QueryContext(ctx, getRafflesByContest, contestID)
+func (q *Queries) GetTickDisputesByContest(ctx context.Context, contestID int32) ([]GetTickDisputesByContestRow, error) {
+	rows, err := q.db.QueryContext(ctx, getTickDisputesByContest, contestID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []GetRafflesByContestRow
+	var items []GetTickDisputesByContestRow
 	for rows.Next() {
-		var i GetRafflesByContestRow
-		if err := rows.Scan(&i.Raffle.ID, &i.Raffle.OrganizerID, &i.Raffle.ContestID); err != nil {
+		var i GetTickDisputesByContestRow
+		if err := rows.Scan(
+			&i.TickDispute.ID,
+			&i.TickDispute.OrganizerID,
+			&i.TickDispute.ContestID,
+			&i.TickDispute.ContenderID,
+			&i.TickDispute.ProblemID,
+			&i.TickDispute.Status,
+			&i.TickDispute.Comment,
+			&i.TickDispute.Response,
+			&i.TickDispute.Zone1,
+			&i.TickDispute.AttemptsZone1,
+			&i.TickDispute.Zone2,
+			&i.TickDispute.AttemptsZone2,
+			&i.TickDispute.Top,
+			&i.TickDispute.AttemptsTop,
+			&i.TickDispute.Created,
+			&i.TickDispute.Resolved,
+			&i.TickDispute.ResolvedBy,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -935,52 +2357,84 @@ func (q *Queries) GetRafflesByContest(ctx context.Context, contestID int32) ([]G
 	return items, nil
 }
 
-const getScrubEligibleContenders = `-- name: GetScrubEligibleContenders :many
-SELECT contender.id, contender.organizer_id, contender.contest_id, contender.registration_code, contender.name, contender.class_id, contender.entered, contender.disqualified, contender.withdrawn_from_finals, contender.scrubbed_at, contender.scrub_before, score.contender_id, score.timestamp, score.score, score.placement, score.finalist, score.rank_order
-FROM contender
-LEFT JOIN score ON score.contender_id = id
-WHERE contender.name != ''
-  AND contender.scrub_before IS NOT NULL
-  AND contender.scrub_before < ?
+const getTickRevisionByIdempotencyKey = `-- name: GetTickRevisionByIdempotencyKey :one
+SELECT tick_revision.id, tick_revision.contest_id, tick_revision.contender_id, tick_revision.problem_id, tick_revision.tick_id, tick_revision.action, tick_revision.timestamp, tick_revision.recorded_at, tick_revision.actor_role, tick_revision.actor_username, tick_revision.idempotency_key, tick_revision.zone_1, tick_revision.attempts_zone_1, tick_revision.zone_2, tick_revision.attempts_zone_2, tick_revision.top, tick_revision.attempts_top
+FROM tick_revision
+WHERE contender_id = ? AND idempotency_key = ?
+`
+
+type GetTickRevisionByIdempotencyKeyParams struct {
+	ContenderID    int32
+	IdempotencyKey sql.NullString
+}
+
+type GetTickRevisionByIdempotencyKeyRow struct {
+	TickRevision TickRevision
+}
+
+func (q *Queries) GetTickRevisionByIdempotencyKey(ctx context.Context, arg GetTickRevisionByIdempotencyKeyParams) (GetTickRevisionByIdempotencyKeyRow, error) {
+	row := q.db.QueryRowContext(ctx, getTickRevisionByIdempotencyKey, arg.ContenderID, arg.IdempotencyKey)
+	var i GetTickRevisionByIdempotencyKeyRow
+	err := row.Scan(
+		&i.TickRevision.ID,
+		&i.TickRevision.ContestID,
+		&i.TickRevision.ContenderID,
+		&i.TickRevision.ProblemID,
+		&i.TickRevision.TickID,
+		&i.TickRevision.Action,
+		&i.TickRevision.Timestamp,
+		&i.TickRevision.RecordedAt,
+		&i.TickRevision.ActorRole,
+		&i.TickRevision.ActorUsername,
+		&i.TickRevision.IdempotencyKey,
+		&i.TickRevision.Zone1,
+		&i.TickRevision.AttemptsZone1,
+		&i.TickRevision.Zone2,
+		&i.TickRevision.AttemptsZone2,
+		&i.TickRevision.Top,
+		&i.TickRevision.AttemptsTop,
+	)
+	return i, err
+}
+
+const getTickRevisionsByContender = `-- name: GetTickRevisionsByContender :many
+SELECT tick_revision.id, tick_revision.contest_id, tick_revision.contender_id, tick_revision.problem_id, tick_revision.tick_id, tick_revision.action, tick_revision.timestamp, tick_revision.recorded_at, tick_revision.actor_role, tick_revision.actor_username, tick_revision.idempotency_key, tick_revision.zone_1, tick_revision.attempts_zone_1, tick_revision.zone_2, tick_revision.attempts_zone_2, tick_revision.top, tick_revision.attempts_top
+FROM tick_revision
+WHERE contender_id = ?
+ORDER BY recorded_at, id
 `
 
-type GetScrubEligibleContendersRow struct {
-	Contender   Contender
-	ContenderID sql.NullInt32
-	Timestamp   sql.NullTime
-	Score       sql.NullInt32
-	Placement   sql.NullInt32
-	Finalist    sql.NullBool
-	RankOrder   sql.NullInt32
+type GetTickRevisionsByContenderRow struct {
+	TickRevision TickRevision
 }
 
-func (q *Queries) GetScrubEligibleContenders(ctx context.Context, scrubBefore sql.NullTime) ([]GetScrubEligibleContendersRow, error) {
-	rows, err := q.db.QueryContext(ctx, getScrubEligibleContenders, scrubBefore)
+func (q *Queries) GetTickRevisionsByContender(ctx context.Context, contenderID int32) ([]GetTickRevisionsByContenderRow, error) {
+	rows, err := q.db.QueryContext(ctx, getTickRevisionsByContender, contenderID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []GetScrubEligibleContendersRow
+	var items []GetTickRevisionsByContenderRow
 	for rows.Next() {
-		var i GetScrubEligibleContendersRow
+		var i GetTickRevisionsByContenderRow
 		if err := rows.Scan(
-			&i.Contender.ID,
-			&i.Contender.OrganizerID,
-			&i.Contender.ContestID,
-			&i.Contender.RegistrationCode,
-			&i.Contender.Name,
-			&i.Contender.ClassID,
-			&i.Contender.Entered,
-			&i.Contender.Disqualified,
-			&i.Contender.WithdrawnFromFinals,
-			&i.Contender.ScrubbedAt,
-			&i.Contender.ScrubBefore,
-			&i.ContenderID,
-			&i.Timestamp,
-			&i.Score,
-			&i.Placement,
-			&i.Finalist,
-			&i.RankOrder,
+			&i.TickRevision.ID,
+			&i.TickRevision.ContestID,
+			&i.TickRevision.ContenderID,
+			&i.TickRevision.ProblemID,
+			&i.TickRevision.TickID,
+			&i.TickRevision.Action,
+			&i.TickRevision.Timestamp,
+			&i.TickRevision.RecordedAt,
+			&i.TickRevision.ActorRole,
+			&i.TickRevision.ActorUsername,
+			&i.TickRevision.IdempotencyKey,
+			&i.TickRevision.Zone1,
+			&i.TickRevision.AttemptsZone1,
+			&i.TickRevision.Zone2,
+			&i.TickRevision.AttemptsZone2,
+			&i.TickRevision.Top,
+			&i.TickRevision.AttemptsTop,
 		); err != nil {
 			return nil, err
 		}
@@ -995,69 +2449,61 @@ func (q *Queries) GetScrubEligibleContenders(ctx context.Context, scrubBefore sq
 	return items, nil
 }
 
-const getTick = `-- name: GetTick :one
-SELECT tick.id, tick.organizer_id, tick.contest_id, tick.contender_id, tick.problem_id, tick.timestamp, tick.zone_1, tick.attempts_zone_1, tick.zone_2, tick.attempts_zone_2, tick.top, tick.attempts_top
-FROM tick
-WHERE id = ?
-`
-
-type GetTickRow struct {
-	Tick Tick
-}
-
-func (q *Queries) GetTick(ctx context.Context, id int32) (GetTickRow, error) {
-	row := q.db.QueryRowContext(ctx, getTick, id)
-	var i GetTickRow
-	err := row.Scan(
-		&i.Tick.ID,
-		&i.Tick.OrganizerID,
-		&i.Tick.ContestID,
-		&i.Tick.ContenderID,
-		&i.Tick.ProblemID,
-		&i.Tick.Timestamp,
-		&i.Tick.Zone1,
-		&i.Tick.AttemptsZone1,
-		&i.Tick.Zone2,
-		&i.Tick.AttemptsZone2,
-		&i.Tick.Top,
-		&i.Tick.AttemptsTop,
-	)
-	return i, err
-}
-
-const getTickByContenderAndProblem = `-- name: GetTickByContenderAndProblem :one
-SELECT tick.id, tick.organizer_id, tick.contest_id, tick.contender_id, tick.problem_id, tick.timestamp, tick.zone_1, tick.attempts_zone_1, tick.zone_2, tick.attempts_zone_2, tick.top, tick.attempts_top
-FROM tick
+const getTickRevisionsByContenderAndProblem = `-- name: GetTickRevisionsByContenderAndProblem :many
+SELECT tick_revision.id, tick_revision.contest_id, tick_revision.contender_id, tick_revision.problem_id, tick_revision.tick_id, tick_revision.action, tick_revision.timestamp, tick_revision.recorded_at, tick_revision.actor_role, tick_revision.actor_username, tick_revision.idempotency_key, tick_revision.zone_1, tick_revision.attempts_zone_1, tick_revision.zone_2, tick_revision.attempts_zone_2, tick_revision.top, tick_revision.attempts_top
+FROM tick_revision
 WHERE contender_id = ? AND problem_id = ?
+ORDER BY recorded_at, id
 `
 
-type GetTickByContenderAndProblemParams struct {
+type GetTickRevisionsByContenderAndProblemParams struct {
 	ContenderID int32
 	ProblemID   int32
 }
 
-type GetTickByContenderAndProblemRow struct {
-	Tick Tick
+type GetTickRevisionsByContenderAndProblemRow struct {
+	TickRevision TickRevision
 }
 
-func (q *Queries) GetTickByContenderAndProblem(ctx context.Context, arg GetTickByContenderAndProblemParams) (GetTickByContenderAndProblemRow, error) {
-	row := q.db.QueryRowContext(ctx, getTickByContenderAndProblem, arg.ContenderID, arg.ProblemID)
-	var i GetTickByContenderAndProblemRow
-	err := row.Scan(
-		&i.Tick.ID,
-		&i.Tick.OrganizerID,
-		&i.Tick.ContestID,
-		&i.Tick.ContenderID,
-		&i.Tick.ProblemID,
-		&i.Tick.Timestamp,
-		&i.Tick.Zone1,
-		&i.Tick.AttemptsZone1,
-		&i.Tick.Zone2,
-		&i.Tick.AttemptsZone2,
-		&i.Tick.Top,
-		&i.Tick.AttemptsTop,
-	)
-	return i, err
+func (q *Queries) GetTickRevisionsByContenderAndProblem(ctx context.Context, arg GetTickRevisionsByContenderAndProblemParams) ([]GetTickRevisionsByContenderAndProblemRow, error) {
+	rows, err := q.db.QueryContext(ctx, getTickRevisionsByContenderAndProblem, arg.ContenderID, arg.ProblemID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTickRevisionsByContenderAndProblemRow
+	for rows.Next() {
+		var i GetTickRevisionsByContenderAndProblemRow
+		if err := rows.Scan(
+			&i.TickRevision.ID,
+			&i.TickRevision.ContestID,
+			&i.TickRevision.ContenderID,
+			&i.TickRevision.ProblemID,
+			&i.TickRevision.TickID,
+			&i.TickRevision.Action,
+			&i.TickRevision.Timestamp,
+			&i.TickRevision.RecordedAt,
+			&i.TickRevision.ActorRole,
+			&i.TickRevision.ActorUsername,
+			&i.TickRevision.IdempotencyKey,
+			&i.TickRevision.Zone1,
+			&i.TickRevision.AttemptsZone1,
+			&i.TickRevision.Zone2,
+			&i.TickRevision.AttemptsZone2,
+			&i.TickRevision.Top,
+			&i.TickRevision.AttemptsTop,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
 const getTicksByContender = `-- name: GetTicksByContender :many
@@ -1152,6 +2598,75 @@ func (q *Queries) GetTicksByContest(ctx context.Context, contestID int32) ([]Get
 	return items, nil
 }
 
+const getTicksByContestFiltered = `-- name: GetTicksByContestFiltered :many
+SELECT tick.id, tick.organizer_id, tick.contest_id, tick.contender_id, tick.problem_id, tick.timestamp, tick.zone_1, tick.attempts_zone_1, tick.zone_2, tick.attempts_zone_2, tick.top, tick.attempts_top
+FROM tick
+WHERE
+	contest_id = ?
+	AND (? IS NULL OR timestamp >= ?)
+	AND (? = 0 OR IF(?, id < ?, id > ?))
+ORDER BY IF(?, -id, id)
+LIMIT ?
+`
+
+type GetTicksByContestFilteredParams struct {
+	ContestID  int32
+	Since      sql.NullTime
+	After      int32
+	Descending interface{}
+	Limit      int32
+}
+
+type GetTicksByContestFilteredRow struct {
+	Tick Tick
+}
+
+func (q *Queries) GetTicksByContestFiltered(ctx context.Context, arg GetTicksByContestFilteredParams) ([]GetTicksByContestFilteredRow, error) {
+	rows, err := q.db.QueryContext(ctx, getTicksByContestFiltered,
+		arg.ContestID,
+		arg.Since,
+		arg.Since,
+		arg.After,
+		arg.Descending,
+		arg.After,
+		arg.After,
+		arg.Descending,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTicksByContestFilteredRow
+	for rows.Next() {
+		var i GetTicksByContestFilteredRow
+		if err := rows.Scan(
+			&i.Tick.ID,
+			&i.Tick.OrganizerID,
+			&i.Tick.ContestID,
+			&i.Tick.ContenderID,
+			&i.Tick.ProblemID,
+			&i.Tick.Timestamp,
+			&i.Tick.Zone1,
+			&i.Tick.AttemptsZone1,
+			&i.Tick.Zone2,
+			&i.Tick.AttemptsZone2,
+			&i.Tick.Top,
+			&i.Tick.AttemptsTop,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getTicksByProblem = `-- name: GetTicksByProblem :many
 SELECT tick.id, tick.organizer_id, tick.contest_id, tick.contender_id, tick.problem_id, tick.timestamp, tick.zone_1, tick.attempts_zone_1, tick.zone_2, tick.attempts_zone_2, tick.top, tick.attempts_top
 FROM tick
@@ -1271,32 +2786,294 @@ func (q *Queries) GetUsersByOrganizer(ctx context.Context, organizerID int32) ([
 	if err := rows.Err(); err != nil {
 		return nil, err
 	}
-	return items, nil
+	return items, nil
+}
+
+const getWaitlistedContenders = `-- name: GetWaitlistedContenders :many
+SELECT contender.id, contender.organizer_id, contender.contest_id, contender.registration_code, contender.name, contender.class_id, contender.team_id, contender.entered, contender.disqualified, contender.withdrawn_from_finals, contender.scrubbed_at, contender.scrub_before, contender.waitlist_class_id, contender.waitlisted_at, contender.version, score.contender_id, score.timestamp, score.score, score.placement, score.finalist, score.rank_order
+FROM contender
+LEFT JOIN score ON score.contender_id = id
+WHERE waitlist_class_id = ?
+ORDER BY waitlisted_at, id
+`
+
+type GetWaitlistedContendersRow struct {
+	Contender   Contender
+	ContenderID sql.NullInt32
+	Timestamp   sql.NullTime
+	Score       sql.NullInt32
+	Placement   sql.NullInt32
+	Finalist    sql.NullBool
+	RankOrder   sql.NullInt32
+}
+
+func (q *Queries) GetWaitlistedContenders(ctx context.Context, waitlistClassID sql.NullInt32) ([]GetWaitlistedContendersRow, error) {
+	rows, err := q.db.QueryContext(ctx, getWaitlistedContenders, waitlistClassID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetWaitlistedContendersRow
+	for rows.Next() {
+		var i GetWaitlistedContendersRow
+		if err := rows.Scan(
+			&i.Contender.ID,
+			&i.Contender.OrganizerID,
+			&i.Contender.ContestID,
+			&i.Contender.RegistrationCode,
+			&i.Contender.Name,
+			&i.Contender.ClassID,
+			&i.Contender.TeamID,
+			&i.Contender.Entered,
+			&i.Contender.Disqualified,
+			&i.Contender.WithdrawnFromFinals,
+			&i.Contender.ScrubbedAt,
+			&i.Contender.ScrubBefore,
+			&i.Contender.WaitlistClassID,
+			&i.Contender.WaitlistedAt,
+			&i.Contender.Version,
+			&i.ContenderID,
+			&i.Timestamp,
+			&i.Score,
+			&i.Placement,
+			&i.Finalist,
+			&i.RankOrder,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const insertAuditEntry = `-- name: InsertAuditEntry :execlastid
+INSERT INTO
+    audit_entry (organizer_id, contest_id, contender_id, action, timestamp)
+VALUES
+    (?, ?, ?, ?, ?)
+`
+
+type InsertAuditEntryParams struct {
+	OrganizerID int32
+	ContestID   int32
+	ContenderID sql.NullInt32
+	Action      string
+	Timestamp   time.Time
+}
+
+func (q *Queries) InsertAuditEntry(ctx context.Context, arg InsertAuditEntryParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, insertAuditEntry,
+		arg.OrganizerID,
+		arg.ContestID,
+		arg.ContenderID,
+		arg.Action,
+		arg.Timestamp,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.LastInsertId()
+}
+
+const insertOrganizerInvite = `-- name: InsertOrganizerInvite :exec
+INSERT INTO
+    organizer_invite (id, organizer_id, expires_at)
+VALUES
+    (?, ?, ?)
+`
+
+type InsertOrganizerInviteParams struct {
+	ID          string
+	OrganizerID int32
+	ExpiresAt   time.Time
+}
+
+func (q *Queries) InsertOrganizerInvite(ctx context.Context, arg InsertOrganizerInviteParams) error {
+	_, err := q.db.ExecContext(ctx, insertOrganizerInvite, arg.ID, arg.OrganizerID, arg.ExpiresAt)
+	return err
+}
+
+const insertProblemCompClass = `-- name: InsertProblemCompClass :exec
+INSERT INTO
+    problem_comp_class (problem_id, comp_class_id)
+VALUES
+    (?, ?)
+`
+
+type InsertProblemCompClassParams struct {
+	ProblemID   int32
+	CompClassID int32
+}
+
+func (q *Queries) InsertProblemCompClass(ctx context.Context, arg InsertProblemCompClassParams) error {
+	_, err := q.db.ExecContext(ctx, insertProblemCompClass, arg.ProblemID, arg.CompClassID)
+	return err
+}
+
+const insertRetentionPolicyExecution = `-- name: InsertRetentionPolicyExecution :execlastid
+INSERT INTO
+    retention_policy_execution (organizer_id, retention_policy_id, timestamp, contests, contenders, ticks, error)
+VALUES
+    (?, ?, ?, ?, ?, ?, ?)
+`
+
+type InsertRetentionPolicyExecutionParams struct {
+	OrganizerID       int32
+	RetentionPolicyID int32
+	Timestamp         time.Time
+	Contests          int32
+	Contenders        int32
+	Ticks             int32
+	Error             sql.NullString
+}
+
+func (q *Queries) InsertRetentionPolicyExecution(ctx context.Context, arg InsertRetentionPolicyExecutionParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, insertRetentionPolicyExecution,
+		arg.OrganizerID,
+		arg.RetentionPolicyID,
+		arg.Timestamp,
+		arg.Contests,
+		arg.Contenders,
+		arg.Ticks,
+		arg.Error,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.LastInsertId()
 }
 
-const insertOrganizerInvite = `-- name: InsertOrganizerInvite :exec
+const insertStartListEntry = `-- name: InsertStartListEntry :exec
 INSERT INTO
-    organizer_invite (id, organizer_id, expires_at)
+    round_contender (round_id, contender_id, previous_placement, timestamp, score, placement, finalist, rank_order)
 VALUES
-    (?, ?, ?)
+    (?, ?, ?, ?, ?, ?, ?, ?)
 `
 
-type InsertOrganizerInviteParams struct {
-	ID          string
-	OrganizerID int32
-	ExpiresAt   time.Time
+type InsertStartListEntryParams struct {
+	RoundID           int32
+	ContenderID       int32
+	PreviousPlacement int32
+	Timestamp         sql.NullTime
+	Score             sql.NullInt32
+	Placement         sql.NullInt32
+	Finalist          sql.NullBool
+	RankOrder         sql.NullInt32
 }
 
-func (q *Queries) InsertOrganizerInvite(ctx context.Context, arg InsertOrganizerInviteParams) error {
-	_, err := q.db.ExecContext(ctx, insertOrganizerInvite, arg.ID, arg.OrganizerID, arg.ExpiresAt)
+func (q *Queries) InsertStartListEntry(ctx context.Context, arg InsertStartListEntryParams) error {
+	_, err := q.db.ExecContext(ctx, insertStartListEntry,
+		arg.RoundID,
+		arg.ContenderID,
+		arg.PreviousPlacement,
+		arg.Timestamp,
+		arg.Score,
+		arg.Placement,
+		arg.Finalist,
+		arg.RankOrder,
+	)
 	return err
 }
 
+const insertTickRevision = `-- name: InsertTickRevision :execlastid
+INSERT INTO
+    tick_revision (contest_id, contender_id, problem_id, tick_id, action, timestamp, recorded_at, actor_role, actor_username, idempotency_key, zone_1, attempts_zone_1, zone_2, attempts_zone_2, top, attempts_top)
+VALUES
+    (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+`
+
+type InsertTickRevisionParams struct {
+	ContestID      int32
+	ContenderID    int32
+	ProblemID      int32
+	TickID         int32
+	Action         string
+	Timestamp      time.Time
+	RecordedAt     time.Time
+	ActorRole      string
+	ActorUsername  sql.NullString
+	IdempotencyKey sql.NullString
+	Zone1          bool
+	AttemptsZone1  int32
+	Zone2          bool
+	AttemptsZone2  int32
+	Top            bool
+	AttemptsTop    int32
+}
+
+func (q *Queries) InsertTickRevision(ctx context.Context, arg InsertTickRevisionParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, insertTickRevision,
+		arg.ContestID,
+		arg.ContenderID,
+		arg.ProblemID,
+		arg.TickID,
+		arg.Action,
+		arg.Timestamp,
+		arg.RecordedAt,
+		arg.ActorRole,
+		arg.ActorUsername,
+		arg.IdempotencyKey,
+		arg.Zone1,
+		arg.AttemptsZone1,
+		arg.Zone2,
+		arg.AttemptsZone2,
+		arg.Top,
+		arg.AttemptsTop,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.LastInsertId()
+}
+
+const updateRoundScore = `-- name: UpdateRoundScore :execrows
+UPDATE round_contender
+SET
+    timestamp = ?,
+    score = ?,
+    placement = ?,
+    finalist = ?,
+    rank_order = ?
+WHERE round_id = ? AND contender_id = ?
+`
+
+type UpdateRoundScoreParams struct {
+	Timestamp   sql.NullTime
+	Score       sql.NullInt32
+	Placement   sql.NullInt32
+	Finalist    sql.NullBool
+	RankOrder   sql.NullInt32
+	RoundID     int32
+	ContenderID int32
+}
+
+func (q *Queries) UpdateRoundScore(ctx context.Context, arg UpdateRoundScoreParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, updateRoundScore,
+		arg.Timestamp,
+		arg.Score,
+		arg.Placement,
+		arg.Finalist,
+		arg.RankOrder,
+		arg.RoundID,
+		arg.ContenderID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const upsertCompClass = `-- name: UpsertCompClass :execlastid
 INSERT INTO 
-	comp_class (id, organizer_id, contest_id, name, description, color, time_begin, time_end)
+	comp_class (id, organizer_id, contest_id, name, description, color, time_begin, time_end, capacity)
 VALUES 
-	(?, ?, ?, ?, ?, ?, ?, ?)
+	(?, ?, ?, ?, ?, ?, ?, ?, ?)
 ON DUPLICATE KEY UPDATE
     organizer_id = VALUES(organizer_id),
     contest_id = VALUES(contest_id),
@@ -1304,7 +3081,8 @@ ON DUPLICATE KEY UPDATE
     description = VALUES(description),
     color = VALUES(color),
     time_begin = VALUES(time_begin),
-    time_end = VALUES(time_end)
+    time_end = VALUES(time_end),
+    capacity = VALUES(capacity)
 `
 
 type UpsertCompClassParams struct {
@@ -1316,6 +3094,7 @@ type UpsertCompClassParams struct {
 	Color       sql.NullString
 	TimeBegin   time.Time
 	TimeEnd     time.Time
+	Capacity    int32
 }
 
 func (q *Queries) UpsertCompClass(ctx context.Context, arg UpsertCompClassParams) (int64, error) {
@@ -1328,6 +3107,7 @@ func (q *Queries) UpsertCompClass(ctx context.Context, arg UpsertCompClassParams
 		arg.Color,
 		arg.TimeBegin,
 		arg.TimeEnd,
+		arg.Capacity,
 	)
 	if err != nil {
 		return 0, err
@@ -1337,20 +3117,24 @@ func (q *Queries) UpsertCompClass(ctx context.Context, arg UpsertCompClassParams
 
 const upsertContender = `-- name: UpsertContender :execlastid
 INSERT INTO 
-	contender (id, organizer_id, contest_id, registration_code, name, class_id, entered, disqualified, withdrawn_from_finals, scrubbed_at, scrub_before)
+	contender (id, organizer_id, contest_id, registration_code, name, class_id, team_id, entered, disqualified, withdrawn_from_finals, scrubbed_at, scrub_before, waitlist_class_id, waitlisted_at, version)
 VALUES 
-	(?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	(?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 ON DUPLICATE KEY UPDATE
     organizer_id = VALUES(organizer_id),
     contest_id = VALUES(contest_id),
     registration_code = VALUES(registration_code),
     name = VALUES(name),
     class_id = VALUES(class_id),
+    team_id = VALUES(team_id),
     entered = VALUES(entered),
     disqualified = VALUES(disqualified),
     withdrawn_from_finals = VALUES(withdrawn_from_finals),
     scrubbed_at = VALUES(scrubbed_at),
-    scrub_before = VALUES(scrub_before)
+    scrub_before = VALUES(scrub_before),
+    waitlist_class_id = VALUES(waitlist_class_id),
+    waitlisted_at = VALUES(waitlisted_at),
+    version = version + 1
 `
 
 type UpsertContenderParams struct {
@@ -1360,11 +3144,15 @@ type UpsertContenderParams struct {
 	RegistrationCode    string
 	Name                sql.NullString
 	ClassID             sql.NullInt32
+	TeamID              sql.NullInt32
 	Entered             sql.NullTime
 	Disqualified        bool
 	WithdrawnFromFinals bool
 	ScrubbedAt          sql.NullTime
 	ScrubBefore         sql.NullTime
+	WaitlistClassID     sql.NullInt32
+	WaitlistedAt        sql.NullTime
+	Version             int32
 }
 
 func (q *Queries) UpsertContender(ctx context.Context, arg UpsertContenderParams) (int64, error) {
@@ -1375,11 +3163,15 @@ func (q *Queries) UpsertContender(ctx context.Context, arg UpsertContenderParams
 		arg.RegistrationCode,
 		arg.Name,
 		arg.ClassID,
+		arg.TeamID,
 		arg.Entered,
 		arg.Disqualified,
 		arg.WithdrawnFromFinals,
 		arg.ScrubbedAt,
 		arg.ScrubBefore,
+		arg.WaitlistClassID,
+		arg.WaitlistedAt,
+		arg.Version,
 	)
 	if err != nil {
 		return 0, err
@@ -1389,9 +3181,9 @@ func (q *Queries) UpsertContender(ctx context.Context, arg UpsertContenderParams
 
 const upsertContest = `-- name: UpsertContest :execlastid
 INSERT INTO 
-	contest (id, organizer_id, archived_at, series_id, name, description, location, country, qualifying_problems, finalists, info, grace_period, name_retention_time, created)
+	contest (id, organizer_id, archived_at, series_id, name, description, location, country, qualifying_problems, finalists, info, grace_period, name_retention_time, scoreboard_freeze, scoreboard_revealed_at, self_registration, created, version)
 VALUES 
-	(?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	(?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 ON DUPLICATE KEY UPDATE
     organizer_id = VALUES(organizer_id),
     archived_at = VALUES(archived_at),
@@ -1405,24 +3197,32 @@ ON DUPLICATE KEY UPDATE
     info = VALUES(info),
     grace_period = VALUES(grace_period),
     name_retention_time = VALUES(name_retention_time),
-    created = VALUES(created)
+    scoreboard_freeze = VALUES(scoreboard_freeze),
+    scoreboard_revealed_at = VALUES(scoreboard_revealed_at),
+    self_registration = VALUES(self_registration),
+    created = VALUES(created),
+    version = version + 1
 `
 
 type UpsertContestParams struct {
-	ID                 int32
-	OrganizerID        int32
-	ArchivedAt         sql.NullTime
-	SeriesID           sql.NullInt32
-	Name               string
-	Description        sql.NullString
-	Location           sql.NullString
-	Country            string
-	QualifyingProblems int32
-	Finalists          int32
-	Info               sql.NullString
-	GracePeriod        int32
-	NameRetentionTime  int32
-	Created            time.Time
+	ID                   int32
+	OrganizerID          int32
+	ArchivedAt           sql.NullTime
+	SeriesID             sql.NullInt32
+	Name                 string
+	Description          sql.NullString
+	Location             sql.NullString
+	Country              string
+	QualifyingProblems   int32
+	Finalists            int32
+	Info                 sql.NullString
+	GracePeriod          int32
+	NameRetentionTime    int32
+	ScoreboardFreeze     int32
+	ScoreboardRevealedAt sql.NullTime
+	SelfRegistration     bool
+	Created              time.Time
+	Version              int32
 }
 
 func (q *Queries) UpsertContest(ctx context.Context, arg UpsertContestParams) (int64, error) {
@@ -1440,7 +3240,11 @@ func (q *Queries) UpsertContest(ctx context.Context, arg UpsertContestParams) (i
 		arg.Info,
 		arg.GracePeriod,
 		arg.NameRetentionTime,
+		arg.ScoreboardFreeze,
+		arg.ScoreboardRevealedAt,
+		arg.SelfRegistration,
 		arg.Created,
+		arg.Version,
 	)
 	if err != nil {
 		return 0, err
@@ -1472,38 +3276,46 @@ func (q *Queries) UpsertOrganizer(ctx context.Context, arg UpsertOrganizerParams
 
 const upsertProblem = `-- name: UpsertProblem :execlastid
 INSERT INTO 
-	problem (id, organizer_id, contest_id, number, hold_color_primary, hold_color_secondary, zone_1_enabled, zone_2_enabled, description, points_zone_1, points_zone_2, points_top, flash_bonus)
+	problem (id, organizer_id, contest_id, round_id, number, hold_color_primary, hold_color_secondary, zone_1_enabled, zone_2_enabled, description, sector, tags, points_zone_1, points_zone_2, points_top, flash_bonus, version)
 VALUES 
-	(?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	(?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 ON DUPLICATE KEY UPDATE
     organizer_id = VALUES(organizer_id),
     contest_id = VALUES(contest_id),
+    round_id = VALUES(round_id),
     number = VALUES(number),
     hold_color_primary = VALUES(hold_color_primary),
     hold_color_secondary = VALUES(hold_color_secondary),
     zone_1_enabled = VALUES(zone_1_enabled),
     zone_2_enabled = VALUES(zone_2_enabled),
     description = VALUES(description),
+    sector = VALUES(sector),
+    tags = VALUES(tags),
     points_zone_1 = VALUES(points_zone_1),
     points_zone_2 = VALUES(points_zone_2),
     points_top = VALUES(points_top),
-    flash_bonus = VALUES(flash_bonus)
+    flash_bonus = VALUES(flash_bonus),
+    version = version + 1
 `
 
 type UpsertProblemParams struct {
 	ID                 int32
 	OrganizerID        int32
 	ContestID          int32
+	RoundID            sql.NullInt32
 	Number             int32
 	HoldColorPrimary   string
 	HoldColorSecondary sql.NullString
 	Zone1Enabled       bool
 	Zone2Enabled       bool
 	Description        sql.NullString
+	Sector             sql.NullString
+	Tags               json.RawMessage
 	PointsZone1        sql.NullInt32
 	PointsZone2        sql.NullInt32
 	PointsTop          int32
 	FlashBonus         sql.NullInt32
+	Version            int32
 }
 
 func (q *Queries) UpsertProblem(ctx context.Context, arg UpsertProblemParams) (int64, error) {
@@ -1511,16 +3323,20 @@ func (q *Queries) UpsertProblem(ctx context.Context, arg UpsertProblemParams) (i
 		arg.ID,
 		arg.OrganizerID,
 		arg.ContestID,
+		arg.RoundID,
 		arg.Number,
 		arg.HoldColorPrimary,
 		arg.HoldColorSecondary,
 		arg.Zone1Enabled,
 		arg.Zone2Enabled,
 		arg.Description,
+		arg.Sector,
+		arg.Tags,
 		arg.PointsZone1,
 		arg.PointsZone2,
 		arg.PointsTop,
 		arg.FlashBonus,
+		arg.Version,
 	)
 	if err != nil {
 		return 0, err
@@ -1528,24 +3344,108 @@ func (q *Queries) UpsertProblem(ctx context.Context, arg UpsertProblemParams) (i
 	return result.LastInsertId()
 }
 
+const upsertPublishedScore = `-- name: UpsertPublishedScore :exec
+INSERT INTO
+    published_score (contender_id, timestamp, score, placement, finalist, rank_order)
+VALUES
+    (?, ?, ?, ?, ?, ?)
+ON DUPLICATE KEY UPDATE
+    timestamp = VALUES(timestamp),
+    score = VALUES(score),
+    placement = VALUES(placement),
+    finalist = VALUES(finalist),
+    rank_order = VALUES(rank_order)
+`
+
+type UpsertPublishedScoreParams struct {
+	ContenderID int32
+	Timestamp   time.Time
+	Score       int32
+	Placement   int32
+	Finalist    bool
+	RankOrder   int32
+}
+
+func (q *Queries) UpsertPublishedScore(ctx context.Context, arg UpsertPublishedScoreParams) error {
+	_, err := q.db.ExecContext(ctx, upsertPublishedScore,
+		arg.ContenderID,
+		arg.Timestamp,
+		arg.Score,
+		arg.Placement,
+		arg.Finalist,
+		arg.RankOrder,
+	)
+	return err
+}
+
 const upsertRaffle = `-- name: UpsertRaffle :execlastid
 INSERT INTO
-    raffle (id, organizer_id, contest_id)
+    raffle (id, organizer_id, contest_id, rules, seed, seed_commitment, seed_revealed_at)
 VALUES
-    (?, ?, ?)
+    (?, ?, ?, ?, ?, ?, ?)
 ON DUPLICATE KEY UPDATE
     organizer_id = VALUES(organizer_id),
-    contest_id = VALUES(contest_id)
+    contest_id = VALUES(contest_id),
+    rules = VALUES(rules),
+    seed = VALUES(seed),
+    seed_commitment = VALUES(seed_commitment),
+    seed_revealed_at = VALUES(seed_revealed_at)
 `
 
 type UpsertRaffleParams struct {
+	ID             int32
+	OrganizerID    int32
+	ContestID      int32
+	Rules          json.RawMessage
+	Seed           sql.NullString
+	SeedCommitment sql.NullString
+	SeedRevealedAt sql.NullTime
+}
+
+func (q *Queries) UpsertRaffle(ctx context.Context, arg UpsertRaffleParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, upsertRaffle,
+		arg.ID,
+		arg.OrganizerID,
+		arg.ContestID,
+		arg.Rules,
+		arg.Seed,
+		arg.SeedCommitment,
+		arg.SeedRevealedAt,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.LastInsertId()
+}
+
+const upsertRafflePrize = `-- name: UpsertRafflePrize :execlastid
+INSERT INTO
+    raffle_prize (id, organizer_id, raffle_id, name, quantity)
+VALUES
+    (?, ?, ?, ?, ?)
+ON DUPLICATE KEY UPDATE
+    organizer_id = VALUES(organizer_id),
+    raffle_id = VALUES(raffle_id),
+    name = VALUES(name),
+    quantity = VALUES(quantity)
+`
+
+type UpsertRafflePrizeParams struct {
 	ID          int32
 	OrganizerID int32
-	ContestID   int32
+	RaffleID    int32
+	Name        string
+	Quantity    int32
 }
 
-func (q *Queries) UpsertRaffle(ctx context.Context, arg UpsertRaffleParams) (int64, error) {
-	result, err := q.db.ExecContext(ctx, upsertRaffle, arg.ID, arg.OrganizerID, arg.ContestID)
+func (q *Queries) UpsertRafflePrize(ctx context.Context, arg UpsertRafflePrizeParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, upsertRafflePrize,
+		arg.ID,
+		arg.OrganizerID,
+		arg.RaffleID,
+		arg.Name,
+		arg.Quantity,
+	)
 	if err != nil {
 		return 0, err
 	}
@@ -1554,14 +3454,19 @@ func (q *Queries) UpsertRaffle(ctx context.Context, arg UpsertRaffleParams) (int
 
 const upsertRaffleWinner = `-- name: UpsertRaffleWinner :execlastid
 INSERT INTO
-    raffle_winner (id, organizer_id, raffle_id, contender_id, timestamp)
+    raffle_winner (id, organizer_id, raffle_id, contender_id, prize_id, timestamp, rules, claimed_at, voided_at, draw)
 VALUES
-    (?, ?, ?, ?, ?)
+    (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 ON DUPLICATE KEY UPDATE
     organizer_id = VALUES(organizer_id),
     raffle_id = VALUES(raffle_id),
     contender_id = VALUES(contender_id),
-    timestamp = VALUES(timestamp)
+    prize_id = VALUES(prize_id),
+    timestamp = VALUES(timestamp),
+    rules = VALUES(rules),
+    claimed_at = VALUES(claimed_at),
+    voided_at = VALUES(voided_at),
+    draw = VALUES(draw)
 `
 
 type UpsertRaffleWinnerParams struct {
@@ -1569,7 +3474,12 @@ type UpsertRaffleWinnerParams struct {
 	OrganizerID int32
 	RaffleID    int32
 	ContenderID int32
+	PrizeID     sql.NullInt32
 	Timestamp   time.Time
+	Rules       json.RawMessage
+	ClaimedAt   sql.NullTime
+	VoidedAt    sql.NullTime
+	Draw        json.RawMessage
 }
 
 func (q *Queries) UpsertRaffleWinner(ctx context.Context, arg UpsertRaffleWinnerParams) (int64, error) {
@@ -1578,7 +3488,94 @@ func (q *Queries) UpsertRaffleWinner(ctx context.Context, arg UpsertRaffleWinner
 		arg.OrganizerID,
 		arg.RaffleID,
 		arg.ContenderID,
+		arg.PrizeID,
 		arg.Timestamp,
+		arg.Rules,
+		arg.ClaimedAt,
+		arg.VoidedAt,
+		arg.Draw,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.LastInsertId()
+}
+
+const upsertRetentionPolicy = `-- name: UpsertRetentionPolicy :execlastid
+INSERT INTO
+    retention_policy (id, organizer_id, target, retention_period, enabled, created)
+VALUES
+    (?, ?, ?, ?, ?, ?)
+ON DUPLICATE KEY UPDATE
+    organizer_id = VALUES(organizer_id),
+    target = VALUES(target),
+    retention_period = VALUES(retention_period),
+    enabled = VALUES(enabled)
+`
+
+type UpsertRetentionPolicyParams struct {
+	ID              int32
+	OrganizerID     int32
+	Target          string
+	RetentionPeriod int32
+	Enabled         bool
+	Created         time.Time
+}
+
+func (q *Queries) UpsertRetentionPolicy(ctx context.Context, arg UpsertRetentionPolicyParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, upsertRetentionPolicy,
+		arg.ID,
+		arg.OrganizerID,
+		arg.Target,
+		arg.RetentionPeriod,
+		arg.Enabled,
+		arg.Created,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.LastInsertId()
+}
+
+const upsertRound = `-- name: UpsertRound :execlastid
+INSERT INTO
+    round (id, organizer_id, contest_id, number, name, qualifying_problems, finalists, time_begin, time_end)
+VALUES
+    (?, ?, ?, ?, ?, ?, ?, ?, ?)
+ON DUPLICATE KEY UPDATE
+    organizer_id = VALUES(organizer_id),
+    contest_id = VALUES(contest_id),
+    number = VALUES(number),
+    name = VALUES(name),
+    qualifying_problems = VALUES(qualifying_problems),
+    finalists = VALUES(finalists),
+    time_begin = VALUES(time_begin),
+    time_end = VALUES(time_end)
+`
+
+type UpsertRoundParams struct {
+	ID                 int32
+	OrganizerID        int32
+	ContestID          int32
+	Number             int32
+	Name               string
+	QualifyingProblems int32
+	Finalists          int32
+	TimeBegin          sql.NullTime
+	TimeEnd            sql.NullTime
+}
+
+func (q *Queries) UpsertRound(ctx context.Context, arg UpsertRoundParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, upsertRound,
+		arg.ID,
+		arg.OrganizerID,
+		arg.ContestID,
+		arg.Number,
+		arg.Name,
+		arg.QualifyingProblems,
+		arg.Finalists,
+		arg.TimeBegin,
+		arg.TimeEnd,
 	)
 	if err != nil {
 		return 0, err
@@ -1620,6 +3617,40 @@ func (q *Queries) UpsertScore(ctx context.Context, arg UpsertScoreParams) error
 	return err
 }
 
+const upsertTeam = `-- name: UpsertTeam :execlastid
+INSERT INTO
+    team (id, organizer_id, contest_id, name, counted_members)
+VALUES
+    (?, ?, ?, ?, ?)
+ON DUPLICATE KEY UPDATE
+    organizer_id = VALUES(organizer_id),
+    contest_id = VALUES(contest_id),
+    name = VALUES(name),
+    counted_members = VALUES(counted_members)
+`
+
+type UpsertTeamParams struct {
+	ID             int32
+	OrganizerID    int32
+	ContestID      int32
+	Name           string
+	CountedMembers int32
+}
+
+func (q *Queries) UpsertTeam(ctx context.Context, arg UpsertTeamParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, upsertTeam,
+		arg.ID,
+		arg.OrganizerID,
+		arg.ContestID,
+		arg.Name,
+		arg.CountedMembers,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.LastInsertId()
+}
+
 const upsertTick = `-- name: UpsertTick :execlastid
 INSERT INTO
     tick (id, organizer_id, contest_id, contender_id, problem_id, timestamp, top, attempts_top, zone_1, attempts_zone_1, zone_2, attempts_zone_2)
@@ -1675,6 +3706,64 @@ func (q *Queries) UpsertTick(ctx context.Context, arg UpsertTickParams) (int64,
 	return result.LastInsertId()
 }
 
+const upsertTickDispute = `-- name: UpsertTickDispute :execlastid
+INSERT INTO
+    tick_dispute (id, organizer_id, contest_id, contender_id, problem_id, status, comment, response, zone_1, attempts_zone_1, zone_2, attempts_zone_2, top, attempts_top, created, resolved, resolved_by)
+VALUES
+    (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+ON DUPLICATE KEY UPDATE
+    status = VALUES(status),
+    response = VALUES(response),
+    resolved = VALUES(resolved),
+    resolved_by = VALUES(resolved_by)
+`
+
+type UpsertTickDisputeParams struct {
+	ID            int32
+	OrganizerID   int32
+	ContestID     int32
+	ContenderID   int32
+	ProblemID     int32
+	Status        string
+	Comment       string
+	Response      sql.NullString
+	Zone1         bool
+	AttemptsZone1 int32
+	Zone2         bool
+	AttemptsZone2 int32
+	Top           bool
+	AttemptsTop   int32
+	Created       time.Time
+	Resolved      sql.NullTime
+	ResolvedBy    sql.NullString
+}
+
+func (q *Queries) UpsertTickDispute(ctx context.Context, arg UpsertTickDisputeParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, upsertTickDispute,
+		arg.ID,
+		arg.OrganizerID,
+		arg.ContestID,
+		arg.ContenderID,
+		arg.ProblemID,
+		arg.Status,
+		arg.Comment,
+		arg.Response,
+		arg.Zone1,
+		arg.AttemptsZone1,
+		arg.Zone2,
+		arg.AttemptsZone2,
+		arg.Top,
+		arg.AttemptsTop,
+		arg.Created,
+		arg.Resolved,
+		arg.ResolvedBy,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.LastInsertId()
+}
+
 const upsertUser = `-- name: UpsertUser :execlastid
 INSERT INTO
     user (id, username, admin)
@@ -0,0 +1,25 @@
+package domain
+
+import (
+	"time"
+)
+
+func (c Contest) ScoreboardFrozenFrom() time.Time {
+	if c.ScoreboardFreeze <= 0 || c.TimeEnd.IsZero() {
+		return time.Time{}
+	}
+
+	frozenFrom := c.TimeEnd.Add(-c.ScoreboardFreeze)
+
+	if !c.ScoreboardRevealedAt.Before(frozenFrom) {
+		return time.Time{}
+	}
+
+	return frozenFrom
+}
+
+func (c Contest) ScoreboardFrozen(now time.Time) bool {
+	frozenFrom := c.ScoreboardFrozenFrom()
+
+	return !frozenFrom.IsZero() && !now.Before(frozenFrom)
+}
@@ -0,0 +1,59 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/climblive/platform/backend/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScoreboardFrozen(t *testing.T) {
+	now := time.Now()
+
+	contest := domain.Contest{
+		TimeEnd:          now.Add(10 * time.Minute),
+		ScoreboardFreeze: 15 * time.Minute,
+	}
+
+	t.Run("NoFreeze", func(t *testing.T) {
+		contest := contest
+		contest.ScoreboardFreeze = 0
+
+		assert.Zero(t, contest.ScoreboardFrozenFrom())
+		assert.False(t, contest.ScoreboardFrozen(now))
+	})
+
+	t.Run("NoEndTime", func(t *testing.T) {
+		contest := contest
+		contest.TimeEnd = time.Time{}
+
+		assert.Zero(t, contest.ScoreboardFrozenFrom())
+		assert.False(t, contest.ScoreboardFrozen(now))
+	})
+
+	t.Run("Frozen", func(t *testing.T) {
+		assert.Equal(t, now.Add(-5*time.Minute), contest.ScoreboardFrozenFrom())
+		assert.True(t, contest.ScoreboardFrozen(now))
+		assert.True(t, contest.ScoreboardFrozen(now.Add(time.Hour)))
+	})
+
+	t.Run("BeforeFreeze", func(t *testing.T) {
+		assert.False(t, contest.ScoreboardFrozen(now.Add(-10*time.Minute)))
+	})
+
+	t.Run("Revealed", func(t *testing.T) {
+		contest := contest
+		contest.ScoreboardRevealedAt = now
+
+		assert.Zero(t, contest.ScoreboardFrozenFrom())
+		assert.False(t, contest.ScoreboardFrozen(now))
+	})
+
+	t.Run("RevealedBeforeFreeze", func(t *testing.T) {
+		contest := contest
+		contest.ScoreboardRevealedAt = now.Add(-time.Hour)
+
+		assert.True(t, contest.ScoreboardFrozen(now))
+	})
+}
@@ -0,0 +1,10 @@
+package domain
+
+func (s TickDisputeStatus) Resolved() bool {
+	switch s {
+	case TickDisputeStatusAccepted, TickDisputeStatusRejected:
+		return true
+	default:
+		return false
+	}
+}
@@ -1,6 +1,9 @@
 package domain
 
-import "errors"
+import (
+	"errors"
+	"strings"
+)
 
 var ErrNotFound = errors.New("not found")
 var ErrArchived = errors.New("archived")
@@ -19,5 +22,26 @@ var ErrEmptyName = errors.New("name is empty")
 var ErrLimitExceeded = errors.New("limit exceeded")
 var ErrNotRegistered = errors.New("not registered")
 var ErrProblemNotInContest = errors.New("problem not in contest")
+var ErrProblemNotAvailable = errors.New("problem not available")
 var ErrAllWinnersDrawn = errors.New("all winners drawn")
 var ErrExpired = errors.New("expired")
+var ErrPreconditionFailed = errors.New("precondition failed")
+
+type FieldViolation struct {
+	Field   string
+	Message string
+}
+
+type ValidationError struct {
+	Violations []FieldViolation
+}
+
+func (e ValidationError) Error() string {
+	messages := make([]string, 0, len(e.Violations))
+
+	for _, violation := range e.Violations {
+		messages = append(messages, violation.Field+": "+violation.Message)
+	}
+
+	return strings.Join(messages, "; ")
+}
@@ -34,7 +34,7 @@ func NewEventFilter(contestID ContestID, contenderID ContenderID, eventTypes ...
 }
 
 type EventBroker interface {
-	Dispatch(contestID ContestID, event any)
+	Dispatch(ctx context.Context, contestID ContestID, event any)
 	Subscribe(filter EventFilter, bufferCapacity int) (SubscriptionID, EventReader)
 	Unsubscribe(subscriptionID SubscriptionID)
 }
@@ -44,5 +44,6 @@ type EventReader interface {
 }
 
 type EventEnvelope struct {
-	Data any
+	Data         any
+	TraceContext map[string]string
 }
@@ -3,3 +3,7 @@ package domain
 type StatusReporter interface {
 	GetStatus() ServiceStatus
 }
+
+type MultiStatusReporter interface {
+	GetStatuses() []ServiceStatus
+}
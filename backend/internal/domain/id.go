@@ -4,30 +4,46 @@ import "github.com/google/uuid"
 
 type ResourceID int32
 
+type AuditEntryID ResourceID
 type CompClassID ResourceID
 type ContenderID ResourceID
 type ContestID ResourceID
 type OrganizerID ResourceID
 type ProblemID ResourceID
 type RaffleID ResourceID
+type RafflePrizeID ResourceID
 type RaffleWinnerID ResourceID
+type RetentionPolicyID ResourceID
+type RetentionPolicyExecutionID ResourceID
+type RoundID ResourceID
 type SeriesID ResourceID
 type UserID ResourceID
+type TeamID ResourceID
 type TickID ResourceID
+type TickRevisionID ResourceID
+type TickDisputeID ResourceID
 
 type OrganizerInviteID = uuid.UUID
 
 type ResourceIDType interface {
-	CompClassID |
+	AuditEntryID |
+		CompClassID |
 		ContenderID |
 		ContestID |
 		OrganizerID |
 		ProblemID |
 		RaffleID |
+		RafflePrizeID |
 		RaffleWinnerID |
+		RetentionPolicyID |
+		RetentionPolicyExecutionID |
+		RoundID |
 		SeriesID |
 		UserID |
-		TickID
+		TeamID |
+		TickID |
+		TickRevisionID |
+		TickDisputeID
 }
 
 type ScoreEngineInstanceID = uuid.UUID
@@ -0,0 +1,34 @@
+package domain
+
+import "time"
+
+const (
+	DefaultPageSize = 100
+	MaxPageSize     = 1000
+)
+
+type PageRequest struct {
+	After      ResourceID
+	Limit      int
+	Descending bool
+}
+
+type Page[T any] struct {
+	Items []T
+	Next  ResourceID
+}
+
+type ContestFilter struct {
+	Country  string
+	Archived *bool
+}
+
+type ContenderFilter struct {
+	CompClassID  CompClassID
+	Entered      *bool
+	Disqualified *bool
+}
+
+type TickFilter struct {
+	Since time.Time
+}
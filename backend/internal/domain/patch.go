@@ -2,6 +2,7 @@ package domain
 
 import (
 	"encoding/json"
+	"slices"
 
 	"github.com/go-errors/errors"
 )
@@ -40,3 +41,38 @@ func (p Patch[T]) IsZero() bool {
 func (p Patch[T]) PresentAndDistinct(old T) bool {
 	return p.Present && p.Value != old
 }
+
+type SlicePatch[T comparable] struct {
+	Present bool
+	Value   []T
+}
+
+func NewSlicePatch[T comparable](v []T) SlicePatch[T] {
+	return SlicePatch[T]{
+		Present: true,
+		Value:   v,
+	}
+}
+
+func (p SlicePatch[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.Value)
+}
+
+func (p *SlicePatch[T]) UnmarshalJSON(data []byte) error {
+	err := json.Unmarshal(data, &p.Value)
+	if err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	p.Present = true
+
+	return nil
+}
+
+func (p SlicePatch[T]) IsZero() bool {
+	return !p.Present
+}
+
+func (p SlicePatch[T]) PresentAndDistinct(old []T) bool {
+	return p.Present && !slices.Equal(p.Value, old)
+}
@@ -136,3 +136,42 @@ func TestPatchUnmarshal(t *testing.T) {
 		assert.Equal(t, 5, nested2.Data.Value)
 	})
 }
+
+func TestSlicePatch(t *testing.T) {
+	t.Run("Distinct", func(t *testing.T) {
+		patch := domain.NewSlicePatch([]string{"crimpy", "slab"})
+
+		assert.False(t, patch.PresentAndDistinct([]string{"crimpy", "slab"}))
+		assert.True(t, patch.PresentAndDistinct([]string{"slab", "crimpy"}))
+		assert.True(t, patch.PresentAndDistinct(nil))
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		patch := domain.SlicePatch[string]{}
+
+		assert.False(t, patch.PresentAndDistinct([]string{"anything"}))
+	})
+
+	t.Run("Unmarshal", func(t *testing.T) {
+		var data struct {
+			Data domain.SlicePatch[int] `json:"data,omitzero"`
+		}
+
+		err := json.Unmarshal([]byte(`{"data":[1,2,3]}`), &data)
+
+		require.NoError(t, err)
+		assert.True(t, data.Data.Present)
+		assert.Equal(t, []int{1, 2, 3}, data.Data.Value)
+	})
+
+	t.Run("MarshalWithoutValue", func(t *testing.T) {
+		var data struct {
+			Data domain.SlicePatch[int] `json:"data,omitzero"`
+		}
+
+		encoded, err := json.Marshal(data)
+
+		require.NoError(t, err)
+		assert.Equal(t, `{}`, string(encoded))
+	})
+}
@@ -0,0 +1,7 @@
+package domain
+
+import "slices"
+
+func (p Problem) AvailableTo(compClassID CompClassID) bool {
+	return len(p.CompClassIDs) == 0 || slices.Contains(p.CompClassIDs, compClassID)
+}
@@ -0,0 +1,27 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/climblive/platform/backend/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProblemAvailableTo(t *testing.T) {
+	t.Run("AllCompClasses", func(t *testing.T) {
+		problem := domain.Problem{}
+
+		assert.True(t, problem.AvailableTo(1))
+		assert.True(t, problem.AvailableTo(2))
+	})
+
+	t.Run("RestrictedCompClasses", func(t *testing.T) {
+		problem := domain.Problem{
+			CompClassIDs: []domain.CompClassID{1, 3},
+		}
+
+		assert.True(t, problem.AvailableTo(1))
+		assert.False(t, problem.AvailableTo(2))
+		assert.True(t, problem.AvailableTo(3))
+	})
+}
@@ -11,6 +11,21 @@ type OwnershipData struct {
 	ContenderID *ContenderID `json:"-"`
 }
 
+type AuditAction string
+
+const (
+	AuditActionContenderErased AuditAction = "CONTENDER_ERASED"
+)
+
+type AuditEntry struct {
+	ID          AuditEntryID  `json:"id"`
+	Ownership   OwnershipData `json:"-"`
+	ContestID   ContestID     `json:"contestId"`
+	ContenderID ContenderID   `json:"contenderId,omitempty"`
+	Action      AuditAction   `json:"action"`
+	Timestamp   time.Time     `json:"timestamp"`
+}
+
 type CompClass struct {
 	ID          CompClassID   `json:"id"`
 	Ownership   OwnershipData `json:"-"`
@@ -19,6 +34,7 @@ type CompClass struct {
 	Description string        `json:"description,omitempty"`
 	TimeBegin   time.Time     `json:"timeBegin"`
 	TimeEnd     time.Time     `json:"timeEnd"`
+	Capacity    int           `json:"capacity,omitempty"`
 }
 
 type CompClassTemplate struct {
@@ -26,6 +42,7 @@ type CompClassTemplate struct {
 	Description string    `json:"description,omitempty"`
 	TimeBegin   time.Time `json:"timeBegin"`
 	TimeEnd     time.Time `json:"timeEnd"`
+	Capacity    int       `json:"capacity,omitempty"`
 }
 
 type CompClassPatch struct {
@@ -33,6 +50,7 @@ type CompClassPatch struct {
 	Description Patch[string]    `json:"description,omitempty" tstype:"string"`
 	TimeBegin   Patch[time.Time] `json:"timeBegin,omitempty" tstype:"Date"`
 	TimeEnd     Patch[time.Time] `json:"timeEnd,omitempty" tstype:"Date"`
+	Capacity    Patch[int]       `json:"capacity,omitempty" tstype:"number"`
 }
 
 type Contender struct {
@@ -40,6 +58,7 @@ type Contender struct {
 	Ownership           OwnershipData `json:"-"`
 	ContestID           ContestID     `json:"contestId"`
 	CompClassID         CompClassID   `json:"compClassId,omitempty"`
+	TeamID              TeamID        `json:"teamId,omitempty"`
 	RegistrationCode    string        `json:"registrationCode"`
 	Name                string        `json:"name,omitempty"`
 	Entered             time.Time     `json:"entered,omitzero"`
@@ -47,16 +66,29 @@ type Contender struct {
 	Disqualified        bool          `json:"disqualified"`
 	ScrubbedAt          time.Time     `json:"scrubbedAt,omitzero"`
 	ScrubBefore         time.Time     `json:"scrubBefore,omitzero"`
+	WaitlistCompClassID CompClassID   `json:"waitlistCompClassId,omitempty"`
+	WaitlistedAt        time.Time     `json:"waitlistedAt,omitzero"`
 	Score               *Score        `json:"score,omitempty"`
+	Version             int           `json:"-"`
 }
 
 type ContenderPatch struct {
 	CompClassID         Patch[CompClassID] `json:"compClassId,omitzero" tstype:"CompClassID"`
+	TeamID              Patch[TeamID]      `json:"teamId,omitzero" tstype:"TeamID"`
 	Name                Patch[string]      `json:"name,omitzero" tstype:"string"`
 	WithdrawnFromFinals Patch[bool]        `json:"withdrawnFromFinals,omitzero" tstype:"boolean"`
 	Disqualified        Patch[bool]        `json:"disqualified,omitzero" tstype:"boolean"`
 }
 
+type ContenderDataExport struct {
+	Contender     Contender      `json:"contender"`
+	Ticks         []Tick         `json:"ticks"`
+	TickRevisions []TickRevision `json:"tickRevisions"`
+	TickDisputes  []TickDispute  `json:"tickDisputes"`
+	RaffleWins    []RaffleWinner `json:"raffleWins"`
+	ExportedAt    time.Time      `json:"exportedAt"`
+}
+
 type Contest struct {
 	ID                   ContestID     `json:"id"`
 	Ownership            OwnershipData `json:"ownership"`
@@ -71,10 +103,14 @@ type Contest struct {
 	Info                 string        `json:"info,omitempty"`
 	GracePeriod          time.Duration `json:"gracePeriod"`
 	NameRetentionTime    time.Duration `json:"nameRetentionTime"`
+	ScoreboardFreeze     time.Duration `json:"scoreboardFreeze"`
+	ScoreboardRevealedAt time.Time     `json:"scoreboardRevealedAt,omitzero"`
+	SelfRegistration     bool          `json:"selfRegistration"`
 	TimeBegin            time.Time     `json:"timeBegin,omitzero"`
 	TimeEnd              time.Time     `json:"timeEnd,omitzero"`
 	Created              time.Time     `json:"created"`
 	RegisteredContenders int           `json:"registeredContenders"`
+	Version              int           `json:"-"`
 }
 
 type ContestTemplate struct {
@@ -88,6 +124,7 @@ type ContestTemplate struct {
 	Info               string        `json:"info,omitempty"`
 	GracePeriod        time.Duration `json:"gracePeriod"`
 	NameRetentionTime  time.Duration `json:"nameRetentionTime"`
+	ScoreboardFreeze   time.Duration `json:"scoreboardFreeze"`
 }
 
 type ContestPatch struct {
@@ -100,6 +137,13 @@ type ContestPatch struct {
 	Finalists          Patch[int]           `json:"finalists,omitzero" tstype:"number"`
 	Info               Patch[string]        `json:"info,omitzero" tstype:"string"`
 	GracePeriod        Patch[time.Duration] `json:"gracePeriod,omitzero" tstype:"number"`
+	ScoreboardFreeze   Patch[time.Duration] `json:"scoreboardFreeze,omitzero" tstype:"number"`
+	SelfRegistration   Patch[bool]          `json:"selfRegistration,omitzero" tstype:"boolean"`
+}
+
+type SelfRegistration struct {
+	CompClassID CompClassID `json:"compClassId"`
+	Name        string      `json:"name"`
 }
 
 type ContestTransferRequest struct {
@@ -138,44 +182,122 @@ type Problem struct {
 	ID                 ProblemID     `json:"id"`
 	Ownership          OwnershipData `json:"-"`
 	ContestID          ContestID     `json:"contestId"`
+	RoundID            RoundID       `json:"roundId,omitempty"`
 	Number             int           `json:"number"`
 	HoldColorPrimary   string        `json:"holdColorPrimary"`
 	HoldColorSecondary string        `json:"holdColorSecondary,omitempty"`
 	Description        string        `json:"description,omitempty"`
+	Sector             string        `json:"sector,omitempty"`
+	Tags               []string      `json:"tags"`
+	CompClassIDs       []CompClassID `json:"compClassIds"`
 	Zone1Enabled       bool          `json:"zone1Enabled"`
 	Zone2Enabled       bool          `json:"zone2Enabled"`
+	Version            int           `json:"-"`
 
 	ProblemValue `tstype:",extends"`
 }
 
 type ProblemTemplate struct {
-	Number             int    `json:"number"`
-	HoldColorPrimary   string `json:"holdColorPrimary"`
-	HoldColorSecondary string `json:"holdColorSecondary,omitempty"`
-	Description        string `json:"description,omitempty"`
-	Zone1Enabled       bool   `json:"zone1Enabled"`
-	Zone2Enabled       bool   `json:"zone2Enabled"`
+	RoundID            RoundID       `json:"roundId,omitempty"`
+	Number             int           `json:"number"`
+	HoldColorPrimary   string        `json:"holdColorPrimary"`
+	HoldColorSecondary string        `json:"holdColorSecondary,omitempty"`
+	Description        string        `json:"description,omitempty"`
+	Sector             string        `json:"sector,omitempty"`
+	Tags               []string      `json:"tags,omitempty"`
+	CompClassIDs       []CompClassID `json:"compClassIds,omitempty"`
+	Zone1Enabled       bool          `json:"zone1Enabled"`
+	Zone2Enabled       bool          `json:"zone2Enabled"`
 
 	ProblemValue `tstype:",extends"`
 }
 
 type ProblemPatch struct {
-	Number             Patch[int]    `json:"number,omitzero" tstype:"number"`
-	HoldColorPrimary   Patch[string] `json:"holdColorPrimary,omitzero" tstype:"string"`
-	HoldColorSecondary Patch[string] `json:"holdColorSecondary,omitzero" tstype:"string"`
-	Description        Patch[string] `json:"description,omitzero" tstype:"string"`
-	Zone1Enabled       Patch[bool]   `json:"zone1Enabled,omitzero" tstype:"boolean"`
-	Zone2Enabled       Patch[bool]   `json:"zone2Enabled,omitzero" tstype:"boolean"`
-	PointsZone1        Patch[int]    `json:"pointsZone1,omitzero" tstype:"number"`
-	PointsZone2        Patch[int]    `json:"pointsZone2,omitzero" tstype:"number"`
-	PointsTop          Patch[int]    `json:"pointsTop,omitzero" tstype:"number"`
-	FlashBonus         Patch[int]    `json:"flashBonus,omitzero" tstype:"number"`
+	Number             Patch[int]              `json:"number,omitzero" tstype:"number"`
+	HoldColorPrimary   Patch[string]           `json:"holdColorPrimary,omitzero" tstype:"string"`
+	HoldColorSecondary Patch[string]           `json:"holdColorSecondary,omitzero" tstype:"string"`
+	Description        Patch[string]           `json:"description,omitzero" tstype:"string"`
+	Sector             Patch[string]           `json:"sector,omitzero" tstype:"string"`
+	Tags               SlicePatch[string]      `json:"tags,omitzero" tstype:"string[]"`
+	CompClassIDs       SlicePatch[CompClassID] `json:"compClassIds,omitzero" tstype:"CompClassID[]"`
+	Zone1Enabled       Patch[bool]             `json:"zone1Enabled,omitzero" tstype:"boolean"`
+	Zone2Enabled       Patch[bool]             `json:"zone2Enabled,omitzero" tstype:"boolean"`
+	PointsZone1        Patch[int]              `json:"pointsZone1,omitzero" tstype:"number"`
+	PointsZone2        Patch[int]              `json:"pointsZone2,omitzero" tstype:"number"`
+	PointsTop          Patch[int]              `json:"pointsTop,omitzero" tstype:"number"`
+	FlashBonus         Patch[int]              `json:"flashBonus,omitzero" tstype:"number"`
+}
+
+type ProblemStats struct {
+	ProblemID            ProblemID   `json:"problemId"`
+	CompClassID          CompClassID `json:"compClassId,omitempty"`
+	Attempts             int         `json:"attempts"`
+	Zone1s               int         `json:"zone1s"`
+	Zone2s               int         `json:"zone2s"`
+	Tops                 int         `json:"tops"`
+	Flashes              int         `json:"flashes"`
+	AverageAttemptsToTop float64     `json:"averageAttemptsToTop"`
+	TopPercentage        float64     `json:"topPercentage"`
+}
+
+type RaffleWeighting string
+
+const (
+	RaffleWeightingNone RaffleWeighting = ""
+	RaffleWeightingTops RaffleWeighting = "tops"
+)
+
+type RaffleRules struct {
+	CompClassID CompClassID     `json:"compClassId,omitempty"`
+	MinTicks    int             `json:"minTicks,omitempty"`
+	Weighting   RaffleWeighting `json:"weighting,omitempty"`
 }
 
 type Raffle struct {
-	ID        RaffleID      `json:"id"`
+	ID             RaffleID      `json:"id"`
+	Ownership      OwnershipData `json:"-"`
+	ContestID      ContestID     `json:"contestId"`
+	Rules          RaffleRules   `json:"rules"`
+	Seed           string        `json:"seed,omitempty"`
+	SeedCommitment string        `json:"seedCommitment,omitempty"`
+	SeedRevealedAt time.Time     `json:"seedRevealedAt,omitzero"`
+}
+
+type RaffleTemplate struct {
+	Rules      RaffleRules `json:"rules"`
+	Verifiable bool        `json:"verifiable,omitempty"`
+}
+
+type RaffleCandidate struct {
+	ContenderID ContenderID `json:"contenderId"`
+	Weight      int64       `json:"weight"`
+}
+
+type RaffleDraw struct {
+	Number      int               `json:"number"`
+	Candidates  []RaffleCandidate `json:"candidates"`
+	ContenderID ContenderID       `json:"contenderId"`
+}
+
+type RaffleVerification struct {
+	RaffleID       RaffleID     `json:"raffleId"`
+	Seed           string       `json:"seed"`
+	SeedCommitment string       `json:"seedCommitment"`
+	Draws          []RaffleDraw `json:"draws"`
+	Verified       bool         `json:"verified"`
+}
+
+type RafflePrize struct {
+	ID        RafflePrizeID `json:"id"`
 	Ownership OwnershipData `json:"-"`
-	ContestID ContestID     `json:"contestId"`
+	RaffleID  RaffleID      `json:"raffleId"`
+	Name      string        `json:"name"`
+	Quantity  int           `json:"quantity"`
+}
+
+type RafflePrizeTemplate struct {
+	Name     string `json:"name"`
+	Quantity int    `json:"quantity"`
 }
 
 type RaffleWinner struct {
@@ -185,7 +307,96 @@ type RaffleWinner struct {
 	ContenderID         ContenderID    `json:"contenderId"`
 	ContenderName       string         `json:"contenderName"`
 	ContenderScrubbedAt time.Time      `json:"contenderScrubbedAt,omitzero"`
+	PrizeID             RafflePrizeID  `json:"prizeId,omitempty"`
+	PrizeName           string         `json:"prizeName,omitempty"`
 	Timestamp           time.Time      `json:"timestamp"`
+	Rules               RaffleRules    `json:"rules"`
+	ClaimedAt           time.Time      `json:"claimedAt,omitzero"`
+	VoidedAt            time.Time      `json:"voidedAt,omitzero"`
+	Draw                *RaffleDraw    `json:"-"`
+}
+
+type RetentionPolicyTarget string
+
+const (
+	RetentionPolicyTargetTicks            RetentionPolicyTarget = "ticks"
+	RetentionPolicyTargetContenders       RetentionPolicyTarget = "contenders"
+	RetentionPolicyTargetArchivedContests RetentionPolicyTarget = "archivedContests"
+)
+
+type RetentionPolicy struct {
+	ID              RetentionPolicyID     `json:"id"`
+	Ownership       OwnershipData         `json:"-"`
+	Target          RetentionPolicyTarget `json:"target"`
+	RetentionPeriod time.Duration         `json:"retentionPeriod"`
+	Enabled         bool                  `json:"enabled"`
+	Created         time.Time             `json:"created"`
+}
+
+type RetentionPolicyTemplate struct {
+	Target          RetentionPolicyTarget `json:"target"`
+	RetentionPeriod time.Duration         `json:"retentionPeriod"`
+	Enabled         bool                  `json:"enabled"`
+}
+
+type RetentionPolicyPatch struct {
+	RetentionPeriod Patch[time.Duration] `json:"retentionPeriod,omitzero" tstype:"number"`
+	Enabled         Patch[bool]          `json:"enabled,omitzero" tstype:"boolean"`
+}
+
+type RetentionCandidate struct {
+	ContestID   ContestID `json:"contestId"`
+	ContestName string    `json:"contestName"`
+	Deadline    time.Time `json:"deadline"`
+	Contenders  int       `json:"contenders"`
+	Ticks       int       `json:"ticks"`
+}
+
+type RetentionPolicyExecution struct {
+	ID         RetentionPolicyExecutionID `json:"id"`
+	Ownership  OwnershipData              `json:"-"`
+	PolicyID   RetentionPolicyID          `json:"policyId"`
+	Timestamp  time.Time                  `json:"timestamp"`
+	Contests   int                        `json:"contests"`
+	Contenders int                        `json:"contenders"`
+	Ticks      int                        `json:"ticks"`
+	Error      string                     `json:"error,omitempty"`
+}
+
+type Round struct {
+	ID                 RoundID       `json:"id"`
+	Ownership          OwnershipData `json:"-"`
+	ContestID          ContestID     `json:"contestId"`
+	Number             int           `json:"number"`
+	Name               string        `json:"name"`
+	QualifyingProblems int           `json:"qualifyingProblems"`
+	Finalists          int           `json:"finalists"`
+	TimeBegin          time.Time     `json:"timeBegin,omitzero"`
+	TimeEnd            time.Time     `json:"timeEnd,omitzero"`
+}
+
+type RoundTemplate struct {
+	Number             int       `json:"number"`
+	Name               string    `json:"name"`
+	QualifyingProblems int       `json:"qualifyingProblems"`
+	Finalists          int       `json:"finalists"`
+	TimeBegin          time.Time `json:"timeBegin,omitzero"`
+	TimeEnd            time.Time `json:"timeEnd,omitzero"`
+}
+
+type RoundPatch struct {
+	Name               Patch[string]    `json:"name,omitzero" tstype:"string"`
+	QualifyingProblems Patch[int]       `json:"qualifyingProblems,omitzero" tstype:"number"`
+	Finalists          Patch[int]       `json:"finalists,omitzero" tstype:"number"`
+	TimeBegin          Patch[time.Time] `json:"timeBegin,omitzero" tstype:"Date"`
+	TimeEnd            Patch[time.Time] `json:"timeEnd,omitzero" tstype:"Date"`
+}
+
+type StartListEntry struct {
+	RoundID           RoundID     `json:"roundId"`
+	ContenderID       ContenderID `json:"contenderId"`
+	PreviousPlacement int         `json:"previousPlacement"`
+	Score             *Score      `json:"score,omitempty"`
 }
 
 type Score struct {
@@ -206,6 +417,7 @@ type Series struct {
 type ScoreboardEntry struct {
 	ContenderID         ContenderID `json:"contenderId"`
 	CompClassID         CompClassID `json:"compClassId"`
+	TeamID              TeamID      `json:"teamId,omitempty"`
 	Name                string      `json:"name"`
 	WithdrawnFromFinals bool        `json:"withdrawnFromFinals"`
 	Disqualified        bool        `json:"disqualified"`
@@ -213,6 +425,39 @@ type ScoreboardEntry struct {
 	Score               *Score      `json:"score,omitempty"`
 }
 
+type Team struct {
+	ID             TeamID        `json:"id"`
+	Ownership      OwnershipData `json:"-"`
+	ContestID      ContestID     `json:"contestId"`
+	Name           string        `json:"name"`
+	CountedMembers int           `json:"countedMembers"`
+}
+
+type TeamTemplate struct {
+	Name           string `json:"name"`
+	CountedMembers int    `json:"countedMembers"`
+}
+
+type TeamPatch struct {
+	Name           Patch[string] `json:"name,omitzero" tstype:"string"`
+	CountedMembers Patch[int]    `json:"countedMembers,omitzero" tstype:"number"`
+}
+
+type TeamScore struct {
+	Timestamp time.Time `json:"timestamp"`
+	TeamID    TeamID    `json:"teamId"`
+	Score     int       `json:"score"`
+	Placement int       `json:"placement"`
+	RankOrder int       `json:"rankOrder"`
+}
+
+type TeamScoreboardEntry struct {
+	TeamID  TeamID        `json:"teamId"`
+	Name    string        `json:"name"`
+	Members []ContenderID `json:"members"`
+	Score   *TeamScore    `json:"score,omitempty"`
+}
+
 type Tick struct {
 	ID            TickID        `json:"id"`
 	Ownership     OwnershipData `json:"-"`
@@ -227,6 +472,106 @@ type Tick struct {
 	AttemptsTop   int           `json:"attemptsTop"`
 }
 
+type TickRevisionAction string
+
+const (
+	TickRevisionActionPut    TickRevisionAction = "put"
+	TickRevisionActionDelete TickRevisionAction = "delete"
+)
+
+type TickRevision struct {
+	ID             TickRevisionID     `json:"id"`
+	ContestID      ContestID          `json:"contestId"`
+	ContenderID    ContenderID        `json:"contenderId"`
+	ProblemID      ProblemID          `json:"problemId"`
+	TickID         TickID             `json:"tickId"`
+	Action         TickRevisionAction `json:"action"`
+	Timestamp      time.Time          `json:"timestamp"`
+	RecordedAt     time.Time          `json:"recordedAt"`
+	ActorRole      AuthRole           `json:"actorRole"`
+	ActorUsername  string             `json:"actorUsername,omitempty"`
+	IdempotencyKey string             `json:"idempotencyKey,omitempty"`
+	Zone1          bool               `json:"zone1"`
+	AttemptsZone1  int                `json:"attemptsZone1"`
+	Zone2          bool               `json:"zone2"`
+	AttemptsZone2  int                `json:"attemptsZone2"`
+	Top            bool               `json:"top"`
+	AttemptsTop    int                `json:"attemptsTop"`
+}
+
+type TickOperation struct {
+	IdempotencyKey string             `json:"idempotencyKey"`
+	Action         TickRevisionAction `json:"action"`
+	Timestamp      time.Time          `json:"timestamp"`
+	ProblemID      ProblemID          `json:"problemId"`
+	Zone1          bool               `json:"zone1"`
+	AttemptsZone1  int                `json:"attemptsZone1"`
+	Zone2          bool               `json:"zone2"`
+	AttemptsZone2  int                `json:"attemptsZone2"`
+	Top            bool               `json:"top"`
+	AttemptsTop    int                `json:"attemptsTop"`
+}
+
+type TickOperationStatus string
+
+const (
+	TickOperationStatusApplied   TickOperationStatus = "applied"
+	TickOperationStatusDuplicate TickOperationStatus = "duplicate"
+	TickOperationStatusConflict  TickOperationStatus = "conflict"
+	TickOperationStatusRejected  TickOperationStatus = "rejected"
+)
+
+type TickOperationResult struct {
+	IdempotencyKey string              `json:"idempotencyKey"`
+	Status         TickOperationStatus `json:"status"`
+	Tick           *Tick               `json:"tick,omitempty"`
+	Error          string              `json:"error,omitempty"`
+}
+
+type TickDisputeStatus string
+
+const (
+	TickDisputeStatusOpen     TickDisputeStatus = "open"
+	TickDisputeStatusAccepted TickDisputeStatus = "accepted"
+	TickDisputeStatusRejected TickDisputeStatus = "rejected"
+)
+
+type TickDispute struct {
+	ID            TickDisputeID     `json:"id"`
+	Ownership     OwnershipData     `json:"-"`
+	ContestID     ContestID         `json:"contestId"`
+	ContenderID   ContenderID       `json:"contenderId"`
+	ProblemID     ProblemID         `json:"problemId"`
+	Status        TickDisputeStatus `json:"status"`
+	Comment       string            `json:"comment"`
+	Response      string            `json:"response,omitempty"`
+	Zone1         bool              `json:"zone1"`
+	AttemptsZone1 int               `json:"attemptsZone1"`
+	Zone2         bool              `json:"zone2"`
+	AttemptsZone2 int               `json:"attemptsZone2"`
+	Top           bool              `json:"top"`
+	AttemptsTop   int               `json:"attemptsTop"`
+	Created       time.Time         `json:"created"`
+	Resolved      time.Time         `json:"resolved,omitzero"`
+	ResolvedBy    string            `json:"resolvedBy,omitempty"`
+}
+
+type TickDisputeTemplate struct {
+	ProblemID     ProblemID `json:"problemId"`
+	Comment       string    `json:"comment"`
+	Zone1         bool      `json:"zone1"`
+	AttemptsZone1 int       `json:"attemptsZone1"`
+	Zone2         bool      `json:"zone2"`
+	AttemptsZone2 int       `json:"attemptsZone2"`
+	Top           bool      `json:"top"`
+	AttemptsTop   int       `json:"attemptsTop"`
+}
+
+type TickDisputeResolution struct {
+	Status   TickDisputeStatus `json:"status"`
+	Response string            `json:"response"`
+}
+
 type User struct {
 	ID         UserID      `json:"id"`
 	Username   string      `json:"username"`
@@ -250,6 +595,16 @@ type ContenderSwitchedClassEvent struct {
 	CompClassID CompClassID `json:"compClassId"`
 }
 
+type ContenderPromotedFromWaitlistEvent struct {
+	ContenderID ContenderID `json:"contenderId"`
+	CompClassID CompClassID `json:"compClassId"`
+}
+
+type ContenderSwitchedTeamEvent struct {
+	ContenderID ContenderID `json:"contenderId"`
+	TeamID      TeamID      `json:"teamId,omitempty"`
+}
+
 type ContenderWithdrewFromFinalsEvent struct {
 	ContenderID ContenderID `json:"contenderId"`
 }
@@ -286,13 +641,17 @@ type AscentDeregisteredEvent struct {
 }
 
 type ProblemAddedEvent struct {
-	ProblemID ProblemID `json:"problemId"`
+	ProblemID    ProblemID     `json:"problemId"`
+	RoundID      RoundID       `json:"roundId,omitempty"`
+	CompClassIDs []CompClassID `json:"compClassIds,omitempty"`
 
 	ProblemValue `tstype:",extends"`
 }
 
 type ProblemUpdatedEvent struct {
-	ProblemID ProblemID `json:"problemId"`
+	ProblemID    ProblemID     `json:"problemId"`
+	RoundID      RoundID       `json:"roundId,omitempty"`
+	CompClassIDs []CompClassID `json:"compClassIds,omitempty"`
 
 	ProblemValue `tstype:",extends"`
 }
@@ -301,14 +660,32 @@ type ProblemDeletedEvent struct {
 	ProblemID ProblemID `json:"problemId"`
 }
 
+type TeamAddedEvent struct {
+	TeamID         TeamID `json:"teamId"`
+	Name           string `json:"name"`
+	CountedMembers int    `json:"countedMembers"`
+}
+
+type TeamUpdatedEvent struct {
+	TeamID         TeamID `json:"teamId"`
+	Name           string `json:"name"`
+	CountedMembers int    `json:"countedMembers"`
+}
+
+type TeamDeletedEvent struct {
+	TeamID TeamID `json:"teamId"`
+}
+
 type RulesUpdatedEvent struct {
-	QualifyingProblems int `json:"qualifyingProblems"`
-	Finalists          int `json:"finalists"`
+	RoundID            RoundID `json:"roundId,omitempty"`
+	QualifyingProblems int     `json:"qualifyingProblems"`
+	Finalists          int     `json:"finalists"`
 }
 
 type ContenderPublicInfoUpdatedEvent struct {
 	ContenderID         ContenderID `json:"contenderId"`
 	CompClassID         CompClassID `json:"compClassId"`
+	TeamID              TeamID      `json:"teamId,omitempty"`
 	Name                string      `json:"name"`
 	WithdrawnFromFinals bool        `json:"withdrawnFromFinals"`
 	Disqualified        bool        `json:"disqualified"`
@@ -324,6 +701,33 @@ type ContenderScoreUpdatedEvent struct {
 	RankOrder   int         `json:"rankOrder"`
 }
 
+type ContenderLiveScoreUpdatedEvent struct {
+	Timestamp   time.Time   `json:"timestamp"`
+	ContenderID ContenderID `json:"contenderId"`
+	Score       int         `json:"score"`
+	Placement   int         `json:"placement"`
+	Finalist    bool        `json:"finalist"`
+	RankOrder   int         `json:"rankOrder"`
+}
+
+type RoundScoreUpdatedEvent struct {
+	RoundID     RoundID     `json:"roundId"`
+	Timestamp   time.Time   `json:"timestamp"`
+	ContenderID ContenderID `json:"contenderId"`
+	Score       int         `json:"score"`
+	Placement   int         `json:"placement"`
+	Finalist    bool        `json:"finalist"`
+	RankOrder   int         `json:"rankOrder"`
+}
+
+type TeamScoreUpdatedEvent struct {
+	Timestamp time.Time `json:"timestamp"`
+	TeamID    TeamID    `json:"teamId"`
+	Score     int       `json:"score"`
+	Placement int       `json:"placement"`
+	RankOrder int       `json:"rankOrder"`
+}
+
 type ScoreEngineStartedEvent struct {
 	InstanceID ScoreEngineInstanceID `json:"instanceId"`
 }
@@ -332,8 +736,41 @@ type ScoreEngineStoppedEvent struct {
 	InstanceID ScoreEngineInstanceID `json:"instanceId"`
 }
 
+type ScoreboardRevealedEvent struct {
+	Timestamp time.Time `json:"timestamp"`
+}
+
+type ScoreboardFreezeUpdatedEvent struct {
+	FrozenFrom time.Time `json:"frozenFrom,omitzero"`
+}
+
+type TickDisputeOpenedEvent struct {
+	DisputeID   TickDisputeID `json:"disputeId"`
+	ContenderID ContenderID   `json:"contenderId"`
+	ProblemID   ProblemID     `json:"problemId"`
+}
+
+type TickDisputeResolvedEvent struct {
+	DisputeID   TickDisputeID     `json:"disputeId"`
+	ContenderID ContenderID       `json:"contenderId"`
+	ProblemID   ProblemID         `json:"problemId"`
+	Status      TickDisputeStatus `json:"status"`
+}
+
 type RaffleWinnerDrawnEvent struct {
-	RaffleID    RaffleID    `json:"raffleId"`
-	ContenderID ContenderID `json:"contenderId"`
-	Timestamp   time.Time   `json:"timestamp"`
+	RaffleID    RaffleID      `json:"raffleId"`
+	ContenderID ContenderID   `json:"contenderId"`
+	PrizeID     RafflePrizeID `json:"prizeId,omitempty"`
+	PrizeName   string        `json:"prizeName,omitempty"`
+	Timestamp   time.Time     `json:"timestamp"`
+}
+
+type RaffleWinnerUpdatedEvent struct {
+	RaffleWinnerID RaffleWinnerID `json:"raffleWinnerId"`
+	RaffleID       RaffleID       `json:"raffleId"`
+	ContenderID    ContenderID    `json:"contenderId"`
+	PrizeID        RafflePrizeID  `json:"prizeId,omitempty"`
+	PrizeName      string         `json:"prizeName,omitempty"`
+	ClaimedAt      time.Time      `json:"claimedAt,omitzero"`
+	VoidedAt       time.Time      `json:"voidedAt,omitzero"`
 }
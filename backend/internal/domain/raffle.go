@@ -0,0 +1,10 @@
+package domain
+
+func (w RaffleWeighting) Valid() bool {
+	switch w {
+	case RaffleWeightingNone, RaffleWeightingTops:
+		return true
+	default:
+		return false
+	}
+}
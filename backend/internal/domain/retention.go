@@ -0,0 +1,10 @@
+package domain
+
+func (t RetentionPolicyTarget) Valid() bool {
+	switch t {
+	case RetentionPolicyTargetTicks, RetentionPolicyTargetContenders, RetentionPolicyTargetArchivedContests:
+		return true
+	default:
+		return false
+	}
+}
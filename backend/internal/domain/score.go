@@ -2,4 +2,6 @@ package domain
 
 type ScoreKeeper interface {
 	GetScore(contenderID ContenderID) (Score, error)
+	GetRoundScore(roundID RoundID, contenderID ContenderID) (Score, error)
+	GetPublishedScore(contenderID ContenderID) (Score, error)
 }
@@ -0,0 +1,10 @@
+package domain
+
+func (a TickRevisionAction) Valid() bool {
+	switch a {
+	case TickRevisionActionPut, TickRevisionActionDelete:
+		return true
+	default:
+		return false
+	}
+}
@@ -1,10 +1,15 @@
 package events
 
 import (
+	"context"
 	"log/slog"
 	"sync"
 
 	"github.com/climblive/platform/backend/internal/domain"
+	"github.com/climblive/platform/backend/internal/metrics"
+	"github.com/climblive/platform/backend/internal/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type broker struct {
@@ -26,6 +31,7 @@ func (b *broker) Subscribe(filter domain.EventFilter, bufferCapacity int) (domai
 	subscription := NewSubscription(filter, bufferCapacity)
 
 	b.subscriptions[subscription.ID] = subscription
+	metrics.ActiveSubscriptions.Inc()
 
 	return subscription.ID, subscription
 }
@@ -34,23 +40,42 @@ func (b *broker) Unsubscribe(subscriptionID domain.SubscriptionID) {
 	b.mu.Lock()
 	defer b.mu.Unlock()
 
+	subscription, found := b.subscriptions[subscriptionID]
+	if !found {
+		return
+	}
+
 	delete(b.subscriptions, subscriptionID)
-}
 
-func (b *broker) Dispatch(contestID domain.ContestID, event any) {
-	b.mu.RLock()
-	defer b.mu.RUnlock()
+	metrics.ActiveSubscriptions.Dec()
+	subscription.release()
+}
 
+func (b *broker) Dispatch(ctx context.Context, contestID domain.ContestID, event any) {
 	eventName := EventName(event)
 	contenderID := extractContenderID(event)
 
+	ctx, span := tracing.Start(ctx, "events.Dispatch", trace.WithAttributes(
+		attribute.String("event.name", eventName),
+		attribute.Int("contest.id", int(contestID)),
+	))
+	defer span.End()
+
+	metrics.EventsDispatched.WithLabelValues(eventName).Inc()
+
+	traceContext := tracing.Inject(ctx)
+
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
 	for _, subscription := range b.subscriptions {
 		if !subscription.FilterMatch(contestID, contenderID, eventName) {
 			continue
 		}
 
 		err := subscription.Post(domain.EventEnvelope{
-			Data: event,
+			Data:         event,
+			TraceContext: traceContext,
 		})
 
 		if err != nil {
@@ -65,6 +90,10 @@ func EventName(event any) string {
 		return "CONTENDER_ENTERED"
 	case domain.ContenderSwitchedClassEvent:
 		return "CONTENDER_SWITCHED_CLASS"
+	case domain.ContenderSwitchedTeamEvent:
+		return "CONTENDER_SWITCHED_TEAM"
+	case domain.ContenderPromotedFromWaitlistEvent:
+		return "CONTENDER_PROMOTED_FROM_WAITLIST"
 	case domain.ContenderWithdrewFromFinalsEvent:
 		return "CONTENDER_WITHDREW_FROM_FINALS"
 	case domain.ContenderReenteredFinalsEvent:
@@ -83,6 +112,12 @@ func EventName(event any) string {
 		return "PROBLEM_UPDATED"
 	case domain.ProblemDeletedEvent:
 		return "PROBLEM_DELETED"
+	case domain.TeamAddedEvent:
+		return "TEAM_ADDED"
+	case domain.TeamUpdatedEvent:
+		return "TEAM_UPDATED"
+	case domain.TeamDeletedEvent:
+		return "TEAM_DELETED"
 	case domain.RulesUpdatedEvent:
 		return "RULES_UPDATED"
 	case domain.ContenderPublicInfoUpdatedEvent:
@@ -91,12 +126,32 @@ func EventName(event any) string {
 		return "CONTENDER_SCORE_UPDATED"
 	case []domain.ContenderScoreUpdatedEvent:
 		return "[]CONTENDER_SCORE_UPDATED"
+	case []domain.ContenderLiveScoreUpdatedEvent:
+		return "[]CONTENDER_LIVE_SCORE_UPDATED"
+	case domain.RoundScoreUpdatedEvent:
+		return "ROUND_SCORE_UPDATED"
+	case []domain.RoundScoreUpdatedEvent:
+		return "[]ROUND_SCORE_UPDATED"
+	case domain.TeamScoreUpdatedEvent:
+		return "TEAM_SCORE_UPDATED"
+	case []domain.TeamScoreUpdatedEvent:
+		return "[]TEAM_SCORE_UPDATED"
 	case domain.ScoreEngineStartedEvent:
 		return "SCORE_ENGINE_STARTED"
 	case domain.ScoreEngineStoppedEvent:
 		return "SCORE_ENGINE_STOPPED"
+	case domain.ScoreboardRevealedEvent:
+		return "SCOREBOARD_REVEALED"
+	case domain.ScoreboardFreezeUpdatedEvent:
+		return "SCOREBOARD_FREEZE_UPDATED"
 	case domain.RaffleWinnerDrawnEvent:
 		return "RAFFLE_WINNER_DRAWN"
+	case domain.RaffleWinnerUpdatedEvent:
+		return "RAFFLE_WINNER_UPDATED"
+	case domain.TickDisputeOpenedEvent:
+		return "TICK_DISPUTE_OPENED"
+	case domain.TickDisputeResolvedEvent:
+		return "TICK_DISPUTE_RESOLVED"
 	default:
 		return "UNKNOWN"
 	}
@@ -108,6 +163,10 @@ func extractContenderID(event any) domain.ContenderID {
 		return ev.ContenderID
 	case domain.ContenderSwitchedClassEvent:
 		return ev.ContenderID
+	case domain.ContenderSwitchedTeamEvent:
+		return ev.ContenderID
+	case domain.ContenderPromotedFromWaitlistEvent:
+		return ev.ContenderID
 	case domain.ContenderWithdrewFromFinalsEvent:
 		return ev.ContenderID
 	case domain.ContenderReenteredFinalsEvent:
@@ -124,8 +183,16 @@ func extractContenderID(event any) domain.ContenderID {
 		return ev.ContenderID
 	case domain.ContenderScoreUpdatedEvent:
 		return ev.ContenderID
+	case domain.RoundScoreUpdatedEvent:
+		return ev.ContenderID
 	case domain.RaffleWinnerDrawnEvent:
 		return ev.ContenderID
+	case domain.RaffleWinnerUpdatedEvent:
+		return ev.ContenderID
+	case domain.TickDisputeOpenedEvent:
+		return ev.ContenderID
+	case domain.TickDisputeResolvedEvent:
+		return ev.ContenderID
 	default:
 		return 0
 	}
@@ -1,10 +1,20 @@
 package events_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/climblive/platform/backend/internal/domain"
 	"github.com/climblive/platform/backend/internal/events"
+	"github.com/climblive/platform/backend/internal/metrics"
+	"github.com/climblive/platform/backend/internal/tracing"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
 )
 
 func TestBlockingSubscriber(t *testing.T) {
@@ -16,8 +26,69 @@ func TestBlockingSubscriber(t *testing.T) {
 	_, _ = broker.Subscribe(filter, 1)
 
 	for range 100 {
-		broker.Dispatch(1, domain.ContenderEnteredEvent{
+		broker.Dispatch(context.Background(), 1, domain.ContenderEnteredEvent{
 			ContenderID: 1,
 		})
 	}
 }
+
+func TestDispatchCarriesTraceContext(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)))
+
+	broker := events.NewBroker()
+
+	_, eventReader := broker.Subscribe(domain.EventFilter{ContestID: 1}, 0)
+
+	ctx, span := tracing.Start(context.Background(), "parent")
+	broker.Dispatch(ctx, 1, domain.ContenderEnteredEvent{
+		ContenderID: 1,
+	})
+	span.End()
+
+	envelope := <-eventReader.EventsChan(context.Background())
+
+	extracted := trace.SpanContextFromContext(tracing.Extract(context.Background(), envelope.TraceContext))
+
+	require.True(t, extracted.IsValid())
+	assert.Equal(t, span.SpanContext().TraceID(), extracted.TraceID())
+
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 2)
+	assert.Equal(t, "events.Dispatch", spans[0].Name)
+	assert.Equal(t, span.SpanContext().SpanID(), spans[0].Parent.SpanID())
+	assert.Equal(t, spans[0].SpanContext.SpanID(), extracted.SpanID())
+}
+
+func TestBufferedEventsMetric(t *testing.T) {
+	broker := events.NewBroker()
+
+	baseline := testutil.ToFloat64(metrics.BufferedEvents)
+
+	subscriptionID, eventReader := broker.Subscribe(domain.EventFilter{ContestID: 1}, 0)
+
+	subscription, ok := eventReader.(*events.Subscription)
+	require.True(t, ok)
+
+	for range 3 {
+		broker.Dispatch(context.Background(), 1, domain.ContenderEnteredEvent{
+			ContenderID: 1,
+		})
+	}
+
+	assert.Equal(t, baseline+3, testutil.ToFloat64(metrics.BufferedEvents))
+
+	_, err := subscription.AwaitEvent(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, baseline+2, testutil.ToFloat64(metrics.BufferedEvents))
+
+	broker.Unsubscribe(subscriptionID)
+
+	assert.Equal(t, baseline, testutil.ToFloat64(metrics.BufferedEvents))
+
+	_, err = subscription.AwaitEvent(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, baseline, testutil.ToFloat64(metrics.BufferedEvents))
+}
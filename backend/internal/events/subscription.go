@@ -5,6 +5,7 @@ import (
 	"sync"
 
 	"github.com/climblive/platform/backend/internal/domain"
+	"github.com/climblive/platform/backend/internal/metrics"
 	"github.com/go-errors/errors"
 	"github.com/google/uuid"
 )
@@ -20,6 +21,7 @@ type Subscription struct {
 	buffer         []domain.EventEnvelope
 	bufferCapacity int
 	closeReason    error
+	released       bool
 }
 
 func NewSubscription(
@@ -34,6 +36,7 @@ func NewSubscription(
 		cond:           nil,
 		buffer:         nil,
 		closeReason:    nil,
+		released:       false,
 	}
 
 	sub.cond = sync.NewCond(&sub.mu)
@@ -94,6 +97,10 @@ func (s *Subscription) popQueueUnsafe() (domain.EventEnvelope, bool) {
 		event := s.buffer[0]
 		s.buffer = s.buffer[1:]
 
+		if !s.released {
+			metrics.BufferedEvents.Dec()
+		}
+
 		return event, true
 	}
 
@@ -109,17 +116,38 @@ func (s *Subscription) Terminate() {
 	s.cond.Broadcast()
 }
 
+func (s *Subscription) release() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.released {
+		return
+	}
+
+	s.released = true
+	metrics.BufferedEvents.Sub(float64(len(s.buffer)))
+}
+
 func (s *Subscription) Post(event domain.EventEnvelope) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
 	if s.bufferCapacity != 0 && len(s.buffer) == s.bufferCapacity {
+		if s.closeReason == nil {
+			metrics.SubscriptionsBufferFull.Inc()
+		}
+
 		s.closeReason = ErrBufferFull
 
 		return ErrBufferFull
 	}
 
 	s.buffer = append(s.buffer, event)
+
+	if !s.released {
+		metrics.BufferedEvents.Inc()
+	}
+
 	s.cond.Broadcast()
 
 	return nil
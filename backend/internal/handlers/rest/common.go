@@ -1,17 +1,25 @@
 package rest
 
 import (
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"log/slog"
 	"net/http"
+	"net/url"
+	"slices"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/climblive/platform/backend/internal/domain"
 	"github.com/climblive/platform/backend/internal/utils"
 	"github.com/go-errors/errors"
 )
 
+var errMalformedRequest = errors.New("malformed request")
+var errRequestTooLarge = errors.New("request too large")
+
 func parseResourceID[T domain.ResourceIDType](id string) (T, error) {
 	number, err := strconv.ParseInt(id, 10, 32)
 	if err != nil {
@@ -22,6 +30,104 @@ func parseResourceID[T domain.ResourceIDType](id string) (T, error) {
 	return T(number), nil
 }
 
+func parseOptionalBool(query url.Values, key string) (*bool, error) {
+	value := query.Get(key)
+	if value == "" {
+		return nil, nil
+	}
+
+	parsed, err := strconv.ParseBool(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return &parsed, nil
+}
+
+func parseOptionalTime(query url.Values, key string) (time.Time, error) {
+	value := query.Get(key)
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	return time.Parse(time.RFC3339, value)
+}
+
+func parsePageRequest(query url.Values) (domain.PageRequest, error) {
+	page := domain.PageRequest{
+		After:      0,
+		Limit:      domain.DefaultPageSize,
+		Descending: false,
+	}
+
+	if value := query.Get("limit"); value != "" {
+		limit, err := strconv.Atoi(value)
+		if err != nil || limit <= 0 {
+			return domain.PageRequest{}, errors.Errorf("invalid limit: %s", value)
+		}
+
+		page.Limit = min(limit, domain.MaxPageSize)
+	}
+
+	if value := query.Get("cursor"); value != "" {
+		decoded, err := base64.RawURLEncoding.DecodeString(value)
+		if err != nil {
+			return domain.PageRequest{}, errors.Wrap(err, 0)
+		}
+
+		after, err := strconv.ParseInt(string(decoded), 10, 32)
+		if err != nil || after <= 0 {
+			return domain.PageRequest{}, errors.Errorf("invalid cursor: %s", value)
+		}
+
+		page.After = domain.ResourceID(after)
+	}
+
+	switch order := query.Get("order"); order {
+	case "", "asc":
+	case "desc":
+		page.Descending = true
+	default:
+		return domain.PageRequest{}, errors.Errorf("invalid order: %s", order)
+	}
+
+	return page, nil
+}
+
+func parseIfMatch(r *http.Request) (int, error) {
+	value := strings.TrimSpace(r.Header.Get("If-Match"))
+	if value == "" || value == "*" {
+		return 0, nil
+	}
+
+	unquoted, err := strconv.Unquote(strings.TrimPrefix(value, "W/"))
+	if err != nil {
+		return 0, errors.Wrap(errMalformedRequest, 0)
+	}
+
+	version, err := strconv.Atoi(unquoted)
+	if err != nil || version <= 0 {
+		return 0, errors.Wrap(errMalformedRequest, 0)
+	}
+
+	return version, nil
+}
+
+func setETag(w http.ResponseWriter, version int) {
+	w.Header().Set("ETag", strconv.Quote(strconv.Itoa(version)))
+}
+
+func writePage[T any](w http.ResponseWriter, r *http.Request, page domain.Page[T]) {
+	if page.Next != 0 {
+		query := r.URL.Query()
+		query.Set("cursor", base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(int(page.Next)))))
+
+		w.Header().Set("Link", fmt.Sprintf(`<?%s>; rel="next"`, query.Encode()))
+	}
+
+	writeResponse(w, http.StatusOK, page.Items)
+}
+
 func writeResponse(w http.ResponseWriter, status int, data any) {
 	if data == nil {
 		w.WriteHeader(status)
@@ -43,37 +149,87 @@ func writeResponse(w http.ResponseWriter, status int, data any) {
 	}
 }
 
+type errorMapping struct {
+	err    error
+	status int
+	code   ErrorCode
+	detail string
+}
+
+var errorMappings = []errorMapping{
+	{domain.ErrAllWinnersDrawn, http.StatusNotFound, ErrorCodeAllWinnersDrawn, "All winners have already been drawn."},
+	{domain.ErrNotFound, http.StatusNotFound, ErrorCodeNotFound, "The requested resource does not exist."},
+	{domain.ErrArchived, http.StatusNotFound, ErrorCodeArchived, "The contest has been archived."},
+	{domain.ErrDuplicate, http.StatusConflict, ErrorCodeDuplicate, "The resource already exists."},
+	{domain.ErrNotAuthenticated, http.StatusForbidden, ErrorCodeNotAuthenticated, "Authentication is required."},
+	{domain.ErrNotAuthorized, http.StatusForbidden, ErrorCodeNotAuthorized, "You are not authorized to perform this action."},
+	{domain.ErrNoOwnership, http.StatusForbidden, ErrorCodeNoOwnership, "You do not own this resource."},
+	{domain.ErrContestNotStarted, http.StatusForbidden, ErrorCodeContestNotStarted, "The contest has not started yet."},
+	{domain.ErrContestEnded, http.StatusForbidden, ErrorCodeContestEnded, "The contest has ended."},
+	{domain.ErrInsufficientRole, http.StatusForbidden, ErrorCodeInsufficientRole, "Your role does not permit this action."},
+	{domain.ErrProblemNotAvailable, http.StatusForbidden, ErrorCodeProblemNotAvailable, "The problem is not available to your class."},
+	{domain.ErrNotAllowed, http.StatusForbidden, ErrorCodeNotAllowed, "The action is not allowed in the current state."},
+	{domain.ErrLimitExceeded, http.StatusConflict, ErrorCodeLimitExceeded, "A limit has been exceeded."},
+	{domain.ErrInvalidData, http.StatusBadRequest, ErrorCodeInvalidData, "The request contains invalid data."},
+	{domain.ErrPreconditionFailed, http.StatusPreconditionFailed, ErrorCodePreconditionFailed, "The resource has been modified by someone else."},
+	{errMalformedRequest, http.StatusBadRequest, ErrorCodeInvalidData, "The request is malformed."},
+	{errRequestTooLarge, http.StatusRequestEntityTooLarge, ErrorCodeInvalidData, "The request body is too large."},
+	{errRateLimited, http.StatusTooManyRequests, ErrorCodeLimitExceeded, "Too many requests, try again later."},
+	{errIdempotencyKeyInUse, http.StatusConflict, ErrorCodeIdempotencyKeyInUse, "A request with the same idempotency key is still being processed."},
+	{errIdempotencyKeyReused, http.StatusUnprocessableEntity, ErrorCodeIdempotencyKeyReused, "The idempotency key has already been used for a different request."},
+	{errIdempotencyKeyLimit, http.StatusTooManyRequests, ErrorCodeLimitExceeded, "Too many idempotency keys are in use."},
+}
+
+func handleMalformedRequest(w http.ResponseWriter) {
+	handleError(w, errMalformedRequest)
+}
+
 func handleError(w http.ResponseWriter, err error) {
-	switch {
-	case errors.Is(err, domain.ErrAllWinnersDrawn):
-		fallthrough
-	case errors.Is(err, domain.ErrNotFound):
-		fallthrough
-	case errors.Is(err, domain.ErrArchived):
-		w.WriteHeader(http.StatusNotFound)
-	case errors.Is(err, domain.ErrDuplicate):
-		w.WriteHeader(http.StatusConflict)
-	case errors.Is(err, domain.ErrNotAuthenticated):
-		fallthrough
-	case errors.Is(err, domain.ErrNotAuthorized):
-		fallthrough
-	case errors.Is(err, domain.ErrNoOwnership):
-		fallthrough
-	case errors.Is(err, domain.ErrContestEnded):
-		fallthrough
-	case errors.Is(err, domain.ErrInsufficientRole):
-		fallthrough
-	case errors.Is(err, domain.ErrNotAllowed):
-		w.WriteHeader(http.StatusForbidden)
-	case errors.Is(err, domain.ErrLimitExceeded):
-		w.WriteHeader(http.StatusConflict)
-	case errors.Is(err, domain.ErrInvalidData):
-		w.WriteHeader(http.StatusBadRequest)
-	default:
-		w.WriteHeader(http.StatusInternalServerError)
+	problem := ProblemDetails{
+		Type:   "about:blank",
+		Title:  http.StatusText(http.StatusInternalServerError),
+		Status: http.StatusInternalServerError,
+		Detail: "An unexpected error occurred.",
+		Code:   ErrorCodeInternal,
+		Errors: nil,
+	}
+
+	index := slices.IndexFunc(errorMappings, func(mapping errorMapping) bool {
+		return errors.Is(err, mapping.err)
+	})
+
+	if index != -1 {
+		mapping := errorMappings[index]
 
-		if stack := utils.GetErrorStack(err); stack != "" {
-			fmt.Println(stack)
+		problem.Title = http.StatusText(mapping.status)
+		problem.Status = mapping.status
+		problem.Detail = mapping.detail
+		problem.Code = mapping.code
+	} else if stack := utils.GetErrorStack(err); stack != "" {
+		fmt.Println(stack)
+	}
+
+	var validationErr domain.ValidationError
+	if errors.As(err, &validationErr) {
+		for _, violation := range validationErr.Violations {
+			problem.Errors = append(problem.Errors, FieldError{
+				Field:   violation.Field,
+				Message: violation.Message,
+			})
 		}
 	}
+
+	json, err := json.Marshal(problem)
+	if err != nil {
+		w.WriteHeader(problem.Status)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(problem.Status)
+
+	_, err = w.Write(json)
+	if err != nil {
+		slog.Error("failed to write http response", "error", err)
+	}
 }
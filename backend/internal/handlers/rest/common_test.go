@@ -0,0 +1,127 @@
+package rest_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/climblive/platform/backend/internal/domain"
+	"github.com/climblive/platform/backend/internal/handlers/rest"
+	"github.com/go-errors/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type healthUseCaseStub struct {
+	err error
+}
+
+func (s *healthUseCaseStub) GetHealth(ctx context.Context) ([]domain.ServiceStatus, error) {
+	return nil, s.err
+}
+
+func (s *healthUseCaseStub) GetRetentionPolicyHealth(ctx context.Context) ([]domain.ServiceStatus, error) {
+	return nil, s.err
+}
+
+func TestProblemDetails(t *testing.T) {
+	serve := func(err error) (*httptest.ResponseRecorder, rest.ProblemDetails) {
+		mux := rest.NewMux()
+		rest.InstallHealthHandler(mux, &healthUseCaseStub{err: err})
+
+		r := httptest.NewRequest("GET", "http://localhost/health", nil)
+		w := httptest.NewRecorder()
+
+		mux.ServeHTTP(w, r)
+
+		var problem rest.ProblemDetails
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &problem))
+
+		return w, problem
+	}
+
+	t.Run("ContestEnded", func(t *testing.T) {
+		w, problem := serve(errors.Wrap(domain.ErrContestEnded, 0))
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+		assert.Equal(t, "application/problem+json", w.Header().Get("Content-Type"))
+		assert.Equal(t, http.StatusForbidden, problem.Status)
+		assert.Equal(t, rest.ErrorCodeContestEnded, problem.Code)
+		assert.Equal(t, "about:blank", problem.Type)
+		assert.NotEmpty(t, problem.Detail)
+	})
+
+	t.Run("InsufficientRole", func(t *testing.T) {
+		w, problem := serve(errors.Wrap(domain.ErrInsufficientRole, 0))
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+		assert.Equal(t, rest.ErrorCodeInsufficientRole, problem.Code)
+	})
+
+	t.Run("ValidationError", func(t *testing.T) {
+		err := errors.Errorf("%w: %w", domain.ErrInvalidData, domain.ValidationError{
+			Violations: []domain.FieldViolation{
+				{Field: "name", Message: "must not be empty"},
+			},
+		})
+
+		w, problem := serve(err)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Equal(t, rest.ErrorCodeInvalidData, problem.Code)
+		assert.Equal(t, []rest.FieldError{{Field: "name", Message: "must not be empty"}}, problem.Errors)
+	})
+
+	t.Run("PreconditionFailed", func(t *testing.T) {
+		w, problem := serve(errors.Wrap(domain.ErrPreconditionFailed, 0))
+
+		assert.Equal(t, http.StatusPreconditionFailed, w.Code)
+		assert.Equal(t, rest.ErrorCodePreconditionFailed, problem.Code)
+	})
+
+	t.Run("UnexpectedError", func(t *testing.T) {
+		w, problem := serve(errors.New("boom"))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+		assert.Equal(t, rest.ErrorCodeInternal, problem.Code)
+		assert.NotContains(t, problem.Detail, "boom")
+	})
+	t.Run("MalformedRequest", func(t *testing.T) {
+		mux := rest.NewMux()
+		rest.InstallTickHandler(mux, nil)
+
+		for _, target := range []string{"/contests/abc/ticks", "/contests/1/ticks?limit=abc"} {
+			w := httptest.NewRecorder()
+			mux.ServeHTTP(w, httptest.NewRequest("GET", "http://localhost"+target, nil))
+
+			var problem rest.ProblemDetails
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &problem))
+
+			assert.Equal(t, http.StatusBadRequest, w.Code, target)
+			assert.Equal(t, "application/problem+json", w.Header().Get("Content-Type"), target)
+			assert.Equal(t, rest.ErrorCodeInvalidData, problem.Code, target)
+		}
+	})
+
+	t.Run("MalformedIfMatch", func(t *testing.T) {
+		mux := rest.NewMux()
+		rest.InstallProblemHandler(mux, nil)
+
+		for _, etag := range []string{"abc", `"abc"`, `"0"`, `W/"-1"`} {
+			r := httptest.NewRequest("PATCH", "http://localhost/problems/1", nil)
+			r.Header.Set("If-Match", etag)
+
+			w := httptest.NewRecorder()
+			mux.ServeHTTP(w, r)
+
+			var problem rest.ProblemDetails
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &problem))
+
+			assert.Equal(t, http.StatusBadRequest, w.Code, etag)
+			assert.Equal(t, "application/problem+json", w.Header().Get("Content-Type"), etag)
+			assert.Equal(t, rest.ErrorCodeInvalidData, problem.Code, etag)
+		}
+	})
+}
@@ -35,7 +35,7 @@ func InstallCompClassHandler(mux *Mux, compClassUseCase compClassUseCase) {
 func (hdlr *compClassHandler) GetCompClass(w http.ResponseWriter, r *http.Request) {
 	compClassID, err := parseResourceID[domain.CompClassID](r.PathValue("compClassID"))
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
+		handleMalformedRequest(w)
 		return
 	}
 
@@ -51,7 +51,7 @@ func (hdlr *compClassHandler) GetCompClass(w http.ResponseWriter, r *http.Reques
 func (hdlr *compClassHandler) GetCompClassesByContest(w http.ResponseWriter, r *http.Request) {
 	contestID, err := parseResourceID[domain.ContestID](r.PathValue("contestID"))
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
+		handleMalformedRequest(w)
 		return
 	}
 
@@ -67,14 +67,14 @@ func (hdlr *compClassHandler) GetCompClassesByContest(w http.ResponseWriter, r *
 func (hdlr *compClassHandler) CreateCompClass(w http.ResponseWriter, r *http.Request) {
 	contestID, err := parseResourceID[domain.ContestID](r.PathValue("contestID"))
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
+		handleMalformedRequest(w)
 		return
 	}
 
 	var tmpl domain.CompClassTemplate
 	err = json.NewDecoder(r.Body).Decode(&tmpl)
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
+		handleMalformedRequest(w)
 		return
 	}
 
@@ -90,7 +90,7 @@ func (hdlr *compClassHandler) CreateCompClass(w http.ResponseWriter, r *http.Req
 func (hdlr *compClassHandler) DeleteCompClass(w http.ResponseWriter, r *http.Request) {
 	compClassID, err := parseResourceID[domain.CompClassID](r.PathValue("compClassID"))
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
+		handleMalformedRequest(w)
 		return
 	}
 
@@ -106,14 +106,14 @@ func (hdlr *compClassHandler) DeleteCompClass(w http.ResponseWriter, r *http.Req
 func (hdlr *compClassHandler) PatchCompClass(w http.ResponseWriter, r *http.Request) {
 	compClassID, err := parseResourceID[domain.CompClassID](r.PathValue("compClassID"))
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
+		handleMalformedRequest(w)
 		return
 	}
 
 	var patch domain.CompClassPatch
 	err = json.NewDecoder(r.Body).Decode(&patch)
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
+		handleMalformedRequest(w)
 		return
 	}
 
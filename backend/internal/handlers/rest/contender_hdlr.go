@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"net/netip"
+	"time"
 
 	"github.com/climblive/platform/backend/internal/domain"
 )
@@ -12,18 +14,22 @@ type contenderUseCase interface {
 	GetContender(ctx context.Context, contenderID domain.ContenderID) (domain.Contender, error)
 	GetContenderByCode(ctx context.Context, registrationCode string) (domain.Contender, error)
 	GetContendersByCompClass(ctx context.Context, compClassID domain.CompClassID) ([]domain.Contender, error)
-	GetContendersByContest(ctx context.Context, contestID domain.ContestID) ([]domain.Contender, error)
-	PatchContender(ctx context.Context, contenderID domain.ContenderID, patch domain.ContenderPatch) (domain.Contender, error)
+	GetContendersByContest(ctx context.Context, contestID domain.ContestID, filter domain.ContenderFilter, page domain.PageRequest) (domain.Page[domain.Contender], error)
+	PatchContender(ctx context.Context, contenderID domain.ContenderID, patch domain.ContenderPatch, expectedVersion int) (domain.Contender, error)
 	ScrubContender(ctx context.Context, contenderID domain.ContenderID) (domain.Contender, error)
+	ExportContenderData(ctx context.Context, contenderID domain.ContenderID) (domain.ContenderDataExport, error)
+	EraseContender(ctx context.Context, contenderID domain.ContenderID) (domain.Contender, error)
 	DeleteContender(ctx context.Context, contenderID domain.ContenderID) error
 	CreateContenders(ctx context.Context, contestID domain.ContestID, number int) ([]domain.Contender, error)
+	SelfRegister(ctx context.Context, contestID domain.ContestID, registration domain.SelfRegistration) (domain.Contender, error)
+	GetWaitlist(ctx context.Context, compClassID domain.CompClassID) ([]domain.Contender, error)
 }
 
 type contenderHandler struct {
 	contenderUseCase contenderUseCase
 }
 
-func InstallContenderHandler(mux *Mux, contenderUseCase contenderUseCase) {
+func InstallContenderHandler(mux *Mux, contenderUseCase contenderUseCase, trustedProxies []netip.Prefix) {
 	handler := &contenderHandler{
 		contenderUseCase: contenderUseCase,
 	}
@@ -31,17 +37,23 @@ func InstallContenderHandler(mux *Mux, contenderUseCase contenderUseCase) {
 	mux.HandleFunc("GET /contenders/{contenderID}", handler.GetContender)
 	mux.HandleFunc("GET /codes/{registrationCode}/contender", handler.GetContenderByCode)
 	mux.HandleFunc("GET /compClasses/{compClassID}/contenders", handler.GetContendersByCompClass)
+	mux.HandleFunc("GET /comp-classes/{compClassID}/waitlist", handler.GetWaitlist)
 	mux.HandleFunc("GET /contests/{contestID}/contenders", handler.GetContendersByContest)
 	mux.HandleFunc("PATCH /contenders/{contenderID}", handler.PatchContender)
 	mux.HandleFunc("POST /contenders/{contenderID}/scrub", handler.ScrubContender)
+	mux.HandleFunc("GET /contenders/{contenderID}/export", handler.ExportContenderData)
+	mux.HandleFunc("POST /contenders/{contenderID}/erase", handler.EraseContender)
 	mux.HandleFunc("DELETE /contenders/{contenderID}", handler.DeleteContender)
 	mux.HandleFunc("POST /contests/{contestID}/contenders", handler.CreateContenders)
+
+	selfRegistrationLimiter := NewRateLimiter(10, time.Minute, trustedProxies)
+	mux.HandleFunc("POST /contests/{contestID}/self-registration", selfRegistrationLimiter.Middleware(http.HandlerFunc(handler.SelfRegister)).ServeHTTP)
 }
 
 func (hdlr *contenderHandler) GetContender(w http.ResponseWriter, r *http.Request) {
 	contenderID, err := parseResourceID[domain.ContenderID](r.PathValue("contenderID"))
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
+		handleMalformedRequest(w)
 		return
 	}
 
@@ -51,6 +63,7 @@ func (hdlr *contenderHandler) GetContender(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
+	setETag(w, contender.Version)
 	writeResponse(w, http.StatusOK, contender)
 }
 
@@ -69,7 +82,7 @@ func (hdlr *contenderHandler) GetContenderByCode(w http.ResponseWriter, r *http.
 func (hdlr *contenderHandler) GetContendersByCompClass(w http.ResponseWriter, r *http.Request) {
 	compClassID, err := parseResourceID[domain.CompClassID](r.PathValue("compClassID"))
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
+		handleMalformedRequest(w)
 		return
 	}
 
@@ -82,49 +95,100 @@ func (hdlr *contenderHandler) GetContendersByCompClass(w http.ResponseWriter, r
 	writeResponse(w, http.StatusOK, contenders)
 }
 
+func (hdlr *contenderHandler) GetWaitlist(w http.ResponseWriter, r *http.Request) {
+	compClassID, err := parseResourceID[domain.CompClassID](r.PathValue("compClassID"))
+	if err != nil {
+		handleMalformedRequest(w)
+		return
+	}
+
+	contenders, err := hdlr.contenderUseCase.GetWaitlist(r.Context(), compClassID)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	writeResponse(w, http.StatusOK, contenders)
+}
+
 func (hdlr *contenderHandler) GetContendersByContest(w http.ResponseWriter, r *http.Request) {
 	contestID, err := parseResourceID[domain.ContestID](r.PathValue("contestID"))
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
+		handleMalformedRequest(w)
+		return
+	}
+
+	query := r.URL.Query()
+
+	page, err := parsePageRequest(query)
+	if err != nil {
+		handleMalformedRequest(w)
 		return
 	}
 
-	contenders, err := hdlr.contenderUseCase.GetContendersByContest(r.Context(), contestID)
+	var filter domain.ContenderFilter
+
+	if value := query.Get("compClassId"); value != "" {
+		filter.CompClassID, err = parseResourceID[domain.CompClassID](value)
+		if err != nil {
+			handleMalformedRequest(w)
+			return
+		}
+	}
+
+	if filter.Entered, err = parseOptionalBool(query, "entered"); err != nil {
+		handleMalformedRequest(w)
+		return
+	}
+
+	if filter.Disqualified, err = parseOptionalBool(query, "disqualified"); err != nil {
+		handleMalformedRequest(w)
+		return
+	}
+
+	contenders, err := hdlr.contenderUseCase.GetContendersByContest(r.Context(), contestID, filter, page)
 	if err != nil {
 		handleError(w, err)
 		return
 	}
 
-	writeResponse(w, http.StatusOK, contenders)
+	writePage(w, r, contenders)
 }
 
 func (hdlr *contenderHandler) PatchContender(w http.ResponseWriter, r *http.Request) {
 	contenderID, err := parseResourceID[domain.ContenderID](r.PathValue("contenderID"))
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
+		handleMalformedRequest(w)
+		return
+	}
+
+	expectedVersion, err := parseIfMatch(r)
+	if err != nil {
+		handleError(w, err)
 		return
 	}
 
 	var patch domain.ContenderPatch
 	err = json.NewDecoder(r.Body).Decode(&patch)
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
+		handleMalformedRequest(w)
 		return
 	}
 
-	updatedContender, err := hdlr.contenderUseCase.PatchContender(r.Context(), contenderID, patch)
+	updatedContender, err := hdlr.contenderUseCase.PatchContender(r.Context(), contenderID, patch, expectedVersion)
 	if err != nil {
 		handleError(w, err)
 		return
 	}
 
+	setETag(w, updatedContender.Version)
 	writeResponse(w, http.StatusOK, updatedContender)
 }
 
 func (hdlr *contenderHandler) DeleteContender(w http.ResponseWriter, r *http.Request) {
 	contenderID, err := parseResourceID[domain.ContenderID](r.PathValue("contenderID"))
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
+		handleMalformedRequest(w)
 		return
 	}
 
@@ -140,7 +204,7 @@ func (hdlr *contenderHandler) DeleteContender(w http.ResponseWriter, r *http.Req
 func (hdlr *contenderHandler) ScrubContender(w http.ResponseWriter, r *http.Request) {
 	contenderID, err := parseResourceID[domain.ContenderID](r.PathValue("contenderID"))
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
+		handleMalformedRequest(w)
 		return
 	}
 
@@ -153,17 +217,49 @@ func (hdlr *contenderHandler) ScrubContender(w http.ResponseWriter, r *http.Requ
 	writeResponse(w, http.StatusOK, contender)
 }
 
+func (hdlr *contenderHandler) ExportContenderData(w http.ResponseWriter, r *http.Request) {
+	contenderID, err := parseResourceID[domain.ContenderID](r.PathValue("contenderID"))
+	if err != nil {
+		handleMalformedRequest(w)
+		return
+	}
+
+	export, err := hdlr.contenderUseCase.ExportContenderData(r.Context(), contenderID)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	writeResponse(w, http.StatusOK, export)
+}
+
+func (hdlr *contenderHandler) EraseContender(w http.ResponseWriter, r *http.Request) {
+	contenderID, err := parseResourceID[domain.ContenderID](r.PathValue("contenderID"))
+	if err != nil {
+		handleMalformedRequest(w)
+		return
+	}
+
+	contender, err := hdlr.contenderUseCase.EraseContender(r.Context(), contenderID)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	writeResponse(w, http.StatusOK, contender)
+}
+
 func (hdlr *contenderHandler) CreateContenders(w http.ResponseWriter, r *http.Request) {
 	contestID, err := parseResourceID[domain.ContestID](r.PathValue("contestID"))
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
+		handleMalformedRequest(w)
 		return
 	}
 
 	var arguments CreateContendersArguments
 	err = json.NewDecoder(r.Body).Decode(&arguments)
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
+		handleMalformedRequest(w)
 		return
 	}
 
@@ -175,3 +271,26 @@ func (hdlr *contenderHandler) CreateContenders(w http.ResponseWriter, r *http.Re
 
 	writeResponse(w, http.StatusCreated, contenders)
 }
+
+func (hdlr *contenderHandler) SelfRegister(w http.ResponseWriter, r *http.Request) {
+	contestID, err := parseResourceID[domain.ContestID](r.PathValue("contestID"))
+	if err != nil {
+		handleMalformedRequest(w)
+		return
+	}
+
+	var registration domain.SelfRegistration
+	err = json.NewDecoder(r.Body).Decode(&registration)
+	if err != nil {
+		handleMalformedRequest(w)
+		return
+	}
+
+	contender, err := hdlr.contenderUseCase.SelfRegister(r.Context(), contestID, registration)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	writeResponse(w, http.StatusCreated, contender)
+}
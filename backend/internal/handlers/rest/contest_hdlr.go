@@ -15,15 +15,17 @@ import (
 
 type contestUseCase interface {
 	GetContest(ctx context.Context, contestID domain.ContestID) (domain.Contest, error)
-	GetAllContests(ctx context.Context) ([]domain.Contest, error)
+	GetAllContests(ctx context.Context, filter domain.ContestFilter, page domain.PageRequest) (domain.Page[domain.Contest], error)
 	GetContestsByOrganizer(ctx context.Context, organizerID domain.OrganizerID) ([]domain.Contest, error)
 	GetScoreboard(ctx context.Context, contestID domain.ContestID) ([]domain.ScoreboardEntry, error)
-	PatchContest(ctx context.Context, contestID domain.ContestID, patch domain.ContestPatch) (domain.Contest, error)
+	GetTeamScoreboard(ctx context.Context, contestID domain.ContestID) ([]domain.TeamScoreboardEntry, error)
+	PatchContest(ctx context.Context, contestID domain.ContestID, patch domain.ContestPatch, expectedVersion int) (domain.Contest, error)
 	ArchiveContest(ctx context.Context, contestID domain.ContestID) (domain.Contest, error)
 	RestoreContest(ctx context.Context, contestID domain.ContestID) (domain.Contest, error)
 	CreateContest(ctx context.Context, organizerID domain.OrganizerID, template domain.ContestTemplate) (domain.Contest, error)
 	DuplicateContest(ctx context.Context, contestID domain.ContestID) (domain.Contest, error)
 	TransferContest(ctx context.Context, contestID domain.ContestID, newOrganizerID domain.OrganizerID) (domain.Contest, error)
+	RevealScoreboard(ctx context.Context, contestID domain.ContestID) (domain.Contest, error)
 }
 
 type contestHandler struct {
@@ -49,6 +51,8 @@ func InstallContestHandler(
 	mux.HandleFunc("GET /contests/{contestID}", handler.GetContest)
 	mux.HandleFunc("GET /contests", handler.GetAllContests)
 	mux.HandleFunc("GET /contests/{contestID}/scoreboard", handler.GetScoreboard)
+	mux.HandleFunc("GET /contests/{contestID}/team-scoreboard", handler.GetTeamScoreboard)
+	mux.HandleFunc("POST /contests/{contestID}/scoreboard/reveal", handler.RevealScoreboard)
 	mux.HandleFunc("GET /organizers/{organizerID}/contests", handler.GetContestsByOrganizer)
 	mux.HandleFunc("POST /organizers/{organizerID}/contests", handler.CreateContest)
 	mux.HandleFunc("POST /contests/{contestID}/duplicate", handler.DuplicateContest)
@@ -62,7 +66,7 @@ func InstallContestHandler(
 func (hdlr *contestHandler) GetContest(w http.ResponseWriter, r *http.Request) {
 	contestID, err := parseResourceID[domain.ContestID](r.PathValue("contestID"))
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
+		handleMalformedRequest(w)
 		return
 	}
 
@@ -72,23 +76,43 @@ func (hdlr *contestHandler) GetContest(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	setETag(w, contest.Version)
 	writeResponse(w, http.StatusOK, contest)
 }
 
 func (hdlr *contestHandler) GetAllContests(w http.ResponseWriter, r *http.Request) {
-	contests, err := hdlr.contestUseCase.GetAllContests(r.Context())
+	query := r.URL.Query()
+
+	page, err := parsePageRequest(query)
+	if err != nil {
+		handleMalformedRequest(w)
+		return
+	}
+
+	archived, err := parseOptionalBool(query, "archived")
+	if err != nil {
+		handleMalformedRequest(w)
+		return
+	}
+
+	filter := domain.ContestFilter{
+		Country:  query.Get("country"),
+		Archived: archived,
+	}
+
+	contests, err := hdlr.contestUseCase.GetAllContests(r.Context(), filter, page)
 	if err != nil {
 		handleError(w, err)
 		return
 	}
 
-	writeResponse(w, http.StatusOK, contests)
+	writePage(w, r, contests)
 }
 
 func (hdlr *contestHandler) GetScoreboard(w http.ResponseWriter, r *http.Request) {
 	contestID, err := parseResourceID[domain.ContestID](r.PathValue("contestID"))
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
+		handleMalformedRequest(w)
 		return
 	}
 
@@ -101,10 +125,26 @@ func (hdlr *contestHandler) GetScoreboard(w http.ResponseWriter, r *http.Request
 	writeResponse(w, http.StatusOK, scoreboard)
 }
 
+func (hdlr *contestHandler) GetTeamScoreboard(w http.ResponseWriter, r *http.Request) {
+	contestID, err := parseResourceID[domain.ContestID](r.PathValue("contestID"))
+	if err != nil {
+		handleMalformedRequest(w)
+		return
+	}
+
+	scoreboard, err := hdlr.contestUseCase.GetTeamScoreboard(r.Context(), contestID)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	writeResponse(w, http.StatusOK, scoreboard)
+}
+
 func (hdlr *contestHandler) GetContestsByOrganizer(w http.ResponseWriter, r *http.Request) {
 	organizerID, err := parseResourceID[domain.OrganizerID](r.PathValue("organizerID"))
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
+		handleMalformedRequest(w)
 		return
 	}
 
@@ -120,37 +160,44 @@ func (hdlr *contestHandler) GetContestsByOrganizer(w http.ResponseWriter, r *htt
 func (hdlr *contestHandler) PatchContest(w http.ResponseWriter, r *http.Request) {
 	contestID, err := parseResourceID[domain.ContestID](r.PathValue("contestID"))
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
+		handleMalformedRequest(w)
+		return
+	}
+
+	expectedVersion, err := parseIfMatch(r)
+	if err != nil {
+		handleError(w, err)
 		return
 	}
 
 	var patch domain.ContestPatch
 	err = json.NewDecoder(r.Body).Decode(&patch)
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
+		handleMalformedRequest(w)
 		return
 	}
 
-	updatedContest, err := hdlr.contestUseCase.PatchContest(r.Context(), contestID, patch)
+	updatedContest, err := hdlr.contestUseCase.PatchContest(r.Context(), contestID, patch, expectedVersion)
 	if err != nil {
 		handleError(w, err)
 		return
 	}
 
+	setETag(w, updatedContest.Version)
 	writeResponse(w, http.StatusOK, updatedContest)
 }
 
 func (hdlr *contestHandler) CreateContest(w http.ResponseWriter, r *http.Request) {
 	organizerID, err := parseResourceID[domain.OrganizerID](r.PathValue("organizerID"))
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
+		handleMalformedRequest(w)
 		return
 	}
 
 	var tmpl domain.ContestTemplate
 	err = json.NewDecoder(r.Body).Decode(&tmpl)
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
+		handleMalformedRequest(w)
 		return
 	}
 
@@ -166,7 +213,7 @@ func (hdlr *contestHandler) CreateContest(w http.ResponseWriter, r *http.Request
 func (hdlr *contestHandler) DuplicateContest(w http.ResponseWriter, r *http.Request) {
 	contestID, err := parseResourceID[domain.ContestID](r.PathValue("contestID"))
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
+		handleMalformedRequest(w)
 		return
 	}
 
@@ -179,10 +226,26 @@ func (hdlr *contestHandler) DuplicateContest(w http.ResponseWriter, r *http.Requ
 	writeResponse(w, http.StatusCreated, duplicatedContest)
 }
 
+func (hdlr *contestHandler) RevealScoreboard(w http.ResponseWriter, r *http.Request) {
+	contestID, err := parseResourceID[domain.ContestID](r.PathValue("contestID"))
+	if err != nil {
+		handleMalformedRequest(w)
+		return
+	}
+
+	contest, err := hdlr.contestUseCase.RevealScoreboard(r.Context(), contestID)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	writeResponse(w, http.StatusOK, contest)
+}
+
 func (hdlr *contestHandler) ArchiveContest(w http.ResponseWriter, r *http.Request) {
 	contestID, err := parseResourceID[domain.ContestID](r.PathValue("contestID"))
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
+		handleMalformedRequest(w)
 		return
 	}
 
@@ -198,7 +261,7 @@ func (hdlr *contestHandler) ArchiveContest(w http.ResponseWriter, r *http.Reques
 func (hdlr *contestHandler) RestoreContest(w http.ResponseWriter, r *http.Request) {
 	contestID, err := parseResourceID[domain.ContestID](r.PathValue("contestID"))
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
+		handleMalformedRequest(w)
 		return
 	}
 
@@ -214,14 +277,14 @@ func (hdlr *contestHandler) RestoreContest(w http.ResponseWriter, r *http.Reques
 func (hdlr *contestHandler) TransferContest(w http.ResponseWriter, r *http.Request) {
 	contestID, err := parseResourceID[domain.ContestID](r.PathValue("contestID"))
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
+		handleMalformedRequest(w)
 		return
 	}
 
 	var req domain.ContestTransferRequest
 	err = json.NewDecoder(r.Body).Decode(&req)
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
+		handleMalformedRequest(w)
 		return
 	}
 
@@ -237,7 +300,7 @@ func (hdlr *contestHandler) TransferContest(w http.ResponseWriter, r *http.Reque
 func (hdlr *contestHandler) DownloadResults(w http.ResponseWriter, r *http.Request) {
 	contestID, err := parseResourceID[domain.ContestID](r.PathValue("contestID"))
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
+		handleMalformedRequest(w)
 		return
 	}
 
@@ -279,7 +342,7 @@ func (hdlr *contestHandler) DownloadResults(w http.ResponseWriter, r *http.Reque
 			return errors.Wrap(err, 0)
 		}
 
-		ticks, err := hdlr.tickUseCase.GetTicksByContest(r.Context(), contestID)
+		ticks, err := hdlr.tickUseCase.GetTicksByContest(r.Context(), contestID, domain.TickFilter{}, domain.PageRequest{})
 		if err != nil {
 			return errors.Wrap(err, 0)
 		}
@@ -396,7 +459,7 @@ func (hdlr *contestHandler) DownloadResults(w http.ResponseWriter, r *http.Reque
 			}
 
 			problemResults := make(map[domain.ProblemID]string, 0)
-			for _, tick := range ticks {
+			for _, tick := range ticks.Items {
 				if *tick.Ownership.ContenderID == entry.ContenderID {
 					result := ""
 
@@ -424,6 +487,17 @@ func (hdlr *contestHandler) DownloadResults(w http.ResponseWriter, r *http.Reque
 			nextRowNumbers[entry.CompClassID]++
 		}
 
+		teamScoreboard, err := hdlr.contestUseCase.GetTeamScoreboard(r.Context(), contestID)
+		if err != nil {
+			return errors.Wrap(err, 0)
+		}
+
+		if len(teamScoreboard) > 0 {
+			if err := writeTeamSheet(book, teamScoreboard, scoreboard); err != nil {
+				return errors.Wrap(err, 0)
+			}
+		}
+
 		err = book.DeleteSheet("Sheet1")
 		if err != nil {
 			return errors.Wrap(err, 0)
@@ -446,3 +520,73 @@ func (hdlr *contestHandler) DownloadResults(w http.ResponseWriter, r *http.Reque
 		handleError(w, err)
 	}
 }
+
+func writeTeamSheet(book *excelize.File, teamScoreboard []domain.TeamScoreboardEntry, scoreboard []domain.ScoreboardEntry) error {
+	const sheetName = "Teams"
+
+	if _, err := book.NewSheet(sheetName); err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	contenderNames := make(map[domain.ContenderID]string)
+	for _, entry := range scoreboard {
+		contenderNames[entry.ContenderID] = entry.Name
+	}
+
+	slices.SortFunc(teamScoreboard, func(a, b domain.TeamScoreboardEntry) int {
+		switch {
+		case a.Score == nil && b.Score == nil:
+			return strings.Compare(a.Name, b.Name)
+		case a.Score == nil:
+			return 1
+		case b.Score == nil:
+			return -1
+		default:
+			return a.Score.RankOrder - b.Score.RankOrder
+		}
+	})
+
+	err := book.SetColWidth(sheetName, "A", "A", 40)
+	if err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	err = book.SetColWidth(sheetName, "B", "C", 20)
+	if err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	err = book.SetColWidth(sheetName, "D", "D", 80)
+	if err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	err = book.SetSheetRow(sheetName, "A1", &[]string{"Name", "Score", "Placement", "Members"})
+	if err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	for index, entry := range teamScoreboard {
+		var score, placement int
+		if entry.Score != nil {
+			score = entry.Score.Score
+			placement = entry.Score.Placement
+		}
+
+		members := make([]string, 0, len(entry.Members))
+		for _, contenderID := range entry.Members {
+			members = append(members, contenderNames[contenderID])
+		}
+
+		err = book.SetSheetRow(sheetName, fmt.Sprintf("A%d", index+2), &[]any{
+			entry.Name,
+			score,
+			placement,
+			strings.Join(members, ", ")})
+		if err != nil {
+			return errors.Wrap(err, 0)
+		}
+	}
+
+	return nil
+}
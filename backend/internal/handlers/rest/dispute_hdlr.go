@@ -0,0 +1,109 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/climblive/platform/backend/internal/domain"
+)
+
+type tickDisputeUseCase interface {
+	GetTickDisputesByContest(ctx context.Context, contestID domain.ContestID) ([]domain.TickDispute, error)
+	GetTickDisputesByContender(ctx context.Context, contenderID domain.ContenderID) ([]domain.TickDispute, error)
+	OpenTickDispute(ctx context.Context, contenderID domain.ContenderID, tmpl domain.TickDisputeTemplate) (domain.TickDispute, error)
+	ResolveTickDispute(ctx context.Context, disputeID domain.TickDisputeID, resolution domain.TickDisputeResolution) (domain.TickDispute, error)
+}
+
+type tickDisputeHandler struct {
+	tickDisputeUseCase tickDisputeUseCase
+}
+
+func InstallTickDisputeHandler(mux *Mux, tickDisputeUseCase tickDisputeUseCase) {
+	handler := &tickDisputeHandler{
+		tickDisputeUseCase: tickDisputeUseCase,
+	}
+
+	mux.HandleFunc("GET /contests/{contestID}/tick-disputes", handler.GetTickDisputesByContest)
+	mux.HandleFunc("GET /contenders/{contenderID}/tick-disputes", handler.GetTickDisputesByContender)
+	mux.HandleFunc("POST /contenders/{contenderID}/tick-disputes", handler.OpenTickDispute)
+	mux.HandleFunc("POST /tick-disputes/{disputeID}/resolve", handler.ResolveTickDispute)
+}
+
+func (hdlr *tickDisputeHandler) GetTickDisputesByContest(w http.ResponseWriter, r *http.Request) {
+	contestID, err := parseResourceID[domain.ContestID](r.PathValue("contestID"))
+	if err != nil {
+		handleMalformedRequest(w)
+		return
+	}
+
+	disputes, err := hdlr.tickDisputeUseCase.GetTickDisputesByContest(r.Context(), contestID)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	writeResponse(w, http.StatusOK, disputes)
+}
+
+func (hdlr *tickDisputeHandler) GetTickDisputesByContender(w http.ResponseWriter, r *http.Request) {
+	contenderID, err := parseResourceID[domain.ContenderID](r.PathValue("contenderID"))
+	if err != nil {
+		handleMalformedRequest(w)
+		return
+	}
+
+	disputes, err := hdlr.tickDisputeUseCase.GetTickDisputesByContender(r.Context(), contenderID)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	writeResponse(w, http.StatusOK, disputes)
+}
+
+func (hdlr *tickDisputeHandler) OpenTickDispute(w http.ResponseWriter, r *http.Request) {
+	contenderID, err := parseResourceID[domain.ContenderID](r.PathValue("contenderID"))
+	if err != nil {
+		handleMalformedRequest(w)
+		return
+	}
+
+	var tmpl domain.TickDisputeTemplate
+	err = json.NewDecoder(r.Body).Decode(&tmpl)
+	if err != nil {
+		handleMalformedRequest(w)
+		return
+	}
+
+	dispute, err := hdlr.tickDisputeUseCase.OpenTickDispute(r.Context(), contenderID, tmpl)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	writeResponse(w, http.StatusCreated, dispute)
+}
+
+func (hdlr *tickDisputeHandler) ResolveTickDispute(w http.ResponseWriter, r *http.Request) {
+	disputeID, err := parseResourceID[domain.TickDisputeID](r.PathValue("disputeID"))
+	if err != nil {
+		handleMalformedRequest(w)
+		return
+	}
+
+	var resolution domain.TickDisputeResolution
+	err = json.NewDecoder(r.Body).Decode(&resolution)
+	if err != nil {
+		handleMalformedRequest(w)
+		return
+	}
+
+	dispute, err := hdlr.tickDisputeUseCase.ResolveTickDispute(r.Context(), disputeID, resolution)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	writeResponse(w, http.StatusOK, dispute)
+}
@@ -13,7 +13,7 @@ import (
 type scoreEngineUseCase interface {
 	ListScoreEnginesByContest(ctx context.Context, contestID domain.ContestID) ([]domain.ScoreEngineInstanceID, error)
 	StopScoreEngine(ctx context.Context, instanceID domain.ScoreEngineInstanceID) error
-	StartScoreEngine(ctx context.Context, contestID domain.ContestID, terminatedBy time.Time) (domain.ScoreEngineInstanceID, error)
+	StartScoreEngine(ctx context.Context, contestID domain.ContestID, roundID domain.RoundID, terminatedBy time.Time) (domain.ScoreEngineInstanceID, error)
 }
 
 type scoreEngineHandler struct {
@@ -33,7 +33,7 @@ func InstallScoreEngineHandler(mux *Mux, scoreEngineUseCase scoreEngineUseCase)
 func (hdlr *scoreEngineHandler) ListScoreEnginesByContest(w http.ResponseWriter, r *http.Request) {
 	contestID, err := parseResourceID[domain.ContestID](r.PathValue("contestID"))
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
+		handleMalformedRequest(w)
 
 		return
 	}
@@ -50,7 +50,7 @@ func (hdlr *scoreEngineHandler) ListScoreEnginesByContest(w http.ResponseWriter,
 func (hdlr *scoreEngineHandler) StopScoreEngine(w http.ResponseWriter, r *http.Request) {
 	id, err := uuid.Parse(r.PathValue("instanceID"))
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
+		handleMalformedRequest(w)
 		return
 	}
 
@@ -68,7 +68,7 @@ func (hdlr *scoreEngineHandler) StopScoreEngine(w http.ResponseWriter, r *http.R
 func (hdlr *scoreEngineHandler) StartScoreEngine(w http.ResponseWriter, r *http.Request) {
 	contestID, err := parseResourceID[domain.ContestID](r.PathValue("contestID"))
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
+		handleMalformedRequest(w)
 
 		return
 	}
@@ -76,11 +76,11 @@ func (hdlr *scoreEngineHandler) StartScoreEngine(w http.ResponseWriter, r *http.
 	var arguments StartScoreEngineArguments
 	err = json.NewDecoder(r.Body).Decode(&arguments)
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
+		handleMalformedRequest(w)
 		return
 	}
 
-	instanceID, err := hdlr.scoreEngineUseCase.StartScoreEngine(r.Context(), contestID, arguments.TerminatedBy)
+	instanceID, err := hdlr.scoreEngineUseCase.StartScoreEngine(r.Context(), contestID, arguments.RoundID, arguments.TerminatedBy)
 	if err != nil {
 		handleError(w, err)
 		return
@@ -1,6 +1,7 @@
 package rest
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log/slog"
@@ -9,20 +10,29 @@ import (
 
 	"github.com/climblive/platform/backend/internal/domain"
 	"github.com/climblive/platform/backend/internal/events"
+	"github.com/climblive/platform/backend/internal/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 const bufferCapacity = 1_000
 const clientRetry = 5 * time.Second
 
+type liveScoreUseCase interface {
+	AuthorizeLiveScores(ctx context.Context, contestID domain.ContestID) error
+}
+
 type eventHandler struct {
-	eventBroker  domain.EventBroker
-	pingInterval time.Duration
+	eventBroker      domain.EventBroker
+	liveScoreUseCase liveScoreUseCase
+	pingInterval     time.Duration
 }
 
-func InstallEventHandler(mux *Mux, eventBroker domain.EventBroker, pingInterval time.Duration) {
+func InstallEventHandler(mux *Mux, eventBroker domain.EventBroker, liveScoreUseCase liveScoreUseCase, pingInterval time.Duration) {
 	handler := &eventHandler{
-		eventBroker:  eventBroker,
-		pingInterval: pingInterval,
+		eventBroker:      eventBroker,
+		liveScoreUseCase: liveScoreUseCase,
+		pingInterval:     pingInterval,
 	}
 
 	mux.HandleFunc("GET /contests/{contestID}/events", handler.HandleSubscribeContestEvents)
@@ -41,20 +51,33 @@ func readRemoteAddr(r *http.Request) string {
 func (hdlr *eventHandler) HandleSubscribeContestEvents(w http.ResponseWriter, r *http.Request) {
 	contestID, err := parseResourceID[domain.ContestID](r.PathValue("contestID"))
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
+		handleMalformedRequest(w)
 		return
 	}
 
 	logger := slog.Default().With("contest_id", contestID, "remote_addr", readRemoteAddr(r))
 
-	filter := domain.NewEventFilter(
-		contestID,
-		0,
+	eventNames := []string{
 		"CONTENDER_PUBLIC_INFO_UPDATED",
 		"[]CONTENDER_SCORE_UPDATED",
+		"[]ROUND_SCORE_UPDATED",
+		"[]TEAM_SCORE_UPDATED",
+		"TEAM_ADDED",
+		"TEAM_UPDATED",
+		"TEAM_DELETED",
 		"SCORE_ENGINE_STARTED",
 		"SCORE_ENGINE_STOPPED",
-	)
+	}
+
+	if err := hdlr.liveScoreUseCase.AuthorizeLiveScores(r.Context(), contestID); err == nil {
+		eventNames = append(eventNames,
+			"[]CONTENDER_LIVE_SCORE_UPDATED",
+			"TICK_DISPUTE_OPENED",
+			"TICK_DISPUTE_RESOLVED",
+		)
+	}
+
+	filter := domain.NewEventFilter(contestID, 0, eventNames...)
 
 	hdlr.subscribe(w, r, filter, logger)
 }
@@ -62,7 +85,7 @@ func (hdlr *eventHandler) HandleSubscribeContestEvents(w http.ResponseWriter, r
 func (hdlr *eventHandler) HandleSubscribeContenderEvents(w http.ResponseWriter, r *http.Request) {
 	contenderID, err := parseResourceID[domain.ContenderID](r.PathValue("contenderID"))
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
+		handleMalformedRequest(w)
 		return
 	}
 
@@ -73,9 +96,14 @@ func (hdlr *eventHandler) HandleSubscribeContenderEvents(w http.ResponseWriter,
 		contenderID,
 		"CONTENDER_PUBLIC_INFO_UPDATED",
 		"CONTENDER_SCORE_UPDATED",
+		"ROUND_SCORE_UPDATED",
 		"ASCENT_REGISTERED",
 		"ASCENT_DEREGISTERED",
 		"RAFFLE_WINNER_DRAWN",
+		"RAFFLE_WINNER_UPDATED",
+		"CONTENDER_PROMOTED_FROM_WAITLIST",
+		"TICK_DISPUTE_OPENED",
+		"TICK_DISPUTE_RESOLVED",
 	)
 
 	hdlr.subscribe(w, r, filter, logger)
@@ -112,12 +140,7 @@ ConsumeEvents:
 				break ConsumeEvents
 			}
 
-			json, err := json.Marshal(event.Data)
-			if err != nil {
-				panic(err)
-			}
-
-			write(w, fmt.Sprintf("event: %s\ndata: %s\n\n", events.EventName(event.Data), json))
+			deliver(w, event)
 		case <-keepAlive:
 			write(w, ":\n\n")
 		case <-r.Context().Done():
@@ -131,6 +154,22 @@ ConsumeEvents:
 	}
 }
 
+func deliver(w http.ResponseWriter, event domain.EventEnvelope) {
+	eventName := events.EventName(event.Data)
+
+	_, span := tracing.Start(tracing.Extract(context.Background(), event.TraceContext), "events.Deliver", trace.WithAttributes(
+		attribute.String("event.name", eventName),
+	))
+	defer span.End()
+
+	json, err := json.Marshal(event.Data)
+	if err != nil {
+		panic(err)
+	}
+
+	write(w, fmt.Sprintf("event: %s\ndata: %s\n\n", eventName, json))
+}
+
 func write(w http.ResponseWriter, data string) {
 	_, err := w.Write([]byte(data))
 	if err != nil {
@@ -2,6 +2,7 @@ package rest_test
 
 import (
 	"bufio"
+	"context"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -39,10 +40,15 @@ func TestEventsHandler(t *testing.T) {
 			"ASCENT_REGISTERED",
 			"ASCENT_DEREGISTERED",
 			"RAFFLE_WINNER_DRAWN",
+			"RAFFLE_WINNER_UPDATED",
+			"CONTENDER_PROMOTED_FROM_WAITLIST",
+			"TICK_DISPUTE_OPENED",
+			"TICK_DISPUTE_RESOLVED",
+			"ROUND_SCORE_UPDATED",
 		))
 
 		mux := rest.NewMux()
-		rest.InstallEventHandler(mux, mockedEventBroker, 0)
+		rest.InstallEventHandler(mux, mockedEventBroker, nil, 0)
 
 		server := httptest.NewServer(mux)
 
@@ -76,10 +82,15 @@ func TestEventsHandler(t *testing.T) {
 			"ASCENT_REGISTERED",
 			"ASCENT_DEREGISTERED",
 			"RAFFLE_WINNER_DRAWN",
+			"RAFFLE_WINNER_UPDATED",
+			"CONTENDER_PROMOTED_FROM_WAITLIST",
+			"TICK_DISPUTE_OPENED",
+			"TICK_DISPUTE_RESOLVED",
+			"ROUND_SCORE_UPDATED",
 		))
 
 		mux := rest.NewMux()
-		rest.InstallEventHandler(mux, mockedEventBroker, time.Millisecond)
+		rest.InstallEventHandler(mux, mockedEventBroker, nil, time.Millisecond)
 
 		server := httptest.NewServer(mux)
 
@@ -117,6 +128,11 @@ func TestEventsHandler(t *testing.T) {
 			"ASCENT_REGISTERED",
 			"ASCENT_DEREGISTERED",
 			"RAFFLE_WINNER_DRAWN",
+			"RAFFLE_WINNER_UPDATED",
+			"CONTENDER_PROMOTED_FROM_WAITLIST",
+			"TICK_DISPUTE_OPENED",
+			"TICK_DISPUTE_RESOLVED",
+			"ROUND_SCORE_UPDATED",
 		))
 
 		err := subscription.Post(domain.EventEnvelope{
@@ -132,7 +148,7 @@ func TestEventsHandler(t *testing.T) {
 		require.NoError(t, err)
 
 		mux := rest.NewMux()
-		rest.InstallEventHandler(mux, mockedEventBroker, time.Hour)
+		rest.InstallEventHandler(mux, mockedEventBroker, nil, time.Hour)
 
 		server := httptest.NewServer(mux)
 
@@ -175,6 +191,11 @@ func TestEventsHandler(t *testing.T) {
 			"ASCENT_REGISTERED",
 			"ASCENT_DEREGISTERED",
 			"RAFFLE_WINNER_DRAWN",
+			"RAFFLE_WINNER_UPDATED",
+			"CONTENDER_PROMOTED_FROM_WAITLIST",
+			"TICK_DISPUTE_OPENED",
+			"TICK_DISPUTE_RESOLVED",
+			"ROUND_SCORE_UPDATED",
 		))
 
 		err := subscription.Post(domain.EventEnvelope{
@@ -188,7 +209,7 @@ func TestEventsHandler(t *testing.T) {
 		require.ErrorIs(t, err, events.ErrBufferFull)
 
 		mux := rest.NewMux()
-		rest.InstallEventHandler(mux, mockedEventBroker, 0)
+		rest.InstallEventHandler(mux, mockedEventBroker, nil, 0)
 
 		server := httptest.NewServer(mux)
 
@@ -210,10 +231,20 @@ func TestEventsHandler(t *testing.T) {
 			"[]CONTENDER_SCORE_UPDATED",
 			"SCORE_ENGINE_STARTED",
 			"SCORE_ENGINE_STOPPED",
+			"[]ROUND_SCORE_UPDATED",
+			"[]TEAM_SCORE_UPDATED",
+			"TEAM_ADDED",
+			"TEAM_UPDATED",
+			"TEAM_DELETED",
 		))
 
+		mockedLiveScoreUseCase := new(liveScoreUseCaseMock)
+		mockedLiveScoreUseCase.
+			On("AuthorizeLiveScores", mock.Anything, domain.ContestID(1)).
+			Return(domain.ErrNotAuthenticated)
+
 		mux := rest.NewMux()
-		rest.InstallEventHandler(mux, mockedEventBroker, time.Hour)
+		rest.InstallEventHandler(mux, mockedEventBroker, mockedLiveScoreUseCase, time.Hour)
 
 		server := httptest.NewServer(mux)
 
@@ -227,6 +258,98 @@ func TestEventsHandler(t *testing.T) {
 		server.Close()
 
 		mockedEventBroker.AssertExpectations(t)
+		mockedLiveScoreUseCase.AssertExpectations(t)
+	})
+
+	t.Run("ContestEventsForOrganizer", func(t *testing.T) {
+		mockedEventBroker, _ := makeMocks(0, domain.NewEventFilter(
+			domain.ContestID(1),
+			0,
+			"CONTENDER_PUBLIC_INFO_UPDATED",
+			"[]CONTENDER_SCORE_UPDATED",
+			"SCORE_ENGINE_STARTED",
+			"SCORE_ENGINE_STOPPED",
+			"TICK_DISPUTE_OPENED",
+			"TICK_DISPUTE_RESOLVED",
+			"[]ROUND_SCORE_UPDATED",
+			"[]TEAM_SCORE_UPDATED",
+			"TEAM_ADDED",
+			"TEAM_UPDATED",
+			"TEAM_DELETED",
+			"[]CONTENDER_LIVE_SCORE_UPDATED",
+			"TICK_DISPUTE_OPENED",
+			"TICK_DISPUTE_RESOLVED",
+		))
+
+		mockedLiveScoreUseCase := new(liveScoreUseCaseMock)
+		mockedLiveScoreUseCase.
+			On("AuthorizeLiveScores", mock.Anything, domain.ContestID(1)).
+			Return(nil)
+
+		mux := rest.NewMux()
+		rest.InstallEventHandler(mux, mockedEventBroker, mockedLiveScoreUseCase, time.Hour)
+
+		server := httptest.NewServer(mux)
+
+		resp, err := http.Get(server.URL + "/contests/1/events")
+		require.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		_ = resp.Body.Close()
+
+		server.Close()
+
+		mockedEventBroker.AssertExpectations(t)
+		mockedLiveScoreUseCase.AssertExpectations(t)
+	})
+
+	t.Run("AnonymousNeverReceivesDisputes", func(t *testing.T) {
+		broker := events.NewBroker()
+
+		mockedLiveScoreUseCase := new(liveScoreUseCaseMock)
+		mockedLiveScoreUseCase.
+			On("AuthorizeLiveScores", mock.Anything, domain.ContestID(1)).
+			Return(domain.ErrNotAuthenticated)
+
+		mux := rest.NewMux()
+		rest.InstallEventHandler(mux, broker, mockedLiveScoreUseCase, time.Hour)
+
+		server := httptest.NewServer(mux)
+
+		resp, err := http.Get(server.URL + "/contests/1/events")
+		require.NoError(t, err)
+
+		buf := bufio.NewReader(resp.Body)
+
+		line, _, err := buf.ReadLine()
+		require.NoError(t, err)
+		assert.Equal(t, "retry: 5000", string(line))
+
+		broker.Dispatch(context.Background(), 1, domain.TickDisputeOpenedEvent{DisputeID: 1, ContenderID: 1, ProblemID: 1})
+		broker.Dispatch(context.Background(), 1, domain.TickDisputeResolvedEvent{DisputeID: 1, ContenderID: 1})
+		broker.Dispatch(context.Background(), 1, domain.TeamAddedEvent{TeamID: 1, Name: "Team", CountedMembers: 3})
+
+		var lines []string
+
+		for range 3 {
+			line, _, err := buf.ReadLine()
+			require.NoError(t, err)
+
+			lines = append(lines, string(line))
+		}
+
+		assert.Equal(t, []string{
+			"",
+			"event: TEAM_ADDED",
+			`data: {"teamId":1,"name":"Team","countedMembers":3}`,
+		}, lines)
+
+		_ = resp.Body.Close()
+
+		server.Close()
+
+		mockedLiveScoreUseCase.AssertExpectations(t)
 	})
 }
 
@@ -234,8 +357,8 @@ type eventBrokerMock struct {
 	mock.Mock
 }
 
-func (m *eventBrokerMock) Dispatch(contestID domain.ContestID, event any) {
-	m.Called(contestID, event)
+func (m *eventBrokerMock) Dispatch(ctx context.Context, contestID domain.ContestID, event any) {
+	m.Called(ctx, contestID, event)
 }
 
 func (m *eventBrokerMock) Subscribe(filter domain.EventFilter, bufferCapacity int) (domain.SubscriptionID, domain.EventReader) {
@@ -246,3 +369,12 @@ func (m *eventBrokerMock) Subscribe(filter domain.EventFilter, bufferCapacity in
 func (m *eventBrokerMock) Unsubscribe(subscriptionID domain.SubscriptionID) {
 	m.Called(subscriptionID)
 }
+
+type liveScoreUseCaseMock struct {
+	mock.Mock
+}
+
+func (m *liveScoreUseCaseMock) AuthorizeLiveScores(ctx context.Context, contestID domain.ContestID) error {
+	args := m.Called(ctx, contestID)
+	return args.Error(0)
+}
@@ -12,6 +12,7 @@ var Version = ""
 
 type healthUseCase interface {
 	GetHealth(ctx context.Context) ([]domain.ServiceStatus, error)
+	GetRetentionPolicyHealth(ctx context.Context) ([]domain.ServiceStatus, error)
 }
 
 type healthHandler struct {
@@ -25,6 +26,7 @@ func InstallHealthHandler(mux *Mux, healthUseCase healthUseCase) {
 
 	mux.HandleFunc("GET /health", handler.GetHealth)
 	mux.HandleFunc("GET /health/ok", handler.GetHealthOk)
+	mux.HandleFunc("GET /health/retention-policies", handler.GetRetentionPolicyHealth)
 	mux.HandleFunc("GET /version", handler.GetVersion)
 }
 
@@ -57,6 +59,16 @@ func (hdlr *healthHandler) GetHealthOk(w http.ResponseWriter, r *http.Request) {
 	writeResponse(w, status, nil)
 }
 
+func (hdlr *healthHandler) GetRetentionPolicyHealth(w http.ResponseWriter, r *http.Request) {
+	health, err := hdlr.healthUseCase.GetRetentionPolicyHealth(r.Context())
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	writeResponse(w, http.StatusOK, health)
+}
+
 func (hdlr *healthHandler) GetVersion(w http.ResponseWriter, _ *http.Request) {
 	version, found := getVersion()
 	if !found {
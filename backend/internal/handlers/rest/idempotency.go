@@ -0,0 +1,275 @@
+package rest
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/climblive/platform/backend/internal/domain"
+	"github.com/go-errors/errors"
+)
+
+const (
+	maxIdempotencyKeyLength        = 255
+	maxIdempotentBodySize          = 1 << 20
+	maxIdempotencyKeysPerPrincipal = 100
+	maxIdempotencyKeys             = 10_000
+)
+
+var errIdempotencyKeyInUse = errors.New("idempotency key in use")
+var errIdempotencyKeyReused = errors.New("idempotency key reused")
+var errIdempotencyKeyLimit = errors.New("idempotency key limit reached")
+
+type idempotencyKey struct {
+	principal string
+	key       string
+}
+
+type idempotentResponse struct {
+	fingerprint [sha256.Size]byte
+	created     time.Time
+	completed   bool
+	status      int
+	header      http.Header
+	body        []byte
+}
+
+// IdempotencyStore keeps responses in memory. Keys are only honoured by the
+// process that stored them, so replicas behind a load balancer must route
+// retries to the same instance or accept that a retry may be executed twice.
+type IdempotencyStore struct {
+	mu         sync.Mutex
+	authorizer domain.Authorizer
+	window     time.Duration
+	responses  map[idempotencyKey]*idempotentResponse
+	principals map[string]int
+	lastSweep  time.Time
+}
+
+func NewIdempotencyStore(authorizer domain.Authorizer, window time.Duration) *IdempotencyStore {
+	return &IdempotencyStore{
+		authorizer: authorizer,
+		window:     window,
+		responses:  make(map[idempotencyKey]*idempotentResponse),
+		principals: make(map[string]int),
+		lastSweep:  time.Now(),
+	}
+}
+
+func (s *IdempotencyStore) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		value := r.Header.Get("Idempotency-Key")
+		if value == "" || !isMutatingMethod(r.Method) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if len(value) > maxIdempotencyKeyLength {
+			handleMalformedRequest(w)
+			return
+		}
+
+		authentication, err := s.authorizer.GetAuthentication(r.Context())
+		if err != nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		var maxBytesError *http.MaxBytesError
+
+		body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxIdempotentBodySize))
+		switch {
+		case errors.As(err, &maxBytesError):
+			handleError(w, errRequestTooLarge)
+			return
+		case err != nil:
+			handleMalformedRequest(w)
+			return
+		}
+
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		key := idempotencyKey{
+			principal: principal(authentication),
+			key:       value,
+		}
+
+		fingerprint := sha256.Sum256([]byte(r.Method + " " + r.URL.RequestURI() + "\n" + string(body)))
+
+		stored, err := s.reserve(key, fingerprint)
+		switch {
+		case err != nil:
+			handleError(w, err)
+			return
+		case stored != nil:
+			replay(w, stored)
+			return
+		}
+
+		recorder := &responseRecorder{
+			ResponseWriter: w,
+			status:         http.StatusOK,
+			body:           bytes.Buffer{},
+		}
+
+		completed := false
+		defer func() {
+			if !completed {
+				s.release(key)
+			}
+		}()
+
+		next.ServeHTTP(recorder, r)
+
+		if recorder.status >= http.StatusInternalServerError {
+			return
+		}
+
+		s.complete(key, recorder.status, w.Header().Clone(), recorder.body.Bytes())
+		completed = true
+	})
+}
+
+func (s *IdempotencyStore) reserve(key idempotencyKey, fingerprint [sha256.Size]byte) (*idempotentResponse, error) {
+	now := time.Now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if now.Sub(s.lastSweep) >= s.window {
+		s.sweep(now)
+	}
+
+	response, found := s.responses[key]
+	expired := found && response.completed && now.Sub(response.created) >= s.window
+
+	if !found && s.full(key.principal) {
+		s.sweep(now)
+
+		if s.full(key.principal) {
+			return nil, errors.Wrap(errIdempotencyKeyLimit, 0)
+		}
+	}
+
+	switch {
+	case !found, expired:
+		if !found {
+			s.principals[key.principal]++
+		}
+
+		s.responses[key] = &idempotentResponse{
+			fingerprint: fingerprint,
+			created:     now,
+			completed:   false,
+			status:      0,
+			header:      nil,
+			body:        nil,
+		}
+
+		return nil, nil
+	case response.fingerprint != fingerprint:
+		return nil, errors.Wrap(errIdempotencyKeyReused, 0)
+	case !response.completed:
+		return nil, errors.Wrap(errIdempotencyKeyInUse, 0)
+	}
+
+	return response, nil
+}
+
+func (s *IdempotencyStore) sweep(now time.Time) {
+	for key, response := range s.responses {
+		if response.completed && now.Sub(response.created) >= s.window {
+			s.delete(key)
+		}
+	}
+
+	s.lastSweep = now
+}
+
+func (s *IdempotencyStore) full(principal string) bool {
+	return len(s.responses) >= maxIdempotencyKeys || s.principals[principal] >= maxIdempotencyKeysPerPrincipal
+}
+
+func (s *IdempotencyStore) delete(key idempotencyKey) {
+	if _, found := s.responses[key]; !found {
+		return
+	}
+
+	delete(s.responses, key)
+
+	s.principals[key.principal]--
+	if s.principals[key.principal] == 0 {
+		delete(s.principals, key.principal)
+	}
+}
+
+func (s *IdempotencyStore) complete(key idempotencyKey, status int, header http.Header, body []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	response, found := s.responses[key]
+	if !found {
+		return
+	}
+
+	response.created = time.Now()
+	response.completed = true
+	response.status = status
+	response.header = header
+	response.body = bytes.Clone(body)
+}
+
+func (s *IdempotencyStore) release(key idempotencyKey) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.delete(key)
+}
+
+func replay(w http.ResponseWriter, response *idempotentResponse) {
+	for name, values := range response.header {
+		w.Header()[name] = values
+	}
+
+	w.Header().Set("Idempotent-Replayed", "true")
+	w.WriteHeader(response.status)
+
+	_, _ = w.Write(response.body)
+}
+
+func principal(authentication domain.Authentication) string {
+	if authentication.Regcode != "" {
+		return "regcode:" + strings.ToUpper(authentication.Regcode)
+	}
+
+	return "user:" + authentication.Username
+}
+
+func isMutatingMethod(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	}
+
+	return false
+}
+
+type responseRecorder struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (w *responseRecorder) WriteHeader(statusCode int) {
+	w.status = statusCode
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (w *responseRecorder) Write(data []byte) (int, error) {
+	w.body.Write(data)
+	return w.ResponseWriter.Write(data)
+}
@@ -0,0 +1,237 @@
+package rest_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"testing/synctest"
+	"time"
+
+	"github.com/climblive/platform/backend/internal/domain"
+	"github.com/climblive/platform/backend/internal/handlers/rest"
+	"github.com/stretchr/testify/assert"
+)
+
+type regcodeContextKey struct{}
+
+type authorizerStub struct{}
+
+func (a *authorizerStub) HasOwnership(ctx context.Context, resourceOwnership domain.OwnershipData) (domain.AuthRole, error) {
+	return domain.NilRole, domain.ErrNoOwnership
+}
+
+func (a *authorizerStub) GetAuthentication(ctx context.Context) (domain.Authentication, error) {
+	regcode, ok := ctx.Value(regcodeContextKey{}).(string)
+	if !ok {
+		return domain.Authentication{}, domain.ErrNotAuthenticated
+	}
+
+	return domain.Authentication{Regcode: regcode}, nil
+}
+
+func TestIdempotency(t *testing.T) {
+	makeRequest := func(regcode, key, body string) *http.Request {
+		r := httptest.NewRequest("POST", "http://localhost/contests/1/contenders", strings.NewReader(body))
+		r.Header.Set("Idempotency-Key", key)
+
+		if regcode != "" {
+			r = r.WithContext(context.WithValue(r.Context(), regcodeContextKey{}, regcode))
+		}
+
+		return r
+	}
+
+	t.Run("ReplayResponse", func(t *testing.T) {
+		calls := 0
+		store := rest.NewIdempotencyStore(&authorizerStub{}, time.Hour)
+
+		handler := store.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"id":1}`))
+		}))
+
+		for range 2 {
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, makeRequest("ABCD1234", "key", "{}"))
+
+			assert.Equal(t, http.StatusCreated, w.Code)
+			assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+			assert.JSONEq(t, `{"id":1}`, w.Body.String())
+		}
+
+		assert.Equal(t, 1, calls)
+
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, makeRequest("ABCD1234", "key", "{}"))
+
+		assert.Equal(t, "true", w.Header().Get("Idempotent-Replayed"))
+	})
+
+	t.Run("KeysArePerPrincipal", func(t *testing.T) {
+		calls := 0
+		store := rest.NewIdempotencyStore(&authorizerStub{}, time.Hour)
+
+		handler := store.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.WriteHeader(http.StatusCreated)
+		}))
+
+		handler.ServeHTTP(httptest.NewRecorder(), makeRequest("ABCD1234", "key", "{}"))
+		handler.ServeHTTP(httptest.NewRecorder(), makeRequest("EFGH5678", "key", "{}"))
+
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("DifferentRequest", func(t *testing.T) {
+		store := rest.NewIdempotencyStore(&authorizerStub{}, time.Hour)
+
+		handler := store.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusCreated)
+		}))
+
+		handler.ServeHTTP(httptest.NewRecorder(), makeRequest("ABCD1234", "key", `{"name":"Alice"}`))
+
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, makeRequest("ABCD1234", "key", `{"name":"Bob"}`))
+
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	})
+
+	t.Run("ConcurrentRequest", func(t *testing.T) {
+		store := rest.NewIdempotencyStore(&authorizerStub{}, time.Hour)
+
+		started := make(chan struct{})
+		release := make(chan struct{})
+		done := make(chan struct{})
+
+		handler := store.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			close(started)
+			<-release
+			w.WriteHeader(http.StatusCreated)
+		}))
+
+		go func() {
+			defer close(done)
+			handler.ServeHTTP(httptest.NewRecorder(), makeRequest("ABCD1234", "key", "{}"))
+		}()
+
+		<-started
+
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, makeRequest("ABCD1234", "key", "{}"))
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+
+		close(release)
+		<-done
+	})
+
+	t.Run("ServerErrorIsNotStored", func(t *testing.T) {
+		calls := 0
+		store := rest.NewIdempotencyStore(&authorizerStub{}, time.Hour)
+
+		handler := store.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+
+		handler.ServeHTTP(httptest.NewRecorder(), makeRequest("ABCD1234", "key", "{}"))
+		handler.ServeHTTP(httptest.NewRecorder(), makeRequest("ABCD1234", "key", "{}"))
+
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("Unauthenticated", func(t *testing.T) {
+		calls := 0
+		store := rest.NewIdempotencyStore(&authorizerStub{}, time.Hour)
+
+		handler := store.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+		}))
+
+		handler.ServeHTTP(httptest.NewRecorder(), makeRequest("", "key", "{}"))
+		handler.ServeHTTP(httptest.NewRecorder(), makeRequest("", "key", "{}"))
+
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("WindowExpires", func(t *testing.T) {
+		synctest.Test(t, func(t *testing.T) {
+			calls := 0
+			store := rest.NewIdempotencyStore(&authorizerStub{}, time.Hour)
+
+			handler := store.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls++
+			}))
+
+			handler.ServeHTTP(httptest.NewRecorder(), makeRequest("ABCD1234", "key", "{}"))
+			handler.ServeHTTP(httptest.NewRecorder(), makeRequest("ABCD1234", "key", "{}"))
+
+			assert.Equal(t, 1, calls)
+
+			time.Sleep(time.Hour)
+
+			handler.ServeHTTP(httptest.NewRecorder(), makeRequest("ABCD1234", "key", "{}"))
+
+			assert.Equal(t, 2, calls)
+		})
+	})
+	t.Run("BodyTooLarge", func(t *testing.T) {
+		calls := 0
+		store := rest.NewIdempotencyStore(&authorizerStub{}, time.Hour)
+
+		handler := store.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+		}))
+
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, makeRequest("ABCD1234", "key", strings.Repeat("x", 2<<20)))
+
+		assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+		assert.Equal(t, 0, calls)
+	})
+
+	t.Run("KeyLimitPerPrincipal", func(t *testing.T) {
+		synctest.Test(t, func(t *testing.T) {
+			store := rest.NewIdempotencyStore(&authorizerStub{}, time.Hour)
+
+			handler := store.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusCreated)
+			}))
+
+			for i := range 100 {
+				w := httptest.NewRecorder()
+				handler.ServeHTTP(w, makeRequest("ABCD1234", fmt.Sprintf("key-%d", i), "{}"))
+
+				assert.Equal(t, http.StatusCreated, w.Code)
+			}
+
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, makeRequest("ABCD1234", "key-100", "{}"))
+
+			assert.Equal(t, http.StatusTooManyRequests, w.Code)
+
+			w = httptest.NewRecorder()
+			handler.ServeHTTP(w, makeRequest("ABCD1234", "key-0", "{}"))
+
+			assert.Equal(t, http.StatusCreated, w.Code)
+
+			w = httptest.NewRecorder()
+			handler.ServeHTTP(w, makeRequest("EFGH5678", "key-0", "{}"))
+
+			assert.Equal(t, http.StatusCreated, w.Code)
+
+			time.Sleep(time.Hour)
+
+			w = httptest.NewRecorder()
+			handler.ServeHTTP(w, makeRequest("ABCD1234", "key-100", "{}"))
+
+			assert.Equal(t, http.StatusCreated, w.Code)
+		})
+	})
+}
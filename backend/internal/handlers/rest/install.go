@@ -0,0 +1,48 @@
+package rest
+
+import (
+	"net/netip"
+	"time"
+
+	"github.com/climblive/platform/backend/internal/domain"
+)
+
+type Dependencies struct {
+	ContenderUseCase   contenderUseCase
+	ContestUseCase     contestUseCase
+	CompClassUseCase   compClassUseCase
+	ProblemUseCase     problemUseCase
+	RoundUseCase       roundUseCase
+	TeamUseCase        teamUseCase
+	TickUseCase        tickUseCase
+	TickDisputeUseCase tickDisputeUseCase
+	ScoreEngineUseCase scoreEngineUseCase
+	RaffleUseCase      raffleUseCase
+	UserUseCase        userUseCase
+	OrganizerUseCase   organizerUseCase
+	RetentionUseCase   retentionUseCase
+	HealthUseCase      healthUseCase
+	LiveScoreUseCase   liveScoreUseCase
+	EventBroker        domain.EventBroker
+	PingInterval       time.Duration
+	TrustedProxies     []netip.Prefix
+}
+
+func InstallHandlers(mux *Mux, deps Dependencies) {
+	InstallContenderHandler(mux, deps.ContenderUseCase, deps.TrustedProxies)
+	InstallContestHandler(mux, deps.ContestUseCase, deps.CompClassUseCase, deps.TickUseCase, deps.ProblemUseCase)
+	InstallCompClassHandler(mux, deps.CompClassUseCase)
+	InstallProblemHandler(mux, deps.ProblemUseCase)
+	InstallRoundHandler(mux, deps.RoundUseCase)
+	InstallTeamHandler(mux, deps.TeamUseCase)
+	InstallTickHandler(mux, deps.TickUseCase)
+	InstallTickDisputeHandler(mux, deps.TickDisputeUseCase)
+	InstallEventHandler(mux, deps.EventBroker, deps.LiveScoreUseCase, deps.PingInterval)
+	InstallScoreEngineHandler(mux, deps.ScoreEngineUseCase)
+	InstallRaffleHandler(mux, deps.RaffleUseCase)
+	InstallUserHandler(mux, deps.UserUseCase)
+	InstallOrganizerHandler(mux, deps.OrganizerUseCase)
+	InstallRetentionHandler(mux, deps.RetentionUseCase)
+	InstallHealthHandler(mux, deps.HealthUseCase)
+	InstallOpenAPIHandler(mux)
+}
@@ -0,0 +1,63 @@
+package rest_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/climblive/platform/backend/internal/handlers/rest"
+	"github.com/climblive/platform/backend/internal/metrics"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetrics(t *testing.T) {
+	mux := rest.NewMux()
+	mux.RegisterMiddleware(rest.Metrics)
+
+	mux.HandleFunc("GET /contests/{contestID}", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+	})
+
+	before := testutil.CollectAndCount(metrics.HTTPRequestDuration)
+
+	r := httptest.NewRequest("GET", "http://localhost/contests/1", nil)
+	w := httptest.NewRecorder()
+
+	mux.ServeHTTP(w, r)
+
+	assert.Equal(t, http.StatusTeapot, w.Code)
+	assert.Equal(t, before+1, testutil.CollectAndCount(metrics.HTTPRequestDuration))
+}
+
+type plainResponseWriter struct {
+	header http.Header
+	status int
+}
+
+func (w *plainResponseWriter) Header() http.Header {
+	return w.header
+}
+
+func (w *plainResponseWriter) Write(b []byte) (int, error) {
+	return len(b), nil
+}
+
+func (w *plainResponseWriter) WriteHeader(statusCode int) {
+	w.status = statusCode
+}
+
+func TestMetricsFlushWithoutFlusher(t *testing.T) {
+	handler := rest.Metrics(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.(http.Flusher).Flush()
+	}))
+
+	w := &plainResponseWriter{header: make(http.Header)}
+
+	assert.NotPanics(t, func() {
+		handler.ServeHTTP(w, httptest.NewRequest("GET", "http://localhost/", nil))
+	})
+
+	assert.Equal(t, http.StatusOK, w.status)
+}
@@ -10,12 +10,14 @@ type Middleware = func(http.Handler) http.Handler
 type Mux struct {
 	mux         *http.ServeMux
 	middlewares []Middleware
+	patterns    []string
 }
 
 func NewMux() *Mux {
 	return &Mux{
 		mux:         http.NewServeMux(),
 		middlewares: make([]Middleware, 0),
+		patterns:    make([]string, 0),
 	}
 }
 
@@ -31,6 +33,11 @@ func (m *Mux) HandleFunc(pattern string, handler func(http.ResponseWriter, *http
 	}
 
 	m.mux.Handle(pattern, chain)
+	m.patterns = append(m.patterns, pattern)
+}
+
+func (m *Mux) Patterns() []string {
+	return slices.Clone(m.patterns)
 }
 
 func (m *Mux) ServeHTTP(w http.ResponseWriter, r *http.Request) {
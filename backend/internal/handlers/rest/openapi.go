@@ -0,0 +1,462 @@
+package rest
+
+import (
+	"net/http"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/climblive/platform/backend/internal/domain"
+	"github.com/go-errors/errors"
+	"github.com/google/uuid"
+)
+
+const openAPIVersion = "3.1.0"
+
+type document = map[string]any
+
+type queryParam struct {
+	name   string
+	schema document
+}
+
+type routeSpec struct {
+	tag         string
+	operationID string
+	query       []queryParam
+	request     reflect.Type
+	response    reflect.Type
+	status      int
+	contentType string
+}
+
+func operation(tag, operationID string) routeSpec {
+	return routeSpec{
+		tag:         tag,
+		operationID: operationID,
+		query:       nil,
+		request:     nil,
+		response:    nil,
+		status:      http.StatusOK,
+		contentType: "application/json",
+	}
+}
+
+func (spec routeSpec) accepts(request reflect.Type) routeSpec {
+	spec.request = request
+	return spec
+}
+
+func (spec routeSpec) returns(status int, response reflect.Type) routeSpec {
+	spec.status = status
+	spec.response = response
+	return spec
+}
+
+func (spec routeSpec) produces(contentType string) routeSpec {
+	spec.contentType = contentType
+	return spec
+}
+
+func (spec routeSpec) withQuery(name string, schema document) routeSpec {
+	spec.query = append(slices.Clone(spec.query), queryParam{name: name, schema: schema})
+	return spec
+}
+
+func (spec routeSpec) paginated() routeSpec {
+	return spec.
+		withQuery("limit", document{"type": "integer", "minimum": 1, "maximum": domain.MaxPageSize, "default": domain.DefaultPageSize}).
+		withQuery("cursor", document{"type": "string"}).
+		withQuery("order", document{"type": "string", "enum": []string{"asc", "desc"}})
+}
+
+func typeOf[T any]() reflect.Type {
+	return reflect.TypeFor[T]()
+}
+
+var routeSpecs = map[string]routeSpec{
+	"OPTIONS /":                      operation("meta", "CORSPreFlight").returns(http.StatusNoContent, nil),
+	"GET /openapi.json":              operation("meta", "GetOpenAPISpecification").returns(http.StatusOK, typeOf[map[string]any]()),
+	"GET /health":                    operation("health", "GetHealth").returns(http.StatusOK, typeOf[[]domain.ServiceStatus]()),
+	"GET /health/ok":                 operation("health", "GetHealthOk").returns(http.StatusOK, nil),
+	"GET /health/retention-policies": operation("health", "GetRetentionPolicyHealth").returns(http.StatusOK, typeOf[[]domain.ServiceStatus]()),
+	"GET /version":                   operation("health", "GetVersion").returns(http.StatusOK, typeOf[string]()),
+	"GET /users/self":                operation("users", "GetSelf").returns(http.StatusOK, typeOf[domain.User]()),
+	"POST /organizers":               operation("organizers", "CreateOrganizer").accepts(typeOf[domain.OrganizerTemplate]()).returns(http.StatusCreated, typeOf[domain.Organizer]()),
+	"DELETE /ticks/{tickID}":         operation("ticks", "DeleteTick").returns(http.StatusNoContent, nil),
+
+	"GET /contests": operation("contests", "GetAllContests").
+		paginated().
+		withQuery("country", document{"type": "string"}).
+		withQuery("archived", document{"type": "boolean"}).
+		returns(http.StatusOK, typeOf[[]domain.Contest]()),
+	"GET /contests/{contestID}":                    operation("contests", "GetContest").returns(http.StatusOK, typeOf[domain.Contest]()),
+	"PATCH /contests/{contestID}":                  operation("contests", "PatchContest").accepts(typeOf[domain.ContestPatch]()).returns(http.StatusOK, typeOf[domain.Contest]()),
+	"GET /contests/{contestID}/scoreboard":         operation("contests", "GetScoreboard").returns(http.StatusOK, typeOf[[]domain.ScoreboardEntry]()),
+	"GET /contests/{contestID}/team-scoreboard":    operation("contests", "GetTeamScoreboard").returns(http.StatusOK, typeOf[[]domain.TeamScoreboardEntry]()),
+	"POST /contests/{contestID}/scoreboard/reveal": operation("contests", "RevealScoreboard").returns(http.StatusOK, typeOf[domain.Contest]()),
+	"GET /organizers/{organizerID}/contests":       operation("contests", "GetContestsByOrganizer").returns(http.StatusOK, typeOf[[]domain.Contest]()),
+	"POST /organizers/{organizerID}/contests":      operation("contests", "CreateContest").accepts(typeOf[domain.ContestTemplate]()).returns(http.StatusCreated, typeOf[domain.Contest]()),
+	"POST /contests/{contestID}/duplicate":         operation("contests", "DuplicateContest").returns(http.StatusCreated, typeOf[domain.Contest]()),
+	"POST /contests/{contestID}/transfer":          operation("contests", "TransferContest").accepts(typeOf[domain.ContestTransferRequest]()).returns(http.StatusOK, typeOf[domain.Contest]()),
+	"POST /contests/{contestID}/archive":           operation("contests", "ArchiveContest").returns(http.StatusOK, typeOf[domain.Contest]()),
+	"POST /contests/{contestID}/restore":           operation("contests", "RestoreContest").returns(http.StatusOK, typeOf[domain.Contest]()),
+	"GET /contests/{contestID}/results": operation("contests", "DownloadResults").
+		returns(http.StatusOK, typeOf[[]byte]()).
+		produces("application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"),
+
+	"GET /comp-classes/{compClassID}":           operation("compClasses", "GetCompClass").returns(http.StatusOK, typeOf[domain.CompClass]()),
+	"GET /contests/{contestID}/comp-classes":    operation("compClasses", "GetCompClassesByContest").returns(http.StatusOK, typeOf[[]domain.CompClass]()),
+	"POST /contests/{contestID}/comp-classes":   operation("compClasses", "CreateCompClass").accepts(typeOf[domain.CompClassTemplate]()).returns(http.StatusCreated, typeOf[domain.CompClass]()),
+	"DELETE /comp-classes/{compClassID}":        operation("compClasses", "DeleteCompClass").returns(http.StatusNoContent, nil),
+	"PATCH /comp-classes/{compClassID}":         operation("compClasses", "PatchCompClass").accepts(typeOf[domain.CompClassPatch]()).returns(http.StatusOK, typeOf[domain.CompClass]()),
+	"GET /comp-classes/{compClassID}/waitlist":  operation("compClasses", "GetWaitlist").returns(http.StatusOK, typeOf[[]domain.Contender]()),
+	"GET /compClasses/{compClassID}/contenders": operation("compClasses", "GetContendersByCompClass").returns(http.StatusOK, typeOf[[]domain.Contender]()),
+
+	"GET /contests/{contestID}/contenders": operation("contenders", "GetContendersByContest").
+		paginated().
+		withQuery("compClassId", document{"type": "integer", "format": "int32"}).
+		withQuery("entered", document{"type": "boolean"}).
+		withQuery("disqualified", document{"type": "boolean"}).
+		returns(http.StatusOK, typeOf[[]domain.Contender]()),
+	"GET /contenders/{contenderID}":                operation("contenders", "GetContender").returns(http.StatusOK, typeOf[domain.Contender]()),
+	"GET /codes/{registrationCode}/contender":      operation("contenders", "GetContenderByCode").returns(http.StatusOK, typeOf[domain.Contender]()),
+	"PATCH /contenders/{contenderID}":              operation("contenders", "PatchContender").accepts(typeOf[domain.ContenderPatch]()).returns(http.StatusOK, typeOf[domain.Contender]()),
+	"POST /contenders/{contenderID}/scrub":         operation("contenders", "ScrubContender").returns(http.StatusOK, typeOf[domain.Contender]()),
+	"GET /contenders/{contenderID}/export":         operation("contenders", "ExportContenderData").returns(http.StatusOK, typeOf[domain.ContenderDataExport]()),
+	"POST /contenders/{contenderID}/erase":         operation("contenders", "EraseContender").returns(http.StatusOK, typeOf[domain.Contender]()),
+	"DELETE /contenders/{contenderID}":             operation("contenders", "DeleteContender").returns(http.StatusNoContent, nil),
+	"POST /contests/{contestID}/contenders":        operation("contenders", "CreateContenders").accepts(typeOf[CreateContendersArguments]()).returns(http.StatusCreated, typeOf[[]domain.Contender]()),
+	"POST /contests/{contestID}/self-registration": operation("contenders", "SelfRegister").accepts(typeOf[domain.SelfRegistration]()).returns(http.StatusCreated, typeOf[domain.Contender]()),
+
+	"GET /problems/{problemID}":           operation("problems", "GetProblem").returns(http.StatusOK, typeOf[domain.Problem]()),
+	"GET /contests/{contestID}/problems":  operation("problems", "GetProblemsByContest").returns(http.StatusOK, typeOf[[]domain.Problem]()),
+	"PATCH /problems/{problemID}":         operation("problems", "PatchProblem").accepts(typeOf[domain.ProblemPatch]()).returns(http.StatusOK, typeOf[domain.Problem]()),
+	"POST /contests/{contestID}/problems": operation("problems", "CreateProblem").accepts(typeOf[domain.ProblemTemplate]()).returns(http.StatusCreated, typeOf[domain.Problem]()),
+	"DELETE /problems/{problemID}":        operation("problems", "DeleteProblem").returns(http.StatusNoContent, nil),
+	"GET /contests/{contestID}/problems/stats": operation("problems", "GetProblemStats").
+		withQuery("byCompClass", document{"type": "boolean"}).
+		returns(http.StatusOK, typeOf[[]domain.ProblemStats]()),
+
+	"GET /rounds/{roundID}":             operation("rounds", "GetRound").returns(http.StatusOK, typeOf[domain.Round]()),
+	"GET /contests/{contestID}/rounds":  operation("rounds", "GetRoundsByContest").returns(http.StatusOK, typeOf[[]domain.Round]()),
+	"POST /contests/{contestID}/rounds": operation("rounds", "CreateRound").accepts(typeOf[domain.RoundTemplate]()).returns(http.StatusCreated, typeOf[domain.Round]()),
+	"PATCH /rounds/{roundID}":           operation("rounds", "PatchRound").accepts(typeOf[domain.RoundPatch]()).returns(http.StatusOK, typeOf[domain.Round]()),
+	"DELETE /rounds/{roundID}":          operation("rounds", "DeleteRound").returns(http.StatusNoContent, nil),
+	"GET /rounds/{roundID}/start-list":  operation("rounds", "GetStartList").returns(http.StatusOK, typeOf[[]domain.StartListEntry]()),
+	"POST /rounds/{roundID}/start-list": operation("rounds", "GenerateStartList").returns(http.StatusOK, typeOf[[]domain.StartListEntry]()),
+	"GET /rounds/{roundID}/scoreboard":  operation("rounds", "GetRoundScoreboard").returns(http.StatusOK, typeOf[[]domain.ScoreboardEntry]()),
+
+	"GET /teams/{teamID}":              operation("teams", "GetTeam").returns(http.StatusOK, typeOf[domain.Team]()),
+	"GET /contests/{contestID}/teams":  operation("teams", "GetTeamsByContest").returns(http.StatusOK, typeOf[[]domain.Team]()),
+	"POST /contests/{contestID}/teams": operation("teams", "CreateTeam").accepts(typeOf[domain.TeamTemplate]()).returns(http.StatusCreated, typeOf[domain.Team]()),
+	"PATCH /teams/{teamID}":            operation("teams", "PatchTeam").accepts(typeOf[domain.TeamPatch]()).returns(http.StatusOK, typeOf[domain.Team]()),
+	"DELETE /teams/{teamID}":           operation("teams", "DeleteTeam").returns(http.StatusNoContent, nil),
+
+	"GET /contenders/{contenderID}/ticks":        operation("ticks", "GetTicksByContender").returns(http.StatusOK, typeOf[[]domain.Tick]()),
+	"PUT /contenders/{contenderID}/ticks":        operation("ticks", "PutTick").accepts(typeOf[domain.Tick]()).returns(http.StatusOK, typeOf[domain.Tick]()),
+	"POST /contenders/{contenderID}/ticks/batch": operation("ticks", "SyncTicks").accepts(typeOf[[]domain.TickOperation]()).returns(http.StatusOK, typeOf[[]domain.TickOperationResult]()),
+	"GET /contests/{contestID}/ticks": operation("ticks", "GetTicksByContest").
+		paginated().
+		withQuery("since", document{"type": "string", "format": "date-time"}).
+		returns(http.StatusOK, typeOf[[]domain.Tick]()),
+	"GET /contenders/{contenderID}/tick-revisions": operation("ticks", "GetTickRevisions").
+		withQuery("problemId", document{"type": "integer", "format": "int32"}).
+		returns(http.StatusOK, typeOf[[]domain.TickRevision]()),
+
+	"GET /contests/{contestID}/tick-disputes":      operation("tickDisputes", "GetTickDisputesByContest").returns(http.StatusOK, typeOf[[]domain.TickDispute]()),
+	"GET /contenders/{contenderID}/tick-disputes":  operation("tickDisputes", "GetTickDisputesByContender").returns(http.StatusOK, typeOf[[]domain.TickDispute]()),
+	"POST /contenders/{contenderID}/tick-disputes": operation("tickDisputes", "OpenTickDispute").accepts(typeOf[domain.TickDisputeTemplate]()).returns(http.StatusCreated, typeOf[domain.TickDispute]()),
+	"POST /tick-disputes/{disputeID}/resolve":      operation("tickDisputes", "ResolveTickDispute").accepts(typeOf[domain.TickDisputeResolution]()).returns(http.StatusOK, typeOf[domain.TickDispute]()),
+
+	"GET /contests/{contestID}/events":     operation("events", "SubscribeContestEvents").returns(http.StatusOK, typeOf[string]()).produces("text/event-stream"),
+	"GET /contenders/{contenderID}/events": operation("events", "SubscribeContenderEvents").returns(http.StatusOK, typeOf[string]()).produces("text/event-stream"),
+
+	"GET /contests/{contestID}/score-engines":  operation("scoreEngines", "ListScoreEnginesByContest").returns(http.StatusOK, typeOf[[]domain.ScoreEngineInstanceID]()),
+	"DELETE /score-engines/{instanceID}":       operation("scoreEngines", "StopScoreEngine").returns(http.StatusNoContent, nil),
+	"POST /contests/{contestID}/score-engines": operation("scoreEngines", "StartScoreEngine").accepts(typeOf[StartScoreEngineArguments]()).returns(http.StatusCreated, typeOf[domain.ScoreEngineInstanceID]()),
+
+	"GET /raffles/{raffleID}":                     operation("raffles", "GetRaffle").returns(http.StatusOK, typeOf[domain.Raffle]()),
+	"GET /contests/{contestID}/raffles":           operation("raffles", "GetRaffles").returns(http.StatusOK, typeOf[[]domain.Raffle]()),
+	"POST /contests/{contestID}/raffles":          operation("raffles", "CreateRaffle").accepts(typeOf[domain.RaffleTemplate]()).returns(http.StatusCreated, typeOf[domain.Raffle]()),
+	"DELETE /raffles/{raffleID}":                  operation("raffles", "DeleteRaffle").returns(http.StatusNoContent, nil),
+	"POST /raffles/{raffleID}/winners":            operation("raffles", "DrawRaffleWinner").returns(http.StatusCreated, typeOf[domain.RaffleWinner]()),
+	"GET /raffles/{raffleID}/winners":             operation("raffles", "GetRaffleWinners").returns(http.StatusOK, typeOf[[]domain.RaffleWinner]()),
+	"POST /raffle-winners/{raffleWinnerID}/claim": operation("raffles", "ClaimRafflePrize").returns(http.StatusOK, typeOf[domain.RaffleWinner]()),
+	"POST /raffle-winners/{raffleWinnerID}/void":  operation("raffles", "VoidRaffleWinner").returns(http.StatusOK, typeOf[domain.RaffleWinner]()),
+	"GET /raffles/{raffleID}/prizes":              operation("raffles", "GetRafflePrizes").returns(http.StatusOK, typeOf[[]domain.RafflePrize]()),
+	"POST /raffles/{raffleID}/prizes":             operation("raffles", "CreateRafflePrize").accepts(typeOf[domain.RafflePrizeTemplate]()).returns(http.StatusCreated, typeOf[domain.RafflePrize]()),
+	"DELETE /raffle-prizes/{prizeID}":             operation("raffles", "DeleteRafflePrize").returns(http.StatusNoContent, nil),
+	"POST /raffles/{raffleID}/reveal":             operation("raffles", "RevealRaffleSeed").returns(http.StatusOK, typeOf[domain.Raffle]()),
+	"GET /raffles/{raffleID}/verification":        operation("raffles", "VerifyRaffle").returns(http.StatusOK, typeOf[domain.RaffleVerification]()),
+
+	"GET /organizers/{organizerID}/users": operation("users", "GetUsersByOrganizer").returns(http.StatusOK, typeOf[[]domain.User]()),
+
+	"GET /organizers/{organizerID}":          operation("organizers", "GetOrganizer").returns(http.StatusOK, typeOf[domain.Organizer]()),
+	"PATCH /organizers/{organizerID}":        operation("organizers", "PatchOrganizer").accepts(typeOf[domain.OrganizerPatch]()).returns(http.StatusOK, typeOf[domain.Organizer]()),
+	"GET /organizers/{organizerID}/invites":  operation("organizers", "GetOrganizerInvites").returns(http.StatusOK, typeOf[[]domain.OrganizerInvite]()),
+	"POST /organizers/{organizerID}/invites": operation("organizers", "CreateOrganizerInvite").returns(http.StatusCreated, typeOf[domain.OrganizerInvite]()),
+	"GET /invites/{inviteID}":                operation("organizers", "GetOrganizerInvite").returns(http.StatusOK, typeOf[domain.OrganizerInvite]()),
+	"DELETE /invites/{inviteID}":             operation("organizers", "DeleteOrganizerInvite").returns(http.StatusNoContent, nil),
+	"POST /invites/{inviteID}/accept":        operation("organizers", "AcceptOrganizerInvite").returns(http.StatusNoContent, nil),
+
+	"GET /organizers/{organizerID}/retention-policies":  operation("retention", "GetRetentionPoliciesByOrganizer").returns(http.StatusOK, typeOf[[]domain.RetentionPolicy]()),
+	"POST /organizers/{organizerID}/retention-policies": operation("retention", "CreateRetentionPolicy").accepts(typeOf[domain.RetentionPolicyTemplate]()).returns(http.StatusCreated, typeOf[domain.RetentionPolicy]()),
+	"PATCH /retention-policies/{policyID}":              operation("retention", "PatchRetentionPolicy").accepts(typeOf[domain.RetentionPolicyPatch]()).returns(http.StatusOK, typeOf[domain.RetentionPolicy]()),
+	"DELETE /retention-policies/{policyID}":             operation("retention", "DeleteRetentionPolicy").returns(http.StatusNoContent, nil),
+	"GET /retention-policies/{policyID}/dry-run":        operation("retention", "DryRunRetentionPolicy").returns(http.StatusOK, typeOf[[]domain.RetentionCandidate]()),
+	"GET /retention-policies/{policyID}/executions":     operation("retention", "GetRetentionPolicyExecutions").returns(http.StatusOK, typeOf[[]domain.RetentionPolicyExecution]()),
+}
+
+var pathParamSchemas = map[string]document{
+	"inviteID":         {"type": "string", "format": "uuid"},
+	"instanceID":       {"type": "string", "format": "uuid"},
+	"registrationCode": {"type": "string"},
+}
+
+var pathParamPattern = regexp.MustCompile(`\{(\w+)\}`)
+
+func InstallOpenAPIHandler(mux *Mux) {
+	mux.HandleFunc("GET /openapi.json", func(w http.ResponseWriter, r *http.Request) {
+		spec, err := BuildOpenAPIDocument(mux.Patterns())
+		if err != nil {
+			handleError(w, err)
+			return
+		}
+
+		writeResponse(w, http.StatusOK, spec)
+	})
+}
+
+func BuildOpenAPIDocument(patterns []string) (map[string]any, error) {
+	schemas := &schemaRegistry{schemas: make(map[string]any)}
+	paths := make(map[string]document)
+
+	var missing []string
+
+	for _, pattern := range patterns {
+		spec, found := routeSpecs[pattern]
+		if !found {
+			missing = append(missing, pattern)
+			continue
+		}
+
+		method, path, _ := strings.Cut(pattern, " ")
+
+		if _, exists := paths[path]; !exists {
+			paths[path] = make(document)
+		}
+
+		paths[path][strings.ToLower(method)] = spec.operation(path, schemas)
+	}
+
+	if len(missing) > 0 {
+		return nil, errors.Errorf("routes missing from OpenAPI specification: %s", strings.Join(missing, ", "))
+	}
+
+	version, found := getVersion()
+	if !found {
+		version = "dev"
+	}
+
+	return document{
+		"openapi": openAPIVersion,
+		"info": document{
+			"title":   "ClimbLive API",
+			"version": version,
+		},
+		"servers": []document{{"url": "/api"}},
+		"paths":   paths,
+		"components": document{
+			"schemas": schemas.schemas,
+		},
+	}, nil
+}
+
+func (spec routeSpec) operation(path string, schemas *schemaRegistry) document {
+	var parameters []document
+
+	for _, match := range pathParamPattern.FindAllStringSubmatch(path, -1) {
+		schema, found := pathParamSchemas[match[1]]
+		if !found {
+			schema = document{"type": "integer", "format": "int32"}
+		}
+
+		parameters = append(parameters, document{
+			"name":     match[1],
+			"in":       "path",
+			"required": true,
+			"schema":   schema,
+		})
+	}
+
+	for _, param := range spec.query {
+		parameters = append(parameters, document{
+			"name":   param.name,
+			"in":     "query",
+			"schema": param.schema,
+		})
+	}
+
+	success := document{"description": http.StatusText(spec.status)}
+	if spec.response != nil {
+		success["content"] = document{
+			spec.contentType: document{"schema": schemas.schemaFor(spec.response)},
+		}
+	}
+
+	op := document{
+		"operationId": spec.operationID,
+		"tags":        []string{spec.tag},
+		"responses": document{
+			strconv.Itoa(spec.status): success,
+			"default": document{
+				"description": "Error",
+				"content": document{
+					"application/problem+json": document{"schema": schemas.schemaFor(typeOf[ProblemDetails]())},
+				},
+			},
+		},
+	}
+
+	if len(parameters) > 0 {
+		op["parameters"] = parameters
+	}
+
+	if spec.request != nil {
+		op["requestBody"] = document{
+			"required": true,
+			"content": document{
+				"application/json": document{"schema": schemas.schemaFor(spec.request)},
+			},
+		}
+	}
+
+	return op
+}
+
+type schemaRegistry struct {
+	schemas map[string]any
+}
+
+var (
+	timeType     = typeOf[time.Time]()
+	durationType = typeOf[time.Duration]()
+	uuidType     = typeOf[uuid.UUID]()
+	bytesType    = typeOf[[]byte]()
+)
+
+func (reg *schemaRegistry) schemaFor(t reflect.Type) document {
+	switch t {
+	case timeType:
+		return document{"type": "string", "format": "date-time"}
+	case durationType:
+		return document{"type": "integer", "format": "int64", "description": "Duration in nanoseconds"}
+	case uuidType:
+		return document{"type": "string", "format": "uuid"}
+	case bytesType:
+		return document{"type": "string", "format": "binary"}
+	}
+
+	if isPatch(t) {
+		field, _ := t.FieldByName("Value")
+		return reg.schemaFor(field.Type)
+	}
+
+	switch t.Kind() {
+	case reflect.Pointer:
+		return reg.schemaFor(t.Elem())
+	case reflect.Bool:
+		return document{"type": "boolean"}
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint8, reflect.Uint16:
+		return document{"type": "integer", "format": "int32"}
+	case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint32, reflect.Uint64:
+		return document{"type": "integer", "format": "int64"}
+	case reflect.Float32, reflect.Float64:
+		return document{"type": "number"}
+	case reflect.String:
+		return document{"type": "string"}
+	case reflect.Slice, reflect.Array:
+		return document{"type": "array", "items": reg.schemaFor(t.Elem())}
+	case reflect.Map:
+		return document{"type": "object", "additionalProperties": reg.schemaFor(t.Elem())}
+	case reflect.Struct:
+		if t.Name() == "" {
+			return reg.structSchema(t)
+		}
+
+		if _, registered := reg.schemas[t.Name()]; !registered {
+			reg.schemas[t.Name()] = nil
+			reg.schemas[t.Name()] = reg.structSchema(t)
+		}
+
+		return document{"$ref": "#/components/schemas/" + t.Name()}
+	default:
+		return document{}
+	}
+}
+
+func (reg *schemaRegistry) structSchema(t reflect.Type) document {
+	properties := make(document)
+	required := make([]string, 0)
+
+	reg.collectFields(t, properties, &required)
+
+	schema := document{
+		"type":       "object",
+		"properties": properties,
+	}
+
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+
+	return schema
+}
+
+func (reg *schemaRegistry) collectFields(t reflect.Type, properties document, required *[]string) {
+	for i := range t.NumField() {
+		field := t.Field(i)
+
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+
+		name, options, _ := strings.Cut(tag, ",")
+
+		if field.Anonymous && name == "" && field.Type.Kind() == reflect.Struct {
+			reg.collectFields(field.Type, properties, required)
+			continue
+		}
+
+		if !field.IsExported() {
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+
+		properties[name] = reg.schemaFor(field.Type)
+
+		optional := slices.ContainsFunc(strings.Split(options, ","), func(option string) bool {
+			return option == "omitempty" || option == "omitzero"
+		})
+
+		if !optional {
+			*required = append(*required, name)
+		}
+	}
+}
+
+func isPatch(t reflect.Type) bool {
+	if t.Kind() != reflect.Struct || t.PkgPath() != typeOf[domain.Contest]().PkgPath() {
+		return false
+	}
+
+	return strings.HasPrefix(t.Name(), "Patch[") || strings.HasPrefix(t.Name(), "SlicePatch[")
+}
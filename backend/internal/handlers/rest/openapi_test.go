@@ -0,0 +1,54 @@
+package rest_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/climblive/platform/backend/internal/handlers/rest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpenAPISpecification(t *testing.T) {
+	mux := rest.NewMux()
+
+	rest.InstallHandlers(mux, rest.Dependencies{})
+
+	t.Run("AllRoutesDocumented", func(t *testing.T) {
+		_, err := rest.BuildOpenAPIDocument(mux.Patterns())
+		require.NoError(t, err)
+	})
+
+	t.Run("Served", func(t *testing.T) {
+		r := httptest.NewRequest("GET", "http://localhost/openapi.json", nil)
+		w := httptest.NewRecorder()
+
+		mux.ServeHTTP(w, r)
+
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var spec struct {
+			OpenAPI    string                               `json:"openapi"`
+			Paths      map[string]map[string]map[string]any `json:"paths"`
+			Components struct {
+				Schemas map[string]map[string]any `json:"schemas"`
+			} `json:"components"`
+		}
+
+		err := json.Unmarshal(w.Body.Bytes(), &spec)
+		require.NoError(t, err)
+
+		assert.Equal(t, "3.1.0", spec.OpenAPI)
+		assert.Contains(t, spec.Paths["/contests/{contestID}"], "get")
+		assert.Contains(t, spec.Paths["/contests/{contestID}"], "patch")
+		assert.Contains(t, spec.Components.Schemas, "Contest")
+		assert.Contains(t, spec.Components.Schemas, "ProblemDetails")
+	})
+
+	t.Run("UndocumentedRoute", func(t *testing.T) {
+		_, err := rest.BuildOpenAPIDocument([]string{"GET /undocumented"})
+		require.ErrorContains(t, err, "GET /undocumented")
+	})
+}
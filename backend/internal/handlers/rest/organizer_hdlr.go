@@ -43,7 +43,7 @@ func (hdlr *organizerHandler) CreateOrganizer(w http.ResponseWriter, r *http.Req
 	var template domain.OrganizerTemplate
 	err := json.NewDecoder(r.Body).Decode(&template)
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
+		handleMalformedRequest(w)
 		return
 	}
 
@@ -59,7 +59,7 @@ func (hdlr *organizerHandler) CreateOrganizer(w http.ResponseWriter, r *http.Req
 func (hdlr *organizerHandler) GetOrganizer(w http.ResponseWriter, r *http.Request) {
 	organizerID, err := parseResourceID[domain.OrganizerID](r.PathValue("organizerID"))
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
+		handleMalformedRequest(w)
 		return
 	}
 
@@ -75,14 +75,14 @@ func (hdlr *organizerHandler) GetOrganizer(w http.ResponseWriter, r *http.Reques
 func (hdlr *organizerHandler) PatchOrganizer(w http.ResponseWriter, r *http.Request) {
 	organizerID, err := parseResourceID[domain.OrganizerID](r.PathValue("organizerID"))
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
+		handleMalformedRequest(w)
 		return
 	}
 
 	var patch domain.OrganizerPatch
 	err = json.NewDecoder(r.Body).Decode(&patch)
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
+		handleMalformedRequest(w)
 		return
 	}
 
@@ -98,7 +98,7 @@ func (hdlr *organizerHandler) PatchOrganizer(w http.ResponseWriter, r *http.Requ
 func (hdlr *organizerHandler) GetOrganizerInvites(w http.ResponseWriter, r *http.Request) {
 	organizerID, err := parseResourceID[domain.OrganizerID](r.PathValue("organizerID"))
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
+		handleMalformedRequest(w)
 		return
 	}
 
@@ -114,7 +114,7 @@ func (hdlr *organizerHandler) GetOrganizerInvites(w http.ResponseWriter, r *http
 func (hdlr *organizerHandler) CreateOrganizerInvite(w http.ResponseWriter, r *http.Request) {
 	organizerID, err := parseResourceID[domain.OrganizerID](r.PathValue("organizerID"))
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
+		handleMalformedRequest(w)
 		return
 	}
 
@@ -130,7 +130,7 @@ func (hdlr *organizerHandler) CreateOrganizerInvite(w http.ResponseWriter, r *ht
 func (hdlr *organizerHandler) GetOrganizerInvite(w http.ResponseWriter, r *http.Request) {
 	inviteID, err := uuid.Parse(r.PathValue("inviteID"))
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
+		handleMalformedRequest(w)
 		return
 	}
 
@@ -146,7 +146,7 @@ func (hdlr *organizerHandler) GetOrganizerInvite(w http.ResponseWriter, r *http.
 func (hdlr *organizerHandler) DeleteOrganizerInvite(w http.ResponseWriter, r *http.Request) {
 	inviteID, err := uuid.Parse(r.PathValue("inviteID"))
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
+		handleMalformedRequest(w)
 		return
 	}
 
@@ -162,7 +162,7 @@ func (hdlr *organizerHandler) DeleteOrganizerInvite(w http.ResponseWriter, r *ht
 func (hdlr *organizerHandler) AcceptOrganizerInvite(w http.ResponseWriter, r *http.Request) {
 	inviteID, err := uuid.Parse(r.PathValue("inviteID"))
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
+		handleMalformedRequest(w)
 		return
 	}
 
@@ -0,0 +1,85 @@
+package rest_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/climblive/platform/backend/internal/domain"
+	"github.com/climblive/platform/backend/internal/handlers/rest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type tickUseCaseStub struct {
+	page domain.PageRequest
+}
+
+func (s *tickUseCaseStub) GetTicksByContender(ctx context.Context, contenderID domain.ContenderID) ([]domain.Tick, error) {
+	return nil, nil
+}
+
+func (s *tickUseCaseStub) GetTicksByContest(ctx context.Context, contestID domain.ContestID, filter domain.TickFilter, page domain.PageRequest) (domain.Page[domain.Tick], error) {
+	s.page = page
+	return domain.Page[domain.Tick]{}, nil
+}
+
+func (s *tickUseCaseStub) DeleteTick(ctx context.Context, tickID domain.TickID) error {
+	return nil
+}
+
+func (s *tickUseCaseStub) PutTick(ctx context.Context, contenderID domain.ContenderID, tick domain.Tick) (domain.Tick, error) {
+	return domain.Tick{}, nil
+}
+
+func (s *tickUseCaseStub) GetTickRevisions(ctx context.Context, contenderID domain.ContenderID, problemID domain.ProblemID) ([]domain.TickRevision, error) {
+	return nil, nil
+}
+
+func (s *tickUseCaseStub) SyncTicks(ctx context.Context, contenderID domain.ContenderID, operations []domain.TickOperation) ([]domain.TickOperationResult, error) {
+	return nil, nil
+}
+
+func TestPageRequest(t *testing.T) {
+	serve := func(query string) (int, domain.PageRequest) {
+		stub := &tickUseCaseStub{}
+
+		mux := rest.NewMux()
+		rest.InstallTickHandler(mux, stub)
+
+		r := httptest.NewRequest("GET", "http://localhost/contests/1/ticks"+query, nil)
+		w := httptest.NewRecorder()
+
+		mux.ServeHTTP(w, r)
+
+		return w.Code, stub.page
+	}
+
+	t.Run("DefaultLimit", func(t *testing.T) {
+		code, page := serve("")
+
+		require.Equal(t, http.StatusOK, code)
+		assert.Equal(t, domain.DefaultPageSize, page.Limit)
+	})
+
+	t.Run("ExplicitLimit", func(t *testing.T) {
+		code, page := serve("?limit=10")
+
+		require.Equal(t, http.StatusOK, code)
+		assert.Equal(t, 10, page.Limit)
+	})
+
+	t.Run("LimitIsCapped", func(t *testing.T) {
+		code, page := serve("?limit=100000")
+
+		require.Equal(t, http.StatusOK, code)
+		assert.Equal(t, domain.MaxPageSize, page.Limit)
+	})
+
+	t.Run("InvalidLimit", func(t *testing.T) {
+		code, _ := serve("?limit=-1")
+
+		assert.Equal(t, http.StatusBadRequest, code)
+	})
+}
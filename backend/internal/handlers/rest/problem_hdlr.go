@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"strconv"
 
 	"github.com/climblive/platform/backend/internal/domain"
 )
@@ -11,9 +12,10 @@ import (
 type problemUseCase interface {
 	GetProblem(ctx context.Context, problemID domain.ProblemID) (domain.Problem, error)
 	GetProblemsByContest(ctx context.Context, contestID domain.ContestID) ([]domain.Problem, error)
-	PatchProblem(ctx context.Context, problemID domain.ProblemID, patch domain.ProblemPatch) (domain.Problem, error)
+	PatchProblem(ctx context.Context, problemID domain.ProblemID, patch domain.ProblemPatch, expectedVersion int) (domain.Problem, error)
 	CreateProblem(ctx context.Context, contestID domain.ContestID, tmpl domain.ProblemTemplate) (domain.Problem, error)
 	DeleteProblem(ctx context.Context, problemID domain.ProblemID) error
+	GetProblemStats(ctx context.Context, contestID domain.ContestID, byCompClass bool) ([]domain.ProblemStats, error)
 }
 
 type problemHandler struct {
@@ -27,6 +29,7 @@ func InstallProblemHandler(mux *Mux, problemUseCase problemUseCase) {
 
 	mux.HandleFunc("GET /problems/{problemID}", handler.GetProblem)
 	mux.HandleFunc("GET /contests/{contestID}/problems", handler.GetProblemsByContest)
+	mux.HandleFunc("GET /contests/{contestID}/problems/stats", handler.GetProblemStats)
 	mux.HandleFunc("PATCH /problems/{problemID}", handler.PatchProblem)
 	mux.HandleFunc("POST /contests/{contestID}/problems", handler.CreateProblem)
 	mux.HandleFunc("DELETE /problems/{problemID}", handler.DeleteProblem)
@@ -35,7 +38,7 @@ func InstallProblemHandler(mux *Mux, problemUseCase problemUseCase) {
 func (hdlr *problemHandler) GetProblem(w http.ResponseWriter, r *http.Request) {
 	problemID, err := parseResourceID[domain.ProblemID](r.PathValue("problemID"))
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
+		handleMalformedRequest(w)
 		return
 	}
 
@@ -45,13 +48,14 @@ func (hdlr *problemHandler) GetProblem(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	setETag(w, problem.Version)
 	writeResponse(w, http.StatusOK, problem)
 }
 
 func (hdlr *problemHandler) GetProblemsByContest(w http.ResponseWriter, r *http.Request) {
 	contestID, err := parseResourceID[domain.ContestID](r.PathValue("contestID"))
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
+		handleMalformedRequest(w)
 		return
 	}
 
@@ -64,40 +68,73 @@ func (hdlr *problemHandler) GetProblemsByContest(w http.ResponseWriter, r *http.
 	writeResponse(w, http.StatusOK, problems)
 }
 
+func (hdlr *problemHandler) GetProblemStats(w http.ResponseWriter, r *http.Request) {
+	contestID, err := parseResourceID[domain.ContestID](r.PathValue("contestID"))
+	if err != nil {
+		handleMalformedRequest(w)
+		return
+	}
+
+	var byCompClass bool
+
+	if value := r.URL.Query().Get("byCompClass"); value != "" {
+		byCompClass, err = strconv.ParseBool(value)
+		if err != nil {
+			handleMalformedRequest(w)
+			return
+		}
+	}
+
+	stats, err := hdlr.problemUseCase.GetProblemStats(r.Context(), contestID, byCompClass)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	writeResponse(w, http.StatusOK, stats)
+}
+
 func (hdlr *problemHandler) PatchProblem(w http.ResponseWriter, r *http.Request) {
 	problemID, err := parseResourceID[domain.ProblemID](r.PathValue("problemID"))
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
+		handleMalformedRequest(w)
+		return
+	}
+
+	expectedVersion, err := parseIfMatch(r)
+	if err != nil {
+		handleError(w, err)
 		return
 	}
 
 	var patch domain.ProblemPatch
 	err = json.NewDecoder(r.Body).Decode(&patch)
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
+		handleMalformedRequest(w)
 		return
 	}
 
-	updatedProblem, err := hdlr.problemUseCase.PatchProblem(r.Context(), problemID, patch)
+	updatedProblem, err := hdlr.problemUseCase.PatchProblem(r.Context(), problemID, patch, expectedVersion)
 	if err != nil {
 		handleError(w, err)
 		return
 	}
 
+	setETag(w, updatedProblem.Version)
 	writeResponse(w, http.StatusOK, updatedProblem)
 }
 
 func (hdlr *problemHandler) CreateProblem(w http.ResponseWriter, r *http.Request) {
 	contestID, err := parseResourceID[domain.ContestID](r.PathValue("contestID"))
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
+		handleMalformedRequest(w)
 		return
 	}
 
 	var tmpl domain.ProblemTemplate
 	err = json.NewDecoder(r.Body).Decode(&tmpl)
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
+		handleMalformedRequest(w)
 		return
 	}
 
@@ -113,7 +150,7 @@ func (hdlr *problemHandler) CreateProblem(w http.ResponseWriter, r *http.Request
 func (hdlr *problemHandler) DeleteProblem(w http.ResponseWriter, r *http.Request) {
 	problemID, err := parseResourceID[domain.ProblemID](r.PathValue("problemID"))
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
+		handleMalformedRequest(w)
 		return
 	}
 
@@ -1,11 +1,53 @@
 package rest
 
-import "time"
+import (
+	"time"
+
+	"github.com/climblive/platform/backend/internal/domain"
+)
 
 type CreateContendersArguments struct {
 	Number int `json:"number"`
 }
 
 type StartScoreEngineArguments struct {
-	TerminatedBy time.Time `json:"terminatedBy"`
+	RoundID      domain.RoundID `json:"roundId,omitempty" tstype:"number"`
+	TerminatedBy time.Time      `json:"terminatedBy"`
+}
+
+type ErrorCode string
+
+const (
+	ErrorCodeNotFound             ErrorCode = "NOT_FOUND"
+	ErrorCodeArchived             ErrorCode = "ARCHIVED"
+	ErrorCodeAllWinnersDrawn      ErrorCode = "ALL_WINNERS_DRAWN"
+	ErrorCodeDuplicate            ErrorCode = "DUPLICATE"
+	ErrorCodeLimitExceeded        ErrorCode = "LIMIT_EXCEEDED"
+	ErrorCodeNotAuthenticated     ErrorCode = "NOT_AUTHENTICATED"
+	ErrorCodeNotAuthorized        ErrorCode = "NOT_AUTHORIZED"
+	ErrorCodeNoOwnership          ErrorCode = "NO_OWNERSHIP"
+	ErrorCodeInsufficientRole     ErrorCode = "INSUFFICIENT_ROLE"
+	ErrorCodeContestNotStarted    ErrorCode = "CONTEST_NOT_STARTED"
+	ErrorCodeContestEnded         ErrorCode = "CONTEST_ENDED"
+	ErrorCodeProblemNotAvailable  ErrorCode = "PROBLEM_NOT_AVAILABLE"
+	ErrorCodeNotAllowed           ErrorCode = "NOT_ALLOWED"
+	ErrorCodeInvalidData          ErrorCode = "INVALID_DATA"
+	ErrorCodePreconditionFailed   ErrorCode = "PRECONDITION_FAILED"
+	ErrorCodeIdempotencyKeyInUse  ErrorCode = "IDEMPOTENCY_KEY_IN_USE"
+	ErrorCodeIdempotencyKeyReused ErrorCode = "IDEMPOTENCY_KEY_REUSED"
+	ErrorCodeInternal             ErrorCode = "INTERNAL_ERROR"
+)
+
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+type ProblemDetails struct {
+	Type   string       `json:"type"`
+	Title  string       `json:"title"`
+	Status int          `json:"status"`
+	Detail string       `json:"detail"`
+	Code   ErrorCode    `json:"code"`
+	Errors []FieldError `json:"errors,omitempty"`
 }
@@ -2,18 +2,28 @@ package rest
 
 import (
 	"context"
+	"encoding/json"
+	"io"
 	"net/http"
 
 	"github.com/climblive/platform/backend/internal/domain"
+	"github.com/go-errors/errors"
 )
 
 type raffleUseCase interface {
 	GetRaffle(ctx context.Context, raffleID domain.RaffleID) (domain.Raffle, error)
 	GetRafflesByContest(ctx context.Context, contestID domain.ContestID) ([]domain.Raffle, error)
-	CreateRaffle(ctx context.Context, contestID domain.ContestID) (domain.Raffle, error)
+	CreateRaffle(ctx context.Context, contestID domain.ContestID, tmpl domain.RaffleTemplate) (domain.Raffle, error)
 	DeleteRaffle(ctx context.Context, raffleID domain.RaffleID) error
 	DrawRaffleWinner(ctx context.Context, raffleID domain.RaffleID) (domain.RaffleWinner, error)
 	GetRaffleWinners(ctx context.Context, raffleID domain.RaffleID) ([]domain.RaffleWinner, error)
+	ClaimRafflePrize(ctx context.Context, raffleWinnerID domain.RaffleWinnerID) (domain.RaffleWinner, error)
+	VoidRaffleWinner(ctx context.Context, raffleWinnerID domain.RaffleWinnerID) (domain.RaffleWinner, error)
+	GetRafflePrizes(ctx context.Context, raffleID domain.RaffleID) ([]domain.RafflePrize, error)
+	CreateRafflePrize(ctx context.Context, raffleID domain.RaffleID, tmpl domain.RafflePrizeTemplate) (domain.RafflePrize, error)
+	DeleteRafflePrize(ctx context.Context, prizeID domain.RafflePrizeID) error
+	RevealRaffleSeed(ctx context.Context, raffleID domain.RaffleID) (domain.Raffle, error)
+	VerifyRaffle(ctx context.Context, raffleID domain.RaffleID) (domain.RaffleVerification, error)
 }
 
 type raffleHandler struct {
@@ -31,12 +41,19 @@ func InstallRaffleHandler(mux *Mux, raffleUseCase raffleUseCase) {
 	mux.HandleFunc("DELETE /raffles/{raffleID}", handler.DeleteRaffle)
 	mux.HandleFunc("POST /raffles/{raffleID}/winners", handler.DrawRaffleWinner)
 	mux.HandleFunc("GET /raffles/{raffleID}/winners", handler.GetRaffleWinners)
+	mux.HandleFunc("POST /raffle-winners/{raffleWinnerID}/claim", handler.ClaimRafflePrize)
+	mux.HandleFunc("POST /raffle-winners/{raffleWinnerID}/void", handler.VoidRaffleWinner)
+	mux.HandleFunc("GET /raffles/{raffleID}/prizes", handler.GetRafflePrizes)
+	mux.HandleFunc("POST /raffles/{raffleID}/prizes", handler.CreateRafflePrize)
+	mux.HandleFunc("DELETE /raffle-prizes/{prizeID}", handler.DeleteRafflePrize)
+	mux.HandleFunc("POST /raffles/{raffleID}/reveal", handler.RevealRaffleSeed)
+	mux.HandleFunc("GET /raffles/{raffleID}/verification", handler.VerifyRaffle)
 }
 
 func (hdlr *raffleHandler) GetRaffle(w http.ResponseWriter, r *http.Request) {
 	raffleID, err := parseResourceID[domain.RaffleID](r.PathValue("raffleID"))
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
+		handleMalformedRequest(w)
 		return
 	}
 
@@ -52,7 +69,7 @@ func (hdlr *raffleHandler) GetRaffle(w http.ResponseWriter, r *http.Request) {
 func (hdlr *raffleHandler) GetRaffles(w http.ResponseWriter, r *http.Request) {
 	contestID, err := parseResourceID[domain.ContestID](r.PathValue("contestID"))
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
+		handleMalformedRequest(w)
 		return
 	}
 
@@ -68,11 +85,18 @@ func (hdlr *raffleHandler) GetRaffles(w http.ResponseWriter, r *http.Request) {
 func (hdlr *raffleHandler) CreateRaffle(w http.ResponseWriter, r *http.Request) {
 	contestID, err := parseResourceID[domain.ContestID](r.PathValue("contestID"))
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
+		handleMalformedRequest(w)
 		return
 	}
 
-	createdRaffle, err := hdlr.raffleUseCase.CreateRaffle(r.Context(), contestID)
+	var tmpl domain.RaffleTemplate
+	err = json.NewDecoder(r.Body).Decode(&tmpl)
+	if err != nil && !errors.Is(err, io.EOF) {
+		handleMalformedRequest(w)
+		return
+	}
+
+	createdRaffle, err := hdlr.raffleUseCase.CreateRaffle(r.Context(), contestID, tmpl)
 	if err != nil {
 		handleError(w, err)
 		return
@@ -84,7 +108,7 @@ func (hdlr *raffleHandler) CreateRaffle(w http.ResponseWriter, r *http.Request)
 func (hdlr *raffleHandler) DeleteRaffle(w http.ResponseWriter, r *http.Request) {
 	raffleID, err := parseResourceID[domain.RaffleID](r.PathValue("raffleID"))
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
+		handleMalformedRequest(w)
 		return
 	}
 
@@ -100,7 +124,7 @@ func (hdlr *raffleHandler) DeleteRaffle(w http.ResponseWriter, r *http.Request)
 func (hdlr *raffleHandler) DrawRaffleWinner(w http.ResponseWriter, r *http.Request) {
 	raffleID, err := parseResourceID[domain.RaffleID](r.PathValue("raffleID"))
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
+		handleMalformedRequest(w)
 		return
 	}
 
@@ -116,7 +140,7 @@ func (hdlr *raffleHandler) DrawRaffleWinner(w http.ResponseWriter, r *http.Reque
 func (hdlr *raffleHandler) GetRaffleWinners(w http.ResponseWriter, r *http.Request) {
 	raffleID, err := parseResourceID[domain.RaffleID](r.PathValue("raffleID"))
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
+		handleMalformedRequest(w)
 		return
 	}
 
@@ -128,3 +152,122 @@ func (hdlr *raffleHandler) GetRaffleWinners(w http.ResponseWriter, r *http.Reque
 
 	writeResponse(w, http.StatusOK, winners)
 }
+
+func (hdlr *raffleHandler) ClaimRafflePrize(w http.ResponseWriter, r *http.Request) {
+	raffleWinnerID, err := parseResourceID[domain.RaffleWinnerID](r.PathValue("raffleWinnerID"))
+	if err != nil {
+		handleMalformedRequest(w)
+		return
+	}
+
+	winner, err := hdlr.raffleUseCase.ClaimRafflePrize(r.Context(), raffleWinnerID)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	writeResponse(w, http.StatusOK, winner)
+}
+
+func (hdlr *raffleHandler) VoidRaffleWinner(w http.ResponseWriter, r *http.Request) {
+	raffleWinnerID, err := parseResourceID[domain.RaffleWinnerID](r.PathValue("raffleWinnerID"))
+	if err != nil {
+		handleMalformedRequest(w)
+		return
+	}
+
+	winner, err := hdlr.raffleUseCase.VoidRaffleWinner(r.Context(), raffleWinnerID)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	writeResponse(w, http.StatusOK, winner)
+}
+
+func (hdlr *raffleHandler) GetRafflePrizes(w http.ResponseWriter, r *http.Request) {
+	raffleID, err := parseResourceID[domain.RaffleID](r.PathValue("raffleID"))
+	if err != nil {
+		handleMalformedRequest(w)
+		return
+	}
+
+	prizes, err := hdlr.raffleUseCase.GetRafflePrizes(r.Context(), raffleID)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	writeResponse(w, http.StatusOK, prizes)
+}
+
+func (hdlr *raffleHandler) CreateRafflePrize(w http.ResponseWriter, r *http.Request) {
+	raffleID, err := parseResourceID[domain.RaffleID](r.PathValue("raffleID"))
+	if err != nil {
+		handleMalformedRequest(w)
+		return
+	}
+
+	var tmpl domain.RafflePrizeTemplate
+	err = json.NewDecoder(r.Body).Decode(&tmpl)
+	if err != nil {
+		handleMalformedRequest(w)
+		return
+	}
+
+	prize, err := hdlr.raffleUseCase.CreateRafflePrize(r.Context(), raffleID, tmpl)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	writeResponse(w, http.StatusCreated, prize)
+}
+
+func (hdlr *raffleHandler) DeleteRafflePrize(w http.ResponseWriter, r *http.Request) {
+	prizeID, err := parseResourceID[domain.RafflePrizeID](r.PathValue("prizeID"))
+	if err != nil {
+		handleMalformedRequest(w)
+		return
+	}
+
+	err = hdlr.raffleUseCase.DeleteRafflePrize(r.Context(), prizeID)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (hdlr *raffleHandler) RevealRaffleSeed(w http.ResponseWriter, r *http.Request) {
+	raffleID, err := parseResourceID[domain.RaffleID](r.PathValue("raffleID"))
+	if err != nil {
+		handleMalformedRequest(w)
+		return
+	}
+
+	raffle, err := hdlr.raffleUseCase.RevealRaffleSeed(r.Context(), raffleID)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	writeResponse(w, http.StatusOK, raffle)
+}
+
+func (hdlr *raffleHandler) VerifyRaffle(w http.ResponseWriter, r *http.Request) {
+	raffleID, err := parseResourceID[domain.RaffleID](r.PathValue("raffleID"))
+	if err != nil {
+		handleMalformedRequest(w)
+		return
+	}
+
+	verification, err := hdlr.raffleUseCase.VerifyRaffle(r.Context(), raffleID)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	writeResponse(w, http.StatusOK, verification)
+}
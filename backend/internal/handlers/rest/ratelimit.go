@@ -0,0 +1,108 @@
+package rest
+
+import (
+	"net/http"
+	"net/netip"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-errors/errors"
+)
+
+var errRateLimited = errors.New("rate limited")
+
+type RateLimiter struct {
+	mu             sync.Mutex
+	limit          int
+	window         time.Duration
+	trustedProxies []netip.Prefix
+	clients        map[string]*rateLimitWindow
+	lastSweep      time.Time
+}
+
+type rateLimitWindow struct {
+	start time.Time
+	count int
+}
+
+func NewRateLimiter(limit int, window time.Duration, trustedProxies []netip.Prefix) *RateLimiter {
+	return &RateLimiter{
+		limit:          limit,
+		window:         window,
+		trustedProxies: trustedProxies,
+		clients:        make(map[string]*rateLimitWindow),
+		lastSweep:      time.Now(),
+	}
+}
+
+func (l *RateLimiter) Allow(key string) bool {
+	now := time.Now()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if now.Sub(l.lastSweep) >= l.window {
+		for client, window := range l.clients {
+			if now.Sub(window.start) >= l.window {
+				delete(l.clients, client)
+			}
+		}
+
+		l.lastSweep = now
+	}
+
+	window, found := l.clients[key]
+	if !found || now.Sub(window.start) >= l.window {
+		l.clients[key] = &rateLimitWindow{
+			start: now,
+			count: 1,
+		}
+
+		return true
+	}
+
+	if window.count >= l.limit {
+		return false
+	}
+
+	window.count++
+
+	return true
+}
+
+func (l *RateLimiter) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !l.Allow(clientAddr(r, l.trustedProxies)) {
+			w.Header().Set("Retry-After", strconv.Itoa(int(l.window.Seconds())))
+			handleError(w, errRateLimited)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// clientAddr returns the address of the peer, or the address in X-Real-IP when
+// the peer is one of the trusted proxies.
+func clientAddr(r *http.Request, trustedProxies []netip.Prefix) string {
+	addrPort, err := netip.ParseAddrPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+
+	peer := addrPort.Addr().Unmap()
+
+	trusted := slices.ContainsFunc(trustedProxies, func(prefix netip.Prefix) bool {
+		return prefix.Contains(peer)
+	})
+
+	if trusted {
+		if realIP, err := netip.ParseAddr(r.Header.Get("X-Real-IP")); err == nil {
+			return realIP.Unmap().String()
+		}
+	}
+
+	return peer.String()
+}
@@ -0,0 +1,108 @@
+package rest_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+	"testing/synctest"
+	"time"
+
+	"github.com/climblive/platform/backend/internal/handlers/rest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimiter(t *testing.T) {
+	t.Run("LimitPerClient", func(t *testing.T) {
+		synctest.Test(t, func(t *testing.T) {
+			limiter := rest.NewRateLimiter(2, time.Minute, nil)
+
+			assert.True(t, limiter.Allow("10.0.0.1"))
+			assert.True(t, limiter.Allow("10.0.0.1"))
+			assert.False(t, limiter.Allow("10.0.0.1"))
+
+			assert.True(t, limiter.Allow("10.0.0.2"))
+
+			time.Sleep(time.Minute)
+
+			assert.True(t, limiter.Allow("10.0.0.1"))
+		})
+	})
+
+	t.Run("Middleware", func(t *testing.T) {
+		synctest.Test(t, func(t *testing.T) {
+			limiter := rest.NewRateLimiter(1, time.Minute, nil)
+
+			handler := limiter.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusCreated)
+			}))
+
+			r := httptest.NewRequest("POST", "http://localhost", nil)
+			r.RemoteAddr = "10.0.0.1:4321"
+
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, r)
+			assert.Equal(t, http.StatusCreated, w.Code)
+
+			r.RemoteAddr = "10.0.0.1:4322"
+
+			w = httptest.NewRecorder()
+			handler.ServeHTTP(w, r)
+			assert.Equal(t, http.StatusTooManyRequests, w.Code)
+			assert.Equal(t, "60", w.Header().Get("Retry-After"))
+			assert.Equal(t, "application/problem+json", w.Header().Get("Content-Type"))
+		})
+	})
+
+	t.Run("IgnoreRealIPFromUntrustedPeer", func(t *testing.T) {
+		synctest.Test(t, func(t *testing.T) {
+			limiter := rest.NewRateLimiter(1, time.Minute, nil)
+
+			handler := limiter.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusCreated)
+			}))
+
+			r := httptest.NewRequest("POST", "http://localhost", nil)
+			r.RemoteAddr = "10.0.0.1:4321"
+			r.Header.Set("X-Real-IP", "10.0.0.2")
+
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, r)
+			assert.Equal(t, http.StatusCreated, w.Code)
+
+			r.Header.Set("X-Real-IP", "10.0.0.3")
+
+			w = httptest.NewRecorder()
+			handler.ServeHTTP(w, r)
+			assert.Equal(t, http.StatusTooManyRequests, w.Code)
+		})
+	})
+
+	t.Run("KeyByRealIPFromTrustedProxy", func(t *testing.T) {
+		synctest.Test(t, func(t *testing.T) {
+			limiter := rest.NewRateLimiter(1, time.Minute, []netip.Prefix{netip.MustParsePrefix("127.0.0.0/8")})
+
+			handler := limiter.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusCreated)
+			}))
+
+			r := httptest.NewRequest("POST", "http://localhost", nil)
+			r.RemoteAddr = "127.0.0.1:4321"
+			r.Header.Set("X-Real-IP", "10.0.0.1")
+
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, r)
+			assert.Equal(t, http.StatusCreated, w.Code)
+
+			r.Header.Set("X-Real-IP", "10.0.0.2")
+
+			w = httptest.NewRecorder()
+			handler.ServeHTTP(w, r)
+			assert.Equal(t, http.StatusCreated, w.Code)
+
+			w = httptest.NewRecorder()
+			handler.ServeHTTP(w, r)
+			assert.Equal(t, http.StatusTooManyRequests, w.Code)
+		})
+	})
+}
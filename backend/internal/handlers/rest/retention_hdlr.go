@@ -0,0 +1,145 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/climblive/platform/backend/internal/domain"
+)
+
+type retentionUseCase interface {
+	GetRetentionPoliciesByOrganizer(ctx context.Context, organizerID domain.OrganizerID) ([]domain.RetentionPolicy, error)
+	CreateRetentionPolicy(ctx context.Context, organizerID domain.OrganizerID, tmpl domain.RetentionPolicyTemplate) (domain.RetentionPolicy, error)
+	PatchRetentionPolicy(ctx context.Context, policyID domain.RetentionPolicyID, patch domain.RetentionPolicyPatch) (domain.RetentionPolicy, error)
+	DeleteRetentionPolicy(ctx context.Context, policyID domain.RetentionPolicyID) error
+	DryRunRetentionPolicy(ctx context.Context, policyID domain.RetentionPolicyID) ([]domain.RetentionCandidate, error)
+	GetRetentionPolicyExecutions(ctx context.Context, policyID domain.RetentionPolicyID) ([]domain.RetentionPolicyExecution, error)
+}
+
+type retentionHandler struct {
+	retentionUseCase retentionUseCase
+}
+
+func InstallRetentionHandler(mux *Mux, retentionUseCase retentionUseCase) {
+	handler := &retentionHandler{
+		retentionUseCase: retentionUseCase,
+	}
+
+	mux.HandleFunc("GET /organizers/{organizerID}/retention-policies", handler.GetRetentionPoliciesByOrganizer)
+	mux.HandleFunc("POST /organizers/{organizerID}/retention-policies", handler.CreateRetentionPolicy)
+	mux.HandleFunc("PATCH /retention-policies/{policyID}", handler.PatchRetentionPolicy)
+	mux.HandleFunc("DELETE /retention-policies/{policyID}", handler.DeleteRetentionPolicy)
+	mux.HandleFunc("GET /retention-policies/{policyID}/dry-run", handler.DryRunRetentionPolicy)
+	mux.HandleFunc("GET /retention-policies/{policyID}/executions", handler.GetRetentionPolicyExecutions)
+}
+
+func (hdlr *retentionHandler) GetRetentionPoliciesByOrganizer(w http.ResponseWriter, r *http.Request) {
+	organizerID, err := parseResourceID[domain.OrganizerID](r.PathValue("organizerID"))
+	if err != nil {
+		handleMalformedRequest(w)
+		return
+	}
+
+	policies, err := hdlr.retentionUseCase.GetRetentionPoliciesByOrganizer(r.Context(), organizerID)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	writeResponse(w, http.StatusOK, policies)
+}
+
+func (hdlr *retentionHandler) CreateRetentionPolicy(w http.ResponseWriter, r *http.Request) {
+	organizerID, err := parseResourceID[domain.OrganizerID](r.PathValue("organizerID"))
+	if err != nil {
+		handleMalformedRequest(w)
+		return
+	}
+
+	var tmpl domain.RetentionPolicyTemplate
+	err = json.NewDecoder(r.Body).Decode(&tmpl)
+	if err != nil {
+		handleMalformedRequest(w)
+		return
+	}
+
+	policy, err := hdlr.retentionUseCase.CreateRetentionPolicy(r.Context(), organizerID, tmpl)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	writeResponse(w, http.StatusCreated, policy)
+}
+
+func (hdlr *retentionHandler) PatchRetentionPolicy(w http.ResponseWriter, r *http.Request) {
+	policyID, err := parseResourceID[domain.RetentionPolicyID](r.PathValue("policyID"))
+	if err != nil {
+		handleMalformedRequest(w)
+		return
+	}
+
+	var patch domain.RetentionPolicyPatch
+	err = json.NewDecoder(r.Body).Decode(&patch)
+	if err != nil {
+		handleMalformedRequest(w)
+		return
+	}
+
+	policy, err := hdlr.retentionUseCase.PatchRetentionPolicy(r.Context(), policyID, patch)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	writeResponse(w, http.StatusOK, policy)
+}
+
+func (hdlr *retentionHandler) DeleteRetentionPolicy(w http.ResponseWriter, r *http.Request) {
+	policyID, err := parseResourceID[domain.RetentionPolicyID](r.PathValue("policyID"))
+	if err != nil {
+		handleMalformedRequest(w)
+		return
+	}
+
+	err = hdlr.retentionUseCase.DeleteRetentionPolicy(r.Context(), policyID)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	writeResponse(w, http.StatusNoContent, nil)
+}
+
+func (hdlr *retentionHandler) DryRunRetentionPolicy(w http.ResponseWriter, r *http.Request) {
+	policyID, err := parseResourceID[domain.RetentionPolicyID](r.PathValue("policyID"))
+	if err != nil {
+		handleMalformedRequest(w)
+		return
+	}
+
+	candidates, err := hdlr.retentionUseCase.DryRunRetentionPolicy(r.Context(), policyID)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	writeResponse(w, http.StatusOK, candidates)
+}
+
+func (hdlr *retentionHandler) GetRetentionPolicyExecutions(w http.ResponseWriter, r *http.Request) {
+	policyID, err := parseResourceID[domain.RetentionPolicyID](r.PathValue("policyID"))
+	if err != nil {
+		handleMalformedRequest(w)
+		return
+	}
+
+	executions, err := hdlr.retentionUseCase.GetRetentionPolicyExecutions(r.Context(), policyID)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	writeResponse(w, http.StatusOK, executions)
+}
@@ -0,0 +1,181 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/climblive/platform/backend/internal/domain"
+)
+
+type roundUseCase interface {
+	GetRound(ctx context.Context, roundID domain.RoundID) (domain.Round, error)
+	GetRoundsByContest(ctx context.Context, contestID domain.ContestID) ([]domain.Round, error)
+	CreateRound(ctx context.Context, contestID domain.ContestID, tmpl domain.RoundTemplate) (domain.Round, error)
+	PatchRound(ctx context.Context, roundID domain.RoundID, patch domain.RoundPatch) (domain.Round, error)
+	DeleteRound(ctx context.Context, roundID domain.RoundID) error
+	GetStartList(ctx context.Context, roundID domain.RoundID) ([]domain.StartListEntry, error)
+	GenerateStartList(ctx context.Context, roundID domain.RoundID) ([]domain.StartListEntry, error)
+	GetScoreboard(ctx context.Context, roundID domain.RoundID) ([]domain.ScoreboardEntry, error)
+}
+
+type roundHandler struct {
+	roundUseCase roundUseCase
+}
+
+func InstallRoundHandler(mux *Mux, roundUseCase roundUseCase) {
+	handler := &roundHandler{
+		roundUseCase: roundUseCase,
+	}
+
+	mux.HandleFunc("GET /rounds/{roundID}", handler.GetRound)
+	mux.HandleFunc("GET /contests/{contestID}/rounds", handler.GetRoundsByContest)
+	mux.HandleFunc("POST /contests/{contestID}/rounds", handler.CreateRound)
+	mux.HandleFunc("PATCH /rounds/{roundID}", handler.PatchRound)
+	mux.HandleFunc("DELETE /rounds/{roundID}", handler.DeleteRound)
+	mux.HandleFunc("GET /rounds/{roundID}/start-list", handler.GetStartList)
+	mux.HandleFunc("POST /rounds/{roundID}/start-list", handler.GenerateStartList)
+	mux.HandleFunc("GET /rounds/{roundID}/scoreboard", handler.GetScoreboard)
+}
+
+func (hdlr *roundHandler) GetRound(w http.ResponseWriter, r *http.Request) {
+	roundID, err := parseResourceID[domain.RoundID](r.PathValue("roundID"))
+	if err != nil {
+		handleMalformedRequest(w)
+		return
+	}
+
+	round, err := hdlr.roundUseCase.GetRound(r.Context(), roundID)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	writeResponse(w, http.StatusOK, round)
+}
+
+func (hdlr *roundHandler) GetRoundsByContest(w http.ResponseWriter, r *http.Request) {
+	contestID, err := parseResourceID[domain.ContestID](r.PathValue("contestID"))
+	if err != nil {
+		handleMalformedRequest(w)
+		return
+	}
+
+	rounds, err := hdlr.roundUseCase.GetRoundsByContest(r.Context(), contestID)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	writeResponse(w, http.StatusOK, rounds)
+}
+
+func (hdlr *roundHandler) CreateRound(w http.ResponseWriter, r *http.Request) {
+	contestID, err := parseResourceID[domain.ContestID](r.PathValue("contestID"))
+	if err != nil {
+		handleMalformedRequest(w)
+		return
+	}
+
+	var tmpl domain.RoundTemplate
+	err = json.NewDecoder(r.Body).Decode(&tmpl)
+	if err != nil {
+		handleMalformedRequest(w)
+		return
+	}
+
+	round, err := hdlr.roundUseCase.CreateRound(r.Context(), contestID, tmpl)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	writeResponse(w, http.StatusCreated, round)
+}
+
+func (hdlr *roundHandler) PatchRound(w http.ResponseWriter, r *http.Request) {
+	roundID, err := parseResourceID[domain.RoundID](r.PathValue("roundID"))
+	if err != nil {
+		handleMalformedRequest(w)
+		return
+	}
+
+	var patch domain.RoundPatch
+	err = json.NewDecoder(r.Body).Decode(&patch)
+	if err != nil {
+		handleMalformedRequest(w)
+		return
+	}
+
+	round, err := hdlr.roundUseCase.PatchRound(r.Context(), roundID, patch)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	writeResponse(w, http.StatusOK, round)
+}
+
+func (hdlr *roundHandler) DeleteRound(w http.ResponseWriter, r *http.Request) {
+	roundID, err := parseResourceID[domain.RoundID](r.PathValue("roundID"))
+	if err != nil {
+		handleMalformedRequest(w)
+		return
+	}
+
+	err = hdlr.roundUseCase.DeleteRound(r.Context(), roundID)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	writeResponse(w, http.StatusNoContent, nil)
+}
+
+func (hdlr *roundHandler) GetStartList(w http.ResponseWriter, r *http.Request) {
+	roundID, err := parseResourceID[domain.RoundID](r.PathValue("roundID"))
+	if err != nil {
+		handleMalformedRequest(w)
+		return
+	}
+
+	startList, err := hdlr.roundUseCase.GetStartList(r.Context(), roundID)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	writeResponse(w, http.StatusOK, startList)
+}
+
+func (hdlr *roundHandler) GenerateStartList(w http.ResponseWriter, r *http.Request) {
+	roundID, err := parseResourceID[domain.RoundID](r.PathValue("roundID"))
+	if err != nil {
+		handleMalformedRequest(w)
+		return
+	}
+
+	startList, err := hdlr.roundUseCase.GenerateStartList(r.Context(), roundID)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	writeResponse(w, http.StatusOK, startList)
+}
+
+func (hdlr *roundHandler) GetScoreboard(w http.ResponseWriter, r *http.Request) {
+	roundID, err := parseResourceID[domain.RoundID](r.PathValue("roundID"))
+	if err != nil {
+		handleMalformedRequest(w)
+		return
+	}
+
+	scoreboard, err := hdlr.roundUseCase.GetScoreboard(r.Context(), roundID)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	writeResponse(w, http.StatusOK, scoreboard)
+}
@@ -0,0 +1,127 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/climblive/platform/backend/internal/domain"
+)
+
+type teamUseCase interface {
+	GetTeam(ctx context.Context, teamID domain.TeamID) (domain.Team, error)
+	GetTeamsByContest(ctx context.Context, contestID domain.ContestID) ([]domain.Team, error)
+	CreateTeam(ctx context.Context, contestID domain.ContestID, tmpl domain.TeamTemplate) (domain.Team, error)
+	PatchTeam(ctx context.Context, teamID domain.TeamID, patch domain.TeamPatch) (domain.Team, error)
+	DeleteTeam(ctx context.Context, teamID domain.TeamID) error
+}
+
+type teamHandler struct {
+	teamUseCase teamUseCase
+}
+
+func InstallTeamHandler(mux *Mux, teamUseCase teamUseCase) {
+	handler := &teamHandler{
+		teamUseCase: teamUseCase,
+	}
+
+	mux.HandleFunc("GET /teams/{teamID}", handler.GetTeam)
+	mux.HandleFunc("GET /contests/{contestID}/teams", handler.GetTeamsByContest)
+	mux.HandleFunc("POST /contests/{contestID}/teams", handler.CreateTeam)
+	mux.HandleFunc("PATCH /teams/{teamID}", handler.PatchTeam)
+	mux.HandleFunc("DELETE /teams/{teamID}", handler.DeleteTeam)
+}
+
+func (hdlr *teamHandler) GetTeam(w http.ResponseWriter, r *http.Request) {
+	teamID, err := parseResourceID[domain.TeamID](r.PathValue("teamID"))
+	if err != nil {
+		handleMalformedRequest(w)
+		return
+	}
+
+	team, err := hdlr.teamUseCase.GetTeam(r.Context(), teamID)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	writeResponse(w, http.StatusOK, team)
+}
+
+func (hdlr *teamHandler) GetTeamsByContest(w http.ResponseWriter, r *http.Request) {
+	contestID, err := parseResourceID[domain.ContestID](r.PathValue("contestID"))
+	if err != nil {
+		handleMalformedRequest(w)
+		return
+	}
+
+	teams, err := hdlr.teamUseCase.GetTeamsByContest(r.Context(), contestID)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	writeResponse(w, http.StatusOK, teams)
+}
+
+func (hdlr *teamHandler) CreateTeam(w http.ResponseWriter, r *http.Request) {
+	contestID, err := parseResourceID[domain.ContestID](r.PathValue("contestID"))
+	if err != nil {
+		handleMalformedRequest(w)
+		return
+	}
+
+	var tmpl domain.TeamTemplate
+	err = json.NewDecoder(r.Body).Decode(&tmpl)
+	if err != nil {
+		handleMalformedRequest(w)
+		return
+	}
+
+	team, err := hdlr.teamUseCase.CreateTeam(r.Context(), contestID, tmpl)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	writeResponse(w, http.StatusCreated, team)
+}
+
+func (hdlr *teamHandler) PatchTeam(w http.ResponseWriter, r *http.Request) {
+	teamID, err := parseResourceID[domain.TeamID](r.PathValue("teamID"))
+	if err != nil {
+		handleMalformedRequest(w)
+		return
+	}
+
+	var patch domain.TeamPatch
+	err = json.NewDecoder(r.Body).Decode(&patch)
+	if err != nil {
+		handleMalformedRequest(w)
+		return
+	}
+
+	team, err := hdlr.teamUseCase.PatchTeam(r.Context(), teamID, patch)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	writeResponse(w, http.StatusOK, team)
+}
+
+func (hdlr *teamHandler) DeleteTeam(w http.ResponseWriter, r *http.Request) {
+	teamID, err := parseResourceID[domain.TeamID](r.PathValue("teamID"))
+	if err != nil {
+		handleMalformedRequest(w)
+		return
+	}
+
+	err = hdlr.teamUseCase.DeleteTeam(r.Context(), teamID)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	writeResponse(w, http.StatusNoContent, nil)
+}
@@ -10,9 +10,11 @@ import (
 
 type tickUseCase interface {
 	GetTicksByContender(ctx context.Context, contenderID domain.ContenderID) ([]domain.Tick, error)
-	GetTicksByContest(ctx context.Context, contestID domain.ContestID) ([]domain.Tick, error)
+	GetTicksByContest(ctx context.Context, contestID domain.ContestID, filter domain.TickFilter, page domain.PageRequest) (domain.Page[domain.Tick], error)
 	DeleteTick(ctx context.Context, tickID domain.TickID) error
 	PutTick(ctx context.Context, contenderID domain.ContenderID, tick domain.Tick) (domain.Tick, error)
+	GetTickRevisions(ctx context.Context, contenderID domain.ContenderID, problemID domain.ProblemID) ([]domain.TickRevision, error)
+	SyncTicks(ctx context.Context, contenderID domain.ContenderID, operations []domain.TickOperation) ([]domain.TickOperationResult, error)
 }
 
 type tickHandler struct {
@@ -27,13 +29,15 @@ func InstallTickHandler(mux *Mux, tickUseCase tickUseCase) {
 	mux.HandleFunc("GET /contenders/{contenderID}/ticks", handler.GetTicksByContender)
 	mux.HandleFunc("GET /contests/{contestID}/ticks", handler.GetTicksByContest)
 	mux.HandleFunc("PUT /contenders/{contenderID}/ticks", handler.PutTick)
+	mux.HandleFunc("POST /contenders/{contenderID}/ticks/batch", handler.SyncTicks)
 	mux.HandleFunc("DELETE /ticks/{tickID}", handler.DeleteTick)
+	mux.HandleFunc("GET /contenders/{contenderID}/tick-revisions", handler.GetTickRevisions)
 }
 
 func (hdlr *tickHandler) GetTicksByContender(w http.ResponseWriter, r *http.Request) {
 	contenderID, err := parseResourceID[domain.ContenderID](r.PathValue("contenderID"))
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
+		handleMalformedRequest(w)
 		return
 	}
 
@@ -49,30 +53,44 @@ func (hdlr *tickHandler) GetTicksByContender(w http.ResponseWriter, r *http.Requ
 func (hdlr *tickHandler) GetTicksByContest(w http.ResponseWriter, r *http.Request) {
 	contestID, err := parseResourceID[domain.ContestID](r.PathValue("contestID"))
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
+		handleMalformedRequest(w)
 		return
 	}
 
-	ticks, err := hdlr.tickUseCase.GetTicksByContest(r.Context(), contestID)
+	query := r.URL.Query()
+
+	page, err := parsePageRequest(query)
+	if err != nil {
+		handleMalformedRequest(w)
+		return
+	}
+
+	since, err := parseOptionalTime(query, "since")
+	if err != nil {
+		handleMalformedRequest(w)
+		return
+	}
+
+	ticks, err := hdlr.tickUseCase.GetTicksByContest(r.Context(), contestID, domain.TickFilter{Since: since}, page)
 	if err != nil {
 		handleError(w, err)
 		return
 	}
 
-	writeResponse(w, http.StatusOK, ticks)
+	writePage(w, r, ticks)
 }
 
 func (hdlr *tickHandler) PutTick(w http.ResponseWriter, r *http.Request) {
 	contenderID, err := parseResourceID[domain.ContenderID](r.PathValue("contenderID"))
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
+		handleMalformedRequest(w)
 		return
 	}
 
 	var tick domain.Tick
 	err = json.NewDecoder(r.Body).Decode(&tick)
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
+		handleMalformedRequest(w)
 		return
 	}
 
@@ -85,10 +103,33 @@ func (hdlr *tickHandler) PutTick(w http.ResponseWriter, r *http.Request) {
 	writeResponse(w, http.StatusOK, updatedTick)
 }
 
+func (hdlr *tickHandler) SyncTicks(w http.ResponseWriter, r *http.Request) {
+	contenderID, err := parseResourceID[domain.ContenderID](r.PathValue("contenderID"))
+	if err != nil {
+		handleMalformedRequest(w)
+		return
+	}
+
+	var operations []domain.TickOperation
+	err = json.NewDecoder(r.Body).Decode(&operations)
+	if err != nil {
+		handleMalformedRequest(w)
+		return
+	}
+
+	results, err := hdlr.tickUseCase.SyncTicks(r.Context(), contenderID, operations)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	writeResponse(w, http.StatusOK, results)
+}
+
 func (hdlr *tickHandler) DeleteTick(w http.ResponseWriter, r *http.Request) {
 	tickID, err := parseResourceID[domain.TickID](r.PathValue("tickID"))
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
+		handleMalformedRequest(w)
 		return
 	}
 
@@ -100,3 +141,29 @@ func (hdlr *tickHandler) DeleteTick(w http.ResponseWriter, r *http.Request) {
 
 	writeResponse(w, http.StatusNoContent, nil)
 }
+
+func (hdlr *tickHandler) GetTickRevisions(w http.ResponseWriter, r *http.Request) {
+	contenderID, err := parseResourceID[domain.ContenderID](r.PathValue("contenderID"))
+	if err != nil {
+		handleMalformedRequest(w)
+		return
+	}
+
+	var problemID domain.ProblemID
+
+	if value := r.URL.Query().Get("problemId"); value != "" {
+		problemID, err = parseResourceID[domain.ProblemID](value)
+		if err != nil {
+			handleMalformedRequest(w)
+			return
+		}
+	}
+
+	revisions, err := hdlr.tickUseCase.GetTickRevisions(r.Context(), contenderID, problemID)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+
+	writeResponse(w, http.StatusOK, revisions)
+}
@@ -0,0 +1,51 @@
+package rest_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/climblive/platform/backend/internal/handlers/rest"
+	"github.com/climblive/platform/backend/internal/tracing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestTracing(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)))
+
+	mux := rest.NewMux()
+	mux.RegisterMiddleware(rest.Tracing)
+
+	mux.HandleFunc("PUT /contenders/{contenderID}/ticks", func(w http.ResponseWriter, r *http.Request) {
+		_, span := tracing.Start(r.Context(), "child")
+		span.End()
+
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	r := httptest.NewRequest("PUT", "http://localhost/contenders/1/ticks", nil)
+	r.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	w := httptest.NewRecorder()
+
+	mux.ServeHTTP(w, r)
+
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 2)
+
+	child, server := spans[0], spans[1]
+
+	assert.Equal(t, "PUT /contenders/{contenderID}/ticks", server.Name)
+	assert.Equal(t, trace.SpanKindServer, server.SpanKind)
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", server.SpanContext.TraceID().String())
+	assert.Equal(t, "00f067aa0ba902b7", server.Parent.SpanID().String())
+	assert.Contains(t, server.Attributes, attribute.Int("http.response.status_code", http.StatusForbidden))
+
+	assert.Equal(t, server.SpanContext.SpanID(), child.Parent.SpanID())
+}
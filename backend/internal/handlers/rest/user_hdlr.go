@@ -38,7 +38,7 @@ func (hdlr *userHandler) GetSelf(w http.ResponseWriter, r *http.Request) {
 func (hdlr *userHandler) GetUsersByOrganizer(w http.ResponseWriter, r *http.Request) {
 	organizerID, err := parseResourceID[domain.OrganizerID](r.PathValue("organizerID"))
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
+		handleMalformedRequest(w)
 		return
 	}
 
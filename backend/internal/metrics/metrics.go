@@ -0,0 +1,90 @@
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "climblive"
+
+var (
+	HTTPRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "request_duration_seconds",
+		Help:      "Latency of handled HTTP requests by route pattern and status code.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"route", "status"})
+
+	ActiveSubscriptions = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "events",
+		Name:      "active_subscriptions",
+		Help:      "Number of active event subscriptions.",
+	})
+
+	BufferedEvents = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "events",
+		Name:      "buffered_events",
+		Help:      "Number of buffered events waiting to be consumed across all subscriptions.",
+	})
+
+	EventsDispatched = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "events",
+		Name:      "dispatched_total",
+		Help:      "Number of dispatched events by event name.",
+	}, []string{"event"})
+
+	SubscriptionsBufferFull = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "events",
+		Name:      "subscriptions_buffer_full_total",
+		Help:      "Number of subscriptions terminated due to a full buffer.",
+	})
+
+	ScoreEnginesRunning = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "score_engine",
+		Name:      "running",
+		Help:      "Number of running score engines.",
+	})
+
+	ScoreEngineEventDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "score_engine",
+		Name:      "event_duration_seconds",
+		Help:      "Time spent by score engines processing events by event name.",
+		Buckets:   prometheus.ExponentialBuckets(0.0001, 4, 8),
+	}, []string{"event"})
+
+	ScoreEngineHydrationDuration = promauto.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "score_engine",
+		Name:      "hydration_duration_seconds",
+		Help:      "Time spent hydrating score engine stores.",
+		Buckets:   prometheus.DefBuckets,
+	})
+
+	ScoreKeeperPendingScores = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "score_keeper",
+		Name:      "pending_scores",
+		Help:      "Number of scores held in memory waiting to be persisted.",
+	})
+
+	ScoreKeeperPersistFailures = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "score_keeper",
+		Name:      "persist_failures_total",
+		Help:      "Number of failed attempts to persist scores.",
+	})
+)
+
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
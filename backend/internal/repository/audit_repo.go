@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/climblive/platform/backend/internal/database"
+	"github.com/climblive/platform/backend/internal/domain"
+	"github.com/go-errors/errors"
+)
+
+func (d *Database) StoreAuditEntry(ctx context.Context, tx domain.Transaction, entry domain.AuditEntry) (domain.AuditEntry, error) {
+	params := database.InsertAuditEntryParams{
+		OrganizerID: int32(entry.Ownership.OrganizerID),
+		ContestID:   int32(entry.ContestID),
+		ContenderID: makeNullInt32(int32(entry.ContenderID)),
+		Action:      string(entry.Action),
+		Timestamp:   entry.Timestamp,
+	}
+
+	insertID, err := d.WithTx(tx).InsertAuditEntry(ctx, params)
+	if err != nil {
+		return domain.AuditEntry{}, errors.Wrap(err, 0)
+	}
+
+	entry.ID = domain.AuditEntryID(insertID)
+
+	return entry, nil
+}
@@ -46,6 +46,7 @@ func (d *Database) StoreCompClass(ctx context.Context, tx domain.Transaction, co
 		Color:       sql.NullString{String: "", Valid: false},
 		TimeBegin:   compClass.TimeBegin,
 		TimeEnd:     compClass.TimeEnd,
+		Capacity:    int32(compClass.Capacity),
 	}
 
 	insertID, err := d.WithTx(tx).UpsertCompClass(ctx, params)
@@ -68,3 +69,12 @@ func (d *Database) DeleteCompClass(ctx context.Context, tx domain.Transaction, c
 
 	return nil
 }
+
+func (d *Database) DeleteCompClassesByContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) error {
+	err := d.WithTx(tx).DeleteCompClassesByContest(ctx, int32(contestID))
+	if err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	return nil
+}
@@ -24,6 +24,18 @@ func (d *Database) GetContender(ctx context.Context, tx domain.Transaction, cont
 	return contender, nil
 }
 
+func (d *Database) GetContenderVersionForUpdate(ctx context.Context, tx domain.Transaction, contenderID domain.ContenderID) (int, error) {
+	version, err := d.WithTx(tx).GetContenderVersionForUpdate(ctx, int32(contenderID))
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return 0, errors.Wrap(domain.ErrNotFound, 0)
+	case err != nil:
+		return 0, errors.Wrap(err, 0)
+	}
+
+	return int(version), nil
+}
+
 func (d *Database) GetContenderByCode(ctx context.Context, tx domain.Transaction, registrationCode string) (domain.Contender, error) {
 	record, err := d.WithTx(tx).GetContenderByCode(ctx, registrationCode)
 	switch {
@@ -55,6 +67,40 @@ func (d *Database) GetContendersByCompClass(ctx context.Context, tx domain.Trans
 	return contenders, nil
 }
 
+func (d *Database) GetWaitlistedContenders(ctx context.Context, tx domain.Transaction, compClassID domain.CompClassID) ([]domain.Contender, error) {
+	records, err := d.WithTx(tx).GetWaitlistedContenders(ctx, sql.NullInt32{Valid: true, Int32: int32(compClassID)})
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	contenders := make([]domain.Contender, 0)
+
+	for _, record := range records {
+		contender := contenderToDomain(database.GetContenderRow(record))
+
+		contenders = append(contenders, contender)
+	}
+
+	return contenders, nil
+}
+
+func (d *Database) GetContendersByTeam(ctx context.Context, tx domain.Transaction, teamID domain.TeamID) ([]domain.Contender, error) {
+	records, err := d.WithTx(tx).GetContendersByTeam(ctx, sql.NullInt32{Valid: true, Int32: int32(teamID)})
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	contenders := make([]domain.Contender, 0)
+
+	for _, record := range records {
+		contender := contenderToDomain(database.GetContenderRow(record))
+
+		contenders = append(contenders, contender)
+	}
+
+	return contenders, nil
+}
+
 func (d *Database) GetContendersByContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) ([]domain.Contender, error) {
 	records, err := d.WithTx(tx).GetContendersByContest(ctx, int32(contestID))
 	if err != nil {
@@ -72,7 +118,51 @@ func (d *Database) GetContendersByContest(ctx context.Context, tx domain.Transac
 	return contenders, nil
 }
 
+func (d *Database) GetContendersByContestFiltered(ctx context.Context, tx domain.Transaction, contestID domain.ContestID, filter domain.ContenderFilter, page domain.PageRequest) ([]domain.Contender, error) {
+	records, err := d.WithTx(tx).GetContendersByContestFiltered(ctx, database.GetContendersByContestFilteredParams{
+		ContestID:    int32(contestID),
+		ClassID:      makeNullInt32(int32(filter.CompClassID)),
+		HasEntered:   makeNullBool(filter.Entered),
+		Disqualified: makeNullBool(filter.Disqualified),
+		After:        int32(page.After),
+		Descending:   page.Descending,
+		Limit:        pageLimit(page),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	contenders := make([]domain.Contender, 0)
+
+	for _, record := range records {
+		contender := contenderToDomain(database.GetContenderRow(record))
+
+		contenders = append(contenders, contender)
+	}
+
+	return contenders, nil
+}
+
 func (d *Database) StoreContender(ctx context.Context, tx domain.Transaction, contender domain.Contender) (domain.Contender, error) {
+	if tx == nil {
+		tx, err := d.Begin()
+		if err != nil {
+			return domain.Contender{}, errors.Wrap(err, 0)
+		}
+
+		contender, err = d.StoreContender(ctx, tx, contender)
+		if err != nil {
+			tx.Rollback()
+			return domain.Contender{}, errors.Wrap(err, 0)
+		}
+
+		if err := tx.Commit(); err != nil {
+			return domain.Contender{}, errors.Wrap(err, 0)
+		}
+
+		return contender, nil
+	}
+
 	params := database.UpsertContenderParams{
 		ID:                  int32(contender.ID),
 		OrganizerID:         int32(contender.Ownership.OrganizerID),
@@ -80,11 +170,15 @@ func (d *Database) StoreContender(ctx context.Context, tx domain.Transaction, co
 		RegistrationCode:    contender.RegistrationCode,
 		Name:                makeNullString(contender.Name),
 		ClassID:             makeNullInt32(int32(contender.CompClassID)),
+		TeamID:              makeNullInt32(int32(contender.TeamID)),
 		Entered:             makeNullTime(contender.Entered),
 		Disqualified:        contender.Disqualified,
 		WithdrawnFromFinals: contender.WithdrawnFromFinals,
 		ScrubbedAt:          makeNullTime(contender.ScrubbedAt),
 		ScrubBefore:         makeNullTime(contender.ScrubBefore),
+		WaitlistClassID:     makeNullInt32(int32(contender.WaitlistCompClassID)),
+		WaitlistedAt:        makeNullTime(contender.WaitlistedAt),
+		Version:             nextVersion(domain.ResourceID(contender.ID), contender.Version),
 	}
 
 	insertID, err := d.WithTx(tx).UpsertContender(ctx, params)
@@ -96,7 +190,14 @@ func (d *Database) StoreContender(ctx context.Context, tx domain.Transaction, co
 		contender.ID = domain.ContenderID(insertID)
 	}
 
-	return contender, err
+	version, err := d.GetContenderVersionForUpdate(ctx, tx, contender.ID)
+	if err != nil {
+		return domain.Contender{}, errors.Wrap(err, 0)
+	}
+
+	contender.Version = version
+
+	return contender, nil
 }
 
 func (d *Database) DeleteContender(ctx context.Context, tx domain.Transaction, contenderID domain.ContenderID) error {
@@ -133,3 +234,12 @@ func (d *Database) GetScrubEligibleContenders(ctx context.Context, deadline time
 
 	return contenders, nil
 }
+
+func (d *Database) DeleteContendersByContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) (int, error) {
+	rows, err := d.WithTx(tx).DeleteContendersByContest(ctx, int32(contestID))
+	if err != nil {
+		return 0, errors.Wrap(err, 0)
+	}
+
+	return int(rows), nil
+}
@@ -21,11 +21,11 @@ func (d *Database) GetContest(ctx context.Context, tx domain.Transaction, contes
 
 	contest := contestToDomain(record.Contest)
 
-	if timeBegin, ok := record.TimeBegin.(time.Time); ok {
+	if timeBegin, ok := aggregateTime(record.TimeBegin); ok {
 		contest.TimeBegin = timeBegin
 	}
 
-	if timeEnd, ok := record.TimeEnd.(time.Time); ok {
+	if timeEnd, ok := aggregateTime(record.TimeEnd); ok {
 		contest.TimeEnd = timeEnd
 	}
 
@@ -34,8 +34,26 @@ func (d *Database) GetContest(ctx context.Context, tx domain.Transaction, contes
 	return contest, nil
 }
 
-func (d *Database) GetAllContests(ctx context.Context, tx domain.Transaction) ([]domain.Contest, error) {
-	records, err := d.WithTx(tx).GetAllContests(ctx)
+func (d *Database) GetContestVersionForUpdate(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) (int, error) {
+	version, err := d.WithTx(tx).GetContestVersionForUpdate(ctx, int32(contestID))
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return 0, errors.Wrap(domain.ErrNotFound, 0)
+	case err != nil:
+		return 0, errors.Wrap(err, 0)
+	}
+
+	return int(version), nil
+}
+
+func (d *Database) GetContests(ctx context.Context, tx domain.Transaction, filter domain.ContestFilter, page domain.PageRequest) ([]domain.Contest, error) {
+	records, err := d.WithTx(tx).GetContests(ctx, database.GetContestsParams{
+		Country:    makeNullString(filter.Country),
+		IsArchived: makeNullBool(filter.Archived),
+		After:      int32(page.After),
+		Descending: page.Descending,
+		Limit:      pageLimit(page),
+	})
 	if err != nil {
 		return nil, errors.Wrap(err, 0)
 	}
@@ -45,11 +63,11 @@ func (d *Database) GetAllContests(ctx context.Context, tx domain.Transaction) ([
 	for _, record := range records {
 		contest := contestToDomain(record.Contest)
 
-		if timeBegin, ok := record.TimeBegin.(time.Time); ok {
+		if timeBegin, ok := aggregateTime(record.TimeBegin); ok {
 			contest.TimeBegin = timeBegin
 		}
 
-		if timeEnd, ok := record.TimeEnd.(time.Time); ok {
+		if timeEnd, ok := aggregateTime(record.TimeEnd); ok {
 			contest.TimeEnd = timeEnd
 		}
 
@@ -72,11 +90,11 @@ func (d *Database) GetContestsByOrganizer(ctx context.Context, tx domain.Transac
 	for _, record := range records {
 		contest := contestToDomain(record.Contest)
 
-		if timeBegin, ok := record.TimeBegin.(time.Time); ok {
+		if timeBegin, ok := aggregateTime(record.TimeBegin); ok {
 			contest.TimeBegin = timeBegin
 		}
 
-		if timeEnd, ok := record.TimeEnd.(time.Time); ok {
+		if timeEnd, ok := aggregateTime(record.TimeEnd); ok {
 			contest.TimeEnd = timeEnd
 		}
 
@@ -101,27 +119,31 @@ func (d *Database) GetContestsCurrentlyRunningOrByStartTime(ctx context.Context,
 
 	for _, record := range records {
 		contest := contestToDomain(database.Contest{
-			ID:                 record.ID,
-			OrganizerID:        record.OrganizerID,
-			SeriesID:           record.SeriesID,
-			Name:               record.Name,
-			Description:        record.Description,
-			ArchivedAt:         record.ArchivedAt,
-			Created:            record.Created,
-			Location:           record.Location,
-			Country:            record.Country,
-			QualifyingProblems: record.QualifyingProblems,
-			Finalists:          record.Finalists,
-			Info:               record.Info,
-			GracePeriod:        record.GracePeriod,
-			NameRetentionTime:  record.NameRetentionTime,
+			ID:                   record.ID,
+			OrganizerID:          record.OrganizerID,
+			SeriesID:             record.SeriesID,
+			Name:                 record.Name,
+			Description:          record.Description,
+			ArchivedAt:           record.ArchivedAt,
+			Created:              record.Created,
+			Location:             record.Location,
+			Country:              record.Country,
+			QualifyingProblems:   record.QualifyingProblems,
+			Finalists:            record.Finalists,
+			Info:                 record.Info,
+			GracePeriod:          record.GracePeriod,
+			NameRetentionTime:    record.NameRetentionTime,
+			ScoreboardFreeze:     record.ScoreboardFreeze,
+			ScoreboardRevealedAt: record.ScoreboardRevealedAt,
+			SelfRegistration:     record.SelfRegistration,
+			Version:              record.Version,
 		})
 
-		if timeBegin, ok := record.TimeBegin.(time.Time); ok {
+		if timeBegin, ok := aggregateTime(record.TimeBegin); ok {
 			contest.TimeBegin = timeBegin
 		}
 
-		if timeEnd, ok := record.TimeEnd.(time.Time); ok {
+		if timeEnd, ok := aggregateTime(record.TimeEnd); ok {
 			contest.TimeEnd = timeEnd
 		}
 
@@ -132,21 +154,44 @@ func (d *Database) GetContestsCurrentlyRunningOrByStartTime(ctx context.Context,
 }
 
 func (d *Database) StoreContest(ctx context.Context, tx domain.Transaction, contest domain.Contest) (domain.Contest, error) {
+	if tx == nil {
+		tx, err := d.Begin()
+		if err != nil {
+			return domain.Contest{}, errors.Wrap(err, 0)
+		}
+
+		contest, err = d.StoreContest(ctx, tx, contest)
+		if err != nil {
+			tx.Rollback()
+			return domain.Contest{}, errors.Wrap(err, 0)
+		}
+
+		if err := tx.Commit(); err != nil {
+			return domain.Contest{}, errors.Wrap(err, 0)
+		}
+
+		return contest, nil
+	}
+
 	params := database.UpsertContestParams{
-		ID:                 int32(contest.ID),
-		OrganizerID:        int32(contest.Ownership.OrganizerID),
-		ArchivedAt:         makeNullTime(contest.ArchivedAt),
-		SeriesID:           makeNullInt32(int32(contest.SeriesID)),
-		Name:               contest.Name,
-		Description:        makeNullString(contest.Description),
-		Location:           makeNullString(contest.Location),
-		Country:            contest.Country,
-		QualifyingProblems: int32(contest.QualifyingProblems),
-		Finalists:          int32(contest.Finalists),
-		Info:               makeNullString(contest.Info),
-		GracePeriod:        int32(contest.GracePeriod / time.Minute),
-		NameRetentionTime:  int32(contest.NameRetentionTime / time.Minute),
-		Created:            contest.Created,
+		ID:                   int32(contest.ID),
+		OrganizerID:          int32(contest.Ownership.OrganizerID),
+		ArchivedAt:           makeNullTime(contest.ArchivedAt),
+		SeriesID:             makeNullInt32(int32(contest.SeriesID)),
+		Name:                 contest.Name,
+		Description:          makeNullString(contest.Description),
+		Location:             makeNullString(contest.Location),
+		Country:              contest.Country,
+		QualifyingProblems:   int32(contest.QualifyingProblems),
+		Finalists:            int32(contest.Finalists),
+		Info:                 makeNullString(contest.Info),
+		GracePeriod:          int32(contest.GracePeriod / time.Minute),
+		NameRetentionTime:    int32(contest.NameRetentionTime / time.Minute),
+		ScoreboardFreeze:     int32(contest.ScoreboardFreeze / time.Minute),
+		ScoreboardRevealedAt: makeNullTime(contest.ScoreboardRevealedAt),
+		SelfRegistration:     contest.SelfRegistration,
+		Created:              contest.Created,
+		Version:              nextVersion(domain.ResourceID(contest.ID), contest.Version),
 	}
 
 	insertID, err := d.WithTx(tx).UpsertContest(ctx, params)
@@ -158,7 +203,14 @@ func (d *Database) StoreContest(ctx context.Context, tx domain.Transaction, cont
 		contest.ID = domain.ContestID(insertID)
 	}
 
-	return contest, err
+	version, err := d.GetContestVersionForUpdate(ctx, tx, contest.ID)
+	if err != nil {
+		return domain.Contest{}, errors.Wrap(err, 0)
+	}
+
+	contest.Version = version
+
+	return contest, nil
 }
 
 func (d *Database) DeleteContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) error {
@@ -3,6 +3,9 @@ package repository
 import (
 	"database/sql"
 	"fmt"
+	"net"
+	"net/url"
+	"strconv"
 	"time"
 
 	"github.com/climblive/platform/backend/internal/database"
@@ -10,13 +13,29 @@ import (
 	"github.com/go-errors/errors"
 
 	_ "github.com/go-sql-driver/mysql"
+	_ "github.com/jackc/pgx/v5/stdlib"
+	_ "modernc.org/sqlite"
 )
 
 type Database struct {
 	Handle  *sql.DB
+	dialect *dialect
 	queries *database.Queries
 }
 
+func Open(driver, username, password, host string, port int, databaseName string) (*Database, error) {
+	switch driver {
+	case "", "mysql":
+		return NewDatabase(username, password, host, port, databaseName)
+	case "sqlite":
+		return NewSQLiteDatabase(databaseName)
+	case "postgres":
+		return NewPostgresDatabase(username, password, host, port, databaseName)
+	default:
+		return nil, errors.Errorf("unsupported database driver: %s", driver)
+	}
+}
+
 func NewDatabase(username, password, host string, port int, databaseName string) (*Database, error) {
 	dsn := fmt.Sprintf(
 		"%s:%s@tcp(%s:%d)/%s?charset=utf8mb4&parseTime=True&loc=Local",
@@ -31,7 +50,49 @@ func NewDatabase(username, password, host string, port int, databaseName string)
 		return nil, errors.Wrap(err, 0)
 	}
 
-	queries := database.New(db)
+	db.SetMaxIdleConns(10)
+	db.SetMaxOpenConns(100)
+	db.SetConnMaxLifetime(time.Hour)
+
+	return &Database{
+		Handle:  db,
+		dialect: mysqlDialect,
+		queries: database.New(mysqlDialect.wrap(db)),
+	}, nil
+}
+
+func NewSQLiteDatabase(path string) (*Database, error) {
+	dsn := fmt.Sprintf(
+		"file:%s?_pragma=foreign_keys(1)&_pragma=busy_timeout(5000)&_pragma=journal_mode(WAL)&_time_format=sqlite&_txlock=immediate",
+		path)
+
+	db, err := sql.Open("sqlite", dsn)
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	db.SetMaxIdleConns(10)
+	db.SetConnMaxLifetime(time.Hour)
+
+	return &Database{
+		Handle:  db,
+		dialect: sqliteDialect,
+		queries: database.New(sqliteDialect.wrap(db)),
+	}, nil
+}
+
+func NewPostgresDatabase(username, password, host string, port int, databaseName string) (*Database, error) {
+	dsn := url.URL{
+		Scheme: "postgres",
+		User:   url.UserPassword(username, password),
+		Host:   net.JoinHostPort(host, strconv.Itoa(port)),
+		Path:   databaseName,
+	}
+
+	db, err := sql.Open("pgx", dsn.String())
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
 
 	db.SetMaxIdleConns(10)
 	db.SetMaxOpenConns(100)
@@ -39,7 +100,8 @@ func NewDatabase(username, password, host string, port int, databaseName string)
 
 	return &Database{
 		Handle:  db,
-		queries: queries,
+		dialect: postgresDialect,
+		queries: database.New(postgresDialect.wrap(db)),
 	}, nil
 }
 
@@ -57,7 +119,7 @@ func (d *Database) Begin() (domain.Transaction, error) {
 func (d *Database) WithTx(tx domain.Transaction) *database.Queries {
 	transaction, ok := tx.(*transaction)
 	if ok {
-		return d.queries.WithTx(transaction.tx)
+		return database.New(d.dialect.wrap(transaction.tx))
 	} else {
 		return d.queries
 	}
@@ -0,0 +1,172 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/climblive/platform/backend/internal/database"
+)
+
+//go:embed sqlite/queries.sql
+var sqliteQueries string
+
+//go:embed postgres/queries.sql
+var postgresQueries string
+
+type dialect struct {
+	system  string
+	queries map[string]dialectQuery
+}
+
+type dialectQuery struct {
+	text      string
+	returning bool
+}
+
+var mysqlDialect = &dialect{
+	system:  "mysql",
+	queries: nil,
+}
+
+var sqliteDialect = newDialect("sqlite", sqliteQueries)
+
+var postgresDialect = newDialect("postgresql", postgresQueries)
+
+func newDialect(system, source string) *dialect {
+	queries := make(map[string]dialectQuery)
+
+	for _, chunk := range strings.Split(source, "-- name: ")[1:] {
+		text := "-- name: " + strings.TrimSuffix(strings.TrimSpace(chunk), ";")
+
+		queries[queryName(text)] = dialectQuery{
+			text:      text,
+			returning: strings.HasSuffix(text, "RETURNING id"),
+		}
+	}
+
+	for name := range queryNames() {
+		if _, found := queries[name]; !found {
+			panic(fmt.Sprintf("%s dialect is missing query %s", system, name))
+		}
+	}
+
+	return &dialect{
+		system:  system,
+		queries: queries,
+	}
+}
+
+func queryNames() map[string]struct{} {
+	names := make(map[string]struct{})
+
+	queries := reflect.TypeFor[*database.Queries]()
+	for i := range queries.NumMethod() {
+		if name := queries.Method(i).Name; name != "WithTx" {
+			names[name] = struct{}{}
+		}
+	}
+
+	return names
+}
+
+func (d *dialect) wrap(db database.DBTX) database.DBTX {
+	if d.queries != nil {
+		db = &dialectDBTX{db: db, dialect: d}
+	}
+
+	return &tracedDBTX{db: db, system: d.system}
+}
+
+// dialectDBTX substitutes the MySQL query text generated by sqlc with the
+// dialect specific query of the same name.
+type dialectDBTX struct {
+	db      database.DBTX
+	dialect *dialect
+}
+
+func (t *dialectDBTX) translate(query string, args []any) (dialectQuery, []any) {
+	translated, found := t.dialect.queries[queryName(query)]
+	if !found {
+		panic(fmt.Sprintf("%s dialect has no query %s", t.dialect.system, queryName(query)))
+	}
+
+	converted := make([]any, len(args))
+	for i, arg := range args {
+		switch v := arg.(type) {
+		case time.Time:
+			converted[i] = v.UTC()
+		case sql.NullTime:
+			converted[i] = sql.NullTime{Time: v.Time.UTC(), Valid: v.Valid}
+		default:
+			converted[i] = arg
+		}
+	}
+
+	return translated, converted
+}
+
+func (t *dialectDBTX) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	translated, args := t.translate(query, args)
+
+	if translated.returning {
+		rows, err := t.db.QueryContext(ctx, translated.text, args...)
+		if err != nil {
+			return nil, err
+		}
+		defer rows.Close()
+
+		var result returningResult
+
+		for rows.Next() {
+			if err := rows.Scan(&result.lastInsertID); err != nil {
+				return nil, err
+			}
+
+			result.rowsAffected++
+		}
+
+		if err := rows.Err(); err != nil {
+			return nil, err
+		}
+
+		return result, nil
+	}
+
+	return t.db.ExecContext(ctx, translated.text, args...)
+}
+
+func (t *dialectDBTX) PrepareContext(ctx context.Context, query string) (*sql.Stmt, error) {
+	translated, _ := t.translate(query, nil)
+
+	return t.db.PrepareContext(ctx, translated.text)
+}
+
+func (t *dialectDBTX) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	translated, args := t.translate(query, args)
+
+	return t.db.QueryContext(ctx, translated.text, args...)
+}
+
+func (t *dialectDBTX) QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row {
+	translated, args := t.translate(query, args)
+
+	return t.db.QueryRowContext(ctx, translated.text, args...)
+}
+
+type returningResult struct {
+	lastInsertID int64
+	rowsAffected int64
+}
+
+func (r returningResult) LastInsertId() (int64, error) {
+	return r.lastInsertID, nil
+}
+
+func (r returningResult) RowsAffected() (int64, error) {
+	return r.rowsAffected, nil
+}
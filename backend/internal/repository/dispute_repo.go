@@ -0,0 +1,94 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/climblive/platform/backend/internal/database"
+	"github.com/climblive/platform/backend/internal/domain"
+	"github.com/go-errors/errors"
+)
+
+func (d *Database) GetTickDispute(ctx context.Context, tx domain.Transaction, disputeID domain.TickDisputeID) (domain.TickDispute, error) {
+	record, err := d.WithTx(tx).GetTickDispute(ctx, int32(disputeID))
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return domain.TickDispute{}, errors.Wrap(domain.ErrNotFound, 0)
+	case err != nil:
+		return domain.TickDispute{}, errors.Wrap(err, 0)
+	}
+
+	return tickDisputeToDomain(record.TickDispute), nil
+}
+
+func (d *Database) GetTickDisputesByContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) ([]domain.TickDispute, error) {
+	records, err := d.WithTx(tx).GetTickDisputesByContest(ctx, int32(contestID))
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	disputes := make([]domain.TickDispute, 0)
+
+	for _, record := range records {
+		disputes = append(disputes, tickDisputeToDomain(record.TickDispute))
+	}
+
+	return disputes, nil
+}
+
+func (d *Database) GetTickDisputesByContender(ctx context.Context, tx domain.Transaction, contenderID domain.ContenderID) ([]domain.TickDispute, error) {
+	records, err := d.WithTx(tx).GetTickDisputesByContender(ctx, int32(contenderID))
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	disputes := make([]domain.TickDispute, 0)
+
+	for _, record := range records {
+		disputes = append(disputes, tickDisputeToDomain(record.TickDispute))
+	}
+
+	return disputes, nil
+}
+
+func (d *Database) StoreTickDispute(ctx context.Context, tx domain.Transaction, dispute domain.TickDispute) (domain.TickDispute, error) {
+	params := database.UpsertTickDisputeParams{
+		ID:            int32(dispute.ID),
+		OrganizerID:   int32(dispute.Ownership.OrganizerID),
+		ContestID:     int32(dispute.ContestID),
+		ContenderID:   int32(dispute.ContenderID),
+		ProblemID:     int32(dispute.ProblemID),
+		Status:        string(dispute.Status),
+		Comment:       dispute.Comment,
+		Response:      makeNullString(dispute.Response),
+		Zone1:         dispute.Zone1,
+		AttemptsZone1: int32(dispute.AttemptsZone1),
+		Zone2:         dispute.Zone2,
+		AttemptsZone2: int32(dispute.AttemptsZone2),
+		Top:           dispute.Top,
+		AttemptsTop:   int32(dispute.AttemptsTop),
+		Created:       dispute.Created,
+		Resolved:      makeNullTime(dispute.Resolved),
+		ResolvedBy:    makeNullString(dispute.ResolvedBy),
+	}
+
+	insertID, err := d.WithTx(tx).UpsertTickDispute(ctx, params)
+	if err != nil {
+		return domain.TickDispute{}, errors.Wrap(err, 0)
+	}
+
+	if insertID != 0 {
+		dispute.ID = domain.TickDisputeID(insertID)
+	}
+
+	return dispute, nil
+}
+
+func (d *Database) DeleteTickDisputesByContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) error {
+	err := d.WithTx(tx).DeleteTickDisputesByContest(ctx, int32(contestID))
+	if err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	return nil
+}
@@ -2,10 +2,12 @@ package repository
 
 import (
 	"database/sql"
+	"encoding/json"
 	"time"
 
 	"github.com/climblive/platform/backend/internal/database"
 	"github.com/climblive/platform/backend/internal/domain"
+	"github.com/go-errors/errors"
 	"github.com/google/uuid"
 )
 
@@ -19,6 +21,7 @@ func contenderToDomain(record database.GetContenderRow) domain.Contender {
 		Score:               nil,
 		ContestID:           domain.ContestID(record.Contender.ContestID),
 		CompClassID:         domain.CompClassID(record.Contender.ClassID.Int32),
+		TeamID:              domain.TeamID(record.Contender.TeamID.Int32),
 		RegistrationCode:    record.Contender.RegistrationCode,
 		Name:                record.Contender.Name.String,
 		Entered:             record.Contender.Entered.Time,
@@ -26,6 +29,9 @@ func contenderToDomain(record database.GetContenderRow) domain.Contender {
 		Disqualified:        record.Contender.Disqualified,
 		ScrubbedAt:          record.Contender.ScrubbedAt.Time,
 		ScrubBefore:         record.Contender.ScrubBefore.Time,
+		WaitlistCompClassID: domain.CompClassID(record.Contender.WaitlistClassID.Int32),
+		WaitlistedAt:        record.Contender.WaitlistedAt.Time,
+		Version:             int(record.Contender.Version),
 	}
 
 	if record.ContenderID.Valid {
@@ -56,6 +62,7 @@ func compClassToDomain(record database.CompClass) domain.CompClass {
 		Description: record.Description.String,
 		TimeBegin:   record.TimeBegin,
 		TimeEnd:     record.TimeEnd,
+		Capacity:    int(record.Capacity),
 	}
 }
 
@@ -80,13 +87,29 @@ func contestToDomain(record database.Contest) domain.Contest {
 		Info:                 record.Info.String,
 		GracePeriod:          time.Duration(record.GracePeriod) * time.Minute,
 		NameRetentionTime:    time.Duration(record.NameRetentionTime) * time.Minute,
+		ScoreboardFreeze:     time.Duration(record.ScoreboardFreeze) * time.Minute,
+		ScoreboardRevealedAt: record.ScoreboardRevealedAt.Time,
+		SelfRegistration:     record.SelfRegistration,
 		Created:              record.Created,
+		Version:              int(record.Version),
 	}
 
 	return contest
 }
 
-func problemToDomain(record database.Problem) domain.Problem {
+func problemToDomain(record database.Problem, compClassIDs []domain.CompClassID) (domain.Problem, error) {
+	tags := make([]string, 0)
+
+	if len(record.Tags) > 0 {
+		if err := json.Unmarshal(record.Tags, &tags); err != nil {
+			return domain.Problem{}, errors.Wrap(err, 0)
+		}
+	}
+
+	if compClassIDs == nil {
+		compClassIDs = make([]domain.CompClassID, 0)
+	}
+
 	return domain.Problem{
 		ID: domain.ProblemID(record.ID),
 		Ownership: domain.OwnershipData{
@@ -94,19 +117,76 @@ func problemToDomain(record database.Problem) domain.Problem {
 			ContenderID: nil,
 		},
 		ContestID:          domain.ContestID(record.ContestID),
+		RoundID:            domain.RoundID(record.RoundID.Int32),
 		Number:             int(record.Number),
 		HoldColorPrimary:   record.HoldColorPrimary,
 		HoldColorSecondary: record.HoldColorSecondary.String,
 		Description:        record.Description.String,
+		Sector:             record.Sector.String,
+		Tags:               tags,
+		CompClassIDs:       compClassIDs,
 		Zone1Enabled:       record.Zone1Enabled,
 		Zone2Enabled:       record.Zone2Enabled,
+		Version:            int(record.Version),
 		ProblemValue: domain.ProblemValue{
 			PointsZone1: int(record.PointsZone1.Int32),
 			PointsZone2: int(record.PointsZone2.Int32),
 			PointsTop:   int(record.PointsTop),
 			FlashBonus:  int(record.FlashBonus.Int32),
 		},
+	}, nil
+}
+
+func roundToDomain(record database.Round) domain.Round {
+	return domain.Round{
+		ID: domain.RoundID(record.ID),
+		Ownership: domain.OwnershipData{
+			OrganizerID: domain.OrganizerID(record.OrganizerID),
+			ContenderID: nil,
+		},
+		ContestID:          domain.ContestID(record.ContestID),
+		Number:             int(record.Number),
+		Name:               record.Name,
+		QualifyingProblems: int(record.QualifyingProblems),
+		Finalists:          int(record.Finalists),
+		TimeBegin:          record.TimeBegin.Time,
+		TimeEnd:            record.TimeEnd.Time,
+	}
+}
+
+func teamToDomain(record database.Team) domain.Team {
+	return domain.Team{
+		ID: domain.TeamID(record.ID),
+		Ownership: domain.OwnershipData{
+			OrganizerID: domain.OrganizerID(record.OrganizerID),
+			ContenderID: nil,
+		},
+		ContestID:      domain.ContestID(record.ContestID),
+		Name:           record.Name,
+		CountedMembers: int(record.CountedMembers),
+	}
+}
+
+func startListEntryToDomain(record database.RoundContender) domain.StartListEntry {
+	entry := domain.StartListEntry{
+		RoundID:           domain.RoundID(record.RoundID),
+		ContenderID:       domain.ContenderID(record.ContenderID),
+		PreviousPlacement: int(record.PreviousPlacement),
+		Score:             nil,
 	}
+
+	if record.Timestamp.Valid {
+		entry.Score = &domain.Score{
+			Timestamp:   record.Timestamp.Time,
+			ContenderID: domain.ContenderID(record.ContenderID),
+			Score:       int(record.Score.Int32),
+			Placement:   int(record.Placement.Int32),
+			Finalist:    record.Finalist.Bool,
+			RankOrder:   int(record.RankOrder.Int32),
+		}
+	}
+
+	return entry
 }
 
 func tickToDomain(record database.Tick) domain.Tick {
@@ -128,6 +208,53 @@ func tickToDomain(record database.Tick) domain.Tick {
 	}
 }
 
+func tickRevisionToDomain(record database.TickRevision) domain.TickRevision {
+	return domain.TickRevision{
+		ID:             domain.TickRevisionID(record.ID),
+		ContestID:      domain.ContestID(record.ContestID),
+		ContenderID:    domain.ContenderID(record.ContenderID),
+		ProblemID:      domain.ProblemID(record.ProblemID),
+		TickID:         domain.TickID(record.TickID),
+		Action:         domain.TickRevisionAction(record.Action),
+		Timestamp:      record.Timestamp,
+		RecordedAt:     record.RecordedAt,
+		ActorRole:      domain.AuthRole(record.ActorRole),
+		ActorUsername:  record.ActorUsername.String,
+		IdempotencyKey: record.IdempotencyKey.String,
+		Zone1:          record.Zone1,
+		AttemptsZone1:  int(record.AttemptsZone1),
+		Zone2:          record.Zone2,
+		AttemptsZone2:  int(record.AttemptsZone2),
+		Top:            record.Top,
+		AttemptsTop:    int(record.AttemptsTop),
+	}
+}
+
+func tickDisputeToDomain(record database.TickDispute) domain.TickDispute {
+	return domain.TickDispute{
+		ID: domain.TickDisputeID(record.ID),
+		Ownership: domain.OwnershipData{
+			OrganizerID: domain.OrganizerID(record.OrganizerID),
+			ContenderID: nillableIntToResourceID[domain.ContenderID](&record.ContenderID),
+		},
+		ContestID:     domain.ContestID(record.ContestID),
+		ContenderID:   domain.ContenderID(record.ContenderID),
+		ProblemID:     domain.ProblemID(record.ProblemID),
+		Status:        domain.TickDisputeStatus(record.Status),
+		Comment:       record.Comment,
+		Response:      record.Response.String,
+		Zone1:         record.Zone1,
+		AttemptsZone1: int(record.AttemptsZone1),
+		Zone2:         record.Zone2,
+		AttemptsZone2: int(record.AttemptsZone2),
+		Top:           record.Top,
+		AttemptsTop:   int(record.AttemptsTop),
+		Created:       record.Created,
+		Resolved:      record.Resolved.Time,
+		ResolvedBy:    record.ResolvedBy.String,
+	}
+}
+
 func userToDomain(record database.User) domain.User {
 	return domain.User{
 		ID:         domain.UserID(record.ID),
@@ -148,18 +275,40 @@ func organizerToDomain(record database.Organizer) domain.Organizer {
 	}
 }
 
-func raffleToDomain(record database.Raffle) domain.Raffle {
+func raffleToDomain(record database.Raffle) (domain.Raffle, error) {
+	rules, err := raffleRulesToDomain(record.Rules)
+	if err != nil {
+		return domain.Raffle{}, errors.Wrap(err, 0)
+	}
+
 	return domain.Raffle{
 		ID: domain.RaffleID(record.ID),
 		Ownership: domain.OwnershipData{
 			OrganizerID: domain.OrganizerID(record.OrganizerID),
 			ContenderID: nil,
 		},
-		ContestID: domain.ContestID(record.ContestID),
-	}
+		ContestID:      domain.ContestID(record.ContestID),
+		Rules:          rules,
+		Seed:           record.Seed.String,
+		SeedCommitment: record.SeedCommitment.String,
+		SeedRevealedAt: record.SeedRevealedAt.Time,
+	}, nil
 }
 
-func raffleWinnerToDomain(record database.RaffleWinner, name string, scrubbedAt time.Time) domain.RaffleWinner {
+func raffleWinnerToDomain(record database.RaffleWinner, name string, scrubbedAt time.Time, prizeName string) (domain.RaffleWinner, error) {
+	rules, err := raffleRulesToDomain(record.Rules)
+	if err != nil {
+		return domain.RaffleWinner{}, errors.Wrap(err, 0)
+	}
+
+	var draw *domain.RaffleDraw
+
+	if len(record.Draw) > 0 {
+		if err := json.Unmarshal(record.Draw, &draw); err != nil {
+			return domain.RaffleWinner{}, errors.Wrap(err, 0)
+		}
+	}
+
 	return domain.RaffleWinner{
 		ID: domain.RaffleWinnerID(record.ID),
 		Ownership: domain.OwnershipData{
@@ -170,10 +319,41 @@ func raffleWinnerToDomain(record database.RaffleWinner, name string, scrubbedAt
 		ContenderID:         domain.ContenderID(record.ContenderID),
 		ContenderName:       name,
 		ContenderScrubbedAt: scrubbedAt,
+		PrizeID:             domain.RafflePrizeID(record.PrizeID.Int32),
+		PrizeName:           prizeName,
 		Timestamp:           record.Timestamp,
+		Rules:               rules,
+		ClaimedAt:           record.ClaimedAt.Time,
+		VoidedAt:            record.VoidedAt.Time,
+		Draw:                draw,
+	}, nil
+}
+
+func rafflePrizeToDomain(record database.RafflePrize) domain.RafflePrize {
+	return domain.RafflePrize{
+		ID: domain.RafflePrizeID(record.ID),
+		Ownership: domain.OwnershipData{
+			OrganizerID: domain.OrganizerID(record.OrganizerID),
+			ContenderID: nil,
+		},
+		RaffleID: domain.RaffleID(record.RaffleID),
+		Name:     record.Name,
+		Quantity: int(record.Quantity),
 	}
 }
 
+func raffleRulesToDomain(data json.RawMessage) (domain.RaffleRules, error) {
+	var rules domain.RaffleRules
+
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, &rules); err != nil {
+			return domain.RaffleRules{}, errors.Wrap(err, 0)
+		}
+	}
+
+	return rules, nil
+}
+
 func organizerInviteToDomain(record database.OrganizerInvite, organizerName string) domain.OrganizerInvite {
 	return domain.OrganizerInvite{
 		ID:            domain.OrganizerInviteID(uuid.MustParse(record.ID)),
@@ -183,6 +363,36 @@ func organizerInviteToDomain(record database.OrganizerInvite, organizerName stri
 	}
 }
 
+func retentionPolicyToDomain(record database.RetentionPolicy) domain.RetentionPolicy {
+	return domain.RetentionPolicy{
+		ID: domain.RetentionPolicyID(record.ID),
+		Ownership: domain.OwnershipData{
+			OrganizerID: domain.OrganizerID(record.OrganizerID),
+			ContenderID: nil,
+		},
+		Target:          domain.RetentionPolicyTarget(record.Target),
+		RetentionPeriod: time.Duration(record.RetentionPeriod) * time.Minute,
+		Enabled:         record.Enabled,
+		Created:         record.Created,
+	}
+}
+
+func retentionPolicyExecutionToDomain(record database.RetentionPolicyExecution) domain.RetentionPolicyExecution {
+	return domain.RetentionPolicyExecution{
+		ID: domain.RetentionPolicyExecutionID(record.ID),
+		Ownership: domain.OwnershipData{
+			OrganizerID: domain.OrganizerID(record.OrganizerID),
+			ContenderID: nil,
+		},
+		PolicyID:   domain.RetentionPolicyID(record.RetentionPolicyID),
+		Timestamp:  record.Timestamp,
+		Contests:   int(record.Contests),
+		Contenders: int(record.Contenders),
+		Ticks:      int(record.Ticks),
+		Error:      record.Error.String,
+	}
+}
+
 func makeNullString(value string) sql.NullString {
 	if value == "" {
 		return sql.NullString{}
@@ -215,3 +425,22 @@ func makeNullTime(value time.Time) sql.NullTime {
 		Time:  value,
 	}
 }
+
+func makeNullBool(value *bool) sql.NullBool {
+	if value == nil {
+		return sql.NullBool{}
+	}
+
+	return sql.NullBool{
+		Valid: true,
+		Bool:  *value,
+	}
+}
+
+func nextVersion(id domain.ResourceID, version int) int32 {
+	if id == 0 {
+		return 1
+	}
+
+	return int32(version + 1)
+}
@@ -0,0 +1,779 @@
+-- name: GetContender :one
+SELECT contender.id, contender.organizer_id, contender.contest_id, contender.registration_code, contender.name, contender.class_id, contender.team_id, contender.entered, contender.disqualified, contender.withdrawn_from_finals, contender.scrubbed_at, contender.scrub_before, contender.waitlist_class_id, contender.waitlisted_at, contender.version, score.contender_id, score.timestamp, score.score, score.placement, score.finalist, score.rank_order
+FROM contender
+LEFT JOIN score ON score.contender_id = id
+WHERE id = $1;
+
+-- name: GetContenderByCode :one
+SELECT contender.id, contender.organizer_id, contender.contest_id, contender.registration_code, contender.name, contender.class_id, contender.team_id, contender.entered, contender.disqualified, contender.withdrawn_from_finals, contender.scrubbed_at, contender.scrub_before, contender.waitlist_class_id, contender.waitlisted_at, contender.version, score.contender_id, score.timestamp, score.score, score.placement, score.finalist, score.rank_order
+FROM contender
+LEFT JOIN score ON score.contender_id = id
+WHERE registration_code = $1;
+
+-- name: GetContendersByCompClass :many
+SELECT contender.id, contender.organizer_id, contender.contest_id, contender.registration_code, contender.name, contender.class_id, contender.team_id, contender.entered, contender.disqualified, contender.withdrawn_from_finals, contender.scrubbed_at, contender.scrub_before, contender.waitlist_class_id, contender.waitlisted_at, contender.version, score.contender_id, score.timestamp, score.score, score.placement, score.finalist, score.rank_order
+FROM contender
+LEFT JOIN score ON score.contender_id = id
+WHERE class_id = $1;
+
+-- name: GetWaitlistedContenders :many
+SELECT contender.id, contender.organizer_id, contender.contest_id, contender.registration_code, contender.name, contender.class_id, contender.team_id, contender.entered, contender.disqualified, contender.withdrawn_from_finals, contender.scrubbed_at, contender.scrub_before, contender.waitlist_class_id, contender.waitlisted_at, contender.version, score.contender_id, score.timestamp, score.score, score.placement, score.finalist, score.rank_order
+FROM contender
+LEFT JOIN score ON score.contender_id = id
+WHERE waitlist_class_id = $1
+ORDER BY waitlisted_at, id;
+
+-- name: GetContendersByTeam :many
+SELECT contender.id, contender.organizer_id, contender.contest_id, contender.registration_code, contender.name, contender.class_id, contender.team_id, contender.entered, contender.disqualified, contender.withdrawn_from_finals, contender.scrubbed_at, contender.scrub_before, contender.waitlist_class_id, contender.waitlisted_at, contender.version, score.contender_id, score.timestamp, score.score, score.placement, score.finalist, score.rank_order
+FROM contender
+LEFT JOIN score ON score.contender_id = id
+WHERE team_id = $1;
+
+-- name: GetContendersByContest :many
+SELECT contender.id, contender.organizer_id, contender.contest_id, contender.registration_code, contender.name, contender.class_id, contender.team_id, contender.entered, contender.disqualified, contender.withdrawn_from_finals, contender.scrubbed_at, contender.scrub_before, contender.waitlist_class_id, contender.waitlisted_at, contender.version, score.contender_id, score.timestamp, score.score, score.placement, score.finalist, score.rank_order
+FROM contender
+LEFT JOIN score ON score.contender_id = id
+WHERE contest_id = $1;
+
+-- name: GetContendersByContestFiltered :many
+SELECT contender.id, contender.organizer_id, contender.contest_id, contender.registration_code, contender.name, contender.class_id, contender.team_id, contender.entered, contender.disqualified, contender.withdrawn_from_finals, contender.scrubbed_at, contender.scrub_before, contender.waitlist_class_id, contender.waitlisted_at, contender.version, score.contender_id, score.timestamp, score.score, score.placement, score.finalist, score.rank_order
+FROM contender
+LEFT JOIN score ON score.contender_id = id
+WHERE
+	contest_id = $1
+	AND ($2::integer IS NULL OR class_id = $3)
+	AND ($4::boolean IS NULL OR CASE WHEN $5 THEN entered IS NOT NULL ELSE entered IS NULL END)
+	AND ($6::boolean IS NULL OR disqualified = $7)
+	AND ($8 = 0 OR CASE WHEN $9 THEN id < $10 ELSE id > $11 END)
+ORDER BY CASE WHEN $12 THEN -id ELSE id END
+LIMIT $13;
+
+-- name: DeleteContender :exec
+DELETE FROM contender
+WHERE id = $1;
+
+-- name: CountContenders :one
+SELECT COUNT(*)
+FROM contender
+WHERE contest_id = $1;
+
+-- name: GetContenderVersionForUpdate :one
+SELECT version
+FROM contender
+WHERE id = $1
+FOR UPDATE;
+
+-- name: UpsertContender :execlastid
+INSERT INTO 
+	contender (id, organizer_id, contest_id, registration_code, name, class_id, team_id, entered, disqualified, withdrawn_from_finals, scrubbed_at, scrub_before, waitlist_class_id, waitlisted_at, version)
+VALUES 
+	(COALESCE(NULLIF($1, 0), nextval(pg_get_serial_sequence('contender', 'id'))), $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
+ON CONFLICT (id) DO UPDATE SET
+    organizer_id = EXCLUDED.organizer_id,
+    contest_id = EXCLUDED.contest_id,
+    registration_code = EXCLUDED.registration_code,
+    name = EXCLUDED.name,
+    class_id = EXCLUDED.class_id,
+    team_id = EXCLUDED.team_id,
+    entered = EXCLUDED.entered,
+    disqualified = EXCLUDED.disqualified,
+    withdrawn_from_finals = EXCLUDED.withdrawn_from_finals,
+    scrubbed_at = EXCLUDED.scrubbed_at,
+    scrub_before = EXCLUDED.scrub_before,
+    waitlist_class_id = EXCLUDED.waitlist_class_id,
+    waitlisted_at = EXCLUDED.waitlisted_at,
+    version = contender.version + 1
+RETURNING id;
+
+-- name: UpsertScore :exec
+INSERT INTO
+    score (contender_id, timestamp, score, placement, finalist, rank_order)
+VALUES
+    ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (contender_id) DO UPDATE SET
+    timestamp = EXCLUDED.timestamp,
+    score = EXCLUDED.score,
+    placement = EXCLUDED.placement,
+    finalist = EXCLUDED.finalist,
+    rank_order = EXCLUDED.rank_order;
+
+-- name: UpsertPublishedScore :exec
+INSERT INTO
+    published_score (contender_id, timestamp, score, placement, finalist, rank_order)
+VALUES
+    ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (contender_id) DO UPDATE SET
+    timestamp = EXCLUDED.timestamp,
+    score = EXCLUDED.score,
+    placement = EXCLUDED.placement,
+    finalist = EXCLUDED.finalist,
+    rank_order = EXCLUDED.rank_order;
+
+-- name: GetPublishedScoresByContest :many
+SELECT published_score.contender_id, published_score.timestamp, published_score.score, published_score.placement, published_score.finalist, published_score.rank_order
+FROM published_score
+JOIN contender ON contender.id = published_score.contender_id
+WHERE contender.contest_id = $1;
+
+-- name: GetCompClass :one
+SELECT comp_class.id, comp_class.organizer_id, comp_class.contest_id, comp_class.name, comp_class.description, comp_class.color, comp_class.time_begin, comp_class.time_end, comp_class.capacity
+FROM comp_class
+WHERE id = $1;
+
+-- name: GetCompClassesByContest :many
+SELECT comp_class.id, comp_class.organizer_id, comp_class.contest_id, comp_class.name, comp_class.description, comp_class.color, comp_class.time_begin, comp_class.time_end, comp_class.capacity
+FROM comp_class
+WHERE contest_id = $1;
+
+-- name: DeleteCompClass :exec
+DELETE FROM comp_class
+WHERE id = $1;
+
+-- name: UpsertCompClass :execlastid
+INSERT INTO 
+	comp_class (id, organizer_id, contest_id, name, description, color, time_begin, time_end, capacity)
+VALUES 
+	(COALESCE(NULLIF($1, 0), nextval(pg_get_serial_sequence('comp_class', 'id'))), $2, $3, $4, $5, $6, $7, $8, $9)
+ON CONFLICT (id) DO UPDATE SET
+    organizer_id = EXCLUDED.organizer_id,
+    contest_id = EXCLUDED.contest_id,
+    name = EXCLUDED.name,
+    description = EXCLUDED.description,
+    color = EXCLUDED.color,
+    time_begin = EXCLUDED.time_begin,
+    time_end = EXCLUDED.time_end,
+    capacity = EXCLUDED.capacity
+RETURNING id;
+
+-- name: GetContest :one
+SELECT contest.id, contest.organizer_id, contest.archived_at, contest.series_id, contest.name, contest.description, contest.location, contest.country, contest.qualifying_problems, contest.finalists, contest.info, contest.grace_period, contest.name_retention_time, contest.scoreboard_freeze, contest.scoreboard_revealed_at, contest.self_registration, contest.created, contest.version, MIN(cc.time_begin) AS time_begin, MAX(cc.time_end) AS time_end, COUNT(DISTINCT CASE WHEN c.entered IS NOT NULL THEN c.id END) AS registered_contenders
+FROM contest
+LEFT JOIN comp_class cc ON cc.contest_id = contest.id
+LEFT JOIN contender c ON c.contest_id = contest.id
+WHERE contest.id = $1
+GROUP BY contest.id;
+
+-- name: GetContests :many
+SELECT contest.id, contest.organizer_id, contest.archived_at, contest.series_id, contest.name, contest.description, contest.location, contest.country, contest.qualifying_problems, contest.finalists, contest.info, contest.grace_period, contest.name_retention_time, contest.scoreboard_freeze, contest.scoreboard_revealed_at, contest.self_registration, contest.created, contest.version, MIN(cc.time_begin) AS time_begin, MAX(cc.time_end) AS time_end, COUNT(DISTINCT CASE WHEN c.entered IS NOT NULL THEN c.id END) AS registered_contenders
+FROM contest
+LEFT JOIN comp_class cc ON cc.contest_id = contest.id
+LEFT JOIN contender c ON c.contest_id = contest.id
+WHERE
+	($1::varchar IS NULL OR contest.country = $2)
+	AND ($3::boolean IS NULL OR CASE WHEN $4 THEN contest.archived_at IS NOT NULL ELSE contest.archived_at IS NULL END)
+	AND ($5 = 0 OR CASE WHEN $6 THEN contest.id < $7 ELSE contest.id > $8 END)
+GROUP BY contest.id
+ORDER BY CASE WHEN $9 THEN -contest.id ELSE contest.id END
+LIMIT $10;
+
+-- name: GetContestVersionForUpdate :one
+SELECT version
+FROM contest
+WHERE id = $1
+FOR UPDATE;
+
+-- name: UpsertContest :execlastid
+INSERT INTO 
+	contest (id, organizer_id, archived_at, series_id, name, description, location, country, qualifying_problems, finalists, info, grace_period, name_retention_time, scoreboard_freeze, scoreboard_revealed_at, self_registration, created, version)
+VALUES 
+	(COALESCE(NULLIF($1, 0), nextval(pg_get_serial_sequence('contest', 'id'))), $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18)
+ON CONFLICT (id) DO UPDATE SET
+    organizer_id = EXCLUDED.organizer_id,
+    archived_at = EXCLUDED.archived_at,
+    series_id = EXCLUDED.series_id,
+    name = EXCLUDED.name,
+    description = EXCLUDED.description,
+    location = EXCLUDED.location,
+    country = EXCLUDED.country,
+    qualifying_problems = EXCLUDED.qualifying_problems,
+    finalists = EXCLUDED.finalists,
+    info = EXCLUDED.info,
+    grace_period = EXCLUDED.grace_period,
+    name_retention_time = EXCLUDED.name_retention_time,
+    scoreboard_freeze = EXCLUDED.scoreboard_freeze,
+    scoreboard_revealed_at = EXCLUDED.scoreboard_revealed_at,
+    self_registration = EXCLUDED.self_registration,
+    created = EXCLUDED.created,
+    version = contest.version + 1
+RETURNING id;
+
+-- name: DeleteContest :exec
+DELETE FROM contest
+WHERE id = $1;
+
+-- name: GetContestsByOrganizer :many
+SELECT contest.id, contest.organizer_id, contest.archived_at, contest.series_id, contest.name, contest.description, contest.location, contest.country, contest.qualifying_problems, contest.finalists, contest.info, contest.grace_period, contest.name_retention_time, contest.scoreboard_freeze, contest.scoreboard_revealed_at, contest.self_registration, contest.created, contest.version, MIN(cc.time_begin) AS time_begin, MAX(cc.time_end) AS time_end, COUNT(DISTINCT CASE WHEN c.entered IS NOT NULL THEN c.id END) AS registered_contenders
+FROM contest
+LEFT JOIN comp_class cc ON cc.contest_id = contest.id
+LEFT JOIN contender c ON c.contest_id = contest.id
+WHERE contest.organizer_id = $1
+GROUP BY contest.id;
+
+-- name: GetContestsCurrentlyRunningOrByStartTime :many
+SELECT
+	id, organizer_id, archived_at, series_id, name, description, location, country, qualifying_problems, finalists, info, grace_period, name_retention_time, scoreboard_freeze, scoreboard_revealed_at, self_registration, created, version, time_begin, time_end
+FROM (
+    SELECT contest.id, contest.organizer_id, contest.archived_at, contest.series_id, contest.name, contest.description, contest.location, contest.country, contest.qualifying_problems, contest.finalists, contest.info, contest.grace_period, contest.name_retention_time, contest.scoreboard_freeze, contest.scoreboard_revealed_at, contest.self_registration, contest.created, contest.version, MIN(cc.time_begin) AS time_begin, MAX(cc.time_end) AS time_end
+    FROM contest
+    JOIN comp_class cc ON cc.contest_id = contest.id
+    WHERE archived_at IS NULL
+    GROUP BY contest.id) AS sub
+WHERE
+    NOW() BETWEEN time_begin AND time_end + make_interval(mins => grace_period + 15)
+	OR time_begin BETWEEN $1::timestamptz AND $2::timestamptz;
+
+-- name: GetProblem :one
+SELECT problem.id, problem.organizer_id, problem.contest_id, problem.round_id, problem.number, problem.hold_color_primary, problem.hold_color_secondary, problem.zone_1_enabled, problem.zone_2_enabled, problem.description, problem.sector, problem.tags, problem.points_zone_1, problem.points_zone_2, problem.points_top, problem.flash_bonus, problem.version
+FROM problem
+WHERE id = $1;
+
+-- name: GetProblemByNumber :one
+SELECT problem.id, problem.organizer_id, problem.contest_id, problem.round_id, problem.number, problem.hold_color_primary, problem.hold_color_secondary, problem.zone_1_enabled, problem.zone_2_enabled, problem.description, problem.sector, problem.tags, problem.points_zone_1, problem.points_zone_2, problem.points_top, problem.flash_bonus, problem.version
+FROM problem
+WHERE contest_id = $1 AND number = $2;
+
+-- name: GetProblemsByContest :many
+SELECT problem.id, problem.organizer_id, problem.contest_id, problem.round_id, problem.number, problem.hold_color_primary, problem.hold_color_secondary, problem.zone_1_enabled, problem.zone_2_enabled, problem.description, problem.sector, problem.tags, problem.points_zone_1, problem.points_zone_2, problem.points_top, problem.flash_bonus, problem.version
+FROM problem
+WHERE contest_id = $1;
+
+-- name: DeleteProblem :exec
+DELETE FROM problem
+WHERE id = $1;
+
+-- name: GetProblemVersionForUpdate :one
+SELECT version
+FROM problem
+WHERE id = $1
+FOR UPDATE;
+
+-- name: UpsertProblem :execlastid
+INSERT INTO 
+	problem (id, organizer_id, contest_id, round_id, number, hold_color_primary, hold_color_secondary, zone_1_enabled, zone_2_enabled, description, sector, tags, points_zone_1, points_zone_2, points_top, flash_bonus, version)
+VALUES 
+	(COALESCE(NULLIF($1, 0), nextval(pg_get_serial_sequence('problem', 'id'))), $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)
+ON CONFLICT (id) DO UPDATE SET
+    organizer_id = EXCLUDED.organizer_id,
+    contest_id = EXCLUDED.contest_id,
+    round_id = EXCLUDED.round_id,
+    number = EXCLUDED.number,
+    hold_color_primary = EXCLUDED.hold_color_primary,
+    hold_color_secondary = EXCLUDED.hold_color_secondary,
+    zone_1_enabled = EXCLUDED.zone_1_enabled,
+    zone_2_enabled = EXCLUDED.zone_2_enabled,
+    description = EXCLUDED.description,
+    sector = EXCLUDED.sector,
+    tags = EXCLUDED.tags,
+    points_zone_1 = EXCLUDED.points_zone_1,
+    points_zone_2 = EXCLUDED.points_zone_2,
+    points_top = EXCLUDED.points_top,
+    flash_bonus = EXCLUDED.flash_bonus,
+    version = problem.version + 1
+RETURNING id;
+
+-- name: GetProblemCompClasses :many
+SELECT comp_class_id
+FROM problem_comp_class
+WHERE problem_id = $1;
+
+-- name: GetProblemCompClassesByContest :many
+SELECT problem_comp_class.problem_id, problem_comp_class.comp_class_id
+FROM problem_comp_class
+JOIN problem ON problem.id = problem_comp_class.problem_id
+WHERE problem.contest_id = $1;
+
+-- name: DeleteProblemCompClasses :exec
+DELETE FROM problem_comp_class
+WHERE problem_id = $1;
+
+-- name: InsertProblemCompClass :exec
+INSERT INTO
+    problem_comp_class (problem_id, comp_class_id)
+VALUES
+    ($1, $2);
+
+-- name: GetTick :one
+SELECT tick.id, tick.organizer_id, tick.contest_id, tick.contender_id, tick.problem_id, tick.timestamp, tick.zone_1, tick.attempts_zone_1, tick.zone_2, tick.attempts_zone_2, tick.top, tick.attempts_top
+FROM tick
+WHERE id = $1;
+
+-- name: GetTickByContenderAndProblem :one
+SELECT tick.id, tick.organizer_id, tick.contest_id, tick.contender_id, tick.problem_id, tick.timestamp, tick.zone_1, tick.attempts_zone_1, tick.zone_2, tick.attempts_zone_2, tick.top, tick.attempts_top
+FROM tick
+WHERE contender_id = $1 AND problem_id = $2;
+
+-- name: GetTicksByContender :many
+SELECT tick.id, tick.organizer_id, tick.contest_id, tick.contender_id, tick.problem_id, tick.timestamp, tick.zone_1, tick.attempts_zone_1, tick.zone_2, tick.attempts_zone_2, tick.top, tick.attempts_top
+FROM tick
+WHERE contender_id = $1;
+
+-- name: GetTicksByContest :many
+SELECT tick.id, tick.organizer_id, tick.contest_id, tick.contender_id, tick.problem_id, tick.timestamp, tick.zone_1, tick.attempts_zone_1, tick.zone_2, tick.attempts_zone_2, tick.top, tick.attempts_top
+FROM tick
+WHERE contest_id = $1;
+
+-- name: GetTicksByContestFiltered :many
+SELECT tick.id, tick.organizer_id, tick.contest_id, tick.contender_id, tick.problem_id, tick.timestamp, tick.zone_1, tick.attempts_zone_1, tick.zone_2, tick.attempts_zone_2, tick.top, tick.attempts_top
+FROM tick
+WHERE
+	contest_id = $1
+	AND ($2::timestamptz IS NULL OR timestamp >= $3)
+	AND ($4 = 0 OR CASE WHEN $5 THEN id < $6 ELSE id > $7 END)
+ORDER BY CASE WHEN $8 THEN -id ELSE id END
+LIMIT $9;
+
+-- name: GetTicksByProblem :many
+SELECT tick.id, tick.organizer_id, tick.contest_id, tick.contender_id, tick.problem_id, tick.timestamp, tick.zone_1, tick.attempts_zone_1, tick.zone_2, tick.attempts_zone_2, tick.top, tick.attempts_top
+FROM tick
+WHERE problem_id = $1;
+
+-- name: DeleteTick :exec
+DELETE
+FROM tick
+WHERE id = $1;
+
+-- name: GetTickRevisionsByContender :many
+SELECT tick_revision.id, tick_revision.contest_id, tick_revision.contender_id, tick_revision.problem_id, tick_revision.tick_id, tick_revision.action, tick_revision.timestamp, tick_revision.recorded_at, tick_revision.actor_role, tick_revision.actor_username, tick_revision.idempotency_key, tick_revision.zone_1, tick_revision.attempts_zone_1, tick_revision.zone_2, tick_revision.attempts_zone_2, tick_revision.top, tick_revision.attempts_top
+FROM tick_revision
+WHERE contender_id = $1
+ORDER BY recorded_at, id;
+
+-- name: GetTickRevisionsByContenderAndProblem :many
+SELECT tick_revision.id, tick_revision.contest_id, tick_revision.contender_id, tick_revision.problem_id, tick_revision.tick_id, tick_revision.action, tick_revision.timestamp, tick_revision.recorded_at, tick_revision.actor_role, tick_revision.actor_username, tick_revision.idempotency_key, tick_revision.zone_1, tick_revision.attempts_zone_1, tick_revision.zone_2, tick_revision.attempts_zone_2, tick_revision.top, tick_revision.attempts_top
+FROM tick_revision
+WHERE contender_id = $1 AND problem_id = $2
+ORDER BY recorded_at, id;
+
+-- name: GetTickRevisionByIdempotencyKey :one
+SELECT tick_revision.id, tick_revision.contest_id, tick_revision.contender_id, tick_revision.problem_id, tick_revision.tick_id, tick_revision.action, tick_revision.timestamp, tick_revision.recorded_at, tick_revision.actor_role, tick_revision.actor_username, tick_revision.idempotency_key, tick_revision.zone_1, tick_revision.attempts_zone_1, tick_revision.zone_2, tick_revision.attempts_zone_2, tick_revision.top, tick_revision.attempts_top
+FROM tick_revision
+WHERE contender_id = $1 AND idempotency_key = $2;
+
+-- name: InsertTickRevision :execlastid
+INSERT INTO
+    tick_revision (contest_id, contender_id, problem_id, tick_id, action, timestamp, recorded_at, actor_role, actor_username, idempotency_key, zone_1, attempts_zone_1, zone_2, attempts_zone_2, top, attempts_top)
+VALUES
+    ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)
+RETURNING id;
+
+-- name: GetTickDispute :one
+SELECT tick_dispute.id, tick_dispute.organizer_id, tick_dispute.contest_id, tick_dispute.contender_id, tick_dispute.problem_id, tick_dispute.status, tick_dispute.comment, tick_dispute.response, tick_dispute.zone_1, tick_dispute.attempts_zone_1, tick_dispute.zone_2, tick_dispute.attempts_zone_2, tick_dispute.top, tick_dispute.attempts_top, tick_dispute.created, tick_dispute.resolved, tick_dispute.resolved_by
+FROM tick_dispute
+WHERE id = $1;
+
+-- name: GetTickDisputesByContest :many
+SELECT tick_dispute.id, tick_dispute.organizer_id, tick_dispute.contest_id, tick_dispute.contender_id, tick_dispute.problem_id, tick_dispute.status, tick_dispute.comment, tick_dispute.response, tick_dispute.zone_1, tick_dispute.attempts_zone_1, tick_dispute.zone_2, tick_dispute.attempts_zone_2, tick_dispute.top, tick_dispute.attempts_top, tick_dispute.created, tick_dispute.resolved, tick_dispute.resolved_by
+FROM tick_dispute
+WHERE contest_id = $1
+ORDER BY created, id;
+
+-- name: GetTickDisputesByContender :many
+SELECT tick_dispute.id, tick_dispute.organizer_id, tick_dispute.contest_id, tick_dispute.contender_id, tick_dispute.problem_id, tick_dispute.status, tick_dispute.comment, tick_dispute.response, tick_dispute.zone_1, tick_dispute.attempts_zone_1, tick_dispute.zone_2, tick_dispute.attempts_zone_2, tick_dispute.top, tick_dispute.attempts_top, tick_dispute.created, tick_dispute.resolved, tick_dispute.resolved_by
+FROM tick_dispute
+WHERE contender_id = $1
+ORDER BY created, id;
+
+-- name: UpsertTickDispute :execlastid
+INSERT INTO
+    tick_dispute (id, organizer_id, contest_id, contender_id, problem_id, status, comment, response, zone_1, attempts_zone_1, zone_2, attempts_zone_2, top, attempts_top, created, resolved, resolved_by)
+VALUES
+    (COALESCE(NULLIF($1, 0), nextval(pg_get_serial_sequence('tick_dispute', 'id'))), $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)
+ON CONFLICT (id) DO UPDATE SET
+    status = EXCLUDED.status,
+    response = EXCLUDED.response,
+    resolved = EXCLUDED.resolved,
+    resolved_by = EXCLUDED.resolved_by
+RETURNING id;
+
+-- name: UpsertTick :execlastid
+INSERT INTO
+    tick (id, organizer_id, contest_id, contender_id, problem_id, timestamp, top, attempts_top, zone_1, attempts_zone_1, zone_2, attempts_zone_2)
+VALUES
+    (COALESCE(NULLIF($1, 0), nextval(pg_get_serial_sequence('tick', 'id'))), $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+ON CONFLICT (contender_id, problem_id) DO UPDATE SET
+    organizer_id = EXCLUDED.organizer_id,
+    contest_id = EXCLUDED.contest_id,
+    contender_id = EXCLUDED.contender_id,
+    problem_id = EXCLUDED.problem_id,
+    timestamp = EXCLUDED.timestamp,
+    top = EXCLUDED.top,
+    attempts_top = EXCLUDED.attempts_top,
+    zone_1 = EXCLUDED.zone_1,
+    attempts_zone_1 = EXCLUDED.attempts_zone_1,
+    zone_2 = EXCLUDED.zone_2,
+    attempts_zone_2 = EXCLUDED.attempts_zone_2
+RETURNING id;
+
+-- name: UpsertOrganizer :execlastid
+INSERT INTO
+    organizer (id, name)
+VALUES
+    (COALESCE(NULLIF($1, 0), nextval(pg_get_serial_sequence('organizer', 'id'))), $2)
+ON CONFLICT (id) DO UPDATE SET
+    name = EXCLUDED.name
+RETURNING id;
+
+-- name: UpsertUser :execlastid
+INSERT INTO
+    "user" (id, username, admin)
+VALUES
+    (COALESCE(NULLIF($1, 0), nextval(pg_get_serial_sequence('"user"', 'id'))), $2, $3)
+ON CONFLICT (username) DO UPDATE SET
+    username = EXCLUDED.username,
+    admin = EXCLUDED.admin
+RETURNING id;
+
+-- name: GetUserByUsername :many
+SELECT "user".id, "user".username, "user".admin, organizer.id, organizer.name
+FROM "user"
+LEFT JOIN user_organizer uo ON uo.user_id = "user".id
+LEFT JOIN organizer ON organizer.id = uo.organizer_id
+WHERE username = $1;
+
+-- name: GetUsersByOrganizer :many
+SELECT "user".id, "user".username, "user".admin
+FROM "user"
+LEFT JOIN user_organizer uo ON uo.user_id = "user".id
+WHERE uo.organizer_id = $1;
+
+-- name: AddUserToOrganizer :exec
+INSERT INTO
+    user_organizer (user_id, organizer_id)
+VALUES
+    ($1, $2);
+
+-- name: GetOrganizer :one
+SELECT id, name
+FROM organizer
+WHERE id = $1;
+
+-- name: GetAllOrganizers :many
+SELECT id, name
+FROM organizer;
+
+-- name: GetRaffle :one
+SELECT raffle.id, raffle.organizer_id, raffle.contest_id, raffle.rules, raffle.seed, raffle.seed_commitment, raffle.seed_revealed_at
+FROM raffle
+WHERE id = $1;
+
+-- name: GetRafflesByContest :many
+SELECT raffle.id, raffle.organizer_id, raffle.contest_id, raffle.rules, raffle.seed, raffle.seed_commitment, raffle.seed_revealed_at
+FROM raffle
+WHERE contest_id = $1;
+
+-- name: UpsertRaffle :execlastid
+INSERT INTO
+    raffle (id, organizer_id, contest_id, rules, seed, seed_commitment, seed_revealed_at)
+VALUES
+    (COALESCE(NULLIF($1, 0), nextval(pg_get_serial_sequence('raffle', 'id'))), $2, $3, $4, $5, $6, $7)
+ON CONFLICT (id) DO UPDATE SET
+    organizer_id = EXCLUDED.organizer_id,
+    contest_id = EXCLUDED.contest_id,
+    rules = EXCLUDED.rules,
+    seed = EXCLUDED.seed,
+    seed_commitment = EXCLUDED.seed_commitment,
+    seed_revealed_at = EXCLUDED.seed_revealed_at
+RETURNING id;
+
+-- name: DeleteRaffle :exec
+DELETE FROM raffle
+WHERE id = $1;
+
+-- name: GetRaffleWinner :one
+SELECT raffle_winner.id, raffle_winner.organizer_id, raffle_winner.raffle_id, raffle_winner.contender_id, raffle_winner.prize_id, raffle_winner.timestamp, raffle_winner.rules, raffle_winner.claimed_at, raffle_winner.voided_at, raffle_winner.draw, contender.name, contender.scrubbed_at, raffle_prize.name AS prize_name
+FROM raffle_winner
+JOIN contender ON contender.id = raffle_winner.contender_id
+LEFT JOIN raffle_prize ON raffle_prize.id = raffle_winner.prize_id
+WHERE raffle_winner.id = $1;
+
+-- name: GetRaffleWinners :many
+SELECT raffle_winner.id, raffle_winner.organizer_id, raffle_winner.raffle_id, raffle_winner.contender_id, raffle_winner.prize_id, raffle_winner.timestamp, raffle_winner.rules, raffle_winner.claimed_at, raffle_winner.voided_at, raffle_winner.draw, contender.name, contender.scrubbed_at, raffle_prize.name AS prize_name
+FROM raffle_winner
+JOIN contender ON contender.id = raffle_winner.contender_id
+LEFT JOIN raffle_prize ON raffle_prize.id = raffle_winner.prize_id
+WHERE raffle_winner.raffle_id = $1;
+
+-- name: GetRaffleWinnersByContender :many
+SELECT raffle_winner.id, raffle_winner.organizer_id, raffle_winner.raffle_id, raffle_winner.contender_id, raffle_winner.prize_id, raffle_winner.timestamp, raffle_winner.rules, raffle_winner.claimed_at, raffle_winner.voided_at, raffle_winner.draw, contender.name, contender.scrubbed_at, raffle_prize.name AS prize_name
+FROM raffle_winner
+JOIN contender ON contender.id = raffle_winner.contender_id
+LEFT JOIN raffle_prize ON raffle_prize.id = raffle_winner.prize_id
+WHERE raffle_winner.contender_id = $1;
+
+-- name: UpsertRaffleWinner :execlastid
+INSERT INTO
+    raffle_winner (id, organizer_id, raffle_id, contender_id, prize_id, timestamp, rules, claimed_at, voided_at, draw)
+VALUES
+    (COALESCE(NULLIF($1, 0), nextval(pg_get_serial_sequence('raffle_winner', 'id'))), $2, $3, $4, $5, $6, $7, $8, $9, $10)
+ON CONFLICT (raffle_id, contender_id) DO UPDATE SET
+    organizer_id = EXCLUDED.organizer_id,
+    raffle_id = EXCLUDED.raffle_id,
+    contender_id = EXCLUDED.contender_id,
+    prize_id = EXCLUDED.prize_id,
+    timestamp = EXCLUDED.timestamp,
+    rules = EXCLUDED.rules,
+    claimed_at = EXCLUDED.claimed_at,
+    voided_at = EXCLUDED.voided_at,
+    draw = EXCLUDED.draw
+RETURNING id;
+
+-- name: DeleteRaffleWinner :exec
+DELETE FROM raffle_winner
+WHERE id = $1;
+
+-- name: GetRafflePrize :one
+SELECT raffle_prize.id, raffle_prize.organizer_id, raffle_prize.raffle_id, raffle_prize.name, raffle_prize.quantity
+FROM raffle_prize
+WHERE id = $1;
+
+-- name: GetRafflePrizes :many
+SELECT raffle_prize.id, raffle_prize.organizer_id, raffle_prize.raffle_id, raffle_prize.name, raffle_prize.quantity
+FROM raffle_prize
+WHERE raffle_id = $1
+ORDER BY id;
+
+-- name: UpsertRafflePrize :execlastid
+INSERT INTO
+    raffle_prize (id, organizer_id, raffle_id, name, quantity)
+VALUES
+    (COALESCE(NULLIF($1, 0), nextval(pg_get_serial_sequence('raffle_prize', 'id'))), $2, $3, $4, $5)
+ON CONFLICT (id) DO UPDATE SET
+    organizer_id = EXCLUDED.organizer_id,
+    raffle_id = EXCLUDED.raffle_id,
+    name = EXCLUDED.name,
+    quantity = EXCLUDED.quantity
+RETURNING id;
+
+-- name: DeleteRafflePrize :exec
+DELETE FROM raffle_prize
+WHERE id = $1;
+
+-- name: GetOrganizerInvitesByOrganizer :many
+SELECT organizer_invite.id, organizer_invite.organizer_id, organizer_invite.expires_at, organizer.name
+FROM organizer_invite
+JOIN organizer ON organizer.id = organizer_invite.organizer_id
+WHERE organizer_id = $1;
+
+-- name: GetOrganizerInvite :one
+SELECT organizer_invite.id, organizer_invite.organizer_id, organizer_invite.expires_at, organizer.name
+FROM organizer_invite
+JOIN organizer ON organizer.id = organizer_invite.organizer_id
+WHERE organizer_invite.id = $1;
+
+-- name: InsertOrganizerInvite :exec
+INSERT INTO
+    organizer_invite (id, organizer_id, expires_at)
+VALUES
+    ($1, $2, $3);
+
+-- name: DeleteOrganizerInvite :exec
+DELETE FROM organizer_invite
+WHERE id = $1;
+
+-- name: GetScrubEligibleContenders :many
+SELECT contender.id, contender.organizer_id, contender.contest_id, contender.registration_code, contender.name, contender.class_id, contender.team_id, contender.entered, contender.disqualified, contender.withdrawn_from_finals, contender.scrubbed_at, contender.scrub_before, contender.waitlist_class_id, contender.waitlisted_at, contender.version, score.contender_id, score.timestamp, score.score, score.placement, score.finalist, score.rank_order
+FROM contender
+LEFT JOIN score ON score.contender_id = id
+WHERE contender.name != ''
+  AND contender.scrub_before IS NOT NULL
+  AND contender.scrub_before < $1;
+
+-- name: GetRound :one
+SELECT round.id, round.organizer_id, round.contest_id, round.number, round.name, round.qualifying_problems, round.finalists, round.time_begin, round.time_end
+FROM round
+WHERE id = $1;
+
+-- name: GetRoundsByContest :many
+SELECT round.id, round.organizer_id, round.contest_id, round.number, round.name, round.qualifying_problems, round.finalists, round.time_begin, round.time_end
+FROM round
+WHERE contest_id = $1
+ORDER BY number;
+
+-- name: GetRoundsCurrentlyRunningOrByStartTime :many
+SELECT round.id, round.organizer_id, round.contest_id, round.number, round.name, round.qualifying_problems, round.finalists, round.time_begin, round.time_end
+FROM round
+JOIN contest ON contest.id = round.contest_id
+WHERE contest.archived_at IS NULL
+  AND (NOW() BETWEEN round.time_begin AND round.time_end + make_interval(mins => contest.grace_period + 15)
+    OR round.time_begin BETWEEN $1::timestamptz AND $2::timestamptz);
+
+-- name: UpsertRound :execlastid
+INSERT INTO
+    round (id, organizer_id, contest_id, number, name, qualifying_problems, finalists, time_begin, time_end)
+VALUES
+    (COALESCE(NULLIF($1, 0), nextval(pg_get_serial_sequence('round', 'id'))), $2, $3, $4, $5, $6, $7, $8, $9)
+ON CONFLICT (id) DO UPDATE SET
+    organizer_id = EXCLUDED.organizer_id,
+    contest_id = EXCLUDED.contest_id,
+    number = EXCLUDED.number,
+    name = EXCLUDED.name,
+    qualifying_problems = EXCLUDED.qualifying_problems,
+    finalists = EXCLUDED.finalists,
+    time_begin = EXCLUDED.time_begin,
+    time_end = EXCLUDED.time_end
+RETURNING id;
+
+-- name: DeleteRound :exec
+DELETE FROM round
+WHERE id = $1;
+
+-- name: GetStartList :many
+SELECT round_contender.round_id, round_contender.contender_id, round_contender.previous_placement, round_contender.timestamp, round_contender.score, round_contender.placement, round_contender.finalist, round_contender.rank_order
+FROM round_contender
+WHERE round_id = $1;
+
+-- name: GetStartListEntry :one
+SELECT round_contender.round_id, round_contender.contender_id, round_contender.previous_placement, round_contender.timestamp, round_contender.score, round_contender.placement, round_contender.finalist, round_contender.rank_order
+FROM round_contender
+WHERE round_id = $1 AND contender_id = $2;
+
+-- name: InsertStartListEntry :exec
+INSERT INTO
+    round_contender (round_id, contender_id, previous_placement, timestamp, score, placement, finalist, rank_order)
+VALUES
+    ($1, $2, $3, $4, $5, $6, $7, $8);
+
+-- name: DeleteStartList :exec
+DELETE FROM round_contender
+WHERE round_id = $1;
+
+-- name: UpdateRoundScore :execrows
+UPDATE round_contender
+SET
+    timestamp = $1,
+    score = $2,
+    placement = $3,
+    finalist = $4,
+    rank_order = $5
+WHERE round_id = $6 AND contender_id = $7;
+
+-- name: GetTeam :one
+SELECT team.id, team.organizer_id, team.contest_id, team.name, team.counted_members
+FROM team
+WHERE id = $1;
+
+-- name: GetTeamsByContest :many
+SELECT team.id, team.organizer_id, team.contest_id, team.name, team.counted_members
+FROM team
+WHERE contest_id = $1
+ORDER BY name;
+
+-- name: UpsertTeam :execlastid
+INSERT INTO
+    team (id, organizer_id, contest_id, name, counted_members)
+VALUES
+    (COALESCE(NULLIF($1, 0), nextval(pg_get_serial_sequence('team', 'id'))), $2, $3, $4, $5)
+ON CONFLICT (id) DO UPDATE SET
+    organizer_id = EXCLUDED.organizer_id,
+    contest_id = EXCLUDED.contest_id,
+    name = EXCLUDED.name,
+    counted_members = EXCLUDED.counted_members
+RETURNING id;
+
+-- name: DeleteTeam :exec
+DELETE FROM team
+WHERE id = $1;
+
+-- name: InsertAuditEntry :execlastid
+INSERT INTO
+    audit_entry (organizer_id, contest_id, contender_id, action, timestamp)
+VALUES
+    ($1, $2, $3, $4, $5)
+RETURNING id;
+
+-- name: GetRetentionPolicy :one
+SELECT retention_policy.id, retention_policy.organizer_id, retention_policy.target, retention_policy.retention_period, retention_policy.enabled, retention_policy.created
+FROM retention_policy
+WHERE id = $1;
+
+-- name: GetRetentionPoliciesByOrganizer :many
+SELECT retention_policy.id, retention_policy.organizer_id, retention_policy.target, retention_policy.retention_period, retention_policy.enabled, retention_policy.created
+FROM retention_policy
+WHERE organizer_id = $1;
+
+-- name: GetEnabledRetentionPolicies :many
+SELECT retention_policy.id, retention_policy.organizer_id, retention_policy.target, retention_policy.retention_period, retention_policy.enabled, retention_policy.created
+FROM retention_policy
+WHERE enabled = TRUE;
+
+-- name: UpsertRetentionPolicy :execlastid
+INSERT INTO
+    retention_policy (id, organizer_id, target, retention_period, enabled, created)
+VALUES
+    (COALESCE(NULLIF($1, 0), nextval(pg_get_serial_sequence('retention_policy', 'id'))), $2, $3, $4, $5, $6)
+ON CONFLICT (id) DO UPDATE SET
+    organizer_id = EXCLUDED.organizer_id,
+    target = EXCLUDED.target,
+    retention_period = EXCLUDED.retention_period,
+    enabled = EXCLUDED.enabled
+RETURNING id;
+
+-- name: DeleteRetentionPolicy :exec
+DELETE FROM retention_policy
+WHERE id = $1;
+
+-- name: GetRetentionPolicyExecutions :many
+SELECT retention_policy_execution.id, retention_policy_execution.organizer_id, retention_policy_execution.retention_policy_id, retention_policy_execution.timestamp, retention_policy_execution.contests, retention_policy_execution.contenders, retention_policy_execution.ticks, retention_policy_execution.error
+FROM retention_policy_execution
+WHERE retention_policy_id = $1
+ORDER BY timestamp DESC, id DESC
+LIMIT 100;
+
+-- name: InsertRetentionPolicyExecution :execlastid
+INSERT INTO
+    retention_policy_execution (organizer_id, retention_policy_id, timestamp, contests, contenders, ticks, error)
+VALUES
+    ($1, $2, $3, $4, $5, $6, $7)
+RETURNING id;
+
+-- name: DeleteTicksByContest :execrows
+DELETE FROM tick
+WHERE contest_id = $1;
+
+-- name: DeleteTickRevisionsByContest :exec
+DELETE FROM tick_revision
+WHERE contest_id = $1;
+
+-- name: DeleteTickDisputesByContest :exec
+DELETE FROM tick_dispute
+WHERE contest_id = $1;
+
+-- name: DeleteScoresByContest :exec
+DELETE FROM score
+WHERE contender_id IN (SELECT id FROM contender WHERE contest_id = $1);
+
+-- name: DeletePublishedScoresByContest :exec
+DELETE FROM published_score
+WHERE contender_id IN (SELECT id FROM contender WHERE contest_id = $1);
+
+-- name: DeleteRaffleWinnersByContest :exec
+DELETE FROM raffle_winner
+WHERE raffle_id IN (SELECT id FROM raffle WHERE contest_id = $1);
+
+-- name: DeleteRafflePrizesByContest :exec
+DELETE FROM raffle_prize
+WHERE raffle_id IN (SELECT id FROM raffle WHERE contest_id = $1);
+
+-- name: DeleteRafflesByContest :exec
+DELETE FROM raffle
+WHERE contest_id = $1;
+
+-- name: DeleteContendersByContest :execrows
+DELETE FROM contender
+WHERE contest_id = $1;
+
+-- name: DeleteProblemsByContest :exec
+DELETE FROM problem
+WHERE contest_id = $1;
+
+-- name: DeleteRoundsByContest :exec
+DELETE FROM round
+WHERE contest_id = $1;
+
+-- name: DeleteTeamsByContest :exec
+DELETE FROM team
+WHERE contest_id = $1;
+
+-- name: DeleteCompClassesByContest :exec
+DELETE FROM comp_class
+WHERE contest_id = $1;
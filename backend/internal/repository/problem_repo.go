@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 
 	"github.com/climblive/platform/backend/internal/database"
 	"github.com/climblive/platform/backend/internal/domain"
@@ -15,10 +16,27 @@ func (d *Database) GetProblemsByContest(ctx context.Context, tx domain.Transacti
 		return nil, errors.Wrap(err, 0)
 	}
 
+	availability, err := d.WithTx(tx).GetProblemCompClassesByContest(ctx, int32(contestID))
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	compClassIDs := make(map[domain.ProblemID][]domain.CompClassID)
+
+	for _, record := range availability {
+		problemID := domain.ProblemID(record.ProblemCompClass.ProblemID)
+		compClassIDs[problemID] = append(compClassIDs[problemID], domain.CompClassID(record.ProblemCompClass.CompClassID))
+	}
+
 	problems := make([]domain.Problem, 0)
 
 	for _, record := range records {
-		problems = append(problems, problemToDomain(record.Problem))
+		problem, err := problemToDomain(record.Problem, compClassIDs[domain.ProblemID(record.Problem.ID)])
+		if err != nil {
+			return nil, errors.Wrap(err, 0)
+		}
+
+		problems = append(problems, problem)
 	}
 
 	return problems, nil
@@ -33,7 +51,19 @@ func (d *Database) GetProblem(ctx context.Context, tx domain.Transaction, proble
 		return domain.Problem{}, errors.Wrap(err, 0)
 	}
 
-	return problemToDomain(record.Problem), nil
+	return d.hydrateProblem(ctx, tx, record.Problem)
+}
+
+func (d *Database) GetProblemVersionForUpdate(ctx context.Context, tx domain.Transaction, problemID domain.ProblemID) (int, error) {
+	version, err := d.WithTx(tx).GetProblemVersionForUpdate(ctx, int32(problemID))
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return 0, errors.Wrap(domain.ErrNotFound, 0)
+	case err != nil:
+		return 0, errors.Wrap(err, 0)
+	}
+
+	return int(version), nil
 }
 
 func (d *Database) GetProblemByNumber(ctx context.Context, tx domain.Transaction, contestID domain.ContestID, problemNumber int) (domain.Problem, error) {
@@ -48,24 +78,78 @@ func (d *Database) GetProblemByNumber(ctx context.Context, tx domain.Transaction
 		return domain.Problem{}, errors.Wrap(err, 0)
 	}
 
-	return problemToDomain(record.Problem), nil
+	return d.hydrateProblem(ctx, tx, record.Problem)
+}
+
+func (d *Database) hydrateProblem(ctx context.Context, tx domain.Transaction, record database.Problem) (domain.Problem, error) {
+	records, err := d.WithTx(tx).GetProblemCompClasses(ctx, record.ID)
+	if err != nil {
+		return domain.Problem{}, errors.Wrap(err, 0)
+	}
+
+	compClassIDs := make([]domain.CompClassID, 0)
+
+	for _, compClassID := range records {
+		compClassIDs = append(compClassIDs, domain.CompClassID(compClassID))
+	}
+
+	problem, err := problemToDomain(record, compClassIDs)
+	if err != nil {
+		return domain.Problem{}, errors.Wrap(err, 0)
+	}
+
+	return problem, nil
 }
 
 func (d *Database) StoreProblem(ctx context.Context, tx domain.Transaction, problem domain.Problem) (domain.Problem, error) {
+	if tx == nil {
+		tx, err := d.Begin()
+		if err != nil {
+			return domain.Problem{}, errors.Wrap(err, 0)
+		}
+
+		problem, err = d.StoreProblem(ctx, tx, problem)
+		if err != nil {
+			tx.Rollback()
+			return domain.Problem{}, errors.Wrap(err, 0)
+		}
+
+		if err := tx.Commit(); err != nil {
+			return domain.Problem{}, errors.Wrap(err, 0)
+		}
+
+		return problem, nil
+	}
+
+	var tags json.RawMessage
+
+	if len(problem.Tags) > 0 {
+		var err error
+
+		tags, err = json.Marshal(problem.Tags)
+		if err != nil {
+			return domain.Problem{}, errors.Wrap(err, 0)
+		}
+	}
+
 	params := database.UpsertProblemParams{
 		ID:                 int32(problem.ID),
 		OrganizerID:        int32(problem.Ownership.OrganizerID),
 		ContestID:          int32(problem.ContestID),
+		RoundID:            makeNullInt32(int32(problem.RoundID)),
 		Number:             int32(problem.Number),
 		HoldColorPrimary:   problem.HoldColorPrimary,
 		HoldColorSecondary: makeNullString(problem.HoldColorSecondary),
 		Description:        makeNullString(problem.Description),
+		Sector:             makeNullString(problem.Sector),
+		Tags:               tags,
 		Zone1Enabled:       problem.Zone1Enabled,
 		Zone2Enabled:       problem.Zone2Enabled,
 		PointsZone1:        makeNullInt32(int32(problem.PointsZone1)),
 		PointsZone2:        makeNullInt32(int32(problem.PointsZone2)),
 		PointsTop:          int32(problem.PointsTop),
 		FlashBonus:         makeNullInt32(int32(problem.FlashBonus)),
+		Version:            nextVersion(domain.ResourceID(problem.ID), problem.Version),
 	}
 
 	insertID, err := d.WithTx(tx).UpsertProblem(ctx, params)
@@ -77,7 +161,28 @@ func (d *Database) StoreProblem(ctx context.Context, tx domain.Transaction, prob
 		problem.ID = domain.ProblemID(insertID)
 	}
 
-	return problem, err
+	version, err := d.GetProblemVersionForUpdate(ctx, tx, problem.ID)
+	if err != nil {
+		return domain.Problem{}, errors.Wrap(err, 0)
+	}
+
+	problem.Version = version
+
+	if err := d.WithTx(tx).DeleteProblemCompClasses(ctx, int32(problem.ID)); err != nil {
+		return domain.Problem{}, errors.Wrap(err, 0)
+	}
+
+	for _, compClassID := range problem.CompClassIDs {
+		err := d.WithTx(tx).InsertProblemCompClass(ctx, database.InsertProblemCompClassParams{
+			ProblemID:   int32(problem.ID),
+			CompClassID: int32(compClassID),
+		})
+		if err != nil {
+			return domain.Problem{}, errors.Wrap(err, 0)
+		}
+	}
+
+	return problem, nil
 }
 
 func (d *Database) DeleteProblem(ctx context.Context, tx domain.Transaction, problemID domain.ProblemID) error {
@@ -88,3 +193,12 @@ func (d *Database) DeleteProblem(ctx context.Context, tx domain.Transaction, pro
 
 	return nil
 }
+
+func (d *Database) DeleteProblemsByContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) error {
+	err := d.WithTx(tx).DeleteProblemsByContest(ctx, int32(contestID))
+	if err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	return nil
+}
@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 
 	"github.com/climblive/platform/backend/internal/database"
 	"github.com/climblive/platform/backend/internal/domain"
@@ -18,7 +19,12 @@ func (d *Database) GetRafflesByContest(ctx context.Context, tx domain.Transactio
 	raffles := make([]domain.Raffle, 0)
 
 	for _, record := range records {
-		raffles = append(raffles, raffleToDomain(record.Raffle))
+		raffle, err := raffleToDomain(record.Raffle)
+		if err != nil {
+			return nil, errors.Wrap(err, 0)
+		}
+
+		raffles = append(raffles, raffle)
 	}
 
 	return raffles, nil
@@ -33,14 +39,28 @@ func (d *Database) GetRaffle(ctx context.Context, tx domain.Transaction, raffleI
 		return domain.Raffle{}, errors.Wrap(err, 0)
 	}
 
-	return raffleToDomain(record.Raffle), nil
+	raffle, err := raffleToDomain(record.Raffle)
+	if err != nil {
+		return domain.Raffle{}, errors.Wrap(err, 0)
+	}
+
+	return raffle, nil
 }
 
 func (d *Database) StoreRaffle(ctx context.Context, tx domain.Transaction, raffle domain.Raffle) (domain.Raffle, error) {
+	rules, err := json.Marshal(raffle.Rules)
+	if err != nil {
+		return domain.Raffle{}, errors.Wrap(err, 0)
+	}
+
 	params := database.UpsertRaffleParams{
-		ID:          int32(raffle.ID),
-		ContestID:   int32(raffle.ContestID),
-		OrganizerID: int32(raffle.Ownership.OrganizerID),
+		ID:             int32(raffle.ID),
+		ContestID:      int32(raffle.ContestID),
+		OrganizerID:    int32(raffle.Ownership.OrganizerID),
+		Rules:          rules,
+		Seed:           makeNullString(raffle.Seed),
+		SeedCommitment: makeNullString(raffle.SeedCommitment),
+		SeedRevealedAt: makeNullTime(raffle.SeedRevealedAt),
 	}
 
 	insertID, err := d.WithTx(tx).UpsertRaffle(ctx, params)
@@ -55,6 +75,23 @@ func (d *Database) StoreRaffle(ctx context.Context, tx domain.Transaction, raffl
 	return raffle, err
 }
 
+func (d *Database) GetRaffleWinner(ctx context.Context, tx domain.Transaction, raffleWinnerID domain.RaffleWinnerID) (domain.RaffleWinner, error) {
+	record, err := d.WithTx(tx).GetRaffleWinner(ctx, int32(raffleWinnerID))
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return domain.RaffleWinner{}, errors.Wrap(domain.ErrNotFound, 0)
+	case err != nil:
+		return domain.RaffleWinner{}, errors.Wrap(err, 0)
+	}
+
+	winner, err := raffleWinnerToDomain(record.RaffleWinner, record.Name.String, record.ScrubbedAt.Time, record.PrizeName.String)
+	if err != nil {
+		return domain.RaffleWinner{}, errors.Wrap(err, 0)
+	}
+
+	return winner, nil
+}
+
 func (d *Database) GetRaffleWinners(ctx context.Context, tx domain.Transaction, raffleID domain.RaffleID) ([]domain.RaffleWinner, error) {
 	records, err := d.WithTx(tx).GetRaffleWinners(ctx, int32(raffleID))
 	if err != nil {
@@ -64,19 +101,63 @@ func (d *Database) GetRaffleWinners(ctx context.Context, tx domain.Transaction,
 	winners := make([]domain.RaffleWinner, 0)
 
 	for _, record := range records {
-		winners = append(winners, raffleWinnerToDomain(record.RaffleWinner, record.Name.String, record.ScrubbedAt.Time))
+		winner, err := raffleWinnerToDomain(record.RaffleWinner, record.Name.String, record.ScrubbedAt.Time, record.PrizeName.String)
+		if err != nil {
+			return nil, errors.Wrap(err, 0)
+		}
+
+		winners = append(winners, winner)
+	}
+
+	return winners, nil
+}
+
+func (d *Database) GetRaffleWinnersByContender(ctx context.Context, tx domain.Transaction, contenderID domain.ContenderID) ([]domain.RaffleWinner, error) {
+	records, err := d.WithTx(tx).GetRaffleWinnersByContender(ctx, int32(contenderID))
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	winners := make([]domain.RaffleWinner, 0)
+
+	for _, record := range records {
+		winner, err := raffleWinnerToDomain(record.RaffleWinner, record.Name.String, record.ScrubbedAt.Time, record.PrizeName.String)
+		if err != nil {
+			return nil, errors.Wrap(err, 0)
+		}
+
+		winners = append(winners, winner)
 	}
 
 	return winners, nil
 }
 
 func (d *Database) StoreRaffleWinner(ctx context.Context, tx domain.Transaction, winner domain.RaffleWinner) (domain.RaffleWinner, error) {
+	rules, err := json.Marshal(winner.Rules)
+	if err != nil {
+		return domain.RaffleWinner{}, errors.Wrap(err, 0)
+	}
+
+	var draw json.RawMessage
+
+	if winner.Draw != nil {
+		draw, err = json.Marshal(winner.Draw)
+		if err != nil {
+			return domain.RaffleWinner{}, errors.Wrap(err, 0)
+		}
+	}
+
 	params := database.UpsertRaffleWinnerParams{
 		ID:          int32(winner.ID),
 		RaffleID:    int32(winner.RaffleID),
 		OrganizerID: int32(winner.Ownership.OrganizerID),
 		ContenderID: int32(winner.ContenderID),
+		PrizeID:     makeNullInt32(int32(winner.PrizeID)),
 		Timestamp:   winner.Timestamp,
+		Rules:       rules,
+		ClaimedAt:   makeNullTime(winner.ClaimedAt),
+		VoidedAt:    makeNullTime(winner.VoidedAt),
+		Draw:        draw,
 	}
 
 	insertID, err := d.WithTx(tx).UpsertRaffleWinner(ctx, params)
@@ -108,3 +189,87 @@ func (d *Database) DeleteRaffleWinner(ctx context.Context, tx domain.Transaction
 
 	return nil
 }
+
+func (d *Database) GetRafflePrize(ctx context.Context, tx domain.Transaction, prizeID domain.RafflePrizeID) (domain.RafflePrize, error) {
+	record, err := d.WithTx(tx).GetRafflePrize(ctx, int32(prizeID))
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return domain.RafflePrize{}, errors.Wrap(domain.ErrNotFound, 0)
+	case err != nil:
+		return domain.RafflePrize{}, errors.Wrap(err, 0)
+	}
+
+	return rafflePrizeToDomain(record.RafflePrize), nil
+}
+
+func (d *Database) GetRafflePrizes(ctx context.Context, tx domain.Transaction, raffleID domain.RaffleID) ([]domain.RafflePrize, error) {
+	records, err := d.WithTx(tx).GetRafflePrizes(ctx, int32(raffleID))
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	prizes := make([]domain.RafflePrize, 0)
+
+	for _, record := range records {
+		prizes = append(prizes, rafflePrizeToDomain(record.RafflePrize))
+	}
+
+	return prizes, nil
+}
+
+func (d *Database) StoreRafflePrize(ctx context.Context, tx domain.Transaction, prize domain.RafflePrize) (domain.RafflePrize, error) {
+	params := database.UpsertRafflePrizeParams{
+		ID:          int32(prize.ID),
+		OrganizerID: int32(prize.Ownership.OrganizerID),
+		RaffleID:    int32(prize.RaffleID),
+		Name:        prize.Name,
+		Quantity:    int32(prize.Quantity),
+	}
+
+	insertID, err := d.WithTx(tx).UpsertRafflePrize(ctx, params)
+	if err != nil {
+		return domain.RafflePrize{}, errors.Wrap(err, 0)
+	}
+
+	if insertID != 0 {
+		prize.ID = domain.RafflePrizeID(insertID)
+	}
+
+	return prize, nil
+}
+
+func (d *Database) DeleteRafflePrize(ctx context.Context, tx domain.Transaction, prizeID domain.RafflePrizeID) error {
+	err := d.WithTx(tx).DeleteRafflePrize(ctx, int32(prizeID))
+	if err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	return nil
+}
+
+func (d *Database) DeleteRaffleWinnersByContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) error {
+	err := d.WithTx(tx).DeleteRaffleWinnersByContest(ctx, int32(contestID))
+	if err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	return nil
+}
+
+func (d *Database) DeleteRafflePrizesByContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) error {
+	err := d.WithTx(tx).DeleteRafflePrizesByContest(ctx, int32(contestID))
+	if err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	return nil
+}
+
+func (d *Database) DeleteRafflesByContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) error {
+	err := d.WithTx(tx).DeleteRafflesByContest(ctx, int32(contestID))
+	if err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	return nil
+}
@@ -0,0 +1,530 @@
+package repository_test
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/climblive/platform/backend/internal/domain"
+	"github.com/climblive/platform/backend/internal/repository"
+	"github.com/pressly/goose/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type backend struct {
+	name       string
+	dialect    goose.Dialect
+	migrations fs.FS
+	queries    string
+	open       func(t *testing.T) *repository.Database
+}
+
+func backends(t *testing.T) []backend {
+	backends := []backend{
+		{
+			name:       "SQLite",
+			dialect:    goose.DialectSQLite3,
+			migrations: os.DirFS("../../cmd/api/migrations/sqlite"),
+			queries:    "sqlite/queries.sql",
+			open: func(t *testing.T) *repository.Database {
+				db, err := repository.NewSQLiteDatabase(filepath.Join(t.TempDir(), "climblive.db"))
+				require.NoError(t, err)
+
+				return db
+			},
+		},
+	}
+
+	if host := os.Getenv("TEST_DB_HOST"); host != "" {
+		backends = append(backends, backend{
+			name:       "MySQL",
+			dialect:    goose.DialectMySQL,
+			migrations: os.DirFS("../../cmd/api/migrations"),
+			queries:    "../../database/queries.sql",
+			open: func(t *testing.T) *repository.Database {
+				port, _ := strconv.Atoi(os.Getenv("TEST_DB_PORT"))
+
+				db, err := repository.NewDatabase(
+					os.Getenv("TEST_DB_USERNAME"),
+					os.Getenv("TEST_DB_PASSWORD"),
+					host,
+					port,
+					os.Getenv("TEST_DB_DATABASE"))
+				require.NoError(t, err)
+
+				return db
+			},
+		})
+	}
+
+	if host := os.Getenv("TEST_POSTGRES_HOST"); host != "" {
+		backends = append(backends, backend{
+			name:       "PostgreSQL",
+			dialect:    goose.DialectPostgres,
+			migrations: os.DirFS("../../cmd/api/migrations/postgres"),
+			queries:    "postgres/queries.sql",
+			open: func(t *testing.T) *repository.Database {
+				port, _ := strconv.Atoi(os.Getenv("TEST_POSTGRES_PORT"))
+
+				db, err := repository.NewPostgresDatabase(
+					os.Getenv("TEST_POSTGRES_USERNAME"),
+					os.Getenv("TEST_POSTGRES_PASSWORD"),
+					host,
+					port,
+					os.Getenv("TEST_POSTGRES_DATABASE"))
+				require.NoError(t, err)
+
+				return db
+			},
+		})
+	}
+
+	return backends
+}
+
+func setupDatabase(t *testing.T, backend backend) *repository.Database {
+	db := backend.open(t)
+
+	t.Cleanup(func() {
+		_ = db.Handle.Close()
+	})
+
+	provider, err := goose.NewProvider(backend.dialect, db.Handle, backend.migrations)
+	require.NoError(t, err)
+
+	_, err = provider.Up(t.Context())
+	require.NoError(t, err)
+
+	return db
+}
+
+var queryHeader = regexp.MustCompile(`(?m)^-- name: (\w+) (:\w+)$`)
+
+func readQueries(t *testing.T, path string) map[string]string {
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	queries := make(map[string]string)
+
+	for _, chunk := range strings.Split(string(data), "-- name: ")[1:] {
+		text := "-- name: " + strings.TrimSuffix(strings.TrimSpace(chunk), ";")
+		match := queryHeader.FindStringSubmatch(text)
+		require.NotNil(t, match, text)
+
+		queries[match[1]] = text
+	}
+
+	return queries
+}
+
+func TestDialectQueries(t *testing.T) {
+	reference := readQueries(t, "../../database/queries.sql")
+
+	for _, path := range []string{"sqlite/queries.sql", "postgres/queries.sql"} {
+		t.Run(path, func(t *testing.T) {
+			queries := readQueries(t, path)
+
+			assert.Len(t, queries, len(reference))
+
+			for name, text := range reference {
+				query, found := queries[name]
+				if !assert.True(t, found, "missing query %s", name) {
+					continue
+				}
+
+				kind := queryHeader.FindStringSubmatch(text)[2]
+				assert.Equal(t, kind, queryHeader.FindStringSubmatch(query)[2], name)
+
+				if kind == ":execlastid" {
+					assert.True(t, strings.HasSuffix(query, "RETURNING id"), name)
+				}
+			}
+		})
+	}
+
+	for _, backend := range backends(t) {
+		t.Run(backend.name, func(t *testing.T) {
+			db := setupDatabase(t, backend)
+
+			for name, query := range readQueries(t, backend.queries) {
+				stmt, err := db.Handle.PrepareContext(t.Context(), query)
+				if assert.NoError(t, err, name) {
+					_ = stmt.Close()
+				}
+			}
+		})
+	}
+}
+
+func TestRepository(t *testing.T) {
+	for _, backend := range backends(t) {
+		t.Run(backend.name, func(t *testing.T) {
+			db := setupDatabase(t, backend)
+			testRepository(t, db)
+		})
+	}
+}
+
+func testRepository(t *testing.T, db *repository.Database) {
+	ctx := context.Background()
+	suffix := strconv.FormatInt(time.Now().UnixNano(), 36)
+	now := time.Now().Truncate(time.Second)
+
+	organizer, err := db.StoreOrganizer(ctx, nil, domain.Organizer{Name: "Organizer " + suffix})
+	require.NoError(t, err)
+	require.NotZero(t, organizer.ID)
+
+	ownership := domain.OwnershipData{OrganizerID: organizer.ID}
+
+	contest, err := db.StoreContest(ctx, nil, domain.Contest{
+		Ownership:          ownership,
+		Country:            "SE",
+		Name:               "Contest " + suffix,
+		QualifyingProblems: 10,
+		Finalists:          7,
+		GracePeriod:        15 * time.Minute,
+		NameRetentionTime:  14 * 24 * time.Hour,
+		Created:            now,
+	})
+	require.NoError(t, err)
+	require.NotZero(t, contest.ID)
+
+	compClass, err := db.StoreCompClass(ctx, nil, domain.CompClass{
+		Ownership: ownership,
+		ContestID: contest.ID,
+		Name:      "Males",
+		TimeBegin: now.Add(-time.Hour),
+		TimeEnd:   now.Add(time.Hour),
+	})
+	require.NoError(t, err)
+	require.NotZero(t, compClass.ID)
+
+	t.Run("Users", func(t *testing.T) {
+		user, err := db.StoreUser(ctx, nil, domain.User{Username: "user-" + suffix})
+		require.NoError(t, err)
+		require.NotZero(t, user.ID)
+
+		require.NoError(t, db.AddUserToOrganizer(ctx, nil, user.ID, organizer.ID))
+
+		stored, err := db.GetUserByUsername(ctx, nil, "user-"+suffix)
+		require.NoError(t, err)
+
+		assert.Equal(t, user.ID, stored.ID)
+		require.Len(t, stored.Organizers, 1)
+		assert.Equal(t, organizer.ID, stored.Organizers[0].ID)
+		assert.Equal(t, organizer.Name, stored.Organizers[0].Name)
+
+		_, err = db.GetUserByUsername(ctx, nil, "missing-"+suffix)
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+
+	t.Run("Contest", func(t *testing.T) {
+		stored, err := db.GetContest(ctx, nil, contest.ID)
+		require.NoError(t, err)
+
+		assert.Equal(t, contest.Name, stored.Name)
+		assert.Equal(t, 15*time.Minute, stored.GracePeriod)
+		assert.True(t, compClass.TimeBegin.Equal(stored.TimeBegin))
+		assert.True(t, compClass.TimeEnd.Equal(stored.TimeEnd))
+		assert.True(t, now.Equal(stored.Created))
+		assert.Equal(t, 1, stored.Version)
+
+		stored.Info = "Updated"
+
+		updated, err := db.StoreContest(ctx, nil, stored)
+		require.NoError(t, err)
+
+		assert.Equal(t, contest.ID, updated.ID)
+		assert.Equal(t, 2, updated.Version)
+
+		tx, err := db.Begin()
+		require.NoError(t, err)
+
+		version, err := db.GetContestVersionForUpdate(ctx, tx, contest.ID)
+		tx.Rollback()
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, version)
+
+		restored, err := db.StoreContest(ctx, nil, stored)
+		require.NoError(t, err)
+
+		assert.Equal(t, 3, restored.Version)
+
+		contests, err := db.GetContestsByOrganizer(ctx, nil, organizer.ID)
+		require.NoError(t, err)
+		require.Len(t, contests, 1)
+		assert.True(t, compClass.TimeBegin.Equal(contests[0].TimeBegin))
+
+		running, err := db.GetContestsCurrentlyRunningOrByStartTime(ctx, nil, now.Add(24*time.Hour), now.Add(48*time.Hour))
+		require.NoError(t, err)
+
+		assert.True(t, containsContest(running, contest.ID))
+
+		upcoming, err := db.GetContestsCurrentlyRunningOrByStartTime(ctx, nil, now.Add(-2*time.Hour), now)
+		require.NoError(t, err)
+
+		assert.True(t, containsContest(upcoming, contest.ID))
+
+		_, err = db.GetContest(ctx, nil, 0)
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+
+	t.Run("Rounds", func(t *testing.T) {
+		round, err := db.StoreRound(ctx, nil, domain.Round{
+			Ownership:          ownership,
+			ContestID:          contest.ID,
+			Number:             1,
+			Name:               "Final",
+			QualifyingProblems: 4,
+			Finalists:          0,
+			TimeBegin:          now.Add(time.Hour),
+			TimeEnd:            now.Add(2 * time.Hour),
+		})
+		require.NoError(t, err)
+		require.NotZero(t, round.ID)
+
+		stored, err := db.GetRound(ctx, nil, round.ID)
+		require.NoError(t, err)
+
+		assert.True(t, round.TimeBegin.Equal(stored.TimeBegin))
+		assert.True(t, round.TimeEnd.Equal(stored.TimeEnd))
+
+		upcoming, err := db.GetRoundsCurrentlyRunningOrByStartTime(ctx, nil, now, now.Add(90*time.Minute))
+		require.NoError(t, err)
+		assert.True(t, containsRound(upcoming, round.ID))
+
+		later, err := db.GetRoundsCurrentlyRunningOrByStartTime(ctx, nil, now, now.Add(30*time.Minute))
+		require.NoError(t, err)
+		assert.False(t, containsRound(later, round.ID))
+
+		require.NoError(t, db.DeleteRound(ctx, nil, round.ID))
+	})
+
+	t.Run("Contenders", func(t *testing.T) {
+		var ids []domain.ContenderID
+
+		for i := range 5 {
+			contender := domain.Contender{
+				Ownership:        ownership,
+				ContestID:        contest.ID,
+				CompClassID:      compClass.ID,
+				RegistrationCode: fmt.Sprintf("%.12s%04d", strings.ToUpper(suffix), i),
+				Name:             fmt.Sprintf("Contender %d", i),
+			}
+
+			if i%2 == 0 {
+				contender.Entered = now
+			}
+
+			stored, err := db.StoreContender(ctx, nil, contender)
+			require.NoError(t, err)
+
+			ids = append(ids, stored.ID)
+		}
+
+		entered := true
+
+		page, err := db.GetContendersByContestFiltered(ctx, nil, contest.ID, domain.ContenderFilter{Entered: &entered}, domain.PageRequest{Limit: 2})
+		require.NoError(t, err)
+		require.Len(t, page, 2)
+
+		assert.Equal(t, ids[0], page[0].ID)
+		assert.Equal(t, ids[2], page[1].ID)
+
+		page, err = db.GetContendersByContestFiltered(ctx, nil, contest.ID, domain.ContenderFilter{}, domain.PageRequest{After: domain.ResourceID(ids[3]), Descending: true})
+		require.NoError(t, err)
+		require.Len(t, page, 3)
+
+		assert.Equal(t, []domain.ContenderID{ids[2], ids[1], ids[0]}, []domain.ContenderID{page[0].ID, page[1].ID, page[2].ID})
+
+		count, err := db.GetNumberOfContenders(ctx, nil, contest.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 5, count)
+
+		err = db.StoreScore(ctx, nil, domain.Score{
+			Timestamp:   now,
+			ContenderID: ids[0],
+			Score:       1000,
+			Placement:   1,
+			Finalist:    true,
+			RankOrder:   0,
+		})
+		require.NoError(t, err)
+
+		contender, err := db.GetContender(ctx, nil, ids[0])
+		require.NoError(t, err)
+		require.NotNil(t, contender.Score)
+
+		assert.Equal(t, 1000, contender.Score.Score)
+		assert.True(t, contender.Score.Finalist)
+		assert.True(t, now.Equal(contender.Entered))
+
+		err = db.StoreScore(ctx, nil, domain.Score{ContenderID: 0, Timestamp: now})
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+
+		for score := range 2 {
+			err = db.StorePublishedScore(ctx, nil, domain.Score{
+				Timestamp:   now,
+				ContenderID: ids[1],
+				Score:       score,
+				Placement:   2,
+				Finalist:    false,
+				RankOrder:   1,
+			})
+			require.NoError(t, err)
+		}
+
+		published, err := db.GetPublishedScoresByContest(ctx, nil, contest.ID)
+		require.NoError(t, err)
+		require.Len(t, published, 1)
+
+		assert.Equal(t, ids[1], published[0].ContenderID)
+		assert.Equal(t, 1, published[0].Score)
+		assert.True(t, now.Equal(published[0].Timestamp))
+
+		require.NoError(t, db.DeleteScoresByContest(ctx, nil, contest.ID))
+		require.NoError(t, db.DeletePublishedScoresByContest(ctx, nil, contest.ID))
+
+		contender, err = db.GetContender(ctx, nil, ids[0])
+		require.NoError(t, err)
+		assert.Nil(t, contender.Score)
+
+		published, err = db.GetPublishedScoresByContest(ctx, nil, contest.ID)
+		require.NoError(t, err)
+		assert.Empty(t, published)
+	})
+
+	t.Run("ProblemsAndTicks", func(t *testing.T) {
+		problem, err := db.StoreProblem(ctx, nil, domain.Problem{
+			Ownership:        ownership,
+			ContestID:        contest.ID,
+			Number:           1,
+			HoldColorPrimary: "#ff0000",
+			Tags:             []string{"crimp", "slab"},
+			CompClassIDs:     []domain.CompClassID{compClass.ID},
+			ProblemValue:     domain.ProblemValue{PointsTop: 100, FlashBonus: 10},
+		})
+		require.NoError(t, err)
+		require.NotZero(t, problem.ID)
+
+		stored, err := db.GetProblem(ctx, nil, problem.ID)
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"crimp", "slab"}, stored.Tags)
+		assert.Equal(t, []domain.CompClassID{compClass.ID}, stored.CompClassIDs)
+		assert.Equal(t, 100, stored.PointsTop)
+		assert.Equal(t, 10, stored.FlashBonus)
+
+		contender, err := db.StoreContender(ctx, nil, domain.Contender{
+			Ownership:        ownership,
+			ContestID:        contest.ID,
+			CompClassID:      compClass.ID,
+			RegistrationCode: fmt.Sprintf("%.12sTICK", strings.ToUpper(suffix)),
+		})
+		require.NoError(t, err)
+
+		tick := domain.Tick{
+			Ownership:   domain.OwnershipData{OrganizerID: organizer.ID, ContenderID: &contender.ID},
+			Timestamp:   now,
+			ContestID:   contest.ID,
+			ProblemID:   problem.ID,
+			Top:         true,
+			AttemptsTop: 1,
+		}
+
+		first, err := db.StoreTick(ctx, nil, tick)
+		require.NoError(t, err)
+		require.NotZero(t, first.ID)
+
+		tick.AttemptsTop = 3
+
+		_, err = db.StoreTick(ctx, nil, tick)
+		require.NoError(t, err)
+
+		ticks, err := db.GetTicksByContender(ctx, nil, contender.ID)
+		require.NoError(t, err)
+		require.Len(t, ticks, 1)
+
+		assert.Equal(t, first.ID, ticks[0].ID)
+		assert.Equal(t, 3, ticks[0].AttemptsTop)
+
+		filtered, err := db.GetTicksByContestFiltered(ctx, nil, contest.ID, domain.TickFilter{Since: now.Add(time.Second)}, domain.PageRequest{})
+		require.NoError(t, err)
+		assert.Empty(t, filtered)
+
+		filtered, err = db.GetTicksByContestFiltered(ctx, nil, contest.ID, domain.TickFilter{Since: now}, domain.PageRequest{})
+		require.NoError(t, err)
+		assert.Len(t, filtered, 1)
+	})
+
+	t.Run("Transactions", func(t *testing.T) {
+		tx, err := db.Begin()
+		require.NoError(t, err)
+
+		team, err := db.StoreTeam(ctx, tx, domain.Team{
+			Ownership: ownership,
+			ContestID: contest.ID,
+			Name:      "Rolled back",
+		})
+		require.NoError(t, err)
+
+		tx.Rollback()
+
+		_, err = db.GetTeam(ctx, nil, team.ID)
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+
+	t.Run("AuditEntriesOutliveContest", func(t *testing.T) {
+		purged, err := db.StoreContest(ctx, nil, domain.Contest{
+			Ownership: ownership,
+			Country:   "SE",
+			Name:      "Purged " + suffix,
+			Created:   now,
+		})
+		require.NoError(t, err)
+
+		entry, err := db.StoreAuditEntry(ctx, nil, domain.AuditEntry{
+			Ownership: ownership,
+			ContestID: purged.ID,
+			Action:    domain.AuditActionContenderErased,
+			Timestamp: now,
+		})
+		require.NoError(t, err)
+
+		require.NoError(t, db.DeleteContest(ctx, nil, purged.ID))
+
+		var count int
+		err = db.Handle.QueryRowContext(ctx, fmt.Sprintf("SELECT COUNT(*) FROM audit_entry WHERE id = %d", entry.ID)).Scan(&count)
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+	})
+}
+
+func containsContest(contests []domain.Contest, contestID domain.ContestID) bool {
+	for _, contest := range contests {
+		if contest.ID == contestID {
+			return true
+		}
+	}
+
+	return false
+}
+
+func containsRound(rounds []domain.Round, roundID domain.RoundID) bool {
+	for _, round := range rounds {
+		if round.ID == roundID {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,120 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/climblive/platform/backend/internal/database"
+	"github.com/climblive/platform/backend/internal/domain"
+	"github.com/go-errors/errors"
+)
+
+func (d *Database) GetRetentionPolicy(ctx context.Context, tx domain.Transaction, policyID domain.RetentionPolicyID) (domain.RetentionPolicy, error) {
+	record, err := d.WithTx(tx).GetRetentionPolicy(ctx, int32(policyID))
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return domain.RetentionPolicy{}, errors.Wrap(domain.ErrNotFound, 0)
+	case err != nil:
+		return domain.RetentionPolicy{}, errors.Wrap(err, 0)
+	}
+
+	return retentionPolicyToDomain(record.RetentionPolicy), nil
+}
+
+func (d *Database) GetRetentionPoliciesByOrganizer(ctx context.Context, tx domain.Transaction, organizerID domain.OrganizerID) ([]domain.RetentionPolicy, error) {
+	records, err := d.WithTx(tx).GetRetentionPoliciesByOrganizer(ctx, int32(organizerID))
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	policies := make([]domain.RetentionPolicy, 0)
+
+	for _, record := range records {
+		policies = append(policies, retentionPolicyToDomain(record.RetentionPolicy))
+	}
+
+	return policies, nil
+}
+
+func (d *Database) GetEnabledRetentionPolicies(ctx context.Context, tx domain.Transaction) ([]domain.RetentionPolicy, error) {
+	records, err := d.WithTx(tx).GetEnabledRetentionPolicies(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	policies := make([]domain.RetentionPolicy, 0)
+
+	for _, record := range records {
+		policies = append(policies, retentionPolicyToDomain(record.RetentionPolicy))
+	}
+
+	return policies, nil
+}
+
+func (d *Database) StoreRetentionPolicy(ctx context.Context, tx domain.Transaction, policy domain.RetentionPolicy) (domain.RetentionPolicy, error) {
+	params := database.UpsertRetentionPolicyParams{
+		ID:              int32(policy.ID),
+		OrganizerID:     int32(policy.Ownership.OrganizerID),
+		Target:          string(policy.Target),
+		RetentionPeriod: int32(policy.RetentionPeriod / time.Minute),
+		Enabled:         policy.Enabled,
+		Created:         policy.Created,
+	}
+
+	insertID, err := d.WithTx(tx).UpsertRetentionPolicy(ctx, params)
+	if err != nil {
+		return domain.RetentionPolicy{}, errors.Wrap(err, 0)
+	}
+
+	if insertID != 0 {
+		policy.ID = domain.RetentionPolicyID(insertID)
+	}
+
+	return policy, nil
+}
+
+func (d *Database) DeleteRetentionPolicy(ctx context.Context, tx domain.Transaction, policyID domain.RetentionPolicyID) error {
+	err := d.WithTx(tx).DeleteRetentionPolicy(ctx, int32(policyID))
+	if err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	return nil
+}
+
+func (d *Database) GetRetentionPolicyExecutions(ctx context.Context, tx domain.Transaction, policyID domain.RetentionPolicyID) ([]domain.RetentionPolicyExecution, error) {
+	records, err := d.WithTx(tx).GetRetentionPolicyExecutions(ctx, int32(policyID))
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	executions := make([]domain.RetentionPolicyExecution, 0)
+
+	for _, record := range records {
+		executions = append(executions, retentionPolicyExecutionToDomain(record.RetentionPolicyExecution))
+	}
+
+	return executions, nil
+}
+
+func (d *Database) StoreRetentionPolicyExecution(ctx context.Context, tx domain.Transaction, execution domain.RetentionPolicyExecution) (domain.RetentionPolicyExecution, error) {
+	params := database.InsertRetentionPolicyExecutionParams{
+		OrganizerID:       int32(execution.Ownership.OrganizerID),
+		RetentionPolicyID: int32(execution.PolicyID),
+		Timestamp:         execution.Timestamp,
+		Contests:          int32(execution.Contests),
+		Contenders:        int32(execution.Contenders),
+		Ticks:             int32(execution.Ticks),
+		Error:             makeNullString(execution.Error),
+	}
+
+	insertID, err := d.WithTx(tx).InsertRetentionPolicyExecution(ctx, params)
+	if err != nil {
+		return domain.RetentionPolicyExecution{}, errors.Wrap(err, 0)
+	}
+
+	execution.ID = domain.RetentionPolicyExecutionID(insertID)
+
+	return execution, nil
+}
@@ -0,0 +1,188 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/climblive/platform/backend/internal/database"
+	"github.com/climblive/platform/backend/internal/domain"
+	"github.com/go-errors/errors"
+)
+
+func (d *Database) GetRound(ctx context.Context, tx domain.Transaction, roundID domain.RoundID) (domain.Round, error) {
+	record, err := d.WithTx(tx).GetRound(ctx, int32(roundID))
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return domain.Round{}, errors.Wrap(domain.ErrNotFound, 0)
+	case err != nil:
+		return domain.Round{}, errors.Wrap(err, 0)
+	}
+
+	return roundToDomain(record.Round), nil
+}
+
+func (d *Database) GetRoundsByContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) ([]domain.Round, error) {
+	records, err := d.WithTx(tx).GetRoundsByContest(ctx, int32(contestID))
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	rounds := make([]domain.Round, 0)
+
+	for _, record := range records {
+		rounds = append(rounds, roundToDomain(record.Round))
+	}
+
+	return rounds, nil
+}
+
+func (d *Database) GetRoundsCurrentlyRunningOrByStartTime(ctx context.Context, tx domain.Transaction, earliestStartTime, latestStartTime time.Time) ([]domain.Round, error) {
+	records, err := d.WithTx(tx).GetRoundsCurrentlyRunningOrByStartTime(ctx, database.GetRoundsCurrentlyRunningOrByStartTimeParams{
+		EarliestStartTime: makeNullTime(earliestStartTime),
+		LatestStartTime:   makeNullTime(latestStartTime),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	rounds := make([]domain.Round, 0)
+
+	for _, record := range records {
+		rounds = append(rounds, roundToDomain(record.Round))
+	}
+
+	return rounds, nil
+}
+
+func (d *Database) StoreRound(ctx context.Context, tx domain.Transaction, round domain.Round) (domain.Round, error) {
+	params := database.UpsertRoundParams{
+		ID:                 int32(round.ID),
+		OrganizerID:        int32(round.Ownership.OrganizerID),
+		ContestID:          int32(round.ContestID),
+		Number:             int32(round.Number),
+		Name:               round.Name,
+		QualifyingProblems: int32(round.QualifyingProblems),
+		Finalists:          int32(round.Finalists),
+		TimeBegin:          makeNullTime(round.TimeBegin),
+		TimeEnd:            makeNullTime(round.TimeEnd),
+	}
+
+	insertID, err := d.WithTx(tx).UpsertRound(ctx, params)
+	if err != nil {
+		return domain.Round{}, errors.Wrap(err, 0)
+	}
+
+	if insertID != 0 {
+		round.ID = domain.RoundID(insertID)
+	}
+
+	return round, nil
+}
+
+func (d *Database) DeleteRound(ctx context.Context, tx domain.Transaction, roundID domain.RoundID) error {
+	err := d.WithTx(tx).DeleteRound(ctx, int32(roundID))
+	if err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	return nil
+}
+
+func (d *Database) GetStartList(ctx context.Context, tx domain.Transaction, roundID domain.RoundID) ([]domain.StartListEntry, error) {
+	records, err := d.WithTx(tx).GetStartList(ctx, int32(roundID))
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	entries := make([]domain.StartListEntry, 0)
+
+	for _, record := range records {
+		entries = append(entries, startListEntryToDomain(record.RoundContender))
+	}
+
+	return entries, nil
+}
+
+func (d *Database) GetStartListEntry(ctx context.Context, tx domain.Transaction, roundID domain.RoundID, contenderID domain.ContenderID) (domain.StartListEntry, error) {
+	record, err := d.WithTx(tx).GetStartListEntry(ctx, database.GetStartListEntryParams{
+		RoundID:     int32(roundID),
+		ContenderID: int32(contenderID),
+	})
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return domain.StartListEntry{}, errors.Wrap(domain.ErrNotFound, 0)
+	case err != nil:
+		return domain.StartListEntry{}, errors.Wrap(err, 0)
+	}
+
+	return startListEntryToDomain(record.RoundContender), nil
+}
+
+func (d *Database) StoreStartListEntry(ctx context.Context, tx domain.Transaction, entry domain.StartListEntry) error {
+	params := database.InsertStartListEntryParams{
+		RoundID:           int32(entry.RoundID),
+		ContenderID:       int32(entry.ContenderID),
+		PreviousPlacement: int32(entry.PreviousPlacement),
+		Timestamp:         sql.NullTime{},
+		Score:             sql.NullInt32{},
+		Placement:         sql.NullInt32{},
+		Finalist:          sql.NullBool{},
+		RankOrder:         sql.NullInt32{},
+	}
+
+	if score := entry.Score; score != nil {
+		params.Timestamp = sql.NullTime{Time: score.Timestamp, Valid: true}
+		params.Score = sql.NullInt32{Int32: int32(score.Score), Valid: true}
+		params.Placement = sql.NullInt32{Int32: int32(score.Placement), Valid: true}
+		params.Finalist = sql.NullBool{Bool: score.Finalist, Valid: true}
+		params.RankOrder = sql.NullInt32{Int32: int32(score.RankOrder), Valid: true}
+	}
+
+	err := d.WithTx(tx).InsertStartListEntry(ctx, params)
+	if err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	return nil
+}
+
+func (d *Database) DeleteStartList(ctx context.Context, tx domain.Transaction, roundID domain.RoundID) error {
+	err := d.WithTx(tx).DeleteStartList(ctx, int32(roundID))
+	if err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	return nil
+}
+
+func (d *Database) StoreRoundScore(ctx context.Context, tx domain.Transaction, roundID domain.RoundID, score domain.Score) error {
+	params := database.UpdateRoundScoreParams{
+		RoundID:     int32(roundID),
+		ContenderID: int32(score.ContenderID),
+		Timestamp:   sql.NullTime{Time: score.Timestamp, Valid: true},
+		Score:       sql.NullInt32{Int32: int32(score.Score), Valid: true},
+		Placement:   sql.NullInt32{Int32: int32(score.Placement), Valid: true},
+		Finalist:    sql.NullBool{Bool: score.Finalist, Valid: true},
+		RankOrder:   sql.NullInt32{Int32: int32(score.RankOrder), Valid: true},
+	}
+
+	affected, err := d.WithTx(tx).UpdateRoundScore(ctx, params)
+	switch {
+	case err != nil:
+		return errors.Wrap(err, 0)
+	case affected == 0:
+		return errors.New(domain.ErrNotFound)
+	}
+
+	return nil
+}
+
+func (d *Database) DeleteRoundsByContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) error {
+	err := d.WithTx(tx).DeleteRoundsByContest(ctx, int32(contestID))
+	if err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	return nil
+}
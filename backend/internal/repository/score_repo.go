@@ -20,7 +20,7 @@ func (d *Database) StoreScore(ctx context.Context, tx domain.Transaction, score
 
 	err := d.WithTx(tx).UpsertScore(ctx, params)
 	switch {
-	case mysqlForeignKeyConstraintViolation.Is(err):
+	case isForeignKeyViolation(err):
 		return errors.New(domain.ErrNotFound)
 	case err != nil:
 		return errors.Wrap(err, 0)
@@ -28,3 +28,64 @@ func (d *Database) StoreScore(ctx context.Context, tx domain.Transaction, score
 
 	return nil
 }
+
+func (d *Database) StorePublishedScore(ctx context.Context, tx domain.Transaction, score domain.Score) error {
+	params := database.UpsertPublishedScoreParams{
+		ContenderID: int32(score.ContenderID),
+		Timestamp:   score.Timestamp,
+		Score:       int32(score.Score),
+		Placement:   int32(score.Placement),
+		Finalist:    score.Finalist,
+		RankOrder:   int32(score.RankOrder),
+	}
+
+	err := d.WithTx(tx).UpsertPublishedScore(ctx, params)
+	switch {
+	case isForeignKeyViolation(err):
+		return errors.New(domain.ErrNotFound)
+	case err != nil:
+		return errors.Wrap(err, 0)
+	}
+
+	return nil
+}
+
+func (d *Database) GetPublishedScoresByContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) ([]domain.Score, error) {
+	records, err := d.WithTx(tx).GetPublishedScoresByContest(ctx, int32(contestID))
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	scores := make([]domain.Score, 0)
+
+	for _, record := range records {
+		scores = append(scores, domain.Score{
+			Timestamp:   record.PublishedScore.Timestamp,
+			ContenderID: domain.ContenderID(record.PublishedScore.ContenderID),
+			Score:       int(record.PublishedScore.Score),
+			Placement:   int(record.PublishedScore.Placement),
+			Finalist:    record.PublishedScore.Finalist,
+			RankOrder:   int(record.PublishedScore.RankOrder),
+		})
+	}
+
+	return scores, nil
+}
+
+func (d *Database) DeleteScoresByContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) error {
+	err := d.WithTx(tx).DeleteScoresByContest(ctx, int32(contestID))
+	if err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	return nil
+}
+
+func (d *Database) DeletePublishedScoresByContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) error {
+	err := d.WithTx(tx).DeletePublishedScoresByContest(ctx, int32(contestID))
+	if err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	return nil
+}
@@ -0,0 +1,776 @@
+-- name: GetContender :one
+SELECT contender.id, contender.organizer_id, contender.contest_id, contender.registration_code, contender.name, contender.class_id, contender.team_id, contender.entered, contender.disqualified, contender.withdrawn_from_finals, contender.scrubbed_at, contender.scrub_before, contender.waitlist_class_id, contender.waitlisted_at, contender.version, score.contender_id, score.timestamp, score.score, score.placement, score.finalist, score.rank_order
+FROM contender
+LEFT JOIN score ON score.contender_id = id
+WHERE id = ?;
+
+-- name: GetContenderByCode :one
+SELECT contender.id, contender.organizer_id, contender.contest_id, contender.registration_code, contender.name, contender.class_id, contender.team_id, contender.entered, contender.disqualified, contender.withdrawn_from_finals, contender.scrubbed_at, contender.scrub_before, contender.waitlist_class_id, contender.waitlisted_at, contender.version, score.contender_id, score.timestamp, score.score, score.placement, score.finalist, score.rank_order
+FROM contender
+LEFT JOIN score ON score.contender_id = id
+WHERE registration_code = ?;
+
+-- name: GetContendersByCompClass :many
+SELECT contender.id, contender.organizer_id, contender.contest_id, contender.registration_code, contender.name, contender.class_id, contender.team_id, contender.entered, contender.disqualified, contender.withdrawn_from_finals, contender.scrubbed_at, contender.scrub_before, contender.waitlist_class_id, contender.waitlisted_at, contender.version, score.contender_id, score.timestamp, score.score, score.placement, score.finalist, score.rank_order
+FROM contender
+LEFT JOIN score ON score.contender_id = id
+WHERE class_id = ?;
+
+-- name: GetWaitlistedContenders :many
+SELECT contender.id, contender.organizer_id, contender.contest_id, contender.registration_code, contender.name, contender.class_id, contender.team_id, contender.entered, contender.disqualified, contender.withdrawn_from_finals, contender.scrubbed_at, contender.scrub_before, contender.waitlist_class_id, contender.waitlisted_at, contender.version, score.contender_id, score.timestamp, score.score, score.placement, score.finalist, score.rank_order
+FROM contender
+LEFT JOIN score ON score.contender_id = id
+WHERE waitlist_class_id = ?
+ORDER BY waitlisted_at, id;
+
+-- name: GetContendersByTeam :many
+SELECT contender.id, contender.organizer_id, contender.contest_id, contender.registration_code, contender.name, contender.class_id, contender.team_id, contender.entered, contender.disqualified, contender.withdrawn_from_finals, contender.scrubbed_at, contender.scrub_before, contender.waitlist_class_id, contender.waitlisted_at, contender.version, score.contender_id, score.timestamp, score.score, score.placement, score.finalist, score.rank_order
+FROM contender
+LEFT JOIN score ON score.contender_id = id
+WHERE team_id = ?;
+
+-- name: GetContendersByContest :many
+SELECT contender.id, contender.organizer_id, contender.contest_id, contender.registration_code, contender.name, contender.class_id, contender.team_id, contender.entered, contender.disqualified, contender.withdrawn_from_finals, contender.scrubbed_at, contender.scrub_before, contender.waitlist_class_id, contender.waitlisted_at, contender.version, score.contender_id, score.timestamp, score.score, score.placement, score.finalist, score.rank_order
+FROM contender
+LEFT JOIN score ON score.contender_id = id
+WHERE contest_id = ?;
+
+-- name: GetContendersByContestFiltered :many
+SELECT contender.id, contender.organizer_id, contender.contest_id, contender.registration_code, contender.name, contender.class_id, contender.team_id, contender.entered, contender.disqualified, contender.withdrawn_from_finals, contender.scrubbed_at, contender.scrub_before, contender.waitlist_class_id, contender.waitlisted_at, contender.version, score.contender_id, score.timestamp, score.score, score.placement, score.finalist, score.rank_order
+FROM contender
+LEFT JOIN score ON score.contender_id = id
+WHERE
+	contest_id = ?
+	AND (? IS NULL OR class_id = ?)
+	AND (? IS NULL OR CASE WHEN ? THEN entered IS NOT NULL ELSE entered IS NULL END)
+	AND (? IS NULL OR disqualified = ?)
+	AND (? = 0 OR CASE WHEN ? THEN id < ? ELSE id > ? END)
+ORDER BY CASE WHEN ? THEN -id ELSE id END
+LIMIT ?;
+
+-- name: DeleteContender :exec
+DELETE FROM contender
+WHERE id = ?;
+
+-- name: CountContenders :one
+SELECT COUNT(*)
+FROM contender
+WHERE contest_id = ?;
+
+-- name: GetContenderVersionForUpdate :one
+SELECT version
+FROM contender
+WHERE id = ?;
+
+-- name: UpsertContender :execlastid
+INSERT INTO 
+	contender (id, organizer_id, contest_id, registration_code, name, class_id, team_id, entered, disqualified, withdrawn_from_finals, scrubbed_at, scrub_before, waitlist_class_id, waitlisted_at, version)
+VALUES 
+	(NULLIF(?, 0), ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT DO UPDATE SET
+    organizer_id = excluded.organizer_id,
+    contest_id = excluded.contest_id,
+    registration_code = excluded.registration_code,
+    name = excluded.name,
+    class_id = excluded.class_id,
+    team_id = excluded.team_id,
+    entered = excluded.entered,
+    disqualified = excluded.disqualified,
+    withdrawn_from_finals = excluded.withdrawn_from_finals,
+    scrubbed_at = excluded.scrubbed_at,
+    scrub_before = excluded.scrub_before,
+    waitlist_class_id = excluded.waitlist_class_id,
+    waitlisted_at = excluded.waitlisted_at,
+    version = version + 1
+RETURNING id;
+
+-- name: UpsertScore :exec
+INSERT INTO
+    score (contender_id, timestamp, score, placement, finalist, rank_order)
+VALUES
+    (?, ?, ?, ?, ?, ?)
+ON CONFLICT DO UPDATE SET
+    timestamp = excluded.timestamp,
+    score = excluded.score,
+    placement = excluded.placement,
+    finalist = excluded.finalist,
+    rank_order = excluded.rank_order;
+
+-- name: UpsertPublishedScore :exec
+INSERT INTO
+    published_score (contender_id, timestamp, score, placement, finalist, rank_order)
+VALUES
+    (?, ?, ?, ?, ?, ?)
+ON CONFLICT DO UPDATE SET
+    timestamp = excluded.timestamp,
+    score = excluded.score,
+    placement = excluded.placement,
+    finalist = excluded.finalist,
+    rank_order = excluded.rank_order;
+
+-- name: GetPublishedScoresByContest :many
+SELECT published_score.contender_id, published_score.timestamp, published_score.score, published_score.placement, published_score.finalist, published_score.rank_order
+FROM published_score
+JOIN contender ON contender.id = published_score.contender_id
+WHERE contender.contest_id = ?;
+
+-- name: GetCompClass :one
+SELECT comp_class.id, comp_class.organizer_id, comp_class.contest_id, comp_class.name, comp_class.description, comp_class.color, comp_class.time_begin, comp_class.time_end, comp_class.capacity
+FROM comp_class
+WHERE id = ?;
+
+-- name: GetCompClassesByContest :many
+SELECT comp_class.id, comp_class.organizer_id, comp_class.contest_id, comp_class.name, comp_class.description, comp_class.color, comp_class.time_begin, comp_class.time_end, comp_class.capacity
+FROM comp_class
+WHERE contest_id = ?;
+
+-- name: DeleteCompClass :exec
+DELETE FROM comp_class
+WHERE id = ?;
+
+-- name: UpsertCompClass :execlastid
+INSERT INTO 
+	comp_class (id, organizer_id, contest_id, name, description, color, time_begin, time_end, capacity)
+VALUES 
+	(NULLIF(?, 0), ?, ?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT DO UPDATE SET
+    organizer_id = excluded.organizer_id,
+    contest_id = excluded.contest_id,
+    name = excluded.name,
+    description = excluded.description,
+    color = excluded.color,
+    time_begin = excluded.time_begin,
+    time_end = excluded.time_end,
+    capacity = excluded.capacity
+RETURNING id;
+
+-- name: GetContest :one
+SELECT contest.id, contest.organizer_id, contest.archived_at, contest.series_id, contest.name, contest.description, contest.location, contest.country, contest.qualifying_problems, contest.finalists, contest.info, contest.grace_period, contest.name_retention_time, contest.scoreboard_freeze, contest.scoreboard_revealed_at, contest.self_registration, contest.created, contest.version, MIN(cc.time_begin) AS time_begin, MAX(cc.time_end) AS time_end, COUNT(DISTINCT CASE WHEN c.entered IS NOT NULL THEN c.id END) AS registered_contenders
+FROM contest
+LEFT JOIN comp_class cc ON cc.contest_id = contest.id
+LEFT JOIN contender c ON c.contest_id = contest.id
+WHERE contest.id = ?
+GROUP BY contest.id;
+
+-- name: GetContests :many
+SELECT contest.id, contest.organizer_id, contest.archived_at, contest.series_id, contest.name, contest.description, contest.location, contest.country, contest.qualifying_problems, contest.finalists, contest.info, contest.grace_period, contest.name_retention_time, contest.scoreboard_freeze, contest.scoreboard_revealed_at, contest.self_registration, contest.created, contest.version, MIN(cc.time_begin) AS time_begin, MAX(cc.time_end) AS time_end, COUNT(DISTINCT CASE WHEN c.entered IS NOT NULL THEN c.id END) AS registered_contenders
+FROM contest
+LEFT JOIN comp_class cc ON cc.contest_id = contest.id
+LEFT JOIN contender c ON c.contest_id = contest.id
+WHERE
+	(? IS NULL OR contest.country = ?)
+	AND (? IS NULL OR CASE WHEN ? THEN contest.archived_at IS NOT NULL ELSE contest.archived_at IS NULL END)
+	AND (? = 0 OR CASE WHEN ? THEN contest.id < ? ELSE contest.id > ? END)
+GROUP BY contest.id
+ORDER BY CASE WHEN ? THEN -contest.id ELSE contest.id END
+LIMIT ?;
+
+-- name: GetContestVersionForUpdate :one
+SELECT version
+FROM contest
+WHERE id = ?;
+
+-- name: UpsertContest :execlastid
+INSERT INTO 
+	contest (id, organizer_id, archived_at, series_id, name, description, location, country, qualifying_problems, finalists, info, grace_period, name_retention_time, scoreboard_freeze, scoreboard_revealed_at, self_registration, created, version)
+VALUES 
+	(NULLIF(?, 0), ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT DO UPDATE SET
+    organizer_id = excluded.organizer_id,
+    archived_at = excluded.archived_at,
+    series_id = excluded.series_id,
+    name = excluded.name,
+    description = excluded.description,
+    location = excluded.location,
+    country = excluded.country,
+    qualifying_problems = excluded.qualifying_problems,
+    finalists = excluded.finalists,
+    info = excluded.info,
+    grace_period = excluded.grace_period,
+    name_retention_time = excluded.name_retention_time,
+    scoreboard_freeze = excluded.scoreboard_freeze,
+    scoreboard_revealed_at = excluded.scoreboard_revealed_at,
+    self_registration = excluded.self_registration,
+    created = excluded.created,
+    version = version + 1
+RETURNING id;
+
+-- name: DeleteContest :exec
+DELETE FROM contest
+WHERE id = ?;
+
+-- name: GetContestsByOrganizer :many
+SELECT contest.id, contest.organizer_id, contest.archived_at, contest.series_id, contest.name, contest.description, contest.location, contest.country, contest.qualifying_problems, contest.finalists, contest.info, contest.grace_period, contest.name_retention_time, contest.scoreboard_freeze, contest.scoreboard_revealed_at, contest.self_registration, contest.created, contest.version, MIN(cc.time_begin) AS time_begin, MAX(cc.time_end) AS time_end, COUNT(DISTINCT CASE WHEN c.entered IS NOT NULL THEN c.id END) AS registered_contenders
+FROM contest
+LEFT JOIN comp_class cc ON cc.contest_id = contest.id
+LEFT JOIN contender c ON c.contest_id = contest.id
+WHERE contest.organizer_id = ?
+GROUP BY contest.id;
+
+-- name: GetContestsCurrentlyRunningOrByStartTime :many
+SELECT
+	id, organizer_id, archived_at, series_id, name, description, location, country, qualifying_problems, finalists, info, grace_period, name_retention_time, scoreboard_freeze, scoreboard_revealed_at, self_registration, created, version, time_begin, time_end
+FROM (
+    SELECT contest.id, contest.organizer_id, contest.archived_at, contest.series_id, contest.name, contest.description, contest.location, contest.country, contest.qualifying_problems, contest.finalists, contest.info, contest.grace_period, contest.name_retention_time, contest.scoreboard_freeze, contest.scoreboard_revealed_at, contest.self_registration, contest.created, contest.version, MIN(cc.time_begin) AS time_begin, MAX(cc.time_end) AS time_end
+    FROM contest
+    JOIN comp_class cc ON cc.contest_id = contest.id
+    WHERE archived_at IS NULL
+    GROUP BY contest.id) AS sub
+WHERE
+    datetime('now') BETWEEN datetime(sub.time_begin) AND datetime(sub.time_end, '+' || (sub.grace_period + 15) || ' minutes')
+	OR sub.time_begin BETWEEN ? AND ?;
+
+-- name: GetProblem :one
+SELECT problem.id, problem.organizer_id, problem.contest_id, problem.round_id, problem.number, problem.hold_color_primary, problem.hold_color_secondary, problem.zone_1_enabled, problem.zone_2_enabled, problem.description, problem.sector, problem.tags, problem.points_zone_1, problem.points_zone_2, problem.points_top, problem.flash_bonus, problem.version
+FROM problem
+WHERE id = ?;
+
+-- name: GetProblemByNumber :one
+SELECT problem.id, problem.organizer_id, problem.contest_id, problem.round_id, problem.number, problem.hold_color_primary, problem.hold_color_secondary, problem.zone_1_enabled, problem.zone_2_enabled, problem.description, problem.sector, problem.tags, problem.points_zone_1, problem.points_zone_2, problem.points_top, problem.flash_bonus, problem.version
+FROM problem
+WHERE contest_id = ? AND number = ?;
+
+-- name: GetProblemsByContest :many
+SELECT problem.id, problem.organizer_id, problem.contest_id, problem.round_id, problem.number, problem.hold_color_primary, problem.hold_color_secondary, problem.zone_1_enabled, problem.zone_2_enabled, problem.description, problem.sector, problem.tags, problem.points_zone_1, problem.points_zone_2, problem.points_top, problem.flash_bonus, problem.version
+FROM problem
+WHERE contest_id = ?;
+
+-- name: DeleteProblem :exec
+DELETE FROM problem
+WHERE id = ?;
+
+-- name: GetProblemVersionForUpdate :one
+SELECT version
+FROM problem
+WHERE id = ?;
+
+-- name: UpsertProblem :execlastid
+INSERT INTO 
+	problem (id, organizer_id, contest_id, round_id, number, hold_color_primary, hold_color_secondary, zone_1_enabled, zone_2_enabled, description, sector, tags, points_zone_1, points_zone_2, points_top, flash_bonus, version)
+VALUES 
+	(NULLIF(?, 0), ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT DO UPDATE SET
+    organizer_id = excluded.organizer_id,
+    contest_id = excluded.contest_id,
+    round_id = excluded.round_id,
+    number = excluded.number,
+    hold_color_primary = excluded.hold_color_primary,
+    hold_color_secondary = excluded.hold_color_secondary,
+    zone_1_enabled = excluded.zone_1_enabled,
+    zone_2_enabled = excluded.zone_2_enabled,
+    description = excluded.description,
+    sector = excluded.sector,
+    tags = excluded.tags,
+    points_zone_1 = excluded.points_zone_1,
+    points_zone_2 = excluded.points_zone_2,
+    points_top = excluded.points_top,
+    flash_bonus = excluded.flash_bonus,
+    version = version + 1
+RETURNING id;
+
+-- name: GetProblemCompClasses :many
+SELECT comp_class_id
+FROM problem_comp_class
+WHERE problem_id = ?;
+
+-- name: GetProblemCompClassesByContest :many
+SELECT problem_comp_class.problem_id, problem_comp_class.comp_class_id
+FROM problem_comp_class
+JOIN problem ON problem.id = problem_comp_class.problem_id
+WHERE problem.contest_id = ?;
+
+-- name: DeleteProblemCompClasses :exec
+DELETE FROM problem_comp_class
+WHERE problem_id = ?;
+
+-- name: InsertProblemCompClass :exec
+INSERT INTO
+    problem_comp_class (problem_id, comp_class_id)
+VALUES
+    (?, ?);
+
+-- name: GetTick :one
+SELECT tick.id, tick.organizer_id, tick.contest_id, tick.contender_id, tick.problem_id, tick.timestamp, tick.zone_1, tick.attempts_zone_1, tick.zone_2, tick.attempts_zone_2, tick.top, tick.attempts_top
+FROM tick
+WHERE id = ?;
+
+-- name: GetTickByContenderAndProblem :one
+SELECT tick.id, tick.organizer_id, tick.contest_id, tick.contender_id, tick.problem_id, tick.timestamp, tick.zone_1, tick.attempts_zone_1, tick.zone_2, tick.attempts_zone_2, tick.top, tick.attempts_top
+FROM tick
+WHERE contender_id = ? AND problem_id = ?;
+
+-- name: GetTicksByContender :many
+SELECT tick.id, tick.organizer_id, tick.contest_id, tick.contender_id, tick.problem_id, tick.timestamp, tick.zone_1, tick.attempts_zone_1, tick.zone_2, tick.attempts_zone_2, tick.top, tick.attempts_top
+FROM tick
+WHERE contender_id = ?;
+
+-- name: GetTicksByContest :many
+SELECT tick.id, tick.organizer_id, tick.contest_id, tick.contender_id, tick.problem_id, tick.timestamp, tick.zone_1, tick.attempts_zone_1, tick.zone_2, tick.attempts_zone_2, tick.top, tick.attempts_top
+FROM tick
+WHERE contest_id = ?;
+
+-- name: GetTicksByContestFiltered :many
+SELECT tick.id, tick.organizer_id, tick.contest_id, tick.contender_id, tick.problem_id, tick.timestamp, tick.zone_1, tick.attempts_zone_1, tick.zone_2, tick.attempts_zone_2, tick.top, tick.attempts_top
+FROM tick
+WHERE
+	contest_id = ?
+	AND (? IS NULL OR timestamp >= ?)
+	AND (? = 0 OR CASE WHEN ? THEN id < ? ELSE id > ? END)
+ORDER BY CASE WHEN ? THEN -id ELSE id END
+LIMIT ?;
+
+-- name: GetTicksByProblem :many
+SELECT tick.id, tick.organizer_id, tick.contest_id, tick.contender_id, tick.problem_id, tick.timestamp, tick.zone_1, tick.attempts_zone_1, tick.zone_2, tick.attempts_zone_2, tick.top, tick.attempts_top
+FROM tick
+WHERE problem_id = ?;
+
+-- name: DeleteTick :exec
+DELETE
+FROM tick
+WHERE id = ?;
+
+-- name: GetTickRevisionsByContender :many
+SELECT tick_revision.id, tick_revision.contest_id, tick_revision.contender_id, tick_revision.problem_id, tick_revision.tick_id, tick_revision.action, tick_revision.timestamp, tick_revision.recorded_at, tick_revision.actor_role, tick_revision.actor_username, tick_revision.idempotency_key, tick_revision.zone_1, tick_revision.attempts_zone_1, tick_revision.zone_2, tick_revision.attempts_zone_2, tick_revision.top, tick_revision.attempts_top
+FROM tick_revision
+WHERE contender_id = ?
+ORDER BY recorded_at, id;
+
+-- name: GetTickRevisionsByContenderAndProblem :many
+SELECT tick_revision.id, tick_revision.contest_id, tick_revision.contender_id, tick_revision.problem_id, tick_revision.tick_id, tick_revision.action, tick_revision.timestamp, tick_revision.recorded_at, tick_revision.actor_role, tick_revision.actor_username, tick_revision.idempotency_key, tick_revision.zone_1, tick_revision.attempts_zone_1, tick_revision.zone_2, tick_revision.attempts_zone_2, tick_revision.top, tick_revision.attempts_top
+FROM tick_revision
+WHERE contender_id = ? AND problem_id = ?
+ORDER BY recorded_at, id;
+
+-- name: GetTickRevisionByIdempotencyKey :one
+SELECT tick_revision.id, tick_revision.contest_id, tick_revision.contender_id, tick_revision.problem_id, tick_revision.tick_id, tick_revision.action, tick_revision.timestamp, tick_revision.recorded_at, tick_revision.actor_role, tick_revision.actor_username, tick_revision.idempotency_key, tick_revision.zone_1, tick_revision.attempts_zone_1, tick_revision.zone_2, tick_revision.attempts_zone_2, tick_revision.top, tick_revision.attempts_top
+FROM tick_revision
+WHERE contender_id = ? AND idempotency_key = ?;
+
+-- name: InsertTickRevision :execlastid
+INSERT INTO
+    tick_revision (contest_id, contender_id, problem_id, tick_id, action, timestamp, recorded_at, actor_role, actor_username, idempotency_key, zone_1, attempts_zone_1, zone_2, attempts_zone_2, top, attempts_top)
+VALUES
+    (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+RETURNING id;
+
+-- name: GetTickDispute :one
+SELECT tick_dispute.id, tick_dispute.organizer_id, tick_dispute.contest_id, tick_dispute.contender_id, tick_dispute.problem_id, tick_dispute.status, tick_dispute.comment, tick_dispute.response, tick_dispute.zone_1, tick_dispute.attempts_zone_1, tick_dispute.zone_2, tick_dispute.attempts_zone_2, tick_dispute.top, tick_dispute.attempts_top, tick_dispute.created, tick_dispute.resolved, tick_dispute.resolved_by
+FROM tick_dispute
+WHERE id = ?;
+
+-- name: GetTickDisputesByContest :many
+SELECT tick_dispute.id, tick_dispute.organizer_id, tick_dispute.contest_id, tick_dispute.contender_id, tick_dispute.problem_id, tick_dispute.status, tick_dispute.comment, tick_dispute.response, tick_dispute.zone_1, tick_dispute.attempts_zone_1, tick_dispute.zone_2, tick_dispute.attempts_zone_2, tick_dispute.top, tick_dispute.attempts_top, tick_dispute.created, tick_dispute.resolved, tick_dispute.resolved_by
+FROM tick_dispute
+WHERE contest_id = ?
+ORDER BY created, id;
+
+-- name: GetTickDisputesByContender :many
+SELECT tick_dispute.id, tick_dispute.organizer_id, tick_dispute.contest_id, tick_dispute.contender_id, tick_dispute.problem_id, tick_dispute.status, tick_dispute.comment, tick_dispute.response, tick_dispute.zone_1, tick_dispute.attempts_zone_1, tick_dispute.zone_2, tick_dispute.attempts_zone_2, tick_dispute.top, tick_dispute.attempts_top, tick_dispute.created, tick_dispute.resolved, tick_dispute.resolved_by
+FROM tick_dispute
+WHERE contender_id = ?
+ORDER BY created, id;
+
+-- name: UpsertTickDispute :execlastid
+INSERT INTO
+    tick_dispute (id, organizer_id, contest_id, contender_id, problem_id, status, comment, response, zone_1, attempts_zone_1, zone_2, attempts_zone_2, top, attempts_top, created, resolved, resolved_by)
+VALUES
+    (NULLIF(?, 0), ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT DO UPDATE SET
+    status = excluded.status,
+    response = excluded.response,
+    resolved = excluded.resolved,
+    resolved_by = excluded.resolved_by
+RETURNING id;
+
+-- name: UpsertTick :execlastid
+INSERT INTO
+    tick (id, organizer_id, contest_id, contender_id, problem_id, timestamp, top, attempts_top, zone_1, attempts_zone_1, zone_2, attempts_zone_2)
+VALUES
+    (NULLIF(?, 0), ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT DO UPDATE SET
+    organizer_id = excluded.organizer_id,
+    contest_id = excluded.contest_id,
+    contender_id = excluded.contender_id,
+    problem_id = excluded.problem_id,
+    timestamp = excluded.timestamp,
+    top = excluded.top,
+    attempts_top = excluded.attempts_top,
+    zone_1 = excluded.zone_1,
+    attempts_zone_1 = excluded.attempts_zone_1,
+    zone_2 = excluded.zone_2,
+    attempts_zone_2 = excluded.attempts_zone_2
+RETURNING id;
+
+-- name: UpsertOrganizer :execlastid
+INSERT INTO
+    organizer (id, name)
+VALUES
+    (NULLIF(?, 0), ?)
+ON CONFLICT DO UPDATE SET
+    name = excluded.name
+RETURNING id;
+
+-- name: UpsertUser :execlastid
+INSERT INTO
+    user (id, username, admin)
+VALUES
+    (NULLIF(?, 0), ?, ?)
+ON CONFLICT DO UPDATE SET
+    username = excluded.username,
+    admin = excluded.admin
+RETURNING id;
+
+-- name: GetUserByUsername :many
+SELECT user.id, user.username, user.admin, organizer.id, organizer.name
+FROM user
+LEFT JOIN user_organizer uo ON uo.user_id = user.id
+LEFT JOIN organizer ON organizer.id = uo.organizer_id
+WHERE username = ?;
+
+-- name: GetUsersByOrganizer :many
+SELECT user.id, user.username, user.admin
+FROM user
+LEFT JOIN user_organizer uo ON uo.user_id = user.id
+WHERE uo.organizer_id = ?;
+
+-- name: AddUserToOrganizer :exec
+INSERT INTO
+    user_organizer (user_id, organizer_id)
+VALUES
+    (?, ?);
+
+-- name: GetOrganizer :one
+SELECT id, name
+FROM organizer
+WHERE id = ?;
+
+-- name: GetAllOrganizers :many
+SELECT id, name
+FROM organizer;
+
+-- name: GetRaffle :one
+SELECT raffle.id, raffle.organizer_id, raffle.contest_id, raffle.rules, raffle.seed, raffle.seed_commitment, raffle.seed_revealed_at
+FROM raffle
+WHERE id = ?;
+
+-- name: GetRafflesByContest :many
+SELECT raffle.id, raffle.organizer_id, raffle.contest_id, raffle.rules, raffle.seed, raffle.seed_commitment, raffle.seed_revealed_at
+FROM raffle
+WHERE contest_id = ?;
+
+-- name: UpsertRaffle :execlastid
+INSERT INTO
+    raffle (id, organizer_id, contest_id, rules, seed, seed_commitment, seed_revealed_at)
+VALUES
+    (NULLIF(?, 0), ?, ?, ?, ?, ?, ?)
+ON CONFLICT DO UPDATE SET
+    organizer_id = excluded.organizer_id,
+    contest_id = excluded.contest_id,
+    rules = excluded.rules,
+    seed = excluded.seed,
+    seed_commitment = excluded.seed_commitment,
+    seed_revealed_at = excluded.seed_revealed_at
+RETURNING id;
+
+-- name: DeleteRaffle :exec
+DELETE FROM raffle
+WHERE id = ?;
+
+-- name: GetRaffleWinner :one
+SELECT raffle_winner.id, raffle_winner.organizer_id, raffle_winner.raffle_id, raffle_winner.contender_id, raffle_winner.prize_id, raffle_winner.timestamp, raffle_winner.rules, raffle_winner.claimed_at, raffle_winner.voided_at, raffle_winner.draw, contender.name, contender.scrubbed_at, raffle_prize.name AS prize_name
+FROM raffle_winner
+JOIN contender ON contender.id = raffle_winner.contender_id
+LEFT JOIN raffle_prize ON raffle_prize.id = raffle_winner.prize_id
+WHERE raffle_winner.id = ?;
+
+-- name: GetRaffleWinners :many
+SELECT raffle_winner.id, raffle_winner.organizer_id, raffle_winner.raffle_id, raffle_winner.contender_id, raffle_winner.prize_id, raffle_winner.timestamp, raffle_winner.rules, raffle_winner.claimed_at, raffle_winner.voided_at, raffle_winner.draw, contender.name, contender.scrubbed_at, raffle_prize.name AS prize_name
+FROM raffle_winner
+JOIN contender ON contender.id = raffle_winner.contender_id
+LEFT JOIN raffle_prize ON raffle_prize.id = raffle_winner.prize_id
+WHERE raffle_winner.raffle_id = ?;
+
+-- name: GetRaffleWinnersByContender :many
+SELECT raffle_winner.id, raffle_winner.organizer_id, raffle_winner.raffle_id, raffle_winner.contender_id, raffle_winner.prize_id, raffle_winner.timestamp, raffle_winner.rules, raffle_winner.claimed_at, raffle_winner.voided_at, raffle_winner.draw, contender.name, contender.scrubbed_at, raffle_prize.name AS prize_name
+FROM raffle_winner
+JOIN contender ON contender.id = raffle_winner.contender_id
+LEFT JOIN raffle_prize ON raffle_prize.id = raffle_winner.prize_id
+WHERE raffle_winner.contender_id = ?;
+
+-- name: UpsertRaffleWinner :execlastid
+INSERT INTO
+    raffle_winner (id, organizer_id, raffle_id, contender_id, prize_id, timestamp, rules, claimed_at, voided_at, draw)
+VALUES
+    (NULLIF(?, 0), ?, ?, ?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT DO UPDATE SET
+    organizer_id = excluded.organizer_id,
+    raffle_id = excluded.raffle_id,
+    contender_id = excluded.contender_id,
+    prize_id = excluded.prize_id,
+    timestamp = excluded.timestamp,
+    rules = excluded.rules,
+    claimed_at = excluded.claimed_at,
+    voided_at = excluded.voided_at,
+    draw = excluded.draw
+RETURNING id;
+
+-- name: DeleteRaffleWinner :exec
+DELETE FROM raffle_winner
+WHERE id = ?;
+
+-- name: GetRafflePrize :one
+SELECT raffle_prize.id, raffle_prize.organizer_id, raffle_prize.raffle_id, raffle_prize.name, raffle_prize.quantity
+FROM raffle_prize
+WHERE id = ?;
+
+-- name: GetRafflePrizes :many
+SELECT raffle_prize.id, raffle_prize.organizer_id, raffle_prize.raffle_id, raffle_prize.name, raffle_prize.quantity
+FROM raffle_prize
+WHERE raffle_id = ?
+ORDER BY id;
+
+-- name: UpsertRafflePrize :execlastid
+INSERT INTO
+    raffle_prize (id, organizer_id, raffle_id, name, quantity)
+VALUES
+    (NULLIF(?, 0), ?, ?, ?, ?)
+ON CONFLICT DO UPDATE SET
+    organizer_id = excluded.organizer_id,
+    raffle_id = excluded.raffle_id,
+    name = excluded.name,
+    quantity = excluded.quantity
+RETURNING id;
+
+-- name: DeleteRafflePrize :exec
+DELETE FROM raffle_prize
+WHERE id = ?;
+
+-- name: GetOrganizerInvitesByOrganizer :many
+SELECT organizer_invite.id, organizer_invite.organizer_id, organizer_invite.expires_at, organizer.name
+FROM organizer_invite
+JOIN organizer ON organizer.id = organizer_invite.organizer_id
+WHERE organizer_id = ?;
+
+-- name: GetOrganizerInvite :one
+SELECT organizer_invite.id, organizer_invite.organizer_id, organizer_invite.expires_at, organizer.name
+FROM organizer_invite
+JOIN organizer ON organizer.id = organizer_invite.organizer_id
+WHERE organizer_invite.id = ?;
+
+-- name: InsertOrganizerInvite :exec
+INSERT INTO
+    organizer_invite (id, organizer_id, expires_at)
+VALUES
+    (?, ?, ?);
+
+-- name: DeleteOrganizerInvite :exec
+DELETE FROM organizer_invite
+WHERE id = ?;
+
+-- name: GetScrubEligibleContenders :many
+SELECT contender.id, contender.organizer_id, contender.contest_id, contender.registration_code, contender.name, contender.class_id, contender.team_id, contender.entered, contender.disqualified, contender.withdrawn_from_finals, contender.scrubbed_at, contender.scrub_before, contender.waitlist_class_id, contender.waitlisted_at, contender.version, score.contender_id, score.timestamp, score.score, score.placement, score.finalist, score.rank_order
+FROM contender
+LEFT JOIN score ON score.contender_id = id
+WHERE contender.name != ''
+  AND contender.scrub_before IS NOT NULL
+  AND contender.scrub_before < ?;
+
+-- name: GetRound :one
+SELECT round.id, round.organizer_id, round.contest_id, round.number, round.name, round.qualifying_problems, round.finalists, round.time_begin, round.time_end
+FROM round
+WHERE id = ?;
+
+-- name: GetRoundsByContest :many
+SELECT round.id, round.organizer_id, round.contest_id, round.number, round.name, round.qualifying_problems, round.finalists, round.time_begin, round.time_end
+FROM round
+WHERE contest_id = ?
+ORDER BY number;
+
+-- name: GetRoundsCurrentlyRunningOrByStartTime :many
+SELECT round.id, round.organizer_id, round.contest_id, round.number, round.name, round.qualifying_problems, round.finalists, round.time_begin, round.time_end
+FROM round
+JOIN contest ON contest.id = round.contest_id
+WHERE contest.archived_at IS NULL
+  AND (datetime('now') BETWEEN datetime(round.time_begin) AND datetime(round.time_end, '+' || (contest.grace_period + 15) || ' minutes')
+    OR round.time_begin BETWEEN ? AND ?);
+
+-- name: UpsertRound :execlastid
+INSERT INTO
+    round (id, organizer_id, contest_id, number, name, qualifying_problems, finalists, time_begin, time_end)
+VALUES
+    (NULLIF(?, 0), ?, ?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT DO UPDATE SET
+    organizer_id = excluded.organizer_id,
+    contest_id = excluded.contest_id,
+    number = excluded.number,
+    name = excluded.name,
+    qualifying_problems = excluded.qualifying_problems,
+    finalists = excluded.finalists,
+    time_begin = excluded.time_begin,
+    time_end = excluded.time_end
+RETURNING id;
+
+-- name: DeleteRound :exec
+DELETE FROM round
+WHERE id = ?;
+
+-- name: GetStartList :many
+SELECT round_contender.round_id, round_contender.contender_id, round_contender.previous_placement, round_contender.timestamp, round_contender.score, round_contender.placement, round_contender.finalist, round_contender.rank_order
+FROM round_contender
+WHERE round_id = ?;
+
+-- name: GetStartListEntry :one
+SELECT round_contender.round_id, round_contender.contender_id, round_contender.previous_placement, round_contender.timestamp, round_contender.score, round_contender.placement, round_contender.finalist, round_contender.rank_order
+FROM round_contender
+WHERE round_id = ? AND contender_id = ?;
+
+-- name: InsertStartListEntry :exec
+INSERT INTO
+    round_contender (round_id, contender_id, previous_placement, timestamp, score, placement, finalist, rank_order)
+VALUES
+    (?, ?, ?, ?, ?, ?, ?, ?);
+
+-- name: DeleteStartList :exec
+DELETE FROM round_contender
+WHERE round_id = ?;
+
+-- name: UpdateRoundScore :execrows
+UPDATE round_contender
+SET
+    timestamp = ?,
+    score = ?,
+    placement = ?,
+    finalist = ?,
+    rank_order = ?
+WHERE round_id = ? AND contender_id = ?;
+
+-- name: GetTeam :one
+SELECT team.id, team.organizer_id, team.contest_id, team.name, team.counted_members
+FROM team
+WHERE id = ?;
+
+-- name: GetTeamsByContest :many
+SELECT team.id, team.organizer_id, team.contest_id, team.name, team.counted_members
+FROM team
+WHERE contest_id = ?
+ORDER BY name;
+
+-- name: UpsertTeam :execlastid
+INSERT INTO
+    team (id, organizer_id, contest_id, name, counted_members)
+VALUES
+    (NULLIF(?, 0), ?, ?, ?, ?)
+ON CONFLICT DO UPDATE SET
+    organizer_id = excluded.organizer_id,
+    contest_id = excluded.contest_id,
+    name = excluded.name,
+    counted_members = excluded.counted_members
+RETURNING id;
+
+-- name: DeleteTeam :exec
+DELETE FROM team
+WHERE id = ?;
+
+-- name: InsertAuditEntry :execlastid
+INSERT INTO
+    audit_entry (organizer_id, contest_id, contender_id, action, timestamp)
+VALUES
+    (?, ?, ?, ?, ?)
+RETURNING id;
+
+-- name: GetRetentionPolicy :one
+SELECT retention_policy.id, retention_policy.organizer_id, retention_policy.target, retention_policy.retention_period, retention_policy.enabled, retention_policy.created
+FROM retention_policy
+WHERE id = ?;
+
+-- name: GetRetentionPoliciesByOrganizer :many
+SELECT retention_policy.id, retention_policy.organizer_id, retention_policy.target, retention_policy.retention_period, retention_policy.enabled, retention_policy.created
+FROM retention_policy
+WHERE organizer_id = ?;
+
+-- name: GetEnabledRetentionPolicies :many
+SELECT retention_policy.id, retention_policy.organizer_id, retention_policy.target, retention_policy.retention_period, retention_policy.enabled, retention_policy.created
+FROM retention_policy
+WHERE enabled = TRUE;
+
+-- name: UpsertRetentionPolicy :execlastid
+INSERT INTO
+    retention_policy (id, organizer_id, target, retention_period, enabled, created)
+VALUES
+    (NULLIF(?, 0), ?, ?, ?, ?, ?)
+ON CONFLICT DO UPDATE SET
+    organizer_id = excluded.organizer_id,
+    target = excluded.target,
+    retention_period = excluded.retention_period,
+    enabled = excluded.enabled
+RETURNING id;
+
+-- name: DeleteRetentionPolicy :exec
+DELETE FROM retention_policy
+WHERE id = ?;
+
+-- name: GetRetentionPolicyExecutions :many
+SELECT retention_policy_execution.id, retention_policy_execution.organizer_id, retention_policy_execution.retention_policy_id, retention_policy_execution.timestamp, retention_policy_execution.contests, retention_policy_execution.contenders, retention_policy_execution.ticks, retention_policy_execution.error
+FROM retention_policy_execution
+WHERE retention_policy_id = ?
+ORDER BY timestamp DESC, id DESC
+LIMIT 100;
+
+-- name: InsertRetentionPolicyExecution :execlastid
+INSERT INTO
+    retention_policy_execution (organizer_id, retention_policy_id, timestamp, contests, contenders, ticks, error)
+VALUES
+    (?, ?, ?, ?, ?, ?, ?)
+RETURNING id;
+
+-- name: DeleteTicksByContest :execrows
+DELETE FROM tick
+WHERE contest_id = ?;
+
+-- name: DeleteTickRevisionsByContest :exec
+DELETE FROM tick_revision
+WHERE contest_id = ?;
+
+-- name: DeleteTickDisputesByContest :exec
+DELETE FROM tick_dispute
+WHERE contest_id = ?;
+
+-- name: DeleteScoresByContest :exec
+DELETE FROM score
+WHERE contender_id IN (SELECT id FROM contender WHERE contest_id = ?);
+
+-- name: DeletePublishedScoresByContest :exec
+DELETE FROM published_score
+WHERE contender_id IN (SELECT id FROM contender WHERE contest_id = ?);
+
+-- name: DeleteRaffleWinnersByContest :exec
+DELETE FROM raffle_winner
+WHERE raffle_id IN (SELECT id FROM raffle WHERE contest_id = ?);
+
+-- name: DeleteRafflePrizesByContest :exec
+DELETE FROM raffle_prize
+WHERE raffle_id IN (SELECT id FROM raffle WHERE contest_id = ?);
+
+-- name: DeleteRafflesByContest :exec
+DELETE FROM raffle
+WHERE contest_id = ?;
+
+-- name: DeleteContendersByContest :execrows
+DELETE FROM contender
+WHERE contest_id = ?;
+
+-- name: DeleteProblemsByContest :exec
+DELETE FROM problem
+WHERE contest_id = ?;
+
+-- name: DeleteRoundsByContest :exec
+DELETE FROM round
+WHERE contest_id = ?;
+
+-- name: DeleteTeamsByContest :exec
+DELETE FROM team
+WHERE contest_id = ?;
+
+-- name: DeleteCompClassesByContest :exec
+DELETE FROM comp_class
+WHERE contest_id = ?;
@@ -0,0 +1,76 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/climblive/platform/backend/internal/database"
+	"github.com/climblive/platform/backend/internal/domain"
+	"github.com/go-errors/errors"
+)
+
+func (d *Database) GetTeam(ctx context.Context, tx domain.Transaction, teamID domain.TeamID) (domain.Team, error) {
+	record, err := d.WithTx(tx).GetTeam(ctx, int32(teamID))
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return domain.Team{}, errors.Wrap(domain.ErrNotFound, 0)
+	case err != nil:
+		return domain.Team{}, errors.Wrap(err, 0)
+	}
+
+	return teamToDomain(record.Team), nil
+}
+
+func (d *Database) GetTeamsByContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) ([]domain.Team, error) {
+	records, err := d.WithTx(tx).GetTeamsByContest(ctx, int32(contestID))
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	teams := make([]domain.Team, 0)
+
+	for _, record := range records {
+		teams = append(teams, teamToDomain(record.Team))
+	}
+
+	return teams, nil
+}
+
+func (d *Database) StoreTeam(ctx context.Context, tx domain.Transaction, team domain.Team) (domain.Team, error) {
+	params := database.UpsertTeamParams{
+		ID:             int32(team.ID),
+		OrganizerID:    int32(team.Ownership.OrganizerID),
+		ContestID:      int32(team.ContestID),
+		Name:           team.Name,
+		CountedMembers: int32(team.CountedMembers),
+	}
+
+	insertID, err := d.WithTx(tx).UpsertTeam(ctx, params)
+	if err != nil {
+		return domain.Team{}, errors.Wrap(err, 0)
+	}
+
+	if insertID != 0 {
+		team.ID = domain.TeamID(insertID)
+	}
+
+	return team, nil
+}
+
+func (d *Database) DeleteTeam(ctx context.Context, tx domain.Transaction, teamID domain.TeamID) error {
+	err := d.WithTx(tx).DeleteTeam(ctx, int32(teamID))
+	if err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	return nil
+}
+
+func (d *Database) DeleteTeamsByContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) error {
+	err := d.WithTx(tx).DeleteTeamsByContest(ctx, int32(contestID))
+	if err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	return nil
+}
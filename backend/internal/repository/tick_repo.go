@@ -39,6 +39,27 @@ func (d *Database) GetTicksByContest(ctx context.Context, tx domain.Transaction,
 	return ticks, nil
 }
 
+func (d *Database) GetTicksByContestFiltered(ctx context.Context, tx domain.Transaction, contestID domain.ContestID, filter domain.TickFilter, page domain.PageRequest) ([]domain.Tick, error) {
+	records, err := d.WithTx(tx).GetTicksByContestFiltered(ctx, database.GetTicksByContestFilteredParams{
+		ContestID:  int32(contestID),
+		Since:      makeNullTime(filter.Since),
+		After:      int32(page.After),
+		Descending: page.Descending,
+		Limit:      pageLimit(page),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	ticks := make([]domain.Tick, 0)
+
+	for _, record := range records {
+		ticks = append(ticks, tickToDomain(record.Tick))
+	}
+
+	return ticks, nil
+}
+
 func (d *Database) StoreTick(ctx context.Context, tx domain.Transaction, tick domain.Tick) (domain.Tick, error) {
 	params := database.UpsertTickParams{
 		ID:            int32(tick.ID),
@@ -117,3 +138,99 @@ func (d *Database) GetTicksByProblem(ctx context.Context, tx domain.Transaction,
 
 	return ticks, nil
 }
+
+func (d *Database) DeleteTicksByContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) (int, error) {
+	rows, err := d.WithTx(tx).DeleteTicksByContest(ctx, int32(contestID))
+	if err != nil {
+		return 0, errors.Wrap(err, 0)
+	}
+
+	return int(rows), nil
+}
+
+func (d *Database) DeleteTickRevisionsByContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) error {
+	err := d.WithTx(tx).DeleteTickRevisionsByContest(ctx, int32(contestID))
+	if err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	return nil
+}
+
+func (d *Database) GetTickRevisionsByContender(ctx context.Context, tx domain.Transaction, contenderID domain.ContenderID) ([]domain.TickRevision, error) {
+	records, err := d.WithTx(tx).GetTickRevisionsByContender(ctx, int32(contenderID))
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	revisions := make([]domain.TickRevision, 0)
+
+	for _, record := range records {
+		revisions = append(revisions, tickRevisionToDomain(record.TickRevision))
+	}
+
+	return revisions, nil
+}
+
+func (d *Database) GetTickRevisionsByContenderAndProblem(ctx context.Context, tx domain.Transaction, contenderID domain.ContenderID, problemID domain.ProblemID) ([]domain.TickRevision, error) {
+	records, err := d.WithTx(tx).GetTickRevisionsByContenderAndProblem(ctx, database.GetTickRevisionsByContenderAndProblemParams{
+		ContenderID: int32(contenderID),
+		ProblemID:   int32(problemID),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	revisions := make([]domain.TickRevision, 0)
+
+	for _, record := range records {
+		revisions = append(revisions, tickRevisionToDomain(record.TickRevision))
+	}
+
+	return revisions, nil
+}
+
+func (d *Database) GetTickRevisionByIdempotencyKey(ctx context.Context, tx domain.Transaction, contenderID domain.ContenderID, idempotencyKey string) (domain.TickRevision, error) {
+	record, err := d.WithTx(tx).GetTickRevisionByIdempotencyKey(ctx, database.GetTickRevisionByIdempotencyKeyParams{
+		ContenderID:    int32(contenderID),
+		IdempotencyKey: makeNullString(idempotencyKey),
+	})
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return domain.TickRevision{}, errors.Wrap(domain.ErrNotFound, 0)
+	case err != nil:
+		return domain.TickRevision{}, errors.Wrap(err, 0)
+	}
+
+	return tickRevisionToDomain(record.TickRevision), nil
+}
+
+func (d *Database) StoreTickRevision(ctx context.Context, tx domain.Transaction, revision domain.TickRevision) (domain.TickRevision, error) {
+	params := database.InsertTickRevisionParams{
+		ContestID:      int32(revision.ContestID),
+		ContenderID:    int32(revision.ContenderID),
+		ProblemID:      int32(revision.ProblemID),
+		TickID:         int32(revision.TickID),
+		Action:         string(revision.Action),
+		Timestamp:      revision.Timestamp,
+		RecordedAt:     revision.RecordedAt,
+		ActorRole:      string(revision.ActorRole),
+		ActorUsername:  makeNullString(revision.ActorUsername),
+		IdempotencyKey: makeNullString(revision.IdempotencyKey),
+		Zone1:          revision.Zone1,
+		AttemptsZone1:  int32(revision.AttemptsZone1),
+		Zone2:          revision.Zone2,
+		AttemptsZone2:  int32(revision.AttemptsZone2),
+		Top:            revision.Top,
+		AttemptsTop:    int32(revision.AttemptsTop),
+	}
+
+	insertID, err := d.WithTx(tx).InsertTickRevision(ctx, params)
+	if err != nil {
+		return domain.TickRevision{}, errors.Wrap(err, 0)
+	}
+
+	revision.ID = domain.TickRevisionID(insertID)
+
+	return revision, nil
+}
@@ -1,8 +1,15 @@
 package repository
 
 import (
+	"math"
+	"time"
+
 	"github.com/climblive/platform/backend/internal/domain"
+	"github.com/go-errors/errors"
 	"github.com/go-sql-driver/mysql"
+	"github.com/jackc/pgx/v5/pgconn"
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
 )
 
 func nillableIntToResourceID[T domain.ResourceIDType](value *int32) *T {
@@ -14,8 +21,50 @@ func nillableIntToResourceID[T domain.ResourceIDType](value *int32) *T {
 	return &out
 }
 
-var mysqlForeignKeyConstraintViolation = mysql.MySQLError{
-	Number:   1452,
-	SQLState: [5]byte{},
-	Message:  "",
+func pageLimit(page domain.PageRequest) int32 {
+	if page.Limit <= 0 {
+		return math.MaxInt32
+	}
+
+	return int32(page.Limit)
+}
+
+const sqliteTimeFormat = "2006-01-02 15:04:05.999999999-07:00"
+
+// aggregateTime converts the result of MIN() and MAX() over timestamp
+// columns, which SQLite returns as text since the column type is lost.
+func aggregateTime(value any) (time.Time, bool) {
+	switch v := value.(type) {
+	case time.Time:
+		return v, true
+	case []byte:
+		return aggregateTime(string(v))
+	case string:
+		for _, layout := range []string{sqliteTimeFormat, time.DateTime} {
+			if t, err := time.Parse(layout, v); err == nil {
+				return t, true
+			}
+		}
+	}
+
+	return time.Time{}, false
+}
+
+func isForeignKeyViolation(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	if errors.As(err, &mysqlErr) {
+		return mysqlErr.Number == 1452
+	}
+
+	var sqliteErr *sqlite.Error
+	if errors.As(err, &sqliteErr) {
+		return sqliteErr.Code() == sqlite3.SQLITE_CONSTRAINT_FOREIGNKEY
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return pgErr.Code == "23503"
+	}
+
+	return false
 }
@@ -3,12 +3,18 @@ package scores
 import (
 	"context"
 	"log/slog"
+	"maps"
 	"slices"
 	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/climblive/platform/backend/internal/domain"
+	"github.com/climblive/platform/backend/internal/events"
+	"github.com/climblive/platform/backend/internal/metrics"
+	"github.com/climblive/platform/backend/internal/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type ScoreEngine interface {
@@ -28,41 +34,89 @@ type ScoreEngine interface {
 	HandleProblemUpdated(event domain.ProblemUpdatedEvent)
 
 	GetDirtyScores() []domain.Score
+	GetProblemStats(byCompClass bool) []domain.ProblemStats
 }
 
 type ScoreEngineDriver struct {
 	logger     *slog.Logger
 	contestID  domain.ContestID
+	roundID    domain.RoundID
 	instanceID domain.ScoreEngineInstanceID
 
 	eventBroker   domain.EventBroker
 	pendingEvents []domain.EventEnvelope
 
-	engine ScoreEngine
+	engine  ScoreEngine
+	queries chan func(ScoreEngine)
 
 	running atomic.Bool
 
 	publishToken bool
+	pendingLinks []trace.Link
+
+	scoreboardFrozenFrom atomic.Pointer[time.Time]
+	withheldScores       map[domain.ContenderID]domain.Score
 }
 
 func NewScoreEngineDriver(
 	contestID domain.ContestID,
+	roundID domain.RoundID,
 	instanceID domain.ScoreEngineInstanceID,
 	eventBroker domain.EventBroker,
 ) *ScoreEngineDriver {
 	logger := slog.New(slog.Default().Handler()).
 		With("contest_id", contestID).
+		With("round_id", roundID).
 		With("instance_id", instanceID)
 
 	return &ScoreEngineDriver{
-		logger:        logger,
-		contestID:     contestID,
-		instanceID:    instanceID,
-		eventBroker:   eventBroker,
-		pendingEvents: make([]domain.EventEnvelope, 0),
-		engine:        nil,
-		running:       atomic.Bool{},
-		publishToken:  false,
+		logger:               logger,
+		contestID:            contestID,
+		roundID:              roundID,
+		instanceID:           instanceID,
+		eventBroker:          eventBroker,
+		pendingEvents:        make([]domain.EventEnvelope, 0),
+		engine:               nil,
+		queries:              make(chan func(ScoreEngine)),
+		running:              atomic.Bool{},
+		publishToken:         false,
+		pendingLinks:         nil,
+		scoreboardFrozenFrom: atomic.Pointer[time.Time]{},
+		withheldScores:       make(map[domain.ContenderID]domain.Score),
+	}
+}
+
+func (d *ScoreEngineDriver) SetScoreboardFrozenFrom(frozenFrom time.Time) {
+	d.scoreboardFrozenFrom.Store(&frozenFrom)
+}
+
+func (d *ScoreEngineDriver) scoreboardFrozen() bool {
+	frozenFrom := d.scoreboardFrozenFrom.Load()
+	if frozenFrom == nil || frozenFrom.IsZero() {
+		return false
+	}
+
+	return !time.Now().Before(*frozenFrom)
+}
+
+func (d *ScoreEngineDriver) GetProblemStats(ctx context.Context, byCompClass bool) ([]domain.ProblemStats, error) {
+	result := make(chan []domain.ProblemStats, 1)
+
+	query := func(engine ScoreEngine) {
+		result <- engine.GetProblemStats(byCompClass)
+	}
+
+	select {
+	case d.queries <- query:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	select {
+	case stats := <-result:
+		return stats, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
 	}
 }
 
@@ -134,6 +188,8 @@ func (d *ScoreEngineDriver) run(
 		"PROBLEM_ADDED",
 		"PROBLEM_UPDATED",
 		"RULES_UPDATED",
+		"SCOREBOARD_REVEALED",
+		"SCOREBOARD_FREEZE_UPDATED",
 	)
 
 	subscriptionID, eventReader := d.eventBroker.Subscribe(filter, 0)
@@ -143,11 +199,11 @@ func (d *ScoreEngineDriver) run(
 
 	close(ready)
 
-	d.eventBroker.Dispatch(d.contestID, domain.ScoreEngineStartedEvent{
+	d.eventBroker.Dispatch(ctx, d.contestID, domain.ScoreEngineStartedEvent{
 		InstanceID: d.instanceID,
 	})
 
-	defer d.eventBroker.Dispatch(d.contestID, domain.ScoreEngineStoppedEvent{
+	defer d.eventBroker.Dispatch(ctx, d.contestID, domain.ScoreEngineStoppedEvent{
 		InstanceID: d.instanceID,
 	})
 
@@ -193,6 +249,9 @@ PreLoop:
 	d.running.Store(true)
 	defer d.running.Store(false)
 
+	metrics.ScoreEnginesRunning.Inc()
+	defer metrics.ScoreEnginesRunning.Dec()
+
 	if len(d.pendingEvents) != 0 {
 		d.logger.Info("replaying pending events", "count", len(d.pendingEvents))
 	}
@@ -212,6 +271,8 @@ PreLoop:
 			}
 
 			d.handleEvent(event)
+		case query := <-d.queries:
+			query(d.engine)
 		case <-ticker:
 			d.publishToken = false
 
@@ -233,10 +294,37 @@ PreLoop:
 }
 
 func (d *ScoreEngineDriver) handleEvent(event domain.EventEnvelope) {
+	eventName := events.EventName(event.Data)
+
+	ctx, span := tracing.Start(tracing.Extract(context.Background(), event.TraceContext), "ScoreEngineDriver.handleEvent", trace.WithAttributes(
+		attribute.String("event.name", eventName),
+		attribute.Int("contest.id", int(d.contestID)),
+		attribute.Int("round.id", int(d.roundID)),
+	))
+
+	start := time.Now()
+	defer func() {
+		metrics.ScoreEngineEventDuration.WithLabelValues(eventName).Observe(time.Since(start).Seconds())
+
+		span.End()
+
+		if span.SpanContext().IsValid() {
+			d.pendingLinks = append(d.pendingLinks, trace.LinkFromContext(ctx))
+		}
+	}()
+
 	switch ev := event.Data.(type) {
 	case domain.RulesUpdatedEvent:
+		if ev.RoundID != d.roundID {
+			return
+		}
+
 		d.engine.HandleRulesUpdated(ev)
 	case domain.ContenderEnteredEvent:
+		if d.roundID != 0 {
+			return
+		}
+
 		d.engine.HandleContenderEntered(ev)
 	case domain.ContenderSwitchedClassEvent:
 		d.engine.HandleContenderSwitchedClass(ev)
@@ -253,26 +341,113 @@ func (d *ScoreEngineDriver) handleEvent(event domain.EventEnvelope) {
 	case domain.AscentDeregisteredEvent:
 		d.engine.HandleAscentDeregistered(ev)
 	case domain.ProblemAddedEvent:
+		if ev.RoundID != d.roundID {
+			return
+		}
+
 		d.engine.HandleProblemAdded(ev)
 	case domain.ProblemUpdatedEvent:
+		if ev.RoundID != d.roundID {
+			return
+		}
+
 		d.engine.HandleProblemUpdated(ev)
+	case domain.ScoreboardRevealedEvent:
+		d.SetScoreboardFrozenFrom(time.Time{})
+	case domain.ScoreboardFreezeUpdatedEvent:
+		d.SetScoreboardFrozenFrom(ev.FrozenFrom)
 	}
 }
 
 func (d *ScoreEngineDriver) publishUpdatedScores() int {
+	ctx, span := d.startPublishSpan()
+	defer span.End()
+
 	scores := d.engine.GetDirtyScores()
 
+	span.SetAttributes(attribute.Int("scores.count", len(scores)))
+
+	if d.roundID != 0 {
+		d.publishRoundScores(ctx, scores)
+
+		return len(scores)
+	}
+
 	var batch []domain.ContenderScoreUpdatedEvent
+	var liveBatch []domain.ContenderLiveScoreUpdatedEvent
+
+	frozen := d.scoreboardFrozen()
+
+	if !frozen && len(d.withheldScores) > 0 {
+		d.logger.Info("releasing withheld scores", "count", len(d.withheldScores))
+
+		for score := range maps.Values(d.withheldScores) {
+			batch = append(batch, domain.ContenderScoreUpdatedEvent(score))
+		}
+
+		clear(d.withheldScores)
+	}
 
 	for score := range slices.Values(scores) {
-		d.eventBroker.Dispatch(d.contestID, domain.ContenderScoreUpdatedEvent(score))
+		d.eventBroker.Dispatch(ctx, d.contestID, domain.ContenderScoreUpdatedEvent(score))
+
+		if frozen {
+			d.withheldScores[score.ContenderID] = score
+			liveBatch = append(liveBatch, domain.ContenderLiveScoreUpdatedEvent(score))
+
+			continue
+		}
 
 		batch = append(batch, domain.ContenderScoreUpdatedEvent(score))
 	}
 
 	if len(batch) > 0 {
-		d.eventBroker.Dispatch(d.contestID, batch)
+		d.eventBroker.Dispatch(ctx, d.contestID, batch)
+	}
+
+	if len(liveBatch) > 0 {
+		d.eventBroker.Dispatch(ctx, d.contestID, liveBatch)
 	}
 
 	return len(scores)
 }
+
+func (d *ScoreEngineDriver) startPublishSpan() (context.Context, trace.Span) {
+	links := d.pendingLinks
+	d.pendingLinks = nil
+
+	switch len(links) {
+	case 0:
+		return context.Background(), trace.SpanFromContext(context.Background())
+	case 1:
+		parent := trace.ContextWithSpanContext(context.Background(), links[0].SpanContext)
+
+		return tracing.Start(parent, "ScoreEngineDriver.publishUpdatedScores")
+	default:
+		return tracing.Start(context.Background(), "ScoreEngineDriver.publishUpdatedScores", trace.WithLinks(links...))
+	}
+}
+
+func (d *ScoreEngineDriver) publishRoundScores(ctx context.Context, scores []domain.Score) {
+	var batch []domain.RoundScoreUpdatedEvent
+
+	for score := range slices.Values(scores) {
+		event := domain.RoundScoreUpdatedEvent{
+			RoundID:     d.roundID,
+			Timestamp:   score.Timestamp,
+			ContenderID: score.ContenderID,
+			Score:       score.Score,
+			Placement:   score.Placement,
+			Finalist:    score.Finalist,
+			RankOrder:   score.RankOrder,
+		}
+
+		d.eventBroker.Dispatch(ctx, d.contestID, event)
+
+		batch = append(batch, event)
+	}
+
+	if len(batch) > 0 {
+		d.eventBroker.Dispatch(ctx, d.contestID, batch)
+	}
+}
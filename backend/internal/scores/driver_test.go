@@ -44,21 +44,23 @@ func TestEngineDriver(t *testing.T) {
 			"PROBLEM_ADDED",
 			"PROBLEM_UPDATED",
 			"RULES_UPDATED",
+			"SCOREBOARD_REVEALED",
+			"SCOREBOARD_FREEZE_UPDATED",
 		)
 
 		mockedEventBroker.On("Subscribe", filter, 0).Return(subscriptionID, subscription)
 
 		mockedEventBroker.On("Unsubscribe", subscriptionID).Return()
 
-		mockedEventBroker.On("Dispatch", fakedContestID, domain.ScoreEngineStartedEvent{
+		mockedEventBroker.On("Dispatch", mock.Anything, fakedContestID, domain.ScoreEngineStartedEvent{
 			InstanceID: fakedInstanceID,
 		}).Return()
 
-		mockedEventBroker.On("Dispatch", fakedContestID, domain.ScoreEngineStoppedEvent{
+		mockedEventBroker.On("Dispatch", mock.Anything, fakedContestID, domain.ScoreEngineStoppedEvent{
 			InstanceID: fakedInstanceID,
 		}).Return()
 
-		driver := scores.NewScoreEngineDriver(fakedContestID, fakedInstanceID, mockedEventBroker)
+		driver := scores.NewScoreEngineDriver(fakedContestID, 0, fakedInstanceID, mockedEventBroker)
 
 		awaitExpectations := func(t *testing.T) {
 			mockedEventBroker.AssertExpectations(t)
@@ -125,6 +127,36 @@ func TestEngineDriver(t *testing.T) {
 		mockedEngine.AssertExpectations(t)
 	})
 
+	t.Run("GetProblemStats", func(t *testing.T) {
+		f, awaitExpectations := makeFixture(0)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		wg, installEngine := f.driver.Run(ctx)
+
+		fakedStats := []domain.ProblemStats{{ProblemID: 1, Tops: 3}}
+
+		mockedEngine := new(scoreEngineMock)
+
+		mockedEngine.On("Start").Return()
+		mockedEngine.On("Stop").Return()
+		mockedEngine.On("GetDirtyScores").Return([]domain.Score{})
+		mockedEngine.On("GetProblemStats", true).Return(fakedStats)
+
+		installEngine(mockedEngine)
+
+		stats, err := f.driver.GetProblemStats(ctx, true)
+
+		require.NoError(t, err)
+		require.Equal(t, fakedStats, stats)
+
+		cancel()
+
+		wg.Wait()
+
+		awaitExpectations(t)
+		mockedEngine.AssertExpectations(t)
+	})
+
 	t.Run("ReplayPendingEvents", func(t *testing.T) {
 		f, awaitExpectations := makeFixture(0)
 
@@ -362,7 +394,7 @@ func TestEngineDriver(t *testing.T) {
 		})
 
 		f.broker.
-			On("Dispatch", fakedContestID,
+			On("Dispatch", mock.Anything, fakedContestID,
 				domain.ContenderScoreUpdatedEvent{
 					ContenderID: 1,
 					Timestamp:   now,
@@ -372,7 +404,7 @@ func TestEngineDriver(t *testing.T) {
 					Finalist:    true,
 				},
 			).Return().
-			On("Dispatch", fakedContestID,
+			On("Dispatch", mock.Anything, fakedContestID,
 				domain.ContenderScoreUpdatedEvent{
 					ContenderID: 2,
 					Timestamp:   now,
@@ -382,7 +414,7 @@ func TestEngineDriver(t *testing.T) {
 					Finalist:    true,
 				},
 			).Return().
-			On("Dispatch", fakedContestID,
+			On("Dispatch", mock.Anything, fakedContestID,
 				domain.ContenderScoreUpdatedEvent{
 					ContenderID: 3,
 					Timestamp:   now,
@@ -392,7 +424,7 @@ func TestEngineDriver(t *testing.T) {
 					Finalist:    false,
 				},
 			).Return().
-			On("Dispatch", fakedContestID,
+			On("Dispatch", mock.Anything, fakedContestID,
 				[]domain.ContenderScoreUpdatedEvent{
 					{
 						ContenderID: 1,
@@ -430,6 +462,119 @@ func TestEngineDriver(t *testing.T) {
 		awaitExpectations(t)
 		mockedEngine.AssertExpectations(t)
 	})
+
+	t.Run("WithholdScoresWhileFrozen", func(t *testing.T) {
+		f, awaitExpectations := makeFixture(0)
+
+		f.driver.SetScoreboardFrozenFrom(time.Now().Add(-time.Minute))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		wg, installEngine := f.driver.Run(ctx)
+
+		score := domain.Score{
+			ContenderID: 1,
+			Timestamp:   time.Now(),
+			Score:       100,
+			Placement:   1,
+			RankOrder:   0,
+			Finalist:    true,
+		}
+
+		mockedEngine := new(scoreEngineMock)
+
+		mockedEngine.On("Start").Return()
+		mockedEngine.On("Stop").Return()
+		mockedEngine.On("GetDirtyScores").Return([]domain.Score{score}).Once()
+		mockedEngine.On("GetDirtyScores").Return([]domain.Score{})
+
+		f.broker.
+			On("Dispatch", mock.Anything, fakedContestID, domain.ContenderScoreUpdatedEvent(score)).
+			Run(func(args mock.Arguments) {
+				err := f.subscription.Post(domain.EventEnvelope{
+					Data: domain.ScoreboardRevealedEvent{},
+				})
+				require.NoError(t, err)
+			}).
+			Return().
+			Once()
+
+		f.broker.
+			On("Dispatch", mock.Anything, fakedContestID, []domain.ContenderLiveScoreUpdatedEvent{
+				domain.ContenderLiveScoreUpdatedEvent(score),
+			}).
+			Return().
+			Once()
+
+		f.broker.
+			On("Dispatch", mock.Anything, fakedContestID, []domain.ContenderScoreUpdatedEvent{
+				domain.ContenderScoreUpdatedEvent(score),
+			}).
+			Run(func(args mock.Arguments) {
+				mockedEngine.AssertNumberOfCalls(t, "GetDirtyScores", 2)
+				cancel()
+			}).
+			Return().
+			Once()
+
+		installEngine(mockedEngine)
+
+		wg.Wait()
+
+		awaitExpectations(t)
+		mockedEngine.AssertExpectations(t)
+	})
+
+	t.Run("FreezeOnFreezeUpdated", func(t *testing.T) {
+		f, awaitExpectations := makeFixture(1)
+
+		err := f.subscription.Post(domain.EventEnvelope{
+			Data: domain.ScoreboardFreezeUpdatedEvent{
+				FrozenFrom: time.Now().Add(-time.Minute),
+			},
+		})
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		wg, installEngine := f.driver.Run(ctx)
+
+		score := domain.Score{
+			ContenderID: 1,
+			Timestamp:   time.Now(),
+			Score:       100,
+			Placement:   1,
+			RankOrder:   0,
+			Finalist:    true,
+		}
+
+		mockedEngine := new(scoreEngineMock)
+
+		mockedEngine.On("Start").Return()
+		mockedEngine.On("Stop").Return()
+		mockedEngine.On("GetDirtyScores").Return([]domain.Score{score}).Once()
+		mockedEngine.On("GetDirtyScores").Return([]domain.Score{})
+
+		f.broker.
+			On("Dispatch", mock.Anything, fakedContestID, domain.ContenderScoreUpdatedEvent(score)).
+			Return().
+			Once()
+
+		f.broker.
+			On("Dispatch", mock.Anything, fakedContestID, []domain.ContenderLiveScoreUpdatedEvent{
+				domain.ContenderLiveScoreUpdatedEvent(score),
+			}).
+			Run(func(args mock.Arguments) {
+				cancel()
+			}).
+			Return().
+			Once()
+
+		installEngine(mockedEngine)
+
+		wg.Wait()
+
+		awaitExpectations(t)
+		mockedEngine.AssertExpectations(t)
+	})
 }
 
 type scoreEngineMock struct {
@@ -492,3 +637,8 @@ func (m *scoreEngineMock) GetDirtyScores() []domain.Score {
 	args := m.Called()
 	return args.Get(0).([]domain.Score)
 }
+
+func (m *scoreEngineMock) GetProblemStats(byCompClass bool) []domain.ProblemStats {
+	args := m.Called(byCompClass)
+	return args.Get(0).([]domain.ProblemStats)
+}
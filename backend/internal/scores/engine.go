@@ -36,6 +36,7 @@ type EngineStore interface {
 	DeleteTick(domain.ContenderID, domain.ProblemID)
 
 	GetProblem(domain.ProblemID) (Problem, bool)
+	GetProblems() iter.Seq[Problem]
 	SaveProblem(Problem)
 
 	SaveScore(domain.Score)
@@ -79,6 +80,10 @@ func (e *DefaultScoreEngine) Start() {
 				tick.Score(problem)
 				e.store.SaveTick(contender.ID, tick)
 
+				if !tick.AvailableTo(contender.CompClassID) {
+					continue
+				}
+
 				yield(tick)
 			}
 		}
@@ -112,7 +117,7 @@ func (e *DefaultScoreEngine) HandleRulesUpdated(event domain.RulesUpdatedEvent)
 	e.ranker = NewBasicRanker(rules.Finalists)
 
 	for contender := range e.store.GetAllContenders() {
-		contender.Score = e.rules.CalculateScore(Points(e.store.GetTicks(contender.ID)))
+		contender.Score = e.rules.CalculateScore(Points(e.availableTicks(contender)))
 		e.store.SaveContender(contender)
 	}
 
@@ -126,6 +131,7 @@ func (e *DefaultScoreEngine) HandleContenderEntered(event domain.ContenderEntere
 		Disqualified:        false,
 		WithdrawnFromFinals: false,
 		Score:               0,
+		PreviousPlacement:   0,
 	}
 
 	e.store.SaveContender(contender)
@@ -150,6 +156,10 @@ func (e *DefaultScoreEngine) HandleContenderSwitchedClass(event domain.Contender
 
 	contender.CompClassID = event.CompClassID
 
+	if !contender.Disqualified {
+		contender.Score = e.rules.CalculateScore(Points(e.availableTicks(contender)))
+	}
+
 	e.store.SaveContender(contender)
 
 	e.rankCompClasses(compClassesToReRank...)
@@ -202,7 +212,7 @@ func (e *DefaultScoreEngine) HandleContenderRequalified(event domain.ContenderRe
 	}
 
 	contender.Disqualified = false
-	contender.Score = e.rules.CalculateScore(Points(e.store.GetTicks(contender.ID)))
+	contender.Score = e.rules.CalculateScore(Points(e.availableTicks(contender)))
 
 	e.store.SaveContender(contender)
 
@@ -238,7 +248,7 @@ func (e *DefaultScoreEngine) HandleAscentRegistered(event domain.AscentRegistere
 		return
 	}
 
-	contender.Score = e.rules.CalculateScore(Points(e.store.GetTicks(contender.ID)))
+	contender.Score = e.rules.CalculateScore(Points(e.availableTicks(contender)))
 	e.store.SaveContender(contender)
 
 	e.rankCompClasses(contender.CompClassID)
@@ -256,7 +266,7 @@ func (e *DefaultScoreEngine) HandleAscentDeregistered(event domain.AscentDeregis
 		return
 	}
 
-	contender.Score = e.rules.CalculateScore(Points(e.store.GetTicks(contender.ID)))
+	contender.Score = e.rules.CalculateScore(Points(e.availableTicks(contender)))
 	e.store.SaveContender(contender)
 
 	e.rankCompClasses(contender.CompClassID)
@@ -264,11 +274,12 @@ func (e *DefaultScoreEngine) HandleAscentDeregistered(event domain.AscentDeregis
 
 func (e *DefaultScoreEngine) HandleProblemAdded(event domain.ProblemAddedEvent) {
 	problem := Problem{
-		ID:          event.ProblemID,
-		PointsZone1: event.PointsZone1,
-		PointsZone2: event.PointsZone2,
-		PointsTop:   event.PointsTop,
-		FlashBonus:  event.FlashBonus,
+		ID:           event.ProblemID,
+		PointsZone1:  event.PointsZone1,
+		PointsZone2:  event.PointsZone2,
+		PointsTop:    event.PointsTop,
+		FlashBonus:   event.FlashBonus,
+		CompClassIDs: event.CompClassIDs,
 	}
 
 	e.store.SaveProblem(problem)
@@ -276,11 +287,12 @@ func (e *DefaultScoreEngine) HandleProblemAdded(event domain.ProblemAddedEvent)
 
 func (e *DefaultScoreEngine) HandleProblemUpdated(event domain.ProblemUpdatedEvent) {
 	problem := Problem{
-		ID:          event.ProblemID,
-		PointsZone1: event.PointsZone1,
-		PointsZone2: event.PointsZone2,
-		PointsTop:   event.PointsTop,
-		FlashBonus:  event.FlashBonus,
+		ID:           event.ProblemID,
+		PointsZone1:  event.PointsZone1,
+		PointsZone2:  event.PointsZone2,
+		PointsTop:    event.PointsTop,
+		FlashBonus:   event.FlashBonus,
+		CompClassIDs: event.CompClassIDs,
 	}
 
 	e.store.SaveProblem(problem)
@@ -292,6 +304,24 @@ func (e *DefaultScoreEngine) GetDirtyScores() []domain.Score {
 	return e.store.GetDirtyScores()
 }
 
+func (e *DefaultScoreEngine) GetProblemStats(byCompClass bool) []domain.ProblemStats {
+	return CalculateProblemStats(slices.Collect(e.store.GetProblems()), e.store.GetAllContenders(), e.store.GetTicks, byCompClass)
+}
+
+func (e *DefaultScoreEngine) availableTicks(contender Contender) iter.Seq[Tick] {
+	return func(yield func(Tick) bool) {
+		for tick := range e.store.GetTicks(contender.ID) {
+			if !tick.AvailableTo(contender.CompClassID) {
+				continue
+			}
+
+			if !yield(tick) {
+				return
+			}
+		}
+	}
+}
+
 func (e *DefaultScoreEngine) rankCompClasses(compClassIDs ...domain.CompClassID) {
 	for _, compClassID := range compClassIDs {
 		scores := e.ranker.RankContenders(e.store.GetContendersByCompClass(compClassID))
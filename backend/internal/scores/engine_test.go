@@ -217,9 +217,17 @@ func TestDefaultScoreEngine(t *testing.T) {
 					CompClassID:         1,
 					Disqualified:        false,
 					WithdrawnFromFinals: false,
-					Score:               123,
+					Score:               1123,
 				}, true)
 
+			f.store.
+				On("GetTicks", domain.ContenderID(4)).
+				Return(slices.Values([]scores.Tick{
+					{Points: 100},
+					{Points: 23, CompClassIDs: []domain.CompClassID{1, 2}},
+					{Points: 1000, CompClassIDs: []domain.CompClassID{1}},
+				}))
+
 			f.store.On("SaveContender", scores.Contender{
 				ID:                  4,
 				CompClassID:         2,
@@ -825,6 +833,11 @@ func (m *engineStoreMock) GetProblem(problemID domain.ProblemID) (scores.Problem
 	return args.Get(0).(scores.Problem), args.Bool(1)
 }
 
+func (m *engineStoreMock) GetProblems() iter.Seq[scores.Problem] {
+	args := m.Called()
+	return args.Get(0).(iter.Seq[scores.Problem])
+}
+
 func (m *engineStoreMock) SaveProblem(problem scores.Problem) {
 	m.Called(problem)
 }
@@ -13,35 +13,66 @@ type standardEngineStoreHydratorRepository interface {
 	GetContendersByContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) ([]domain.Contender, error)
 	GetProblemsByContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) ([]domain.Problem, error)
 	GetTicksByContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) ([]domain.Tick, error)
+	GetRound(ctx context.Context, tx domain.Transaction, roundID domain.RoundID) (domain.Round, error)
+	GetStartList(ctx context.Context, tx domain.Transaction, roundID domain.RoundID) ([]domain.StartListEntry, error)
 }
 
 type StandardEngineStoreHydrator struct {
 	Repo standardEngineStoreHydratorRepository
 }
 
-func (h *StandardEngineStoreHydrator) Hydrate(ctx context.Context, contestID domain.ContestID, store EngineStore) error {
-	contest, err := h.Repo.GetContest(ctx, nil, contestID)
-	if err != nil {
-		return errors.Wrap(err, 0)
+func (h *StandardEngineStoreHydrator) Hydrate(ctx context.Context, contestID domain.ContestID, roundID domain.RoundID, store EngineStore) error {
+	var rules Rules
+
+	if roundID == 0 {
+		contest, err := h.Repo.GetContest(ctx, nil, contestID)
+		if err != nil {
+			return errors.Wrap(err, 0)
+		}
+
+		rules = Rules{
+			QualifyingProblems: contest.QualifyingProblems,
+			Finalists:          contest.Finalists,
+		}
+	} else {
+		round, err := h.Repo.GetRound(ctx, nil, roundID)
+		if err != nil {
+			return errors.Wrap(err, 0)
+		}
+
+		if round.ContestID != contestID {
+			return errors.Wrap(domain.ErrNotFound, 0)
+		}
+
+		rules = Rules{
+			QualifyingProblems: round.QualifyingProblems,
+			Finalists:          round.Finalists,
+		}
 	}
 
-	store.SaveRules(Rules{
-		QualifyingProblems: contest.QualifyingProblems,
-		Finalists:          contest.Finalists,
-	})
+	store.SaveRules(rules)
 
 	problems, err := h.Repo.GetProblemsByContest(ctx, nil, contestID)
 	if err != nil {
 		return errors.Wrap(err, 0)
 	}
 
+	roundProblems := make(map[domain.ProblemID]domain.Problem)
+
 	for problem := range slices.Values(problems) {
+		if problem.RoundID != roundID {
+			continue
+		}
+
+		roundProblems[problem.ID] = problem
+
 		store.SaveProblem(Problem{
-			ID:          problem.ID,
-			PointsZone1: problem.PointsZone1,
-			PointsZone2: problem.PointsZone2,
-			PointsTop:   problem.PointsTop,
-			FlashBonus:  problem.FlashBonus,
+			ID:           problem.ID,
+			PointsZone1:  problem.PointsZone1,
+			PointsZone2:  problem.PointsZone2,
+			PointsTop:    problem.PointsTop,
+			FlashBonus:   problem.FlashBonus,
+			CompClassIDs: problem.CompClassIDs,
 		})
 	}
 
@@ -50,17 +81,48 @@ func (h *StandardEngineStoreHydrator) Hydrate(ctx context.Context, contestID dom
 		return errors.Wrap(err, 0)
 	}
 
+	var startList map[domain.ContenderID]domain.StartListEntry
+
+	if roundID != 0 {
+		entries, err := h.Repo.GetStartList(ctx, nil, roundID)
+		if err != nil {
+			return errors.Wrap(err, 0)
+		}
+
+		startList = make(map[domain.ContenderID]domain.StartListEntry)
+
+		for entry := range slices.Values(entries) {
+			startList[entry.ContenderID] = entry
+		}
+	}
+
+	roundContenders := make(map[domain.ContenderID]domain.CompClassID)
+
 	for contender := range slices.Values(contenders) {
 		if contender.CompClassID == 0 {
 			continue
 		}
 
+		var previousPlacement int
+
+		if startList != nil {
+			entry, found := startList[contender.ID]
+			if !found {
+				continue
+			}
+
+			previousPlacement = entry.PreviousPlacement
+		}
+
+		roundContenders[contender.ID] = contender.CompClassID
+
 		store.SaveContender(Contender{
 			ID:                  contender.ID,
 			CompClassID:         contender.CompClassID,
 			WithdrawnFromFinals: contender.WithdrawnFromFinals,
 			Disqualified:        contender.Disqualified,
 			Score:               0,
+			PreviousPlacement:   previousPlacement,
 		})
 	}
 
@@ -70,7 +132,23 @@ func (h *StandardEngineStoreHydrator) Hydrate(ctx context.Context, contestID dom
 	}
 
 	for tick := range slices.Values(ticks) {
-		store.SaveTick(*tick.Ownership.ContenderID, Tick{
+		problem, found := roundProblems[tick.ProblemID]
+		if !found {
+			continue
+		}
+
+		contenderID := *tick.Ownership.ContenderID
+
+		compClassID, found := roundContenders[contenderID]
+		if !found {
+			continue
+		}
+
+		if !problem.AvailableTo(compClassID) {
+			continue
+		}
+
+		store.SaveTick(contenderID, Tick{
 			ProblemID:     tick.ProblemID,
 			Zone1:         tick.Zone1,
 			AttemptsZone1: tick.AttemptsZone1,
@@ -43,6 +43,13 @@ func TestHydrate(t *testing.T) {
 					FlashBonus:  10,
 				},
 			},
+			{
+				ID:           fakedProblemID + 1,
+				CompClassIDs: []domain.CompClassID{fakedCompClassID + 1},
+				ProblemValue: domain.ProblemValue{
+					PointsTop: 100,
+				},
+			},
 		}, nil)
 
 	mockedRepo.
@@ -76,6 +83,14 @@ func TestHydrate(t *testing.T) {
 				Zone2:         true,
 				AttemptsZone2: 2,
 			},
+			{
+				Ownership: domain.OwnershipData{
+					ContenderID: &fakedContenderID,
+				},
+				ProblemID:   fakedProblemID + 1,
+				Top:         true,
+				AttemptsTop: 1,
+			},
 		}, nil)
 
 	mockedStore.On("SaveRules", scores.Rules{
@@ -91,6 +106,12 @@ func TestHydrate(t *testing.T) {
 		FlashBonus:  10,
 	}).Return()
 
+	mockedStore.On("SaveProblem", scores.Problem{
+		ID:           fakedProblemID + 1,
+		PointsTop:    100,
+		CompClassIDs: []domain.CompClassID{fakedCompClassID + 1},
+	}).Return()
+
 	mockedStore.On("SaveContender", scores.Contender{
 		ID:                  fakedContenderID,
 		CompClassID:         fakedCompClassID,
@@ -109,7 +130,7 @@ func TestHydrate(t *testing.T) {
 	}).Return()
 
 	hydrator := &scores.StandardEngineStoreHydrator{Repo: mockedRepo}
-	err := hydrator.Hydrate(context.Background(), fakedContestID, mockedStore)
+	err := hydrator.Hydrate(context.Background(), fakedContestID, 0, mockedStore)
 
 	require.NoError(t, err)
 
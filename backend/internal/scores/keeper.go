@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/climblive/platform/backend/internal/domain"
+	"github.com/climblive/platform/backend/internal/metrics"
 	"github.com/go-errors/errors"
 )
 
@@ -16,12 +17,21 @@ const lastDitchPersistTimeout = 10 * time.Second
 
 type keeperRepository interface {
 	StoreScore(ctx context.Context, tx domain.Transaction, score domain.Score) error
+	StoreRoundScore(ctx context.Context, tx domain.Transaction, roundID domain.RoundID, score domain.Score) error
+	StorePublishedScore(ctx context.Context, tx domain.Transaction, score domain.Score) error
+}
+
+type scoreKey struct {
+	roundID     domain.RoundID
+	contenderID domain.ContenderID
+	published   bool
 }
 
 type Keeper struct {
 	mu                     sync.RWMutex
 	eventBroker            domain.EventBroker
-	scores                 map[domain.ContenderID]domain.Score
+	scores                 map[scoreKey]domain.Score
+	publishedScores        map[domain.ContenderID]domain.Score
 	repo                   keeperRepository
 	externalPersistTrigger chan struct{}
 	running                atomic.Bool
@@ -30,7 +40,8 @@ type Keeper struct {
 func NewScoreKeeper(eventBroker domain.EventBroker, repo keeperRepository) *Keeper {
 	return &Keeper{
 		eventBroker:            eventBroker,
-		scores:                 make(map[domain.ContenderID]domain.Score),
+		scores:                 make(map[scoreKey]domain.Score),
+		publishedScores:        make(map[domain.ContenderID]domain.Score),
 		repo:                   repo,
 		externalPersistTrigger: make(chan struct{}, 1),
 		mu:                     sync.RWMutex{},
@@ -75,6 +86,8 @@ func (k *Keeper) run(ctx context.Context, ready chan<- struct{}) {
 		0,
 		0,
 		"CONTENDER_SCORE_UPDATED",
+		"[]CONTENDER_SCORE_UPDATED",
+		"ROUND_SCORE_UPDATED",
 	)
 
 	subscriptionID, eventReader := k.eventBroker.Subscribe(filter, 0)
@@ -99,6 +112,10 @@ EventLoop:
 			switch ev := event.Data.(type) {
 			case domain.ContenderScoreUpdatedEvent:
 				k.HandleContenderScoreUpdated(ev)
+			case []domain.ContenderScoreUpdatedEvent:
+				k.HandleContenderScoresPublished(ev)
+			case domain.RoundScoreUpdatedEvent:
+				k.HandleRoundScoreUpdated(ev)
 			}
 		case <-ticker:
 			k.persistScores(ctx)
@@ -131,31 +148,31 @@ func (k *Keeper) RequestPersist() {
 }
 
 func (k *Keeper) persistScores(ctx context.Context) {
-	takeFirst := func() (domain.ContenderID, domain.Score) {
+	takeFirst := func() (scoreKey, domain.Score) {
 		k.mu.Lock()
 		defer k.mu.Unlock()
 
-		var contenderID domain.ContenderID
+		var key scoreKey
 		var score domain.Score
-		for contenderID, score = range k.scores {
+		for key, score = range k.scores {
 			break
 		}
 
-		if contenderID == 0 {
-			return 0, domain.Score{}
+		if key.contenderID == 0 {
+			return scoreKey{}, domain.Score{}
 		}
 
-		delete(k.scores, contenderID)
+		delete(k.scores, key)
 
-		return contenderID, score
+		return key, score
 	}
 
-	putBack := func(contenderID domain.ContenderID, score domain.Score) {
+	putBack := func(key scoreKey, score domain.Score) {
 		k.mu.Lock()
 		defer k.mu.Unlock()
 
-		if _, found := k.scores[contenderID]; !found {
-			k.scores[contenderID] = score
+		if _, found := k.scores[key]; !found {
+			k.scores[key] = score
 		}
 	}
 
@@ -163,36 +180,51 @@ func (k *Keeper) persistScores(ctx context.Context) {
 
 IterateScores:
 	for ctx.Err() == nil {
-		contenderID, score := takeFirst()
+		key, score := takeFirst()
 
-		if contenderID == 0 {
+		if key.contenderID == 0 {
 			break
 		}
 
-		err := k.repo.StoreScore(ctx, nil, score)
+		var err error
+		switch {
+		case key.published:
+			err = k.repo.StorePublishedScore(ctx, nil, score)
+		case key.roundID == 0:
+			err = k.repo.StoreScore(ctx, nil, score)
+		default:
+			err = k.repo.StoreRoundScore(ctx, nil, key.roundID, score)
+		}
+
 		switch {
 		case err == nil:
 			persistedScores += 1
 		case errors.Is(err, domain.ErrNotFound):
 			slog.Warn("failed to persist score for non-existent contender",
-				"contender_id", contenderID,
+				"contender_id", key.contenderID,
+				"round_id", key.roundID,
 				"action", "drop",
 				"error", err)
 
 			continue
 		default:
+			metrics.ScoreKeeperPersistFailures.Inc()
+
 			slog.Error("failed to persist score",
-				"contender_id", contenderID,
+				"contender_id", key.contenderID,
+				"round_id", key.roundID,
 				"action", "try_again_later",
 				"error", err)
 
-			putBack(contenderID, score)
+			putBack(key, score)
 
 			break IterateScores
 		}
 	}
 
 	leftInMemory := k.getNumScoresWithLock()
+	metrics.ScoreKeeperPendingScores.Set(float64(leftInMemory))
+
 	if leftInMemory > 0 {
 		slog.Warn("not all scores where persisted",
 			"left_in_memory", leftInMemory,
@@ -213,14 +245,56 @@ func (k *Keeper) HandleContenderScoreUpdated(event domain.ContenderScoreUpdatedE
 	k.mu.Lock()
 	defer k.mu.Unlock()
 
-	k.scores[event.ContenderID] = domain.Score(event)
+	k.scores[scoreKey{roundID: 0, contenderID: event.ContenderID, published: false}] = domain.Score(event)
+	metrics.ScoreKeeperPendingScores.Set(float64(len(k.scores)))
+}
+
+func (k *Keeper) HandleContenderScoresPublished(batch []domain.ContenderScoreUpdatedEvent) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	for _, event := range batch {
+		k.publishedScores[event.ContenderID] = domain.Score(event)
+		k.scores[scoreKey{roundID: 0, contenderID: event.ContenderID, published: true}] = domain.Score(event)
+	}
+	metrics.ScoreKeeperPendingScores.Set(float64(len(k.scores)))
+}
+
+func (k *Keeper) HandleRoundScoreUpdated(event domain.RoundScoreUpdatedEvent) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	k.scores[scoreKey{roundID: event.RoundID, contenderID: event.ContenderID, published: false}] = domain.Score{
+		Timestamp:   event.Timestamp,
+		ContenderID: event.ContenderID,
+		Score:       event.Score,
+		Placement:   event.Placement,
+		Finalist:    event.Finalist,
+		RankOrder:   event.RankOrder,
+	}
+	metrics.ScoreKeeperPendingScores.Set(float64(len(k.scores)))
 }
 
 func (k *Keeper) GetScore(contenderID domain.ContenderID) (domain.Score, error) {
+	return k.GetRoundScore(0, contenderID)
+}
+
+func (k *Keeper) GetRoundScore(roundID domain.RoundID, contenderID domain.ContenderID) (domain.Score, error) {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	if score, found := k.scores[scoreKey{roundID: roundID, contenderID: contenderID, published: false}]; found {
+		return score, nil
+	}
+
+	return domain.Score{}, domain.ErrNotFound
+}
+
+func (k *Keeper) GetPublishedScore(contenderID domain.ContenderID) (domain.Score, error) {
 	k.mu.RLock()
 	defer k.mu.RUnlock()
 
-	if score, found := k.scores[contenderID]; found {
+	if score, found := k.publishedScores[contenderID]; found {
 		return score, nil
 	}
 
@@ -27,6 +27,8 @@ func TestKeeper(t *testing.T) {
 			0,
 			0,
 			"CONTENDER_SCORE_UPDATED",
+			"[]CONTENDER_SCORE_UPDATED",
+			"ROUND_SCORE_UPDATED",
 		), 0).Return(subscriptionID, subscription)
 
 		mockedEventBroker.On("Unsubscribe", subscriptionID).Return()
@@ -121,6 +123,60 @@ func TestKeeper(t *testing.T) {
 		mockedRepo.AssertExpectations(t)
 	})
 
+	t.Run("GatherRoundScores", func(t *testing.T) {
+		mockedRepo, mockedEventBroker, subscription := makeMocks(0)
+		keeper := scores.NewScoreKeeper(mockedEventBroker, mockedRepo)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		now := time.Now()
+
+		wg := keeper.Run(ctx)
+
+		fakedRoundID := domain.RoundID(1)
+		fakedContenderID := domain.ContenderID(1)
+
+		err := subscription.Post(domain.EventEnvelope{
+			Data: domain.RoundScoreUpdatedEvent{
+				RoundID:     fakedRoundID,
+				Timestamp:   now,
+				ContenderID: fakedContenderID,
+				Score:       500,
+				Placement:   1,
+				Finalist:    true,
+				RankOrder:   0,
+			},
+		})
+		require.NoError(t, err)
+
+		expected := domain.Score{
+			Timestamp:   now,
+			ContenderID: fakedContenderID,
+			Score:       500,
+			Placement:   1,
+			Finalist:    true,
+			RankOrder:   0,
+		}
+
+		mockedRepo.On("StoreRoundScore", mock.Anything, nil, fakedRoundID, expected).Return(nil)
+
+		assert.EventuallyWithT(t, func(collect *assert.CollectT) {
+			score, err := keeper.GetRoundScore(fakedRoundID, fakedContenderID)
+
+			require.NoError(collect, err)
+			assert.Equal(collect, expected, score)
+		}, time.Second, 10*time.Millisecond)
+
+		_, err = keeper.GetScore(fakedContenderID)
+		require.ErrorIs(t, err, domain.ErrNotFound)
+
+		cancel()
+
+		wg.Wait()
+
+		mockedEventBroker.AssertExpectations(t)
+		mockedRepo.AssertExpectations(t)
+	})
+
 	t.Run("PersistScores", func(t *testing.T) {
 		mockedRepo, mockedEventBroker, subscription := makeMocks(0)
 		keeper := scores.NewScoreKeeper(mockedEventBroker, mockedRepo)
@@ -175,6 +231,50 @@ func TestKeeper(t *testing.T) {
 		mockedRepo.AssertExpectations(t)
 	})
 
+	t.Run("PersistPublishedScores", func(t *testing.T) {
+		mockedRepo, mockedEventBroker, subscription := makeMocks(0)
+		keeper := scores.NewScoreKeeper(mockedEventBroker, mockedRepo)
+
+		ctx, cancel := context.WithCancel(context.Background())
+
+		wg := keeper.Run(ctx)
+
+		score := domain.Score{
+			Timestamp:   time.Now(),
+			ContenderID: 1,
+			Score:       100,
+			Placement:   1,
+			Finalist:    true,
+			RankOrder:   0,
+		}
+
+		mockedRepo.On("StorePublishedScore", mock.Anything, nil, score).Return(nil)
+
+		err := subscription.Post(domain.EventEnvelope{
+			Data: []domain.ContenderScoreUpdatedEvent{domain.ContenderScoreUpdatedEvent(score)},
+		})
+		require.NoError(t, err)
+
+		assert.EventuallyWithT(t, func(collect *assert.CollectT) {
+			published, err := keeper.GetPublishedScore(1)
+
+			require.NoError(collect, err)
+			assert.Equal(collect, score, published)
+		}, time.Second, 10*time.Millisecond)
+
+		_, err = keeper.GetScore(1)
+		require.ErrorIs(t, err, domain.ErrNotFound)
+
+		keeper.RequestPersist()
+
+		cancel()
+
+		wg.Wait()
+
+		mockedEventBroker.AssertExpectations(t)
+		mockedRepo.AssertExpectations(t)
+	})
+
 	t.Run("PersistScoresBeforeShutdown", func(t *testing.T) {
 		mockedRepo, mockedEventBroker, subscription := makeMocks(0)
 		keeper := scores.NewScoreKeeper(mockedEventBroker, mockedRepo)
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/climblive/platform/backend/internal/domain"
+	"github.com/climblive/platform/backend/internal/metrics"
 	"github.com/go-errors/errors"
 	"github.com/google/uuid"
 )
@@ -19,6 +20,12 @@ var ErrAlreadyStarted = errors.New("already started")
 type ScoreEngineDescriptor struct {
 	InstanceID domain.ScoreEngineInstanceID
 	ContestID  domain.ContestID
+	RoundID    domain.RoundID
+}
+
+type engineKey struct {
+	contestID domain.ContestID
+	roundID   domain.RoundID
 }
 
 type Request[A any, R any] struct {
@@ -60,25 +67,37 @@ type getScoreEngineArguments struct {
 
 type startScoreEngineArguments struct {
 	contestID    domain.ContestID
+	roundID      domain.RoundID
 	terminatedBy time.Time
 }
 
+type getProblemStatsArguments struct {
+	contestID   domain.ContestID
+	byCompClass bool
+}
+
 const pollInterval = 10 * time.Second
 
 type EngineStoreHydrator interface {
-	Hydrate(ctx context.Context, contestID domain.ContestID, store EngineStore) error
+	Hydrate(ctx context.Context, contestID domain.ContestID, roundID domain.RoundID, store EngineStore) error
 }
 
 type scoreEngineManagerRepository interface {
 	GetContestsCurrentlyRunningOrByStartTime(ctx context.Context, tx domain.Transaction, earliestStartTime, latestStartTime time.Time) ([]domain.Contest, error)
 	GetContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) (domain.Contest, error)
+	GetTeamsByContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) ([]domain.Team, error)
+	GetContendersByContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) ([]domain.Contender, error)
+	GetPublishedScoresByContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) ([]domain.Score, error)
+	GetRoundsByContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) ([]domain.Round, error)
+	GetRoundsCurrentlyRunningOrByStartTime(ctx context.Context, tx domain.Transaction, earliestStartTime, latestStartTime time.Time) ([]domain.Round, error)
+	GetStartList(ctx context.Context, tx domain.Transaction, roundID domain.RoundID) ([]domain.StartListEntry, error)
 }
 
 type ScoreEngineManager struct {
 	repo                   scoreEngineManagerRepository
 	engineStoreHydrator    EngineStoreHydrator
 	eventBroker            domain.EventBroker
-	handlers               map[domain.ContestID]*engineHandler
+	handlers               map[engineKey]*engineHandler
 	requests               chan any
 	terminations           chan domain.ScoreEngineInstanceID
 	scoreEngineMaxLifetime time.Duration
@@ -97,7 +116,7 @@ func NewScoreEngineManager(repo scoreEngineManagerRepository, engineStoreHydrato
 		repo:                   repo,
 		engineStoreHydrator:    engineStoreHydrator,
 		eventBroker:            eventBroker,
-		handlers:               make(map[domain.ContestID]*engineHandler),
+		handlers:               make(map[engineKey]*engineHandler),
 		requests:               make(chan any),
 		terminations:           make(chan domain.ScoreEngineInstanceID),
 		scoreEngineMaxLifetime: scoreEngineMaxLifetime,
@@ -161,11 +180,13 @@ func (mngr *ScoreEngineManager) StopScoreEngine(
 func (mngr *ScoreEngineManager) StartScoreEngine(
 	ctx context.Context,
 	contestID domain.ContestID,
+	roundID domain.RoundID,
 	terminatedBy time.Time,
 ) (domain.ScoreEngineInstanceID, error) {
 	request := Request[startScoreEngineArguments, domain.ScoreEngineInstanceID]{
 		Args: startScoreEngineArguments{
 			contestID:    contestID,
+			roundID:      roundID,
 			terminatedBy: terminatedBy,
 		},
 		Response: nil,
@@ -181,6 +202,21 @@ func (mngr *ScoreEngineManager) GetScoreEngine(ctx context.Context, instanceID d
 	return request.Do(ctx, mngr.requests)
 }
 
+func (mngr *ScoreEngineManager) GetProblemStats(
+	ctx context.Context,
+	contestID domain.ContestID,
+	byCompClass bool,
+) (map[domain.RoundID][]domain.ProblemStats, error) {
+	request := Request[getProblemStatsArguments, map[domain.RoundID][]domain.ProblemStats]{
+		Args: getProblemStatsArguments{
+			contestID:   contestID,
+			byCompClass: byCompClass,
+		},
+		Response: nil,
+	}
+	return request.Do(ctx, mngr.requests)
+}
+
 func (mngr *ScoreEngineManager) run(ctx context.Context) {
 	mngr.running.Store(true)
 	defer mngr.running.Store(false)
@@ -209,10 +245,10 @@ func (mngr *ScoreEngineManager) run(ctx context.Context) {
 		case request := <-mngr.requests:
 			mngr.handleRequest(request)
 		case terminatedInstanceID := <-mngr.terminations:
-			for contestID, handler := range mngr.handlers {
+			for key, handler := range mngr.handlers {
 				if handler.instanceID == terminatedInstanceID {
 					slog.Info("removing terminated score engine", "instance_id", terminatedInstanceID)
-					delete(mngr.handlers, contestID)
+					delete(mngr.handlers, key)
 
 					break
 				}
@@ -234,7 +270,7 @@ func (mngr *ScoreEngineManager) handleRequest(request any) {
 		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 		defer cancel()
 
-		instanceID, err := mngr.startScoreEngine(ctx, req.Args.contestID, req.Args.terminatedBy)
+		instanceID, err := mngr.startScoreEngine(ctx, req.Args.contestID, req.Args.roundID, req.Args.terminatedBy)
 
 		req.Response <- Response[domain.ScoreEngineInstanceID]{
 			Value: instanceID,
@@ -254,9 +290,54 @@ func (mngr *ScoreEngineManager) handleRequest(request any) {
 		}
 
 		close(req.Response)
+	case Request[getProblemStatsArguments, map[domain.RoundID][]domain.ProblemStats]:
+		drivers := make(map[domain.RoundID]*ScoreEngineDriver)
+
+		for key, handler := range mngr.handlers {
+			if key.contestID == req.Args.contestID {
+				drivers[key.roundID] = handler.driver
+			}
+		}
+
+		go func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			stats, err := getProblemStats(ctx, drivers, req.Args.byCompClass)
+
+			req.Response <- Response[map[domain.RoundID][]domain.ProblemStats]{
+				Value: stats,
+				Err:   err,
+			}
+
+			close(req.Response)
+		}()
 	}
 }
 
+func getProblemStats(
+	ctx context.Context,
+	drivers map[domain.RoundID]*ScoreEngineDriver,
+	byCompClass bool,
+) (map[domain.RoundID][]domain.ProblemStats, error) {
+	stats := make(map[domain.RoundID][]domain.ProblemStats, len(drivers))
+
+	for roundID, driver := range drivers {
+		if !driver.running.Load() {
+			continue
+		}
+
+		roundStats, err := driver.GetProblemStats(ctx, byCompClass)
+		if err != nil {
+			return nil, errors.Wrap(err, 0)
+		}
+
+		stats[roundID] = roundStats
+	}
+
+	return stats, nil
+}
+
 func (mngr *ScoreEngineManager) runPeriodicCheck(ctx context.Context) {
 	now := time.Now()
 	contests, err := mngr.repo.GetContestsCurrentlyRunningOrByStartTime(ctx, nil, now, now.Add(5*time.Minute))
@@ -271,16 +352,64 @@ func (mngr *ScoreEngineManager) runPeriodicCheck(ctx context.Context) {
 			continue
 		}
 
-		if _, found := mngr.handlers[contest.ID]; found {
+		mngr.ensureScoreEngine(ctx, contest, 0, contest.TimeEnd)
+
+		rounds, err := mngr.repo.GetRoundsByContest(ctx, nil, contest.ID)
+		if err != nil {
+			slog.Error("score engine manager failed to get rounds", "contest_id", contest.ID, "error", err)
+
+			continue
+		}
+
+		for round := range slices.Values(rounds) {
+			if !round.TimeBegin.IsZero() {
+				continue
+			}
+
+			mngr.ensureScoreEngine(ctx, contest, round.ID, contest.TimeEnd)
+		}
+	}
+
+	rounds, err := mngr.repo.GetRoundsCurrentlyRunningOrByStartTime(ctx, nil, now, now.Add(5*time.Minute))
+	if err != nil {
+		slog.Error("score engine manager failed to complete periodic check", "error", err)
+
+		return
+	}
+
+	for round := range slices.Values(rounds) {
+		contest, err := mngr.repo.GetContest(ctx, nil, round.ContestID)
+		if err != nil {
+			slog.Error("score engine manager failed to get contest", "contest_id", round.ContestID, "error", err)
+
 			continue
 		}
 
-		_, _ = mngr.startScoreEngine(ctx, contest.ID, contest.TimeEnd.Add(12*time.Hour))
+		mngr.ensureScoreEngine(ctx, contest, round.ID, round.TimeEnd)
 	}
 }
 
-func (mngr *ScoreEngineManager) startScoreEngine(ctx context.Context, contestID domain.ContestID, terminatedBy time.Time) (domain.ScoreEngineInstanceID, error) {
-	if _, ok := mngr.handlers[contestID]; ok {
+func (mngr *ScoreEngineManager) ensureScoreEngine(ctx context.Context, contest domain.Contest, roundID domain.RoundID, timeEnd time.Time) {
+	if handler, found := mngr.handlers[engineKey{contestID: contest.ID, roundID: roundID}]; found {
+		handler.driver.SetScoreboardFrozenFrom(contest.ScoreboardFrozenFrom())
+
+		return
+	}
+
+	if roundID != 0 {
+		startList, err := mngr.repo.GetStartList(ctx, nil, roundID)
+		if err != nil || len(startList) == 0 {
+			return
+		}
+	}
+
+	_, _ = mngr.startScoreEngine(ctx, contest.ID, roundID, timeEnd.Add(12*time.Hour))
+}
+
+func (mngr *ScoreEngineManager) startScoreEngine(ctx context.Context, contestID domain.ContestID, roundID domain.RoundID, terminatedBy time.Time) (domain.ScoreEngineInstanceID, error) {
+	key := engineKey{contestID: contestID, roundID: roundID}
+
+	if _, ok := mngr.handlers[key]; ok {
 		return uuid.Nil, errors.New(ErrAlreadyStarted)
 	}
 
@@ -291,7 +420,7 @@ func (mngr *ScoreEngineManager) startScoreEngine(ctx context.Context, contestID
 		return uuid.Nil, errors.Wrap(err, 0)
 	}
 
-	logger := slog.New(slog.Default().Handler()).With("contest_id", contestID)
+	logger := slog.New(slog.Default().Handler()).With("contest_id", contestID, "round_id", roundID)
 
 	latestPermittedTerminationTime := time.Now().Add(mngr.scoreEngineMaxLifetime)
 
@@ -311,14 +440,31 @@ func (mngr *ScoreEngineManager) startScoreEngine(ctx context.Context, contestID
 
 	instanceID := uuid.New()
 	store := NewMemoryStore()
-	driver := NewScoreEngineDriver(contest.ID, instanceID, mngr.eventBroker)
+	driver := NewScoreEngineDriver(contest.ID, roundID, instanceID, mngr.eventBroker)
+	driver.SetScoreboardFrozenFrom(contest.ScoreboardFrozenFrom())
 	engine := NewDefaultScoreEngine(store)
 
 	cancellableCtx, stop := context.WithDeadline(context.Background(), terminatedBy)
+
+	var teamScoreDriverWG *sync.WaitGroup
+	if roundID == 0 {
+		teamScoreDriverWG = NewTeamScoreDriver(contest.ID, mngr.eventBroker, mngr.repo).Run(cancellableCtx, WithPanicRecovery())
+	}
+
 	wg, installEngine := driver.Run(cancellableCtx, WithPanicRecovery())
 
+	if teamScoreDriverWG != nil {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			teamScoreDriverWG.Wait()
+		}()
+	}
+
 	hydrationStartTime := time.Now()
-	err = mngr.engineStoreHydrator.Hydrate(ctx, contestID, store)
+	err = mngr.engineStoreHydrator.Hydrate(ctx, contestID, roundID, store)
 	if err != nil {
 		logger.Error("hydration failed", "error", err)
 
@@ -327,11 +473,14 @@ func (mngr *ScoreEngineManager) startScoreEngine(ctx context.Context, contestID
 		return uuid.Nil, errors.Wrap(err, 0)
 	}
 
-	logger.Debug("score engine store hydration complete", "time", time.Since(hydrationStartTime))
+	hydrationDuration := time.Since(hydrationStartTime)
+	metrics.ScoreEngineHydrationDuration.Observe(hydrationDuration.Seconds())
+
+	logger.Debug("score engine store hydration complete", "time", hydrationDuration)
 
 	installEngine(engine)
 
-	mngr.handlers[contestID] = &engineHandler{
+	mngr.handlers[key] = &engineHandler{
 		instanceID: instanceID,
 		driver:     driver,
 		stop:       stop,
@@ -352,11 +501,12 @@ func (mngr *ScoreEngineManager) startScoreEngine(ctx context.Context, contestID
 func (mngr *ScoreEngineManager) listScoreEnginesByContest(needle domain.ContestID) []ScoreEngineDescriptor {
 	instances := make([]ScoreEngineDescriptor, 0)
 
-	for contestID, handler := range mngr.handlers {
-		if contestID == needle {
+	for key, handler := range mngr.handlers {
+		if key.contestID == needle {
 			instances = append(instances, ScoreEngineDescriptor{
 				InstanceID: handler.instanceID,
-				ContestID:  contestID,
+				ContestID:  key.contestID,
+				RoundID:    key.roundID,
 			})
 		}
 	}
@@ -365,12 +515,12 @@ func (mngr *ScoreEngineManager) listScoreEnginesByContest(needle domain.ContestI
 }
 
 func (mngr *ScoreEngineManager) stopScoreEngine(instanceID domain.ScoreEngineInstanceID) {
-	for contestID, handler := range mngr.handlers {
+	for key, handler := range mngr.handlers {
 		if handler.instanceID == instanceID {
 			handler.stop()
 			handler.wg.Wait()
 
-			delete(mngr.handlers, contestID)
+			delete(mngr.handlers, key)
 
 			return
 		}
@@ -378,11 +528,12 @@ func (mngr *ScoreEngineManager) stopScoreEngine(instanceID domain.ScoreEngineIns
 }
 
 func (mngr *ScoreEngineManager) getScoreEngine(instanceID domain.ScoreEngineInstanceID) (ScoreEngineDescriptor, error) {
-	for contestID, handler := range mngr.handlers {
+	for key, handler := range mngr.handlers {
 		if handler.instanceID == instanceID {
 			return ScoreEngineDescriptor{
 				InstanceID: handler.instanceID,
-				ContestID:  contestID,
+				ContestID:  key.contestID,
+				RoundID:    key.roundID,
 			}, nil
 		}
 	}
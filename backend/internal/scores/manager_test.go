@@ -25,6 +25,10 @@ func TestScoreEngineManager(t *testing.T) {
 			On("GetContestsCurrentlyRunningOrByStartTime", mock.Anything, mock.Anything, mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).
 			Return([]domain.Contest{}, nil)
 
+		mockedRepo.
+			On("GetRoundsCurrentlyRunningOrByStartTime", mock.Anything, mock.Anything, mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).
+			Return([]domain.Round{}, nil)
+
 		mngr := scores.NewScoreEngineManager(mockedRepo, mockedStoreHydrator, mockedEventBroker, time.Hour)
 
 		ctx, cancel := context.WithCancel(context.Background())
@@ -63,6 +67,14 @@ func TestScoreEngineManager(t *testing.T) {
 				},
 			}, nil)
 
+		mockedRepo.
+			On("GetRoundsByContest", mock.Anything, mock.Anything, fakedContestID).
+			Return([]domain.Round{}, nil)
+
+		mockedRepo.
+			On("GetRoundsCurrentlyRunningOrByStartTime", mock.Anything, mock.Anything, mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).
+			Return([]domain.Round{}, nil)
+
 		mockedRepo.
 			On("GetContest", mock.Anything, mock.Anything, fakedContestID).
 			Return(domain.Contest{
@@ -73,6 +85,16 @@ func TestScoreEngineManager(t *testing.T) {
 				TimeEnd:            now,
 			}, nil)
 
+		mockedRepo.
+			On("GetTeamsByContest", mock.Anything, mock.Anything, fakedContestID).
+			Return([]domain.Team{}, nil).
+			Maybe()
+
+		mockedRepo.
+			On("GetContendersByContest", mock.Anything, mock.Anything, fakedContestID).
+			Return([]domain.Contender{}, nil).
+			Maybe()
+
 		mockedEventBroker.
 			On("Subscribe", mock.Anything, mock.Anything).
 			Return(fakedSubscriptionID, events.NewSubscription(domain.EventFilter{}, 1000))
@@ -82,11 +104,11 @@ func TestScoreEngineManager(t *testing.T) {
 			Return()
 
 		mockedEventBroker.
-			On("Dispatch", fakedContestID, mock.AnythingOfType("domain.ScoreEngineStartedEvent")).
-			On("Dispatch", fakedContestID, mock.AnythingOfType("domain.ScoreEngineStoppedEvent"))
+			On("Dispatch", mock.Anything, fakedContestID, mock.AnythingOfType("domain.ScoreEngineStartedEvent")).
+			On("Dispatch", mock.Anything, fakedContestID, mock.AnythingOfType("domain.ScoreEngineStoppedEvent"))
 
 		mockedStoreHydrator.
-			On("Hydrate", mock.Anything, fakedContestID, mock.AnythingOfType("*scores.MemoryStore")).
+			On("Hydrate", mock.Anything, fakedContestID, domain.RoundID(0), mock.AnythingOfType("*scores.MemoryStore")).
 			Run(func(args mock.Arguments) {
 				cancel()
 			}).
@@ -104,6 +126,112 @@ func TestScoreEngineManager(t *testing.T) {
 		mockedEventBroker.AssertExpectations(t)
 	})
 
+	t.Run("LoadRoundEngines", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+
+		mockedRepo := new(repositoryMock)
+		mockedStoreHydrator := new(engineStoreHydratorMock)
+		mockedEventBroker := new(eventBrokerMock)
+
+		fakedSubscriptionID := domain.SubscriptionID(uuid.New())
+		fakedContestID := testutils.RandomResourceID[domain.ContestID]()
+		fakedRoundID := testutils.RandomResourceID[domain.RoundID]()
+		fakedPendingRoundID := testutils.RandomResourceID[domain.RoundID]()
+		fakedScheduledRoundID := testutils.RandomResourceID[domain.RoundID]()
+
+		now := time.Now()
+
+		fakedContest := domain.Contest{
+			ID:                 fakedContestID,
+			QualifyingProblems: 10,
+			Finalists:          7,
+			TimeBegin:          now,
+			TimeEnd:            now,
+		}
+
+		mockedRepo.
+			On("GetContestsCurrentlyRunningOrByStartTime", mock.Anything, mock.Anything, mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).
+			Return([]domain.Contest{fakedContest}, nil)
+
+		mockedRepo.
+			On("GetRoundsByContest", mock.Anything, mock.Anything, fakedContestID).
+			Return([]domain.Round{
+				{ID: fakedRoundID, ContestID: fakedContestID, Number: 1},
+				{ID: fakedPendingRoundID, ContestID: fakedContestID, Number: 2},
+				{ID: fakedScheduledRoundID, ContestID: fakedContestID, Number: 3, TimeBegin: now.Add(time.Hour), TimeEnd: now.Add(2 * time.Hour)},
+			}, nil)
+
+		mockedRepo.
+			On("GetRoundsCurrentlyRunningOrByStartTime", mock.Anything, mock.Anything, mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).
+			Return([]domain.Round{
+				{ID: fakedScheduledRoundID, ContestID: fakedContestID, Number: 3, TimeBegin: now.Add(time.Hour), TimeEnd: now.Add(2 * time.Hour)},
+			}, nil)
+
+		mockedRepo.
+			On("GetStartList", mock.Anything, mock.Anything, fakedRoundID).
+			Return([]domain.StartListEntry{{RoundID: fakedRoundID}}, nil)
+
+		mockedRepo.
+			On("GetStartList", mock.Anything, mock.Anything, fakedPendingRoundID).
+			Return([]domain.StartListEntry{}, nil)
+
+		mockedRepo.
+			On("GetStartList", mock.Anything, mock.Anything, fakedScheduledRoundID).
+			Return([]domain.StartListEntry{{RoundID: fakedScheduledRoundID}}, nil)
+
+		mockedRepo.
+			On("GetContest", mock.Anything, mock.Anything, fakedContestID).
+			Return(fakedContest, nil)
+
+		mockedRepo.
+			On("GetTeamsByContest", mock.Anything, mock.Anything, fakedContestID).
+			Return([]domain.Team{}, nil).
+			Maybe()
+
+		mockedRepo.
+			On("GetContendersByContest", mock.Anything, mock.Anything, fakedContestID).
+			Return([]domain.Contender{}, nil).
+			Maybe()
+
+		mockedEventBroker.
+			On("Subscribe", mock.Anything, mock.Anything).
+			Return(fakedSubscriptionID, events.NewSubscription(domain.EventFilter{}, 1000))
+
+		mockedEventBroker.
+			On("Unsubscribe", fakedSubscriptionID).
+			Return()
+
+		mockedEventBroker.
+			On("Dispatch", mock.Anything, fakedContestID, mock.AnythingOfType("domain.ScoreEngineStartedEvent")).Return().Maybe().
+			On("Dispatch", mock.Anything, fakedContestID, mock.AnythingOfType("domain.ScoreEngineStoppedEvent")).Return().Maybe()
+
+		mockedStoreHydrator.
+			On("Hydrate", mock.Anything, fakedContestID, domain.RoundID(0), mock.AnythingOfType("*scores.MemoryStore")).
+			Return(nil)
+
+		mockedStoreHydrator.
+			On("Hydrate", mock.Anything, fakedContestID, fakedRoundID, mock.AnythingOfType("*scores.MemoryStore")).
+			Return(nil)
+
+		mockedStoreHydrator.
+			On("Hydrate", mock.Anything, fakedContestID, fakedScheduledRoundID, mock.AnythingOfType("*scores.MemoryStore")).
+			Run(func(args mock.Arguments) {
+				cancel()
+			}).
+			Return(nil)
+
+		mngr := scores.NewScoreEngineManager(mockedRepo, mockedStoreHydrator, mockedEventBroker, time.Hour)
+
+		wg := mngr.Run(ctx)
+
+		<-ctx.Done()
+		wg.Wait()
+
+		mockedRepo.AssertExpectations(t)
+		mockedStoreHydrator.AssertExpectations(t)
+		mockedStoreHydrator.AssertNotCalled(t, "Hydrate", mock.Anything, fakedContestID, fakedPendingRoundID, mock.Anything)
+	})
+
 	t.Run("ManageScoreEngines", func(t *testing.T) {
 		ctx, cancel := context.WithCancel(context.Background())
 
@@ -120,6 +248,10 @@ func TestScoreEngineManager(t *testing.T) {
 			On("GetContestsCurrentlyRunningOrByStartTime", mock.Anything, mock.Anything, mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).
 			Return([]domain.Contest{}, nil)
 
+		mockedRepo.
+			On("GetRoundsCurrentlyRunningOrByStartTime", mock.Anything, mock.Anything, mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).
+			Return([]domain.Round{}, nil)
+
 		mockedRepo.
 			On("GetContest", mock.Anything, mock.Anything, fakedContestID).
 			Return(domain.Contest{
@@ -130,6 +262,16 @@ func TestScoreEngineManager(t *testing.T) {
 				TimeEnd:            now,
 			}, nil)
 
+		mockedRepo.
+			On("GetTeamsByContest", mock.Anything, mock.Anything, fakedContestID).
+			Return([]domain.Team{}, nil).
+			Maybe()
+
+		mockedRepo.
+			On("GetContendersByContest", mock.Anything, mock.Anything, fakedContestID).
+			Return([]domain.Contender{}, nil).
+			Maybe()
+
 		mockedEventBroker.
 			On("Subscribe", mock.Anything, mock.Anything).
 			Return(fakedSubscriptionID, events.NewSubscription(domain.EventFilter{}, 1000))
@@ -139,23 +281,23 @@ func TestScoreEngineManager(t *testing.T) {
 			Return()
 
 		mockedStoreHydrator.
-			On("Hydrate", mock.Anything, fakedContestID, mock.AnythingOfType("*scores.MemoryStore")).
+			On("Hydrate", mock.Anything, fakedContestID, domain.RoundID(0), mock.AnythingOfType("*scores.MemoryStore")).
 			Return(nil)
 
 		mockedEventBroker.
-			On("Dispatch", fakedContestID, mock.AnythingOfType("domain.ScoreEngineStartedEvent")).Return().
-			On("Dispatch", fakedContestID, mock.AnythingOfType("domain.ScoreEngineStoppedEvent")).Return()
+			On("Dispatch", mock.Anything, fakedContestID, mock.AnythingOfType("domain.ScoreEngineStartedEvent")).Return().
+			On("Dispatch", mock.Anything, fakedContestID, mock.AnythingOfType("domain.ScoreEngineStoppedEvent")).Return()
 
 		mngr := scores.NewScoreEngineManager(mockedRepo, mockedStoreHydrator, mockedEventBroker, time.Hour)
 
 		wg := mngr.Run(ctx)
 
-		instanceID, err := mngr.StartScoreEngine(context.Background(), fakedContestID, time.Now().Add(time.Hour))
+		instanceID, err := mngr.StartScoreEngine(context.Background(), fakedContestID, 0, time.Now().Add(time.Hour))
 
 		require.NoError(t, err)
 		assert.NotEmpty(t, instanceID)
 
-		_, err = mngr.StartScoreEngine(context.Background(), fakedContestID, time.Now().Add(time.Hour))
+		_, err = mngr.StartScoreEngine(context.Background(), fakedContestID, 0, time.Now().Add(time.Hour))
 
 		require.ErrorIs(t, err, scores.ErrAlreadyStarted)
 
@@ -171,6 +313,7 @@ func TestScoreEngineManager(t *testing.T) {
 		assert.ElementsMatch(t, []scores.ScoreEngineDescriptor{{
 			InstanceID: instanceID,
 			ContestID:  fakedContestID,
+			RoundID:    0,
 		}}, instances)
 
 		err = mngr.StopScoreEngine(context.Background(), instanceID)
@@ -211,6 +354,21 @@ func (m *repositoryMock) GetContest(ctx context.Context, tx domain.Transaction,
 	return args.Get(0).(domain.Contest), args.Error(1)
 }
 
+func (m *repositoryMock) GetRoundsByContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) ([]domain.Round, error) {
+	args := m.Called(ctx, tx, contestID)
+	return args.Get(0).([]domain.Round), args.Error(1)
+}
+
+func (m *repositoryMock) GetRoundsCurrentlyRunningOrByStartTime(ctx context.Context, tx domain.Transaction, earliestStartTime, latestStartTime time.Time) ([]domain.Round, error) {
+	args := m.Called(ctx, tx, earliestStartTime, latestStartTime)
+	return args.Get(0).([]domain.Round), args.Error(1)
+}
+
+func (m *repositoryMock) GetTeamsByContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) ([]domain.Team, error) {
+	args := m.Called(ctx, tx, contestID)
+	return args.Get(0).([]domain.Team), args.Error(1)
+}
+
 func (m *repositoryMock) GetContendersByContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) ([]domain.Contender, error) {
 	args := m.Called(ctx, tx, contestID)
 	return args.Get(0).([]domain.Contender), args.Error(1)
@@ -231,12 +389,37 @@ func (m *repositoryMock) StoreScore(ctx context.Context, tx domain.Transaction,
 	return args.Error(0)
 }
 
+func (m *repositoryMock) StorePublishedScore(ctx context.Context, tx domain.Transaction, score domain.Score) error {
+	args := m.Called(ctx, tx, score)
+	return args.Error(0)
+}
+
+func (m *repositoryMock) GetPublishedScoresByContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) ([]domain.Score, error) {
+	args := m.Called(ctx, tx, contestID)
+	return args.Get(0).([]domain.Score), args.Error(1)
+}
+
+func (m *repositoryMock) GetRound(ctx context.Context, tx domain.Transaction, roundID domain.RoundID) (domain.Round, error) {
+	args := m.Called(ctx, tx, roundID)
+	return args.Get(0).(domain.Round), args.Error(1)
+}
+
+func (m *repositoryMock) GetStartList(ctx context.Context, tx domain.Transaction, roundID domain.RoundID) ([]domain.StartListEntry, error) {
+	args := m.Called(ctx, tx, roundID)
+	return args.Get(0).([]domain.StartListEntry), args.Error(1)
+}
+
+func (m *repositoryMock) StoreRoundScore(ctx context.Context, tx domain.Transaction, roundID domain.RoundID, score domain.Score) error {
+	args := m.Called(ctx, tx, roundID, score)
+	return args.Error(0)
+}
+
 type eventBrokerMock struct {
 	mock.Mock
 }
 
-func (m *eventBrokerMock) Dispatch(contestID domain.ContestID, event any) {
-	m.Called(contestID, event)
+func (m *eventBrokerMock) Dispatch(ctx context.Context, contestID domain.ContestID, event any) {
+	m.Called(ctx, contestID, event)
 }
 
 func (m *eventBrokerMock) Subscribe(filter domain.EventFilter, bufferCapacity int) (domain.SubscriptionID, domain.EventReader) {
@@ -252,7 +435,7 @@ type engineStoreHydratorMock struct {
 	mock.Mock
 }
 
-func (m *engineStoreHydratorMock) Hydrate(ctx context.Context, contestID domain.ContestID, store scores.EngineStore) error {
-	args := m.Called(ctx, contestID, store)
+func (m *engineStoreHydratorMock) Hydrate(ctx context.Context, contestID domain.ContestID, roundID domain.RoundID, store scores.EngineStore) error {
+	args := m.Called(ctx, contestID, roundID, store)
 	return args.Error(0)
 }
@@ -81,9 +81,9 @@ func (r *BasicRanker) RankContenders(contenders iter.Seq[Contender]) []domain.Sc
 		case previousContender == nil:
 			placement = 1
 			gap = 0
-		case contender.Score == previousContender.Score:
+		case contender.Score == previousContender.Score && contender.PreviousPlacement == previousContender.PreviousPlacement:
 			gap++
-		case contender.Score != previousContender.Score:
+		default:
 			placement += 1 + gap
 			gap = 0
 		}
@@ -111,3 +111,53 @@ func (r *BasicRanker) RankContenders(contenders iter.Seq[Contender]) []domain.Sc
 
 	return scores
 }
+
+type TeamRanker struct {
+}
+
+func NewTeamRanker() *TeamRanker {
+	return &TeamRanker{}
+}
+
+func (r *TeamRanker) RankTeams(teams iter.Seq[Team]) []domain.TeamScore {
+	var scores []domain.TeamScore
+
+	type scoredTeam struct {
+		id    domain.TeamID
+		score int
+	}
+
+	var scoredTeams []scoredTeam
+
+	for team := range teams {
+		scoredTeams = append(scoredTeams, scoredTeam{id: team.ID, score: team.Score()})
+	}
+
+	slices.SortFunc(scoredTeams, func(t1, t2 scoredTeam) int {
+		if t1.score != t2.score {
+			return t2.score - t1.score
+		}
+
+		return int(t1.id) - int(t2.id)
+	})
+
+	now := time.Now()
+
+	var placement int
+
+	for i, team := range scoredTeams {
+		if i == 0 || team.score != scoredTeams[i-1].score {
+			placement = i + 1
+		}
+
+		scores = append(scores, domain.TeamScore{
+			Timestamp: now,
+			TeamID:    team.id,
+			Score:     team.score,
+			Placement: placement,
+			RankOrder: i,
+		})
+	}
+
+	return scores
+}
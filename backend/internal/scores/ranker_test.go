@@ -181,6 +181,62 @@ func TestBasicRanker(t *testing.T) {
 	})
 }
 
+func TestTeamRanker(t *testing.T) {
+	ranker := scores.NewTeamRanker()
+
+	prettifyTeamScores := func(teamScores []domain.TeamScore) []string {
+		arr := make([]string, 0)
+
+		for score := range slices.Values(teamScores) {
+			arr = append(arr, fmt.Sprintf("i:%v s:%d p:%d r:%d", score.TeamID, score.Score, score.Placement, score.RankOrder))
+		}
+
+		return arr
+	}
+
+	t.Run("Simple", func(t *testing.T) {
+		teams := []scores.Team{
+			{ID: 1, CountedMembers: 2, MemberScores: []int{100, 200, 300}},
+			{ID: 2, CountedMembers: 2, MemberScores: []int{1000}},
+			{ID: 3, CountedMembers: 2, MemberScores: []int{}},
+		}
+
+		shuffleSlice(teams)
+
+		teamScores := ranker.RankTeams(slices.Values(teams))
+
+		expected := []string{
+			"i:2 s:1000 p:1 r:0",
+			"i:1 s:500 p:2 r:1",
+			"i:3 s:0 p:3 r:2",
+		}
+
+		assert.Equal(t, expected, prettifyTeamScores(teamScores))
+	})
+
+	t.Run("SharedPlacement", func(t *testing.T) {
+		teams := []scores.Team{
+			{ID: 1, CountedMembers: 1, MemberScores: []int{100}},
+			{ID: 2, CountedMembers: 1, MemberScores: []int{200}},
+			{ID: 3, CountedMembers: 1, MemberScores: []int{200}},
+			{ID: 4, CountedMembers: 1, MemberScores: []int{50}},
+		}
+
+		shuffleSlice(teams)
+
+		teamScores := ranker.RankTeams(slices.Values(teams))
+
+		expected := []string{
+			"i:2 s:200 p:1 r:0",
+			"i:3 s:200 p:1 r:1",
+			"i:1 s:100 p:3 r:2",
+			"i:4 s:50 p:4 r:3",
+		}
+
+		assert.Equal(t, expected, prettifyTeamScores(teamScores))
+	})
+}
+
 func shuffleSlice[T any](slice []T) {
 	for i := range slice {
 		j := rand.Intn(i + 1)
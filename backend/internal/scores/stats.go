@@ -0,0 +1,153 @@
+package scores
+
+import (
+	"cmp"
+	"iter"
+	"maps"
+	"slices"
+
+	"github.com/climblive/platform/backend/internal/domain"
+)
+
+type problemStatsKey struct {
+	problemID   domain.ProblemID
+	compClassID domain.CompClassID
+}
+
+type problemStatsCounter struct {
+	stats              domain.ProblemStats
+	attemptsToTop      int
+	eligibleContenders int
+}
+
+func CalculateProblemStats(
+	problems []Problem,
+	contenders iter.Seq[Contender],
+	getTicks func(domain.ContenderID) iter.Seq[Tick],
+	byCompClass bool,
+) []domain.ProblemStats {
+	problemsByID := make(map[domain.ProblemID]Problem)
+	for problem := range slices.Values(problems) {
+		problemsByID[problem.ID] = problem
+	}
+
+	counters := make(map[problemStatsKey]*problemStatsCounter)
+
+	counter := func(problemID domain.ProblemID, compClassID domain.CompClassID) *problemStatsCounter {
+		if !byCompClass {
+			compClassID = 0
+		}
+
+		key := problemStatsKey{problemID: problemID, compClassID: compClassID}
+
+		if c, found := counters[key]; found {
+			return c
+		}
+
+		c := &problemStatsCounter{
+			stats: domain.ProblemStats{
+				ProblemID:   problemID,
+				CompClassID: compClassID,
+			},
+		}
+
+		counters[key] = c
+
+		return c
+	}
+
+	if !byCompClass {
+		for problem := range slices.Values(problems) {
+			counter(problem.ID, 0)
+		}
+	}
+
+	activeContenders := make(map[domain.CompClassID]int)
+
+	for contender := range contenders {
+		if contender.CompClassID == 0 {
+			continue
+		}
+
+		active := false
+
+		for tick := range getTicks(contender.ID) {
+			problem, found := problemsByID[tick.ProblemID]
+			if !found || !problem.AvailableTo(contender.CompClassID) {
+				continue
+			}
+
+			active = true
+
+			c := counter(problem.ID, contender.CompClassID)
+
+			c.stats.Attempts += max(tick.AttemptsTop, tick.AttemptsZone2, tick.AttemptsZone1)
+
+			if tick.Zone1 {
+				c.stats.Zone1s++
+			}
+
+			if tick.Zone2 {
+				c.stats.Zone2s++
+			}
+
+			if tick.Top {
+				c.stats.Tops++
+				c.attemptsToTop += tick.AttemptsTop
+
+				if tick.AttemptsTop == 1 {
+					c.stats.Flashes++
+				}
+			}
+		}
+
+		if active {
+			activeContenders[contender.CompClassID]++
+		}
+	}
+
+	if byCompClass {
+		for compClassID := range activeContenders {
+			for problem := range slices.Values(problems) {
+				if problem.AvailableTo(compClassID) {
+					counter(problem.ID, compClassID)
+				}
+			}
+		}
+	}
+
+	for key, c := range counters {
+		for compClassID, count := range activeContenders {
+			if byCompClass && compClassID != key.compClassID {
+				continue
+			}
+
+			if problemsByID[key.problemID].AvailableTo(compClassID) {
+				c.eligibleContenders += count
+			}
+		}
+	}
+
+	stats := make([]domain.ProblemStats, 0, len(counters))
+
+	for c := range maps.Values(counters) {
+		if c.stats.Tops > 0 {
+			c.stats.AverageAttemptsToTop = float64(c.attemptsToTop) / float64(c.stats.Tops)
+		}
+
+		if c.eligibleContenders > 0 {
+			c.stats.TopPercentage = 100 * float64(c.stats.Tops) / float64(c.eligibleContenders)
+		}
+
+		stats = append(stats, c.stats)
+	}
+
+	slices.SortFunc(stats, func(s1, s2 domain.ProblemStats) int {
+		return cmp.Or(
+			cmp.Compare(s1.ProblemID, s2.ProblemID),
+			cmp.Compare(s1.CompClassID, s2.CompClassID),
+		)
+	})
+
+	return stats
+}
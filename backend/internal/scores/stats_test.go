@@ -0,0 +1,106 @@
+package scores_test
+
+import (
+	"iter"
+	"slices"
+	"testing"
+
+	"github.com/climblive/platform/backend/internal/domain"
+	"github.com/climblive/platform/backend/internal/scores"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCalculateProblemStats(t *testing.T) {
+	problems := []scores.Problem{
+		{ID: 1},
+		{ID: 2, CompClassIDs: []domain.CompClassID{1}},
+	}
+
+	contenders := []scores.Contender{
+		{ID: 1, CompClassID: 1},
+		{ID: 2, CompClassID: 1},
+		{ID: 3, CompClassID: 2},
+		{ID: 4, CompClassID: 2},
+		{ID: 5, CompClassID: 0},
+	}
+
+	ticks := map[domain.ContenderID][]scores.Tick{
+		1: {
+			{ProblemID: 1, Zone1: true, AttemptsZone1: 1, Top: true, AttemptsTop: 1},
+			{ProblemID: 2, Zone1: true, AttemptsZone1: 2, Top: true, AttemptsTop: 4},
+		},
+		2: {
+			{ProblemID: 1, Zone1: true, AttemptsZone1: 1, AttemptsTop: 5},
+		},
+		3: {
+			{ProblemID: 1, Zone1: true, AttemptsZone1: 2, Top: true, AttemptsTop: 3},
+			{ProblemID: 2, Top: true, AttemptsTop: 1},
+		},
+		5: {
+			{ProblemID: 1, Top: true, AttemptsTop: 1},
+		},
+	}
+
+	getTicks := func(contenderID domain.ContenderID) iter.Seq[scores.Tick] {
+		return slices.Values(ticks[contenderID])
+	}
+
+	t.Run("Overall", func(t *testing.T) {
+		stats := scores.CalculateProblemStats(problems, slices.Values(contenders), getTicks, false)
+
+		assert.Equal(t, []domain.ProblemStats{
+			{
+				ProblemID:            1,
+				Attempts:             9,
+				Zone1s:               3,
+				Tops:                 2,
+				Flashes:              1,
+				AverageAttemptsToTop: 2,
+				TopPercentage:        100 * 2.0 / 3.0,
+			},
+			{
+				ProblemID:            2,
+				Attempts:             4,
+				Zone1s:               1,
+				Tops:                 1,
+				AverageAttemptsToTop: 4,
+				TopPercentage:        50,
+			},
+		}, stats)
+	})
+
+	t.Run("ByCompClass", func(t *testing.T) {
+		stats := scores.CalculateProblemStats(problems, slices.Values(contenders), getTicks, true)
+
+		assert.Equal(t, []domain.ProblemStats{
+			{
+				ProblemID:            1,
+				CompClassID:          1,
+				Attempts:             6,
+				Zone1s:               2,
+				Tops:                 1,
+				Flashes:              1,
+				AverageAttemptsToTop: 1,
+				TopPercentage:        50,
+			},
+			{
+				ProblemID:            1,
+				CompClassID:          2,
+				Attempts:             3,
+				Zone1s:               1,
+				Tops:                 1,
+				AverageAttemptsToTop: 3,
+				TopPercentage:        100,
+			},
+			{
+				ProblemID:            2,
+				CompClassID:          1,
+				Attempts:             4,
+				Zone1s:               1,
+				Tops:                 1,
+				AverageAttemptsToTop: 4,
+				TopPercentage:        50,
+			},
+		}, stats)
+	})
+}
@@ -102,6 +102,10 @@ func (s *MemoryStore) GetProblem(problemID domain.ProblemID) (Problem, bool) {
 	return problem, ok
 }
 
+func (s *MemoryStore) GetProblems() iter.Seq[Problem] {
+	return maps.Values(s.problems)
+}
+
 func (s *MemoryStore) SaveProblem(problem Problem) {
 	s.problems[problem.ID] = problem
 }
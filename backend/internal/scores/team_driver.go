@@ -0,0 +1,271 @@
+package scores
+
+import (
+	"context"
+	"log/slog"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/climblive/platform/backend/internal/domain"
+	"github.com/go-errors/errors"
+)
+
+type teamScoreDriverRepository interface {
+	GetContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) (domain.Contest, error)
+	GetTeamsByContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) ([]domain.Team, error)
+	GetContendersByContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) ([]domain.Contender, error)
+	GetPublishedScoresByContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) ([]domain.Score, error)
+}
+
+type TeamScoreDriver struct {
+	logger      *slog.Logger
+	contestID   domain.ContestID
+	eventBroker domain.EventBroker
+	repo        teamScoreDriverRepository
+	ranker      *TeamRanker
+
+	teams           map[domain.TeamID]int
+	memberships     map[domain.ContenderID]domain.TeamID
+	contenderScores map[domain.ContenderID]int
+	publishedScores map[domain.TeamID]domain.TeamScore
+	dirty           bool
+}
+
+func NewTeamScoreDriver(
+	contestID domain.ContestID,
+	eventBroker domain.EventBroker,
+	repo teamScoreDriverRepository,
+) *TeamScoreDriver {
+	logger := slog.New(slog.Default().Handler()).
+		With("contest_id", contestID).
+		With("component", "team_score_driver")
+
+	return &TeamScoreDriver{
+		logger:          logger,
+		contestID:       contestID,
+		eventBroker:     eventBroker,
+		repo:            repo,
+		ranker:          NewTeamRanker(),
+		teams:           make(map[domain.TeamID]int),
+		memberships:     make(map[domain.ContenderID]domain.TeamID),
+		contenderScores: make(map[domain.ContenderID]int),
+		publishedScores: make(map[domain.TeamID]domain.TeamScore),
+		dirty:           false,
+	}
+}
+
+func (d *TeamScoreDriver) Run(ctx context.Context, options ...func(*runOptions)) *sync.WaitGroup {
+	config := &runOptions{}
+	for _, opt := range options {
+		opt(config)
+	}
+
+	wg := new(sync.WaitGroup)
+	ready := make(chan struct{}, 1)
+
+	wg.Add(1)
+
+	go func() {
+		defer func() {
+			if !config.recoverPanics {
+				return
+			}
+
+			if r := recover(); r != nil {
+				d.logger.Error("team score driver panicked", "error", r)
+			}
+		}()
+
+		defer wg.Done()
+
+		d.run(ctx, ready)
+	}()
+
+	<-ready
+
+	return wg
+}
+
+func (d *TeamScoreDriver) run(ctx context.Context, ready chan<- struct{}) {
+	filter := domain.NewEventFilter(
+		d.contestID,
+		0,
+		"[]CONTENDER_SCORE_UPDATED",
+		"CONTENDER_SWITCHED_TEAM",
+		"TEAM_ADDED",
+		"TEAM_UPDATED",
+		"TEAM_DELETED",
+	)
+
+	subscriptionID, eventReader := d.eventBroker.Subscribe(filter, 0)
+	defer d.eventBroker.Unsubscribe(subscriptionID)
+
+	close(ready)
+
+	if err := d.hydrate(ctx); err != nil {
+		d.logger.Error("hydration failed", "error", err)
+
+		return
+	}
+
+	events := eventReader.EventsChan(ctx)
+	ticker := time.Tick(100 * time.Millisecond)
+
+	for {
+		select {
+		case event, open := <-events:
+			if !open {
+				return
+			}
+
+			d.handleEvent(event)
+		case <-ticker:
+			d.publishUpdatedScores()
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (d *TeamScoreDriver) hydrate(ctx context.Context) error {
+	contest, err := d.repo.GetContest(ctx, nil, d.contestID)
+	if err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	teams, err := d.repo.GetTeamsByContest(ctx, nil, d.contestID)
+	if err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	contenders, err := d.repo.GetContendersByContest(ctx, nil, d.contestID)
+	if err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	for team := range slices.Values(teams) {
+		d.teams[team.ID] = team.CountedMembers
+	}
+
+	frozenFrom := contest.ScoreboardFrozenFrom()
+	publishedScores := make(map[domain.ContenderID]int)
+
+	if !frozenFrom.IsZero() {
+		scores, err := d.repo.GetPublishedScoresByContest(ctx, nil, d.contestID)
+		if err != nil {
+			return errors.Wrap(err, 0)
+		}
+
+		for score := range slices.Values(scores) {
+			publishedScores[score.ContenderID] = score.Score
+		}
+	}
+
+	for contender := range slices.Values(contenders) {
+		if contender.TeamID != 0 {
+			d.memberships[contender.ID] = contender.TeamID
+		}
+
+		if contender.Score == nil {
+			continue
+		}
+
+		if !frozenFrom.IsZero() && !contender.Score.Timestamp.Before(frozenFrom) {
+			if score, found := publishedScores[contender.ID]; found {
+				d.contenderScores[contender.ID] = score
+			}
+
+			continue
+		}
+
+		d.contenderScores[contender.ID] = contender.Score.Score
+	}
+
+	d.dirty = true
+
+	return nil
+}
+
+func (d *TeamScoreDriver) handleEvent(event domain.EventEnvelope) {
+	switch ev := event.Data.(type) {
+	case []domain.ContenderScoreUpdatedEvent:
+		for score := range slices.Values(ev) {
+			d.contenderScores[score.ContenderID] = score.Score
+		}
+	case domain.ContenderSwitchedTeamEvent:
+		if ev.TeamID == 0 {
+			delete(d.memberships, ev.ContenderID)
+		} else {
+			d.memberships[ev.ContenderID] = ev.TeamID
+		}
+	case domain.TeamAddedEvent:
+		d.teams[ev.TeamID] = ev.CountedMembers
+	case domain.TeamUpdatedEvent:
+		d.teams[ev.TeamID] = ev.CountedMembers
+	case domain.TeamDeletedEvent:
+		delete(d.teams, ev.TeamID)
+		delete(d.publishedScores, ev.TeamID)
+	default:
+		return
+	}
+
+	d.dirty = true
+}
+
+func (d *TeamScoreDriver) publishUpdatedScores() {
+	if !d.dirty {
+		return
+	}
+
+	d.dirty = false
+
+	teams := make(map[domain.TeamID]*Team)
+
+	for teamID, countedMembers := range d.teams {
+		teams[teamID] = &Team{
+			ID:             teamID,
+			CountedMembers: countedMembers,
+			MemberScores:   nil,
+		}
+	}
+
+	for contenderID, teamID := range d.memberships {
+		team, found := teams[teamID]
+		if !found {
+			continue
+		}
+
+		team.MemberScores = append(team.MemberScores, d.contenderScores[contenderID])
+	}
+
+	teamValues := func(yield func(Team) bool) {
+		for team := range maps.Values(teams) {
+			if !yield(*team) {
+				return
+			}
+		}
+	}
+
+	var batch []domain.TeamScoreUpdatedEvent
+
+	for score := range slices.Values(d.ranker.RankTeams(teamValues)) {
+		published, found := d.publishedScores[score.TeamID]
+		if found && published.Score == score.Score && published.Placement == score.Placement && published.RankOrder == score.RankOrder {
+			continue
+		}
+
+		d.publishedScores[score.TeamID] = score
+
+		event := domain.TeamScoreUpdatedEvent(score)
+
+		d.eventBroker.Dispatch(context.Background(), d.contestID, event)
+
+		batch = append(batch, event)
+	}
+
+	if len(batch) > 0 {
+		d.eventBroker.Dispatch(context.Background(), d.contestID, batch)
+	}
+}
@@ -0,0 +1,222 @@
+package scores_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/climblive/platform/backend/internal/domain"
+	"github.com/climblive/platform/backend/internal/events"
+	"github.com/climblive/platform/backend/internal/scores"
+	"github.com/climblive/platform/backend/internal/testutils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTeamScoreDriver(t *testing.T) {
+	fakedContestID := testutils.RandomResourceID[domain.ContestID]()
+	fakedTeamID := testutils.RandomResourceID[domain.TeamID]()
+
+	filter := domain.NewEventFilter(
+		fakedContestID,
+		0,
+		"[]CONTENDER_SCORE_UPDATED",
+		"CONTENDER_SWITCHED_TEAM",
+		"TEAM_ADDED",
+		"TEAM_UPDATED",
+		"TEAM_DELETED",
+	)
+
+	t.Run("PublishTeamScores", func(t *testing.T) {
+		mockedRepo := new(repositoryMock)
+		mockedEventBroker := new(eventBrokerMock)
+
+		subscription := events.NewSubscription(domain.EventFilter{}, 0)
+		subscriptionID := uuid.New()
+
+		now := time.Now()
+
+		mockedRepo.
+			On("GetContest", mock.Anything, nil, fakedContestID).
+			Return(domain.Contest{ID: fakedContestID}, nil)
+
+		mockedRepo.
+			On("GetTeamsByContest", mock.Anything, nil, fakedContestID).
+			Return([]domain.Team{{ID: fakedTeamID, ContestID: fakedContestID, CountedMembers: 2}}, nil)
+
+		mockedRepo.
+			On("GetContendersByContest", mock.Anything, nil, fakedContestID).
+			Return([]domain.Contender{
+				{ID: 1, TeamID: fakedTeamID, Score: &domain.Score{Timestamp: now, ContenderID: 1, Score: 100}},
+				{ID: 2, TeamID: fakedTeamID, Score: &domain.Score{Timestamp: now, ContenderID: 2, Score: 200}},
+				{ID: 3, Score: &domain.Score{Timestamp: now, ContenderID: 3, Score: 1000}},
+			}, nil)
+
+		mockedEventBroker.On("Subscribe", filter, 0).Return(subscriptionID, subscription)
+		mockedEventBroker.On("Unsubscribe", subscriptionID).Return()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		hydrated := make(chan struct{})
+
+		mockedEventBroker.
+			On("Dispatch", mock.Anything, fakedContestID, mock.MatchedBy(func(event domain.TeamScoreUpdatedEvent) bool {
+				return event.TeamID == fakedTeamID && event.Score == 300 && event.Placement == 1
+			})).
+			Return().
+			Once()
+
+		mockedEventBroker.
+			On("Dispatch", mock.Anything, fakedContestID, mock.AnythingOfType("[]domain.TeamScoreUpdatedEvent")).
+			Run(func(args mock.Arguments) { close(hydrated) }).
+			Return().
+			Once()
+
+		mockedEventBroker.
+			On("Dispatch", mock.Anything, fakedContestID, mock.MatchedBy(func(event domain.TeamScoreUpdatedEvent) bool {
+				return event.TeamID == fakedTeamID && event.Score == 1200 && event.Placement == 1
+			})).
+			Return().
+			Once()
+
+		mockedEventBroker.
+			On("Dispatch", mock.Anything, fakedContestID, mock.AnythingOfType("[]domain.TeamScoreUpdatedEvent")).
+			Run(func(args mock.Arguments) { cancel() }).
+			Return().
+			Once()
+
+		driver := scores.NewTeamScoreDriver(fakedContestID, mockedEventBroker, mockedRepo)
+
+		wg := driver.Run(ctx)
+
+		<-hydrated
+
+		err := subscription.Post(domain.EventEnvelope{
+			Data: domain.ContenderSwitchedTeamEvent{ContenderID: 3, TeamID: fakedTeamID},
+		})
+		require.NoError(t, err)
+
+		wg.Wait()
+
+		mockedRepo.AssertExpectations(t)
+		mockedEventBroker.AssertExpectations(t)
+	})
+
+	t.Run("WithholdFrozenScoresOnHydration", func(t *testing.T) {
+		mockedRepo := new(repositoryMock)
+		mockedEventBroker := new(eventBrokerMock)
+
+		subscription := events.NewSubscription(domain.EventFilter{}, 0)
+		subscriptionID := uuid.New()
+
+		now := time.Now()
+
+		mockedRepo.
+			On("GetContest", mock.Anything, nil, fakedContestID).
+			Return(domain.Contest{
+				ID:               fakedContestID,
+				TimeEnd:          now.Add(time.Minute),
+				ScoreboardFreeze: time.Hour,
+			}, nil)
+
+		mockedRepo.
+			On("GetTeamsByContest", mock.Anything, nil, fakedContestID).
+			Return([]domain.Team{{ID: fakedTeamID, ContestID: fakedContestID, CountedMembers: 1}}, nil)
+
+		mockedRepo.
+			On("GetContendersByContest", mock.Anything, nil, fakedContestID).
+			Return([]domain.Contender{
+				{ID: 1, TeamID: fakedTeamID, Score: &domain.Score{Timestamp: now, ContenderID: 1, Score: 100}},
+			}, nil)
+
+		mockedRepo.
+			On("GetPublishedScoresByContest", mock.Anything, nil, fakedContestID).
+			Return([]domain.Score{}, nil)
+
+		mockedEventBroker.On("Subscribe", filter, 0).Return(subscriptionID, subscription)
+		mockedEventBroker.On("Unsubscribe", subscriptionID).Return()
+
+		ctx, cancel := context.WithCancel(context.Background())
+
+		mockedEventBroker.
+			On("Dispatch", mock.Anything, fakedContestID, mock.MatchedBy(func(event domain.TeamScoreUpdatedEvent) bool {
+				return event.TeamID == fakedTeamID && event.Score == 0
+			})).
+			Return().
+			Once()
+
+		mockedEventBroker.
+			On("Dispatch", mock.Anything, fakedContestID, mock.AnythingOfType("[]domain.TeamScoreUpdatedEvent")).
+			Run(func(args mock.Arguments) { cancel() }).
+			Return().
+			Once()
+
+		driver := scores.NewTeamScoreDriver(fakedContestID, mockedEventBroker, mockedRepo)
+
+		wg := driver.Run(ctx)
+
+		wg.Wait()
+
+		mockedRepo.AssertExpectations(t)
+		mockedEventBroker.AssertExpectations(t)
+	})
+
+	t.Run("UsePublishedScoresOnHydration", func(t *testing.T) {
+		mockedRepo := new(repositoryMock)
+		mockedEventBroker := new(eventBrokerMock)
+
+		subscription := events.NewSubscription(domain.EventFilter{}, 0)
+		subscriptionID := uuid.New()
+
+		now := time.Now()
+
+		mockedRepo.
+			On("GetContest", mock.Anything, nil, fakedContestID).
+			Return(domain.Contest{
+				ID:               fakedContestID,
+				TimeEnd:          now.Add(time.Minute),
+				ScoreboardFreeze: time.Hour,
+			}, nil)
+
+		mockedRepo.
+			On("GetTeamsByContest", mock.Anything, nil, fakedContestID).
+			Return([]domain.Team{{ID: fakedTeamID, ContestID: fakedContestID, CountedMembers: 1}}, nil)
+
+		mockedRepo.
+			On("GetContendersByContest", mock.Anything, nil, fakedContestID).
+			Return([]domain.Contender{
+				{ID: 1, TeamID: fakedTeamID, Score: &domain.Score{Timestamp: now, ContenderID: 1, Score: 100}},
+			}, nil)
+
+		mockedRepo.
+			On("GetPublishedScoresByContest", mock.Anything, nil, fakedContestID).
+			Return([]domain.Score{{Timestamp: now.Add(-2 * time.Hour), ContenderID: 1, Score: 60}}, nil)
+
+		mockedEventBroker.On("Subscribe", filter, 0).Return(subscriptionID, subscription)
+		mockedEventBroker.On("Unsubscribe", subscriptionID).Return()
+
+		ctx, cancel := context.WithCancel(context.Background())
+
+		mockedEventBroker.
+			On("Dispatch", mock.Anything, fakedContestID, mock.MatchedBy(func(event domain.TeamScoreUpdatedEvent) bool {
+				return event.TeamID == fakedTeamID && event.Score == 60
+			})).
+			Return().
+			Once()
+
+		mockedEventBroker.
+			On("Dispatch", mock.Anything, fakedContestID, mock.AnythingOfType("[]domain.TeamScoreUpdatedEvent")).
+			Run(func(args mock.Arguments) { cancel() }).
+			Return().
+			Once()
+
+		driver := scores.NewTeamScoreDriver(fakedContestID, mockedEventBroker, mockedRepo)
+
+		wg := driver.Run(ctx)
+
+		wg.Wait()
+
+		mockedRepo.AssertExpectations(t)
+		mockedEventBroker.AssertExpectations(t)
+	})
+}
@@ -1,6 +1,10 @@
 package scores
 
-import "github.com/climblive/platform/backend/internal/domain"
+import (
+	"slices"
+
+	"github.com/climblive/platform/backend/internal/domain"
+)
 
 type Contender struct {
 	ID                  domain.ContenderID
@@ -8,14 +12,46 @@ type Contender struct {
 	Disqualified        bool
 	WithdrawnFromFinals bool
 	Score               int
+	PreviousPlacement   int
 }
 
 func (c Contender) Compare(other Contender) int {
-	if c.Score == other.Score {
+	switch {
+	case c.Score != other.Score:
+		return other.Score - c.Score
+	case c.PreviousPlacement != other.PreviousPlacement:
+		switch {
+		case c.PreviousPlacement == 0:
+			return 1
+		case other.PreviousPlacement == 0:
+			return -1
+		}
+
+		return c.PreviousPlacement - other.PreviousPlacement
+	default:
 		return int(c.ID) - int(other.ID)
 	}
+}
 
-	return other.Score - c.Score
+type Team struct {
+	ID             domain.TeamID
+	CountedMembers int
+	MemberScores   []int
+}
+
+func (t Team) Score() int {
+	memberScores := slices.Clone(t.MemberScores)
+	slices.SortFunc(memberScores, func(s1, s2 int) int {
+		return s2 - s1
+	})
+
+	var score int
+
+	for _, memberScore := range memberScores[:min(t.CountedMembers, len(memberScores))] {
+		score += memberScore
+	}
+
+	return score
 }
 
 type Tick struct {
@@ -27,10 +63,12 @@ type Tick struct {
 	Top           bool
 	AttemptsTop   int
 	Points        int
+	CompClassIDs  []domain.CompClassID
 }
 
 func (t *Tick) Score(problem Problem) {
 	t.Points = 0
+	t.CompClassIDs = problem.CompClassIDs
 
 	if t.Zone1 {
 		t.Points = problem.PointsZone1
@@ -49,10 +87,23 @@ func (t *Tick) Score(problem Problem) {
 	}
 }
 
+func (t Tick) AvailableTo(compClassID domain.CompClassID) bool {
+	return availableTo(t.CompClassIDs, compClassID)
+}
+
 type Problem struct {
-	ID          domain.ProblemID
-	PointsZone1 int
-	PointsZone2 int
-	PointsTop   int
-	FlashBonus  int
+	ID           domain.ProblemID
+	PointsZone1  int
+	PointsZone2  int
+	PointsTop    int
+	FlashBonus   int
+	CompClassIDs []domain.CompClassID
+}
+
+func (p Problem) AvailableTo(compClassID domain.CompClassID) bool {
+	return availableTo(p.CompClassIDs, compClassID)
+}
+
+func availableTo(compClassIDs []domain.CompClassID, compClassID domain.CompClassID) bool {
+	return len(compClassIDs) == 0 || slices.Contains(compClassIDs, compClassID)
 }
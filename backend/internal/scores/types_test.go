@@ -3,6 +3,7 @@ package scores_test
 import (
 	"testing"
 
+	"github.com/climblive/platform/backend/internal/domain"
 	"github.com/climblive/platform/backend/internal/scores"
 	"github.com/stretchr/testify/assert"
 )
@@ -108,6 +109,23 @@ func TestScoreTick(t *testing.T) {
 	})
 }
 
+func TestTickAvailableTo(t *testing.T) {
+	t.Run("Unrestricted", func(t *testing.T) {
+		tick := scores.Tick{}
+		tick.Score(scores.Problem{PointsTop: 100})
+
+		assert.True(t, tick.AvailableTo(1))
+	})
+
+	t.Run("Restricted", func(t *testing.T) {
+		tick := scores.Tick{}
+		tick.Score(scores.Problem{PointsTop: 100, CompClassIDs: []domain.CompClassID{2}})
+
+		assert.False(t, tick.AvailableTo(1))
+		assert.True(t, tick.AvailableTo(2))
+	})
+}
+
 func TestCompareContender(t *testing.T) {
 	t.Run("ByScore", func(t *testing.T) {
 		c1 := scores.Contender{
@@ -136,4 +154,66 @@ func TestCompareContender(t *testing.T) {
 		assert.Less(t, c1.Compare(c2), 0)
 		assert.Greater(t, c2.Compare(c1), 0)
 	})
+
+	t.Run("ByPreviousPlacement", func(t *testing.T) {
+		c1 := scores.Contender{
+			ID:                2,
+			Score:             100,
+			PreviousPlacement: 1,
+		}
+
+		c2 := scores.Contender{
+			ID:                1,
+			Score:             100,
+			PreviousPlacement: 2,
+		}
+
+		assert.Less(t, c1.Compare(c2), 0)
+		assert.Greater(t, c2.Compare(c1), 0)
+	})
+
+	t.Run("UnplacedLast", func(t *testing.T) {
+		c1 := scores.Contender{
+			ID:                2,
+			Score:             100,
+			PreviousPlacement: 5,
+		}
+
+		c2 := scores.Contender{
+			ID:                1,
+			Score:             100,
+			PreviousPlacement: 0,
+		}
+
+		assert.Less(t, c1.Compare(c2), 0)
+		assert.Greater(t, c2.Compare(c1), 0)
+	})
+}
+
+func TestTeamScore(t *testing.T) {
+	t.Run("BestMembersCounted", func(t *testing.T) {
+		team := scores.Team{
+			CountedMembers: 2,
+			MemberScores:   []int{100, 300, 200},
+		}
+
+		assert.Equal(t, 500, team.Score())
+	})
+
+	t.Run("FewerMembersThanCounted", func(t *testing.T) {
+		team := scores.Team{
+			CountedMembers: 5,
+			MemberScores:   []int{100, 200},
+		}
+
+		assert.Equal(t, 300, team.Score())
+	})
+
+	t.Run("NoMembers", func(t *testing.T) {
+		team := scores.Team{
+			CountedMembers: 3,
+		}
+
+		assert.Equal(t, 0, team.Score())
+	})
 }
@@ -2,7 +2,9 @@ package scrubber
 
 import (
 	"context"
+	"fmt"
 	"log/slog"
+	"slices"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -24,14 +26,29 @@ type contenderScrubberUseCase interface {
 	ScrubContenders(ctx context.Context, deadline time.Time) (int, error)
 }
 
+type retentionUseCase interface {
+	ApplyRetentionPolicies(ctx context.Context) ([]domain.RetentionPolicyExecution, error)
+}
+
 type Scrubber struct {
-	useCase  contenderScrubberUseCase
-	interval time.Duration
-	running  atomic.Bool
+	useCase          contenderScrubberUseCase
+	retentionUseCase retentionUseCase
+	interval         time.Duration
+	running          atomic.Bool
+	mu               sync.Mutex
+	retentionStatus  *domain.ServiceStatus
+	policyStatuses   map[domain.RetentionPolicyID]domain.ServiceStatus
 }
 
-func New(useCase contenderScrubberUseCase, interval time.Duration) *Scrubber {
-	return &Scrubber{useCase: useCase, interval: interval, running: atomic.Bool{}}
+func New(useCase contenderScrubberUseCase, retentionUseCase retentionUseCase, interval time.Duration) *Scrubber {
+	return &Scrubber{
+		useCase:          useCase,
+		retentionUseCase: retentionUseCase,
+		interval:         interval,
+		running:          atomic.Bool{},
+		retentionStatus:  nil,
+		policyStatuses:   make(map[domain.RetentionPolicyID]domain.ServiceStatus),
+	}
 }
 
 func (s *Scrubber) Run(ctx context.Context, options ...func(*runOptions)) *sync.WaitGroup {
@@ -77,6 +94,8 @@ func (s *Scrubber) Run(ctx context.Context, options ...func(*runOptions)) *sync.
 				} else if count > 0 {
 					slog.Info("scrubber completed", "count", count)
 				}
+
+				s.applyRetentionPolicies(ctx)
 			}
 		}
 	}()
@@ -84,6 +103,82 @@ func (s *Scrubber) Run(ctx context.Context, options ...func(*runOptions)) *sync.
 	return &wg
 }
 
+func (s *Scrubber) applyRetentionPolicies(ctx context.Context) {
+	executions, err := s.retentionUseCase.ApplyRetentionPolicies(ctx)
+	if err != nil {
+		slog.Error("failed to apply retention policies", "error", err)
+	}
+
+	retentionStatus := domain.ServiceStatus{
+		Name:      "Retention policies",
+		Healthy:   err == nil,
+		CheckedAt: time.Now(),
+	}
+
+	statuses := make(map[domain.RetentionPolicyID]domain.ServiceStatus)
+
+	for _, execution := range executions {
+		if execution.Error != "" {
+			slog.Error("retention policy failed",
+				"policy_id", execution.PolicyID,
+				"error", execution.Error)
+		} else if execution.Contests > 0 {
+			slog.Info("retention policy applied",
+				"policy_id", execution.PolicyID,
+				"contests", execution.Contests,
+				"contenders", execution.Contenders,
+				"ticks", execution.Ticks)
+		}
+
+		statuses[execution.PolicyID] = domain.ServiceStatus{
+			Name:      fmt.Sprintf("Retention policy %d", execution.PolicyID),
+			Healthy:   execution.Error == "",
+			CheckedAt: execution.Timestamp,
+		}
+
+		if execution.Error != "" {
+			retentionStatus.Healthy = false
+		}
+	}
+
+	s.mu.Lock()
+	s.retentionStatus = &retentionStatus
+	s.policyStatuses = statuses
+	s.mu.Unlock()
+}
+
 func (s *Scrubber) GetStatus() domain.ServiceStatus {
 	return domain.ServiceStatus{Name: "Scrubber", Healthy: s.running.Load(), CheckedAt: time.Now()}
 }
+
+func (s *Scrubber) GetStatuses() []domain.ServiceStatus {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	statuses := []domain.ServiceStatus{s.GetStatus()}
+
+	if s.retentionStatus != nil {
+		statuses = append(statuses, *s.retentionStatus)
+	}
+
+	return statuses
+}
+
+func (s *Scrubber) GetRetentionPolicyStatuses() []domain.ServiceStatus {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	policyIDs := make([]domain.RetentionPolicyID, 0, len(s.policyStatuses))
+	for policyID := range s.policyStatuses {
+		policyIDs = append(policyIDs, policyID)
+	}
+
+	slices.Sort(policyIDs)
+
+	statuses := make([]domain.ServiceStatus, 0, len(policyIDs))
+	for _, policyID := range policyIDs {
+		statuses = append(statuses, s.policyStatuses[policyID])
+	}
+
+	return statuses
+}
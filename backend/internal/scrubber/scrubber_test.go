@@ -6,7 +6,9 @@ import (
 	"testing/synctest"
 	"time"
 
+	"github.com/climblive/platform/backend/internal/domain"
 	"github.com/climblive/platform/backend/internal/scrubber"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
 
@@ -19,10 +21,20 @@ func (m *contenderScrubberMock) ScrubContenders(ctx context.Context, deadline ti
 	return args.Int(0), args.Error(1)
 }
 
+type retentionMock struct {
+	mock.Mock
+}
+
+func (m *retentionMock) ApplyRetentionPolicies(ctx context.Context) ([]domain.RetentionPolicyExecution, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]domain.RetentionPolicyExecution), args.Error(1)
+}
+
 func TestScrubber(t *testing.T) {
 	t.Run("RunsOncePerInterval", func(t *testing.T) {
 		synctest.Test(t, func(t *testing.T) {
 			mockedScrubber := new(contenderScrubberMock)
+			mockedRetention := new(retentionMock)
 
 			interval := time.Hour
 
@@ -35,7 +47,10 @@ func TestScrubber(t *testing.T) {
 			mockedScrubber.On("ScrubContenders", mock.Anything, start.Add(4*interval)).
 				Return(3, nil).Once()
 
-			scrubber := scrubber.New(mockedScrubber, interval)
+			mockedRetention.On("ApplyRetentionPolicies", mock.Anything).
+				Return([]domain.RetentionPolicyExecution{}, nil).Times(3)
+
+			scrubber := scrubber.New(mockedScrubber, mockedRetention, interval)
 			ctx, cancel := context.WithCancel(context.Background())
 
 			wg := scrubber.Run(ctx)
@@ -50,6 +65,60 @@ func TestScrubber(t *testing.T) {
 			wg.Wait()
 
 			mockedScrubber.AssertExpectations(t)
+			mockedRetention.AssertExpectations(t)
 		})
 	})
+
+	t.Run("ReportsRetentionPolicyStatuses", func(t *testing.T) {
+		synctest.Test(t, func(t *testing.T) {
+			mockedScrubber := new(contenderScrubberMock)
+			mockedRetention := new(retentionMock)
+
+			interval := time.Hour
+
+			mockedScrubber.On("ScrubContenders", mock.Anything, mock.Anything).
+				Return(0, nil)
+
+			mockedRetention.On("ApplyRetentionPolicies", mock.Anything).
+				Return([]domain.RetentionPolicyExecution{
+					{PolicyID: 2, Timestamp: time.Now().Add(interval), Error: "boom"},
+					{PolicyID: 1, Timestamp: time.Now().Add(interval), Contests: 1},
+				}, nil).Once()
+
+			scrubber := scrubber.New(mockedScrubber, mockedRetention, interval)
+			ctx, cancel := context.WithCancel(context.Background())
+
+			wg := scrubber.Run(ctx)
+
+			time.Sleep(interval)
+			synctest.Wait()
+
+			statuses := scrubber.GetStatuses()
+			policyStatuses := scrubber.GetRetentionPolicyStatuses()
+
+			cancel()
+			wg.Wait()
+
+			assert.Equal(t, []domain.ServiceStatus{
+				{Name: "Scrubber", Healthy: true, CheckedAt: time.Now()},
+				{Name: "Retention policies", Healthy: false, CheckedAt: time.Now()},
+			}, statuses)
+
+			assert.Equal(t, []domain.ServiceStatus{
+				{Name: "Retention policy 1", Healthy: true, CheckedAt: time.Now()},
+				{Name: "Retention policy 2", Healthy: false, CheckedAt: time.Now()},
+			}, policyStatuses)
+
+			mockedRetention.AssertExpectations(t)
+		})
+	})
+	t.Run("NoRetentionStatusBeforeFirstRun", func(t *testing.T) {
+		scrubber := scrubber.New(new(contenderScrubberMock), new(retentionMock), time.Hour)
+
+		statuses := scrubber.GetStatuses()
+
+		assert.Len(t, statuses, 1)
+		assert.Equal(t, "Scrubber", statuses[0].Name)
+		assert.Empty(t, scrubber.GetRetentionPolicyStatuses())
+	})
 }
@@ -1,7 +1,10 @@
 package usecases
 
 import (
+	"context"
+
 	"github.com/climblive/platform/backend/internal/domain"
+	"github.com/go-errors/errors"
 )
 
 func withScore(contender domain.Contender, scoreKeeper domain.ScoreKeeper) domain.Contender {
@@ -12,3 +15,100 @@ func withScore(contender domain.Contender, scoreKeeper domain.ScoreKeeper) domai
 
 	return contender
 }
+
+func lookAhead(page domain.PageRequest) domain.PageRequest {
+	if page.Limit > 0 {
+		page.Limit = min(page.Limit, domain.MaxPageSize) + 1
+	}
+
+	return page
+}
+
+func toPage[T any](items []T, page domain.PageRequest, id func(T) domain.ResourceID) domain.Page[T] {
+	limit := min(page.Limit, domain.MaxPageSize)
+
+	if page.Limit <= 0 || len(items) <= limit {
+		return domain.Page[T]{Items: items, Next: 0}
+	}
+
+	items = items[:limit]
+
+	return domain.Page[T]{Items: items, Next: id(items[limit-1])}
+}
+
+func checkVersion(expectedVersion, version int) error {
+	if expectedVersion != 0 && expectedVersion != version {
+		return errors.Wrap(domain.ErrPreconditionFailed, 0)
+	}
+
+	return nil
+}
+
+func inTransaction[T any](
+	ctx context.Context,
+	transactor domain.Transactor,
+	fn func(ctx context.Context, tx domain.Transaction) (T, error),
+) (T, error) {
+	var mty T
+
+	tx, err := transactor.Begin()
+	if err != nil {
+		return mty, errors.Wrap(err, 0)
+	}
+
+	result, err := fn(ctx, tx)
+	if err != nil {
+		tx.Rollback()
+		return mty, errors.Wrap(err, 0)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return mty, errors.Wrap(err, 0)
+	}
+
+	return result, nil
+}
+
+func storeVersioned[T any](
+	ctx context.Context,
+	transactor domain.Transactor,
+	expectedVersion int,
+	lockVersion func(ctx context.Context, tx domain.Transaction) (int, error),
+	store func(ctx context.Context, tx domain.Transaction) (T, error),
+) (T, error) {
+	var mty T
+
+	if expectedVersion == 0 {
+		return store(ctx, nil)
+	}
+
+	tx, err := transactor.Begin()
+	if err != nil {
+		return mty, errors.Wrap(err, 0)
+	}
+
+	stored, err := func() (T, error) {
+		version, err := lockVersion(ctx, tx)
+		if err != nil {
+			return mty, err
+		}
+
+		if err := checkVersion(expectedVersion, version); err != nil {
+			return mty, err
+		}
+
+		return store(ctx, tx)
+	}()
+	if err != nil {
+		tx.Rollback()
+		return mty, errors.Wrap(err, 0)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return mty, errors.Wrap(err, 0)
+	}
+
+	return stored, nil
+}
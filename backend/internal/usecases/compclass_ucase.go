@@ -15,15 +15,19 @@ type compClassUseCaseRepository interface {
 
 	GetCompClassesByContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) ([]domain.CompClass, error)
 	GetContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) (domain.Contest, error)
+	GetContestVersionForUpdate(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) (int, error)
 	StoreCompClass(ctx context.Context, tx domain.Transaction, compClass domain.CompClass) (domain.CompClass, error)
 	DeleteCompClass(ctx context.Context, tx domain.Transaction, compClassID domain.CompClassID) error
 	GetCompClass(ctx context.Context, tx domain.Transaction, compClassID domain.CompClassID) (domain.CompClass, error)
 	GetContendersByCompClass(ctx context.Context, tx domain.Transaction, compClassID domain.CompClassID) ([]domain.Contender, error)
+	GetWaitlistedContenders(ctx context.Context, tx domain.Transaction, compClassID domain.CompClassID) ([]domain.Contender, error)
+	StoreContender(ctx context.Context, tx domain.Transaction, contender domain.Contender) (domain.Contender, error)
 }
 
 type CompClassUseCase struct {
-	Repo       compClassUseCaseRepository
-	Authorizer domain.Authorizer
+	Repo        compClassUseCaseRepository
+	Authorizer  domain.Authorizer
+	EventBroker domain.EventBroker
 }
 
 func (uc *CompClassUseCase) GetCompClass(ctx context.Context, compClassID domain.CompClassID) (domain.CompClass, error) {
@@ -72,6 +76,7 @@ func (uc *CompClassUseCase) CreateCompClass(ctx context.Context, contestID domai
 		Description: tmpl.Description,
 		TimeBegin:   tmpl.TimeBegin,
 		TimeEnd:     tmpl.TimeEnd,
+		Capacity:    tmpl.Capacity,
 	}
 
 	if err := (validators.CompClassValidator{}).Validate(compClass); err != nil {
@@ -105,6 +110,15 @@ func (uc *CompClassUseCase) DeleteCompClass(ctx context.Context, compClassID dom
 		return errors.Wrap(domain.ErrNotAllowed, 0)
 	}
 
+	waitlist, err := uc.Repo.GetWaitlistedContenders(ctx, nil, compClassID)
+	if err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	if len(waitlist) > 0 {
+		return errors.Wrap(domain.ErrNotAllowed, 0)
+	}
+
 	err = uc.Repo.DeleteCompClass(ctx, nil, compClassID)
 	if err != nil {
 		return errors.Wrap(err, 0)
@@ -139,6 +153,12 @@ func (uc *CompClassUseCase) PatchCompClass(ctx context.Context, compClassID doma
 		compClass.TimeEnd = patch.TimeEnd.Value
 	}
 
+	previousCapacity := compClass.Capacity
+
+	if patch.Capacity.Present {
+		compClass.Capacity = patch.Capacity.Value
+	}
+
 	if err := (validators.CompClassValidator{}).Validate(compClass); err != nil {
 		return domain.CompClass{}, errors.Wrap(err, 0)
 	}
@@ -147,5 +167,16 @@ func (uc *CompClassUseCase) PatchCompClass(ctx context.Context, compClassID doma
 		return domain.CompClass{}, errors.Wrap(err, 0)
 	}
 
+	if previousCapacity > 0 && (compClass.Capacity == 0 || compClass.Capacity > previousCapacity) {
+		contest, err := uc.Repo.GetContest(ctx, nil, compClass.ContestID)
+		if err != nil {
+			return domain.CompClass{}, errors.Wrap(err, 0)
+		}
+
+		if err := promoteWaitlisted(ctx, uc.Repo, uc.EventBroker, contest, compClassID); err != nil {
+			return domain.CompClass{}, errors.Wrap(err, 0)
+		}
+	}
+
 	return compClass, nil
 }
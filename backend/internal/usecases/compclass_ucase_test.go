@@ -3,6 +3,7 @@ package usecases_test
 import (
 	"context"
 	"testing"
+	"testing/synctest"
 	"time"
 
 	"github.com/climblive/platform/backend/internal/domain"
@@ -263,6 +264,10 @@ func TestDeleteCompClass(t *testing.T) {
 			On("GetContendersByCompClass", mock.Anything, nil, fakedCompClassID).
 			Return([]domain.Contender{}, nil)
 
+		mockedRepo.
+			On("GetWaitlistedContenders", mock.Anything, nil, fakedCompClassID).
+			Return([]domain.Contender{}, nil)
+
 		mockedRepo.
 			On("DeleteCompClass", mock.Anything, nil, fakedCompClassID).
 			Return(nil)
@@ -304,6 +309,34 @@ func TestDeleteCompClass(t *testing.T) {
 		mockedAuthorizer.AssertExpectations(t)
 	})
 
+	t.Run("CompClassHasWaitlist", func(t *testing.T) {
+		mockedRepo, mockedAuthorizer := makeMocks()
+
+		mockedAuthorizer.
+			On("HasOwnership", mock.Anything, fakedOwnership).
+			Return(domain.OrganizerRole, nil)
+
+		mockedRepo.
+			On("GetContendersByCompClass", mock.Anything, nil, fakedCompClassID).
+			Return([]domain.Contender{}, nil)
+
+		mockedRepo.
+			On("GetWaitlistedContenders", mock.Anything, nil, fakedCompClassID).
+			Return([]domain.Contender{{}}, nil)
+
+		ucase := usecases.CompClassUseCase{
+			Repo:       mockedRepo,
+			Authorizer: mockedAuthorizer,
+		}
+
+		err := ucase.DeleteCompClass(context.Background(), fakedCompClassID)
+
+		assert.ErrorIs(t, err, domain.ErrNotAllowed)
+
+		mockedRepo.AssertExpectations(t)
+		mockedAuthorizer.AssertExpectations(t)
+	})
+
 	t.Run("BadCredentials", func(t *testing.T) {
 		mockedRepo, mockedAuthorizer := makeMocks()
 
@@ -401,6 +434,125 @@ func TestPatchCompClass(t *testing.T) {
 		mockedAuthorizer.AssertExpectations(t)
 	})
 
+	t.Run("PromoteWaitlistedOnCapacityIncrease", func(t *testing.T) {
+		synctest.Test(t, func(t *testing.T) {
+			fakedContestID := testutils.RandomResourceID[domain.ContestID]()
+			fakedContenderID := testutils.RandomResourceID[domain.ContenderID]()
+
+			now := time.Now()
+
+			fakedCompClass := domain.CompClass{
+				ID:        fakedCompClassID,
+				Ownership: fakedOwnership,
+				ContestID: fakedContestID,
+				Name:      "Females",
+				TimeBegin: now,
+				TimeEnd:   now.Add(time.Hour),
+				Capacity:  1,
+			}
+
+			mockedRepo := new(repositoryMock)
+			mockedTx := new(transactionMock)
+			mockedAuthorizer := new(authorizerMock)
+			mockedEventBroker := new(eventBrokerMock)
+
+			mockedAuthorizer.
+				On("HasOwnership", mock.Anything, fakedOwnership).
+				Return(domain.OrganizerRole, nil)
+
+			mockedRepo.
+				On("GetCompClass", mock.Anything, nil, fakedCompClassID).
+				Return(fakedCompClass, nil)
+
+			increased := fakedCompClass
+			increased.Capacity = 2
+
+			mockedRepo.
+				On("StoreCompClass", mock.Anything, nil, increased).
+				Return(increased, nil)
+
+			mockedRepo.
+				On("GetContest", mock.Anything, nil, fakedContestID).
+				Return(domain.Contest{ID: fakedContestID}, nil)
+
+			mockedRepo.On("Begin").Return(mockedTx, nil)
+
+			mockedRepo.
+				On("GetContestVersionForUpdate", mock.Anything, mockedTx, fakedContestID).
+				Return(0, nil)
+
+			mockedRepo.
+				On("GetCompClass", mock.Anything, mockedTx, fakedCompClassID).
+				Return(increased, nil)
+
+			mockedRepo.
+				On("GetWaitlistedContenders", mock.Anything, mockedTx, fakedCompClassID).
+				Return([]domain.Contender{
+					{
+						ID:                  fakedContenderID,
+						ContestID:           fakedContestID,
+						WaitlistCompClassID: fakedCompClassID,
+						WaitlistedAt:        now.Add(-1 * time.Minute),
+					},
+				}, nil)
+
+			mockedRepo.
+				On("GetContendersByCompClass", mock.Anything, mockedTx, fakedCompClassID).
+				Return([]domain.Contender{{}}, nil)
+
+			mockedRepo.
+				On("StoreContender", mock.Anything, mockedTx, domain.Contender{
+					ID:          fakedContenderID,
+					ContestID:   fakedContestID,
+					CompClassID: fakedCompClassID,
+					Entered:     now,
+					ScrubBefore: now.Add(time.Hour),
+				}).
+				Return(domain.Contender{}, nil)
+
+			mockedTx.On("Commit").Return(nil)
+
+			mockedEventBroker.
+				On("Dispatch", mock.Anything, fakedContestID, domain.ContenderEnteredEvent{
+					ContenderID: fakedContenderID,
+					CompClassID: fakedCompClassID,
+				}).
+				Return()
+
+			mockedEventBroker.
+				On("Dispatch", mock.Anything, fakedContestID, domain.ContenderPublicInfoUpdatedEvent{
+					ContenderID: fakedContenderID,
+					CompClassID: fakedCompClassID,
+				}).
+				Return()
+
+			mockedEventBroker.
+				On("Dispatch", mock.Anything, fakedContestID, domain.ContenderPromotedFromWaitlistEvent{
+					ContenderID: fakedContenderID,
+					CompClassID: fakedCompClassID,
+				}).
+				Return()
+
+			ucase := usecases.CompClassUseCase{
+				Repo:        mockedRepo,
+				Authorizer:  mockedAuthorizer,
+				EventBroker: mockedEventBroker,
+			}
+
+			compClass, err := ucase.PatchCompClass(context.Background(), fakedCompClassID, domain.CompClassPatch{
+				Capacity: domain.NewPatch(2),
+			})
+
+			require.NoError(t, err)
+			assert.Equal(t, 2, compClass.Capacity)
+
+			mockedRepo.AssertExpectations(t)
+			mockedTx.AssertExpectations(t)
+			mockedAuthorizer.AssertExpectations(t)
+			mockedEventBroker.AssertExpectations(t)
+		})
+	})
+
 	t.Run("BadCredentials", func(t *testing.T) {
 		mockedRepo, mockedAuthorizer := makeMocks()
 
@@ -13,15 +13,25 @@ type contenderUseCaseRepository interface {
 	domain.Transactor
 
 	GetContender(ctx context.Context, tx domain.Transaction, contenderID domain.ContenderID) (domain.Contender, error)
+	GetContenderVersionForUpdate(ctx context.Context, tx domain.Transaction, contenderID domain.ContenderID) (int, error)
 	GetContenderByCode(ctx context.Context, tx domain.Transaction, registrationCode string) (domain.Contender, error)
 	GetContendersByCompClass(ctx context.Context, tx domain.Transaction, compClassID domain.CompClassID) ([]domain.Contender, error)
+	GetWaitlistedContenders(ctx context.Context, tx domain.Transaction, compClassID domain.CompClassID) ([]domain.Contender, error)
 	GetContendersByContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) ([]domain.Contender, error)
+	GetContendersByContestFiltered(ctx context.Context, tx domain.Transaction, contestID domain.ContestID, filter domain.ContenderFilter, page domain.PageRequest) ([]domain.Contender, error)
 	StoreContender(ctx context.Context, tx domain.Transaction, contender domain.Contender) (domain.Contender, error)
 	DeleteContender(ctx context.Context, tx domain.Transaction, contenderID domain.ContenderID) error
 	GetContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) (domain.Contest, error)
+	GetContestVersionForUpdate(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) (int, error)
 	GetCompClass(ctx context.Context, tx domain.Transaction, compClassID domain.CompClassID) (domain.CompClass, error)
+	GetTeam(ctx context.Context, tx domain.Transaction, teamID domain.TeamID) (domain.Team, error)
 	GetNumberOfContenders(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) (int, error)
 	GetScrubEligibleContenders(ctx context.Context, deadline time.Time) ([]domain.Contender, error)
+	GetTicksByContender(ctx context.Context, tx domain.Transaction, contenderID domain.ContenderID) ([]domain.Tick, error)
+	GetTickRevisionsByContender(ctx context.Context, tx domain.Transaction, contenderID domain.ContenderID) ([]domain.TickRevision, error)
+	GetTickDisputesByContender(ctx context.Context, tx domain.Transaction, contenderID domain.ContenderID) ([]domain.TickDispute, error)
+	GetRaffleWinnersByContender(ctx context.Context, tx domain.Transaction, contenderID domain.ContenderID) ([]domain.RaffleWinner, error)
+	StoreAuditEntry(ctx context.Context, tx domain.Transaction, entry domain.AuditEntry) (domain.AuditEntry, error)
 }
 
 type ContenderUseCase struct {
@@ -76,31 +86,35 @@ func (uc *ContenderUseCase) GetContendersByCompClass(ctx context.Context, compCl
 	return contenders, nil
 }
 
-func (uc *ContenderUseCase) GetContendersByContest(ctx context.Context, contestID domain.ContestID) ([]domain.Contender, error) {
+func (uc *ContenderUseCase) GetContendersByContest(ctx context.Context, contestID domain.ContestID, filter domain.ContenderFilter, page domain.PageRequest) (domain.Page[domain.Contender], error) {
 	contest, err := uc.Repo.GetContest(ctx, nil, contestID)
 	if err != nil {
-		return nil, errors.Wrap(err, 0)
+		return domain.Page[domain.Contender]{}, errors.Wrap(err, 0)
 	}
 
 	if _, err := uc.Authorizer.HasOwnership(ctx, contest.Ownership); err != nil {
-		return nil, errors.Wrap(err, 0)
+		return domain.Page[domain.Contender]{}, errors.Wrap(err, 0)
 	}
 
-	contenders, err := uc.Repo.GetContendersByContest(ctx, nil, contestID)
+	contenders, err := uc.Repo.GetContendersByContestFiltered(ctx, nil, contestID, filter, lookAhead(page))
 	if err != nil {
-		return nil, errors.Wrap(err, 0)
+		return domain.Page[domain.Contender]{}, errors.Wrap(err, 0)
 	}
 
 	for i, contender := range contenders {
 		contenders[i] = withScore(contender, uc.ScoreKeeper)
 	}
 
-	return contenders, nil
+	return toPage(contenders, page, func(contender domain.Contender) domain.ResourceID {
+		return domain.ResourceID(contender.ID)
+	}), nil
 }
 
-func (uc *ContenderUseCase) PatchContender(ctx context.Context, contenderID domain.ContenderID, patch domain.ContenderPatch) (domain.Contender, error) {
+func (uc *ContenderUseCase) PatchContender(ctx context.Context, contenderID domain.ContenderID, patch domain.ContenderPatch, expectedVersion int) (domain.Contender, error) {
 	var mty domain.Contender
 	var events []any
+	var vacatedCompClassID domain.CompClassID
+	var targetCompClass domain.CompClass
 
 	contender, err := uc.Repo.GetContender(ctx, nil, contenderID)
 	if err != nil {
@@ -112,9 +126,14 @@ func (uc *ContenderUseCase) PatchContender(ctx context.Context, contenderID doma
 		return mty, errors.Wrap(err, 0)
 	}
 
+	if err := checkVersion(expectedVersion, contender.Version); err != nil {
+		return mty, err
+	}
+
 	publicInfoEvent := domain.ContenderPublicInfoUpdatedEvent{
 		ContenderID:         contenderID,
 		CompClassID:         contender.CompClassID,
+		TeamID:              contender.TeamID,
 		Name:                contender.Name,
 		WithdrawnFromFinals: contender.WithdrawnFromFinals,
 		Disqualified:        contender.Disqualified,
@@ -153,18 +172,6 @@ func (uc *ContenderUseCase) PatchContender(ctx context.Context, contenderID doma
 			return mty, errors.Wrap(err, 0)
 		}
 
-		if contender.CompClassID == 0 {
-			events = append(events, domain.ContenderEnteredEvent{
-				ContenderID: contenderID,
-				CompClassID: patch.CompClassID.Value,
-			})
-		} else {
-			events = append(events, domain.ContenderSwitchedClassEvent{
-				ContenderID: contenderID,
-				CompClassID: patch.CompClassID.Value,
-			})
-		}
-
 		gracePeriodEnd := compClass.TimeEnd.Add(contest.GracePeriod)
 
 		switch {
@@ -174,15 +181,10 @@ func (uc *ContenderUseCase) PatchContender(ctx context.Context, contenderID doma
 			return mty, errors.Wrap(domain.ErrContestEnded, 0)
 		}
 
-		contender.CompClassID = patch.CompClassID.Value
-
-		if contender.Entered.IsZero() {
-			contender.Entered = time.Now()
-			contender.ScrubBefore = compClass.TimeEnd.Add(contest.NameRetentionTime)
-		}
+		targetCompClass = compClass
 	}
 
-	if contender.CompClassID == 0 {
+	if contender.CompClassID == 0 && contender.WaitlistCompClassID == 0 && targetCompClass.ID == 0 {
 		return mty, errors.New(domain.ErrNotRegistered)
 	}
 
@@ -230,7 +232,72 @@ func (uc *ContenderUseCase) PatchContender(ctx context.Context, contenderID doma
 		contender.Disqualified = patch.Disqualified.Value
 	}
 
+	if patch.TeamID.Present && contender.TeamID != patch.TeamID.Value {
+		if !role.OneOf(domain.AdminRole, domain.OrganizerRole) {
+			return mty, errors.Wrap(domain.ErrInsufficientRole, 0)
+		}
+
+		if patch.TeamID.Value != 0 {
+			team, err := uc.Repo.GetTeam(ctx, nil, patch.TeamID.Value)
+			if err != nil {
+				return mty, errors.Wrap(err, 0)
+			}
+
+			if team.ContestID != contender.ContestID {
+				return mty, errors.Wrap(domain.ErrNotAllowed, 0)
+			}
+		}
+
+		events = append(events, domain.ContenderSwitchedTeamEvent{
+			ContenderID: contenderID,
+			TeamID:      patch.TeamID.Value,
+		})
+
+		contender.TeamID = patch.TeamID.Value
+	}
+
+	lockVersion := func(ctx context.Context, tx domain.Transaction) (int, error) {
+		return uc.Repo.GetContenderVersionForUpdate(ctx, tx, contenderID)
+	}
+
+	store := func(ctx context.Context, tx domain.Transaction) (domain.Contender, error) {
+		if targetCompClass.ID != 0 {
+			classEvents, vacated, err := uc.switchCompClass(ctx, tx, contest, &contender, targetCompClass)
+			if err != nil {
+				return mty, err
+			}
+
+			events = append(classEvents, events...)
+			vacatedCompClassID = vacated
+		}
+
+		return uc.Repo.StoreContender(ctx, tx, contender)
+	}
+
+	if targetCompClass.ID != 0 {
+		contender, err = inTransaction(ctx, uc.Repo, func(ctx context.Context, tx domain.Transaction) (domain.Contender, error) {
+			if expectedVersion != 0 {
+				version, err := lockVersion(ctx, tx)
+				if err != nil {
+					return mty, err
+				}
+
+				if err := checkVersion(expectedVersion, version); err != nil {
+					return mty, err
+				}
+			}
+
+			return store(ctx, tx)
+		})
+	} else {
+		contender, err = storeVersioned(ctx, uc.Repo, expectedVersion, lockVersion, store)
+	}
+	if err != nil {
+		return mty, errors.Wrap(err, 0)
+	}
+
 	publicInfoEvent.CompClassID = contender.CompClassID
+	publicInfoEvent.TeamID = contender.TeamID
 	publicInfoEvent.Name = contender.Name
 	publicInfoEvent.WithdrawnFromFinals = contender.WithdrawnFromFinals
 	publicInfoEvent.Disqualified = contender.Disqualified
@@ -239,12 +306,14 @@ func (uc *ContenderUseCase) PatchContender(ctx context.Context, contenderID doma
 		events = append(events, publicInfoEvent)
 	}
 
-	if contender, err = uc.Repo.StoreContender(ctx, nil, contender); err != nil {
-		return mty, errors.Wrap(err, 0)
+	for _, event := range events {
+		uc.EventBroker.Dispatch(ctx, contest.ID, event)
 	}
 
-	for _, event := range events {
-		uc.EventBroker.Dispatch(contest.ID, event)
+	if vacatedCompClassID != 0 {
+		if err := promoteWaitlisted(ctx, uc.Repo, uc.EventBroker, contest, vacatedCompClassID); err != nil {
+			return mty, errors.Wrap(err, 0)
+		}
 	}
 
 	return withScore(contender, uc.ScoreKeeper), nil
@@ -271,22 +340,140 @@ func (uc *ContenderUseCase) ScrubContender(ctx context.Context, contenderID doma
 		return mty, errors.Wrap(err, 0)
 	}
 
-	uc.EventBroker.Dispatch(contender.ContestID, domain.ContenderPublicInfoUpdatedEvent{
+	uc.EventBroker.Dispatch(ctx, contender.ContestID, domain.ContenderPublicInfoUpdatedEvent{
 		ContenderID:         contender.ID,
 		CompClassID:         contender.CompClassID,
+		TeamID:              contender.TeamID,
 		Name:                contender.Name,
 		WithdrawnFromFinals: contender.WithdrawnFromFinals,
 		Disqualified:        contender.Disqualified,
 		ScrubbedAt:          contender.ScrubbedAt,
 	})
 
-	uc.EventBroker.Dispatch(contender.ContestID, domain.ContenderWithdrewFromFinalsEvent{
+	uc.EventBroker.Dispatch(ctx, contender.ContestID, domain.ContenderWithdrewFromFinalsEvent{
 		ContenderID: contenderID,
 	})
 
 	return withScore(contender, uc.ScoreKeeper), nil
 }
 
+func (uc *ContenderUseCase) ExportContenderData(ctx context.Context, contenderID domain.ContenderID) (domain.ContenderDataExport, error) {
+	var mty domain.ContenderDataExport
+
+	contender, err := uc.Repo.GetContender(ctx, nil, contenderID)
+	if err != nil {
+		return mty, errors.Wrap(err, 0)
+	}
+
+	if _, err := uc.Authorizer.HasOwnership(ctx, contender.Ownership); err != nil {
+		return mty, errors.Wrap(err, 0)
+	}
+
+	ticks, err := uc.Repo.GetTicksByContender(ctx, nil, contenderID)
+	if err != nil {
+		return mty, errors.Wrap(err, 0)
+	}
+
+	revisions, err := uc.Repo.GetTickRevisionsByContender(ctx, nil, contenderID)
+	if err != nil {
+		return mty, errors.Wrap(err, 0)
+	}
+
+	disputes, err := uc.Repo.GetTickDisputesByContender(ctx, nil, contenderID)
+	if err != nil {
+		return mty, errors.Wrap(err, 0)
+	}
+
+	winners, err := uc.Repo.GetRaffleWinnersByContender(ctx, nil, contenderID)
+	if err != nil {
+		return mty, errors.Wrap(err, 0)
+	}
+
+	return domain.ContenderDataExport{
+		Contender:     withScore(contender, uc.ScoreKeeper),
+		Ticks:         ticks,
+		TickRevisions: revisions,
+		TickDisputes:  disputes,
+		RaffleWins:    winners,
+		ExportedAt:    time.Now(),
+	}, nil
+}
+
+func (uc *ContenderUseCase) EraseContender(ctx context.Context, contenderID domain.ContenderID) (domain.Contender, error) {
+	var mty domain.Contender
+
+	contender, err := uc.Repo.GetContender(ctx, nil, contenderID)
+	if err != nil {
+		return mty, errors.Wrap(err, 0)
+	}
+
+	if _, err := uc.Authorizer.HasOwnership(ctx, contender.Ownership); err != nil {
+		return mty, errors.Wrap(err, 0)
+	}
+
+	now := time.Now()
+
+	contender.Name = ""
+	contender.ScrubbedAt = now
+
+	tx, err := uc.Repo.Begin()
+	if err != nil {
+		return mty, errors.Wrap(err, 0)
+	}
+	defer tx.Rollback()
+
+	contender, err = uc.Repo.StoreContender(ctx, tx, contender)
+	if err != nil {
+		return mty, errors.Wrap(err, 0)
+	}
+
+	_, err = uc.Repo.StoreAuditEntry(ctx, tx, domain.AuditEntry{
+		Ownership: domain.OwnershipData{
+			OrganizerID: contender.Ownership.OrganizerID,
+		},
+		ContestID:   contender.ContestID,
+		ContenderID: contender.ID,
+		Action:      domain.AuditActionContenderErased,
+		Timestamp:   now,
+	})
+	if err != nil {
+		return mty, errors.Wrap(err, 0)
+	}
+
+	winners, err := uc.Repo.GetRaffleWinnersByContender(ctx, tx, contenderID)
+	if err != nil {
+		return mty, errors.Wrap(err, 0)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return mty, errors.Wrap(err, 0)
+	}
+
+	uc.EventBroker.Dispatch(ctx, contender.ContestID, domain.ContenderPublicInfoUpdatedEvent{
+		ContenderID:         contender.ID,
+		CompClassID:         contender.CompClassID,
+		TeamID:              contender.TeamID,
+		Name:                contender.Name,
+		WithdrawnFromFinals: contender.WithdrawnFromFinals,
+		Disqualified:        contender.Disqualified,
+		ScrubbedAt:          contender.ScrubbedAt,
+	})
+
+	for _, winner := range winners {
+		uc.EventBroker.Dispatch(ctx, contender.ContestID, domain.RaffleWinnerUpdatedEvent{
+			RaffleWinnerID: winner.ID,
+			RaffleID:       winner.RaffleID,
+			ContenderID:    winner.ContenderID,
+			PrizeID:        winner.PrizeID,
+			PrizeName:      winner.PrizeName,
+			ClaimedAt:      winner.ClaimedAt,
+			VoidedAt:       winner.VoidedAt,
+		})
+	}
+
+	return withScore(contender, uc.ScoreKeeper), nil
+}
+
 func (uc *ContenderUseCase) DeleteContender(ctx context.Context, contenderID domain.ContenderID) error {
 	contender, err := uc.Repo.GetContender(ctx, nil, contenderID)
 	if err != nil {
@@ -306,10 +493,226 @@ func (uc *ContenderUseCase) DeleteContender(ctx context.Context, contenderID dom
 		return errors.Wrap(err, 0)
 	}
 
+	if contender.CompClassID != 0 {
+		contest, err := uc.Repo.GetContest(ctx, nil, contender.ContestID)
+		if err != nil {
+			return errors.Wrap(err, 0)
+		}
+
+		if err := promoteWaitlisted(ctx, uc.Repo, uc.EventBroker, contest, contender.CompClassID); err != nil {
+			return errors.Wrap(err, 0)
+		}
+	}
+
+	return nil
+}
+
+func (uc *ContenderUseCase) GetWaitlist(ctx context.Context, compClassID domain.CompClassID) ([]domain.Contender, error) {
+	compClass, err := uc.Repo.GetCompClass(ctx, nil, compClassID)
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	if _, err := uc.Authorizer.HasOwnership(ctx, compClass.Ownership); err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	contenders, err := uc.Repo.GetWaitlistedContenders(ctx, nil, compClassID)
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	return contenders, nil
+}
+
+func (uc *ContenderUseCase) switchCompClass(
+	ctx context.Context,
+	tx domain.Transaction,
+	contest domain.Contest,
+	contender *domain.Contender,
+	compClass domain.CompClass,
+) ([]any, domain.CompClassID, error) {
+	if _, err := uc.Repo.GetContestVersionForUpdate(ctx, tx, contest.ID); err != nil {
+		return nil, 0, errors.Wrap(err, 0)
+	}
+
+	full, err := compClassFull(ctx, uc.Repo, tx, compClass)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, 0)
+	}
+
+	if full {
+		if contender.WaitlistCompClassID != compClass.ID {
+			contender.WaitlistCompClassID = compClass.ID
+			contender.WaitlistedAt = time.Now()
+		}
+
+		return nil, 0, nil
+	}
+
+	var events []any
+
+	if contender.CompClassID == 0 {
+		events = append(events, domain.ContenderEnteredEvent{
+			ContenderID: contender.ID,
+			CompClassID: compClass.ID,
+		})
+	} else {
+		events = append(events, domain.ContenderSwitchedClassEvent{
+			ContenderID: contender.ID,
+			CompClassID: compClass.ID,
+		})
+	}
+
+	vacatedCompClassID := contender.CompClassID
+
+	contender.CompClassID = compClass.ID
+	contender.WaitlistCompClassID = 0
+	contender.WaitlistedAt = time.Time{}
+
+	if contender.Entered.IsZero() {
+		contender.Entered = time.Now()
+		contender.ScrubBefore = compClass.TimeEnd.Add(contest.NameRetentionTime)
+	}
+
+	return events, vacatedCompClassID, nil
+}
+
+type waitlistRepository interface {
+	domain.Transactor
+
+	GetContestVersionForUpdate(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) (int, error)
+	GetCompClass(ctx context.Context, tx domain.Transaction, compClassID domain.CompClassID) (domain.CompClass, error)
+	GetContendersByCompClass(ctx context.Context, tx domain.Transaction, compClassID domain.CompClassID) ([]domain.Contender, error)
+	GetWaitlistedContenders(ctx context.Context, tx domain.Transaction, compClassID domain.CompClassID) ([]domain.Contender, error)
+	StoreContender(ctx context.Context, tx domain.Transaction, contender domain.Contender) (domain.Contender, error)
+}
+
+func compClassFull(ctx context.Context, repo waitlistRepository, tx domain.Transaction, compClass domain.CompClass) (bool, error) {
+	if compClass.Capacity == 0 {
+		return false, nil
+	}
+
+	contenders, err := repo.GetContendersByCompClass(ctx, tx, compClass.ID)
+	if err != nil {
+		return false, errors.Wrap(err, 0)
+	}
+
+	return len(contenders) >= compClass.Capacity, nil
+}
+
+func promoteWaitlisted(
+	ctx context.Context,
+	repo waitlistRepository,
+	eventBroker domain.EventBroker,
+	contest domain.Contest,
+	compClassID domain.CompClassID,
+) error {
+	var events []any
+
+	_, err := inTransaction(ctx, repo, func(ctx context.Context, tx domain.Transaction) (struct{}, error) {
+		if _, err := repo.GetContestVersionForUpdate(ctx, tx, contest.ID); err != nil {
+			return struct{}{}, err
+		}
+
+		queue := []domain.CompClassID{compClassID}
+
+		for len(queue) > 0 {
+			compClassID := queue[0]
+			queue = queue[1:]
+
+			compClass, err := repo.GetCompClass(ctx, tx, compClassID)
+			if err != nil {
+				return struct{}{}, err
+			}
+
+			if time.Now().After(compClass.TimeEnd.Add(contest.GracePeriod)) {
+				continue
+			}
+
+			waitlist, err := repo.GetWaitlistedContenders(ctx, tx, compClassID)
+			if err != nil {
+				return struct{}{}, err
+			}
+
+			if len(waitlist) == 0 {
+				continue
+			}
+
+			if compClass.Capacity > 0 {
+				contenders, err := repo.GetContendersByCompClass(ctx, tx, compClassID)
+				if err != nil {
+					return struct{}{}, err
+				}
+
+				available := max(compClass.Capacity-len(contenders), 0)
+				waitlist = waitlist[:min(available, len(waitlist))]
+			}
+
+			for _, contender := range waitlist {
+				vacatedCompClassID := contender.CompClassID
+
+				contender.CompClassID = compClassID
+				contender.WaitlistCompClassID = 0
+				contender.WaitlistedAt = time.Time{}
+
+				if contender.Entered.IsZero() {
+					contender.Entered = time.Now()
+					contender.ScrubBefore = compClass.TimeEnd.Add(contest.NameRetentionTime)
+				}
+
+				if _, err := repo.StoreContender(ctx, tx, contender); err != nil {
+					return struct{}{}, err
+				}
+
+				if vacatedCompClassID == 0 {
+					events = append(events, domain.ContenderEnteredEvent{
+						ContenderID: contender.ID,
+						CompClassID: compClassID,
+					})
+				} else {
+					events = append(events, domain.ContenderSwitchedClassEvent{
+						ContenderID: contender.ID,
+						CompClassID: compClassID,
+					})
+
+					queue = append(queue, vacatedCompClassID)
+				}
+
+				events = append(events, domain.ContenderPublicInfoUpdatedEvent{
+					ContenderID:         contender.ID,
+					CompClassID:         contender.CompClassID,
+					TeamID:              contender.TeamID,
+					Name:                contender.Name,
+					WithdrawnFromFinals: contender.WithdrawnFromFinals,
+					Disqualified:        contender.Disqualified,
+					ScrubbedAt:          contender.ScrubbedAt,
+				})
+
+				events = append(events, domain.ContenderPromotedFromWaitlistEvent{
+					ContenderID: contender.ID,
+					CompClassID: compClassID,
+				})
+			}
+		}
+
+		return struct{}{}, nil
+	})
+	if err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	for _, event := range events {
+		eventBroker.Dispatch(ctx, contest.ID, event)
+	}
+
 	return nil
 }
 
-const registrationCodeLength = 8
+const (
+	registrationCodeLength  = 8
+	maxContendersPerContest = 500
+)
 
 func (uc *ContenderUseCase) CreateContenders(ctx context.Context, contestID domain.ContestID, number int) ([]domain.Contender, error) {
 	contest, err := uc.Repo.GetContest(ctx, nil, contestID)
@@ -326,7 +729,7 @@ func (uc *ContenderUseCase) CreateContenders(ctx context.Context, contestID doma
 		return nil, errors.Wrap(err, 0)
 	}
 
-	if numberOfContenders+number > 500 {
+	if numberOfContenders+number > maxContendersPerContest {
 		return nil, errors.New(domain.ErrLimitExceeded)
 	}
 
@@ -369,6 +772,120 @@ func (uc *ContenderUseCase) CreateContenders(ctx context.Context, contestID doma
 	return contenders, err
 }
 
+func (uc *ContenderUseCase) SelfRegister(ctx context.Context, contestID domain.ContestID, registration domain.SelfRegistration) (domain.Contender, error) {
+	contest, err := uc.Repo.GetContest(ctx, nil, contestID)
+	if err != nil {
+		return domain.Contender{}, errors.Wrap(err, 0)
+	}
+
+	if !contest.ArchivedAt.IsZero() {
+		return domain.Contender{}, errors.Wrap(domain.ErrArchived, 0)
+	}
+
+	if !contest.SelfRegistration {
+		return domain.Contender{}, errors.Wrap(domain.ErrNotAllowed, 0)
+	}
+
+	compClass, err := uc.Repo.GetCompClass(ctx, nil, registration.CompClassID)
+	switch {
+	case errors.Is(err, domain.ErrNotFound):
+		return domain.Contender{}, errors.Wrap(domain.ErrInvalidData, 0)
+	case err != nil:
+		return domain.Contender{}, errors.Wrap(err, 0)
+	case compClass.ContestID != contestID:
+		return domain.Contender{}, errors.Wrap(domain.ErrInvalidData, 0)
+	}
+
+	if time.Now().After(compClass.TimeEnd) {
+		return domain.Contender{}, errors.Wrap(domain.ErrContestEnded, 0)
+	}
+
+	name := strings.TrimSpace(registration.Name)
+	if name == "" {
+		return domain.Contender{}, errors.Errorf("%w: %w", domain.ErrInvalidData, domain.ErrEmptyName)
+	}
+
+	tx, err := uc.Repo.Begin()
+	if err != nil {
+		return domain.Contender{}, errors.Wrap(err, 0)
+	}
+	defer tx.Rollback()
+
+	if _, err := uc.Repo.GetContestVersionForUpdate(ctx, tx, contestID); err != nil {
+		return domain.Contender{}, errors.Wrap(err, 0)
+	}
+
+	numberOfContenders, err := uc.Repo.GetNumberOfContenders(ctx, tx, contestID)
+	if err != nil {
+		return domain.Contender{}, errors.Wrap(err, 0)
+	}
+
+	if numberOfContenders >= maxContendersPerContest {
+		return domain.Contender{}, errors.New(domain.ErrLimitExceeded)
+	}
+
+	full, err := compClassFull(ctx, uc.Repo, tx, compClass)
+	if err != nil {
+		return domain.Contender{}, errors.Wrap(err, 0)
+	}
+
+	contender := domain.Contender{
+		ID:                  0,
+		ContestID:           contestID,
+		Ownership:           contest.Ownership,
+		CompClassID:         compClass.ID,
+		WaitlistCompClassID: 0,
+		WaitlistedAt:        time.Time{},
+		TeamID:              0,
+		RegistrationCode:    uc.RegistrationCodeGenerator.Generate(registrationCodeLength),
+		Name:                name,
+		Entered:             time.Now(),
+		WithdrawnFromFinals: false,
+		Disqualified:        false,
+		ScrubbedAt:          time.Time{},
+		ScrubBefore:         compClass.TimeEnd.Add(contest.NameRetentionTime),
+		Score:               nil,
+		Version:             0,
+	}
+
+	if full {
+		contender.CompClassID = 0
+		contender.WaitlistCompClassID = compClass.ID
+		contender.WaitlistedAt = time.Now()
+		contender.Entered = time.Time{}
+	}
+
+	contender, err = uc.Repo.StoreContender(ctx, tx, contender)
+	if err != nil {
+		return domain.Contender{}, errors.Wrap(err, 0)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return domain.Contender{}, errors.Wrap(err, 0)
+	}
+
+	if full {
+		return contender, nil
+	}
+
+	uc.EventBroker.Dispatch(ctx, contestID, domain.ContenderEnteredEvent{
+		ContenderID: contender.ID,
+		CompClassID: contender.CompClassID,
+	})
+
+	uc.EventBroker.Dispatch(ctx, contestID, domain.ContenderPublicInfoUpdatedEvent{
+		ContenderID:         contender.ID,
+		CompClassID:         contender.CompClassID,
+		TeamID:              contender.TeamID,
+		Name:                contender.Name,
+		WithdrawnFromFinals: contender.WithdrawnFromFinals,
+		Disqualified:        contender.Disqualified,
+		ScrubbedAt:          contender.ScrubbedAt,
+	})
+
+	return contender, nil
+}
+
 func (uc *ContenderUseCase) ScrubContenders(ctx context.Context, deadline time.Time) (int, error) {
 	contenders, err := uc.Repo.GetScrubEligibleContenders(ctx, deadline)
 	if err != nil {
@@ -399,9 +916,10 @@ func (uc *ContenderUseCase) ScrubContenders(ctx context.Context, deadline time.T
 	}
 
 	for _, contender := range contenders {
-		uc.EventBroker.Dispatch(contender.ContestID, domain.ContenderPublicInfoUpdatedEvent{
+		uc.EventBroker.Dispatch(ctx, contender.ContestID, domain.ContenderPublicInfoUpdatedEvent{
 			ContenderID:         contender.ID,
 			CompClassID:         contender.CompClassID,
+			TeamID:              contender.TeamID,
 			Name:                contender.Name,
 			WithdrawnFromFinals: contender.WithdrawnFromFinals,
 			Disqualified:        contender.Disqualified,
@@ -308,7 +308,7 @@ func TestGetContendersByContest(t *testing.T) {
 			}, nil)
 
 		mockedRepo.
-			On("GetContendersByContest", mock.Anything, mock.Anything, fakedContestID).
+			On("GetContendersByContestFiltered", mock.Anything, mock.Anything, fakedContestID, domain.ContenderFilter{}, domain.PageRequest{}).
 			Return(contenders, nil)
 
 		ucase := usecases.ContenderUseCase{
@@ -317,12 +317,13 @@ func TestGetContendersByContest(t *testing.T) {
 			ScoreKeeper: mockedScoreKeeper,
 		}
 
-		contenders, err := ucase.GetContendersByContest(context.Background(), fakedContestID)
+		page, err := ucase.GetContendersByContest(context.Background(), fakedContestID, domain.ContenderFilter{}, domain.PageRequest{})
 
 		require.NoError(t, err)
-		assert.Len(t, contenders, 10)
+		assert.Len(t, page.Items, 10)
+		assert.Zero(t, page.Next)
 
-		for i, contender := range contenders {
+		for i, contender := range page.Items {
 			assert.Equal(t, domain.ContenderID(i+1), contender.ID)
 			require.NotNil(t, contender.Score)
 			assert.Equal(t, (i+1)*10, contender.Score.Score)
@@ -357,10 +358,62 @@ func TestGetContendersByContest(t *testing.T) {
 			Authorizer: mockedAuthorizer,
 		}
 
-		contenders, err := ucase.GetContendersByContest(context.Background(), fakedContestID)
+		page, err := ucase.GetContendersByContest(context.Background(), fakedContestID, domain.ContenderFilter{}, domain.PageRequest{})
 
 		assert.ErrorIs(t, err, domain.ErrNoOwnership)
-		assert.Nil(t, contenders)
+		assert.Nil(t, page.Items)
+
+		mockedAuthorizer.AssertExpectations(t)
+		mockedRepo.AssertExpectations(t)
+	})
+
+	t.Run("Paginated", func(t *testing.T) {
+		mockedAuthorizer := new(authorizerMock)
+		mockedRepo := new(repositoryMock)
+		mockedScoreKeeper := new(scoreKeeperMock)
+
+		entered := true
+		filter := domain.ContenderFilter{
+			CompClassID: 1,
+			Entered:     &entered,
+		}
+
+		var contenders []domain.Contender
+
+		for k := 11; k <= 14; k++ {
+			contenders = append(contenders, domain.Contender{
+				ID: domain.ContenderID(k),
+			})
+		}
+
+		mockedScoreKeeper.On("GetScore", mock.Anything).Return(domain.Score{}, domain.ErrNotFound)
+
+		mockedAuthorizer.
+			On("HasOwnership", mock.Anything, fakedOwnership).
+			Return(domain.OrganizerRole, nil)
+
+		mockedRepo.
+			On("GetContest", mock.Anything, mock.Anything, fakedContestID).
+			Return(domain.Contest{
+				ID:        fakedContestID,
+				Ownership: fakedOwnership,
+			}, nil)
+
+		mockedRepo.
+			On("GetContendersByContestFiltered", mock.Anything, mock.Anything, fakedContestID, filter, domain.PageRequest{After: 10, Limit: 4}).
+			Return(contenders, nil)
+
+		ucase := usecases.ContenderUseCase{
+			Repo:        mockedRepo,
+			Authorizer:  mockedAuthorizer,
+			ScoreKeeper: mockedScoreKeeper,
+		}
+
+		page, err := ucase.GetContendersByContest(context.Background(), fakedContestID, filter, domain.PageRequest{After: 10, Limit: 3})
+
+		require.NoError(t, err)
+		assert.Equal(t, contenders[:3], page.Items)
+		assert.Equal(t, domain.ResourceID(13), page.Next)
 
 		mockedAuthorizer.AssertExpectations(t)
 		mockedRepo.AssertExpectations(t)
@@ -403,6 +456,138 @@ func TestDeleteContender(t *testing.T) {
 		mockedRepo.AssertExpectations(t)
 	})
 
+	t.Run("PromotesWaitlistedContender", func(t *testing.T) {
+		synctest.Test(t, func(t *testing.T) {
+			mockedAuthorizer := new(authorizerMock)
+			mockedRepo := new(repositoryMock)
+			mockedTx := new(transactionMock)
+			mockedEventBroker := new(eventBrokerMock)
+
+			fakedContestID := testutils.RandomResourceID[domain.ContestID]()
+			fakedCompClassID := testutils.RandomResourceID[domain.CompClassID]()
+			fakedWaitlistedContenderID := testutils.RandomResourceID[domain.ContenderID]()
+
+			now := time.Now()
+
+			fakedCompClass := domain.CompClass{
+				ID:        fakedCompClassID,
+				ContestID: fakedContestID,
+				TimeBegin: now.Add(-1 * time.Hour),
+				TimeEnd:   now.Add(time.Hour),
+				Capacity:  1,
+			}
+
+			mockedRepo.
+				On("GetContender", mock.Anything, mock.Anything, fakedContenderID).
+				Return(domain.Contender{
+					ID:          fakedContenderID,
+					Ownership:   fakedOwnership,
+					ContestID:   fakedContestID,
+					CompClassID: fakedCompClassID,
+				}, nil)
+
+			mockedRepo.
+				On("DeleteContender", mock.Anything, mock.Anything, fakedContenderID).
+				Return(nil)
+
+			mockedRepo.
+				On("GetContest", mock.Anything, mock.Anything, fakedContestID).
+				Return(domain.Contest{
+					ID:                fakedContestID,
+					NameRetentionTime: 24 * time.Hour,
+				}, nil)
+
+			mockedRepo.
+				On("Begin").
+				Return(mockedTx, nil)
+
+			mockedRepo.
+				On("GetContestVersionForUpdate", mock.Anything, mockedTx, fakedContestID).
+				Return(0, nil)
+
+			mockedTx.On("Commit").Return(nil)
+
+			mockedRepo.
+				On("GetCompClass", mock.Anything, mock.Anything, fakedCompClassID).
+				Return(fakedCompClass, nil)
+
+			mockedRepo.
+				On("GetWaitlistedContenders", mock.Anything, mockedTx, fakedCompClassID).
+				Return([]domain.Contender{
+					{
+						ID:                  fakedWaitlistedContenderID,
+						ContestID:           fakedContestID,
+						Name:                "John Doe",
+						WaitlistCompClassID: fakedCompClassID,
+						WaitlistedAt:        now.Add(-1 * time.Minute),
+					},
+					{
+						ID:                  testutils.RandomResourceID[domain.ContenderID](),
+						ContestID:           fakedContestID,
+						WaitlistCompClassID: fakedCompClassID,
+						WaitlistedAt:        now,
+					},
+				}, nil)
+
+			mockedRepo.
+				On("GetContendersByCompClass", mock.Anything, mockedTx, fakedCompClassID).
+				Return([]domain.Contender{}, nil)
+
+			mockedRepo.
+				On("StoreContender", mock.Anything, mockedTx, domain.Contender{
+					ID:          fakedWaitlistedContenderID,
+					ContestID:   fakedContestID,
+					CompClassID: fakedCompClassID,
+					Name:        "John Doe",
+					Entered:     now,
+					ScrubBefore: now.Add(time.Hour).Add(24 * time.Hour),
+				}).
+				Return(domain.Contender{}, nil).
+				Once()
+
+			mockedEventBroker.
+				On("Dispatch", mock.Anything, fakedContestID, domain.ContenderEnteredEvent{
+					ContenderID: fakedWaitlistedContenderID,
+					CompClassID: fakedCompClassID,
+				}).
+				Return()
+
+			mockedEventBroker.
+				On("Dispatch", mock.Anything, fakedContestID, domain.ContenderPublicInfoUpdatedEvent{
+					ContenderID: fakedWaitlistedContenderID,
+					CompClassID: fakedCompClassID,
+					Name:        "John Doe",
+				}).
+				Return()
+
+			mockedEventBroker.
+				On("Dispatch", mock.Anything, fakedContestID, domain.ContenderPromotedFromWaitlistEvent{
+					ContenderID: fakedWaitlistedContenderID,
+					CompClassID: fakedCompClassID,
+				}).
+				Return()
+
+			mockedAuthorizer.
+				On("HasOwnership", mock.Anything, fakedOwnership).
+				Return(domain.OrganizerRole, nil)
+
+			ucase := usecases.ContenderUseCase{
+				Repo:        mockedRepo,
+				Authorizer:  mockedAuthorizer,
+				EventBroker: mockedEventBroker,
+			}
+
+			err := ucase.DeleteContender(context.Background(), fakedContenderID)
+
+			require.NoError(t, err)
+
+			mockedAuthorizer.AssertExpectations(t)
+			mockedEventBroker.AssertExpectations(t)
+			mockedRepo.AssertExpectations(t)
+			mockedTx.AssertExpectations(t)
+		})
+	})
+
 	t.Run("BadCredentials", func(t *testing.T) {
 		mockedAuthorizer := new(authorizerMock)
 		mockedRepo := new(repositoryMock)
@@ -630,6 +815,280 @@ func TestCreateContenders(t *testing.T) {
 	})
 }
 
+func TestSelfRegister(t *testing.T) {
+	fakedContestID := testutils.RandomResourceID[domain.ContestID]()
+	fakedCompClassID := testutils.RandomResourceID[domain.CompClassID]()
+	fakedContenderID := testutils.RandomResourceID[domain.ContenderID]()
+	fakedOwnership := domain.OwnershipData{
+		OrganizerID: testutils.RandomResourceID[domain.OrganizerID](),
+	}
+
+	makeMocks := func(selfRegistration bool, capacity int) (*repositoryMock, *transactionMock, *eventBrokerMock, *codeGeneratorMock) {
+		mockedRepo := new(repositoryMock)
+		mockedTx := new(transactionMock)
+		mockedEventBroker := new(eventBrokerMock)
+		mockedCodeGenerator := new(codeGeneratorMock)
+
+		mockedRepo.
+			On("GetContest", mock.Anything, nil, fakedContestID).
+			Return(domain.Contest{
+				ID:                fakedContestID,
+				Ownership:         fakedOwnership,
+				NameRetentionTime: time.Hour,
+				SelfRegistration:  selfRegistration,
+			}, nil)
+
+		mockedRepo.
+			On("GetCompClass", mock.Anything, nil, fakedCompClassID).
+			Return(domain.CompClass{
+				ID:        fakedCompClassID,
+				Ownership: fakedOwnership,
+				ContestID: fakedContestID,
+				TimeEnd:   time.Now().Add(time.Hour),
+				Capacity:  capacity,
+			}, nil).
+			Maybe()
+
+		return mockedRepo, mockedTx, mockedEventBroker, mockedCodeGenerator
+	}
+
+	expectLockedContest := func(mockedRepo *repositoryMock, mockedTx *transactionMock) {
+		mockedRepo.
+			On("Begin").
+			Return(mockedTx, nil)
+
+		mockedRepo.
+			On("GetContestVersionForUpdate", mock.Anything, mockedTx, fakedContestID).
+			Return(0, nil)
+
+		mockedTx.On("Rollback").Return()
+	}
+
+	t.Run("HappyPath", func(t *testing.T) {
+		synctest.Test(t, func(t *testing.T) {
+			mockedRepo, mockedTx, mockedEventBroker, mockedCodeGenerator := makeMocks(true, 10)
+
+			expectLockedContest(mockedRepo, mockedTx)
+
+			mockedRepo.
+				On("GetNumberOfContenders", mock.Anything, mockedTx, fakedContestID).
+				Return(100, nil)
+
+			mockedRepo.
+				On("GetContendersByCompClass", mock.Anything, mockedTx, fakedCompClassID).
+				Return(make([]domain.Contender, 9), nil)
+
+			mockedCodeGenerator.
+				On("Generate", 8).
+				Return("ABCD1234")
+
+			contender := domain.Contender{
+				ContestID:        fakedContestID,
+				Ownership:        fakedOwnership,
+				CompClassID:      fakedCompClassID,
+				RegistrationCode: "ABCD1234",
+				Name:             "Alex Honnold",
+				Entered:          time.Now(),
+				ScrubBefore:      time.Now().Add(2 * time.Hour),
+			}
+
+			storedContender := contender
+			storedContender.ID = fakedContenderID
+
+			mockedRepo.
+				On("StoreContender", mock.Anything, mockedTx, contender).
+				Return(storedContender, nil)
+
+			mockedTx.On("Commit").Return(nil)
+
+			mockedEventBroker.
+				On("Dispatch", mock.Anything, fakedContestID, domain.ContenderEnteredEvent{
+					ContenderID: fakedContenderID,
+					CompClassID: fakedCompClassID,
+				}).
+				Return()
+
+			mockedEventBroker.
+				On("Dispatch", mock.Anything, fakedContestID, domain.ContenderPublicInfoUpdatedEvent{
+					ContenderID: fakedContenderID,
+					CompClassID: fakedCompClassID,
+					Name:        "Alex Honnold",
+				}).
+				Return()
+
+			ucase := usecases.ContenderUseCase{
+				Repo:                      mockedRepo,
+				EventBroker:               mockedEventBroker,
+				RegistrationCodeGenerator: mockedCodeGenerator,
+			}
+
+			registered, err := ucase.SelfRegister(context.Background(), fakedContestID, domain.SelfRegistration{
+				CompClassID: fakedCompClassID,
+				Name:        " Alex Honnold ",
+			})
+
+			require.NoError(t, err)
+			assert.Equal(t, storedContender, registered)
+
+			mockedRepo.AssertExpectations(t)
+			mockedTx.AssertExpectations(t)
+			mockedEventBroker.AssertExpectations(t)
+			mockedCodeGenerator.AssertExpectations(t)
+		})
+	})
+
+	t.Run("SelfRegistrationDisabled", func(t *testing.T) {
+		mockedRepo, _, mockedEventBroker, mockedCodeGenerator := makeMocks(false, 0)
+
+		ucase := usecases.ContenderUseCase{
+			Repo:                      mockedRepo,
+			EventBroker:               mockedEventBroker,
+			RegistrationCodeGenerator: mockedCodeGenerator,
+		}
+
+		_, err := ucase.SelfRegister(context.Background(), fakedContestID, domain.SelfRegistration{
+			CompClassID: fakedCompClassID,
+			Name:        "Alex Honnold",
+		})
+
+		require.ErrorIs(t, err, domain.ErrNotAllowed)
+
+		mockedRepo.AssertExpectations(t)
+	})
+
+	t.Run("JoinWaitlistWhenCompClassFull", func(t *testing.T) {
+		synctest.Test(t, func(t *testing.T) {
+			mockedRepo, mockedTx, mockedEventBroker, mockedCodeGenerator := makeMocks(true, 10)
+
+			expectLockedContest(mockedRepo, mockedTx)
+
+			mockedRepo.
+				On("GetNumberOfContenders", mock.Anything, mockedTx, fakedContestID).
+				Return(100, nil)
+
+			mockedRepo.
+				On("GetContendersByCompClass", mock.Anything, mockedTx, fakedCompClassID).
+				Return(make([]domain.Contender, 10), nil)
+
+			mockedCodeGenerator.
+				On("Generate", 8).
+				Return("ABCD1234")
+
+			contender := domain.Contender{
+				ContestID:           fakedContestID,
+				Ownership:           fakedOwnership,
+				WaitlistCompClassID: fakedCompClassID,
+				WaitlistedAt:        time.Now(),
+				RegistrationCode:    "ABCD1234",
+				Name:                "Alex Honnold",
+				ScrubBefore:         time.Now().Add(2 * time.Hour),
+			}
+
+			storedContender := contender
+			storedContender.ID = fakedContenderID
+
+			mockedRepo.
+				On("StoreContender", mock.Anything, mockedTx, contender).
+				Return(storedContender, nil)
+
+			mockedTx.On("Commit").Return(nil)
+
+			ucase := usecases.ContenderUseCase{
+				Repo:                      mockedRepo,
+				EventBroker:               mockedEventBroker,
+				RegistrationCodeGenerator: mockedCodeGenerator,
+			}
+
+			registered, err := ucase.SelfRegister(context.Background(), fakedContestID, domain.SelfRegistration{
+				CompClassID: fakedCompClassID,
+				Name:        "Alex Honnold",
+			})
+
+			require.NoError(t, err)
+			assert.Equal(t, storedContender, registered)
+
+			mockedRepo.AssertExpectations(t)
+			mockedTx.AssertExpectations(t)
+			mockedEventBroker.AssertExpectations(t)
+			mockedCodeGenerator.AssertExpectations(t)
+		})
+	})
+
+	t.Run("ContenderLimitReached", func(t *testing.T) {
+		mockedRepo, mockedTx, mockedEventBroker, mockedCodeGenerator := makeMocks(true, 0)
+
+		expectLockedContest(mockedRepo, mockedTx)
+
+		mockedRepo.
+			On("GetNumberOfContenders", mock.Anything, mockedTx, fakedContestID).
+			Return(500, nil)
+
+		ucase := usecases.ContenderUseCase{
+			Repo:                      mockedRepo,
+			EventBroker:               mockedEventBroker,
+			RegistrationCodeGenerator: mockedCodeGenerator,
+		}
+
+		_, err := ucase.SelfRegister(context.Background(), fakedContestID, domain.SelfRegistration{
+			CompClassID: fakedCompClassID,
+			Name:        "Alex Honnold",
+		})
+
+		require.ErrorIs(t, err, domain.ErrLimitExceeded)
+
+		mockedRepo.AssertExpectations(t)
+		mockedTx.AssertExpectations(t)
+	})
+
+	t.Run("EmptyName", func(t *testing.T) {
+		mockedRepo, _, mockedEventBroker, mockedCodeGenerator := makeMocks(true, 0)
+
+		ucase := usecases.ContenderUseCase{
+			Repo:                      mockedRepo,
+			EventBroker:               mockedEventBroker,
+			RegistrationCodeGenerator: mockedCodeGenerator,
+		}
+
+		_, err := ucase.SelfRegister(context.Background(), fakedContestID, domain.SelfRegistration{
+			CompClassID: fakedCompClassID,
+			Name:        "   ",
+		})
+
+		require.ErrorIs(t, err, domain.ErrInvalidData)
+		require.ErrorIs(t, err, domain.ErrEmptyName)
+
+		mockedRepo.AssertExpectations(t)
+	})
+
+	t.Run("CompClassFromOtherContest", func(t *testing.T) {
+		mockedRepo, _, mockedEventBroker, mockedCodeGenerator := makeMocks(true, 0)
+
+		otherCompClassID := fakedCompClassID + 1
+
+		mockedRepo.
+			On("GetCompClass", mock.Anything, nil, otherCompClassID).
+			Return(domain.CompClass{
+				ID:        otherCompClassID,
+				ContestID: fakedContestID + 1,
+			}, nil)
+
+		ucase := usecases.ContenderUseCase{
+			Repo:                      mockedRepo,
+			EventBroker:               mockedEventBroker,
+			RegistrationCodeGenerator: mockedCodeGenerator,
+		}
+
+		_, err := ucase.SelfRegister(context.Background(), fakedContestID, domain.SelfRegistration{
+			CompClassID: otherCompClassID,
+			Name:        "Alex Honnold",
+		})
+
+		require.ErrorIs(t, err, domain.ErrInvalidData)
+
+		mockedRepo.AssertExpectations(t)
+	})
+}
+
 func TestPatchContender(t *testing.T) {
 	fakedContenderID := testutils.RandomResourceID[domain.ContenderID]()
 	fakedOwnership := domain.OwnershipData{
@@ -663,6 +1122,20 @@ func TestPatchContender(t *testing.T) {
 		return mockedRepo
 	}
 
+	expectCompClassSwitch := func(mockedRepo *repositoryMock) *transactionMock {
+		mockedTx := new(transactionMock)
+
+		mockedRepo.On("Begin").Return(mockedTx, nil)
+
+		mockedRepo.
+			On("GetContestVersionForUpdate", mock.Anything, mockedTx, fakedContestID).
+			Return(0, nil)
+
+		mockedTx.On("Commit").Return(nil)
+
+		return mockedTx
+	}
+
 	t.Run("UpdateWithoutChanges", func(t *testing.T) {
 		mockedAuthorizer := new(authorizerMock)
 		mockedScoreKeeper := new(scoreKeeperMock)
@@ -736,7 +1209,7 @@ func TestPatchContender(t *testing.T) {
 			ScoreKeeper: mockedScoreKeeper,
 		}
 
-		contender, err := ucase.PatchContender(context.Background(), fakedContenderID, domain.ContenderPatch{})
+		contender, err := ucase.PatchContender(context.Background(), fakedContenderID, domain.ContenderPatch{}, 0)
 
 		require.NoError(t, err)
 
@@ -794,7 +1267,7 @@ func TestPatchContender(t *testing.T) {
 
 		contender, err := ucase.PatchContender(context.Background(), fakedContenderID, domain.ContenderPatch{
 			Disqualified: domain.NewPatch(false),
-		})
+		}, 0)
 
 		assert.ErrorIs(t, err, domain.ErrInsufficientRole)
 		assert.Empty(t, contender)
@@ -823,6 +1296,8 @@ func TestPatchContender(t *testing.T) {
 
 			mockedRepo := makeMockedRepo(fakedContender)
 
+			mockedTx := expectCompClassSwitch(mockedRepo)
+
 			mockedRepo.
 				On("GetCompClass", mock.Anything, mock.Anything, fakedCompClassID).
 				Return(domain.CompClass{
@@ -832,7 +1307,7 @@ func TestPatchContender(t *testing.T) {
 				}, nil)
 
 			mockedRepo.
-				On("StoreContender", mock.Anything, mock.Anything,
+				On("StoreContender", mock.Anything, mockedTx,
 					domain.Contender{
 						ID:                  fakedContenderID,
 						Ownership:           fakedOwnership,
@@ -865,7 +1340,7 @@ func TestPatchContender(t *testing.T) {
 
 			mockedScoreKeeper.On("GetScore", fakedContenderID).Return(domain.Score{}, errMock)
 
-			mockedEventBroker.On("Dispatch", fakedContestID, mock.Anything).Return()
+			mockedEventBroker.On("Dispatch", mock.Anything, fakedContestID, mock.Anything).Return()
 
 			ucase := usecases.ContenderUseCase{
 				Repo:        mockedRepo,
@@ -877,7 +1352,7 @@ func TestPatchContender(t *testing.T) {
 			contender, err := ucase.PatchContender(context.Background(), fakedContenderID, domain.ContenderPatch{
 				CompClassID: domain.NewPatch(fakedCompClassID),
 				Name:        domain.NewPatch("John Doe"),
-			})
+			}, 0)
 
 			require.NoError(t, err)
 
@@ -886,12 +1361,12 @@ func TestPatchContender(t *testing.T) {
 			assert.Equal(t, time.Now(), contender.Entered)
 			assert.Equal(t, currentTime.Add(time.Hour).Add(fakedNameRetentionTime), contender.ScrubBefore)
 
-			mockedEventBroker.AssertCalled(t, "Dispatch", fakedContestID, domain.ContenderEnteredEvent{
+			mockedEventBroker.AssertCalled(t, "Dispatch", mock.Anything, fakedContestID, domain.ContenderEnteredEvent{
 				ContenderID: fakedContenderID,
 				CompClassID: fakedCompClassID,
 			})
 
-			mockedEventBroker.AssertCalled(t, "Dispatch", fakedContestID, domain.ContenderPublicInfoUpdatedEvent{
+			mockedEventBroker.AssertCalled(t, "Dispatch", mock.Anything, fakedContestID, domain.ContenderPublicInfoUpdatedEvent{
 				ContenderID:         fakedContenderID,
 				CompClassID:         fakedCompClassID,
 				Name:                "John Doe",
@@ -903,6 +1378,7 @@ func TestPatchContender(t *testing.T) {
 			mockedScoreKeeper.AssertExpectations(t)
 			mockedEventBroker.AssertExpectations(t)
 			mockedRepo.AssertExpectations(t)
+			mockedTx.AssertExpectations(t)
 		})
 	})
 
@@ -932,7 +1408,7 @@ func TestPatchContender(t *testing.T) {
 			Authorizer: mockedAuthorizer,
 		}
 
-		contender, err := ucase.PatchContender(context.Background(), fakedContenderID, domain.ContenderPatch{})
+		contender, err := ucase.PatchContender(context.Background(), fakedContenderID, domain.ContenderPatch{}, 0)
 
 		assert.ErrorIs(t, err, domain.ErrNotRegistered)
 		assert.Empty(t, contender)
@@ -977,7 +1453,7 @@ func TestPatchContender(t *testing.T) {
 
 		contender, err := ucase.PatchContender(context.Background(), fakedContenderID, domain.ContenderPatch{
 			CompClassID: domain.NewPatch(domain.CompClassID(0)),
-		})
+		}, 0)
 
 		assert.ErrorIs(t, err, domain.ErrNotAllowed)
 		assert.Empty(t, contender)
@@ -1006,6 +1482,8 @@ func TestPatchContender(t *testing.T) {
 
 		mockedRepo := makeMockedRepo(fakedContender)
 
+		mockedTx := expectCompClassSwitch(mockedRepo)
+
 		mockedRepo.
 			On("GetCompClass", mock.Anything, mock.Anything, fakedCompClassID).
 			Return(domain.CompClass{
@@ -1021,7 +1499,7 @@ func TestPatchContender(t *testing.T) {
 		}
 
 		mockedRepo.
-			On("StoreContender", mock.Anything, mock.Anything,
+			On("StoreContender", mock.Anything, mockedTx,
 				domain.Contender{
 					ID:                  fakedContenderID,
 					Ownership:           fakedOwnership,
@@ -1054,12 +1532,16 @@ func TestPatchContender(t *testing.T) {
 
 		mockedScoreKeeper.On("GetScore", fakedContenderID).Return(domain.Score{}, errMock)
 
-		mockedEventBroker.On("Dispatch", fakedContestID, mock.Anything).Return()
+		mockedEventBroker.On("Dispatch", mock.Anything, fakedContestID, mock.Anything).Return()
 
 		mockedRepo.
 			On("GetCompClass", mock.Anything, mock.Anything, fakedOtherCompClass.ID).
 			Return(fakedOtherCompClass, nil)
 
+		mockedRepo.
+			On("GetWaitlistedContenders", mock.Anything, mock.Anything, fakedCompClassID).
+			Return([]domain.Contender{}, nil)
+
 		ucase := usecases.ContenderUseCase{
 			Repo:        mockedRepo,
 			Authorizer:  mockedAuthorizer,
@@ -1072,7 +1554,7 @@ func TestPatchContender(t *testing.T) {
 			Name:                domain.NewPatch("Jane Doe"),
 			WithdrawnFromFinals: domain.NewPatch(true),
 			Disqualified:        domain.NewPatch(true),
-		})
+		}, 0)
 
 		require.NoError(t, err)
 
@@ -1083,12 +1565,12 @@ func TestPatchContender(t *testing.T) {
 		assert.Equal(t, currentTime, contender.Entered)
 		assert.Equal(t, currentTime.Add(42*time.Hour), contender.ScrubBefore)
 
-		mockedEventBroker.AssertCalled(t, "Dispatch", fakedContestID, domain.ContenderSwitchedClassEvent{
+		mockedEventBroker.AssertCalled(t, "Dispatch", mock.Anything, fakedContestID, domain.ContenderSwitchedClassEvent{
 			ContenderID: fakedContenderID,
 			CompClassID: fakedOtherCompClass.ID,
 		})
 
-		mockedEventBroker.AssertCalled(t, "Dispatch", fakedContestID, domain.ContenderPublicInfoUpdatedEvent{
+		mockedEventBroker.AssertCalled(t, "Dispatch", mock.Anything, fakedContestID, domain.ContenderPublicInfoUpdatedEvent{
 			ContenderID:         fakedContenderID,
 			CompClassID:         fakedOtherCompClass.ID,
 			Name:                "Jane Doe",
@@ -1096,11 +1578,11 @@ func TestPatchContender(t *testing.T) {
 			Disqualified:        true,
 		})
 
-		mockedEventBroker.AssertCalled(t, "Dispatch", fakedContestID, domain.ContenderWithdrewFromFinalsEvent{
+		mockedEventBroker.AssertCalled(t, "Dispatch", mock.Anything, fakedContestID, domain.ContenderWithdrewFromFinalsEvent{
 			ContenderID: fakedContenderID,
 		})
 
-		mockedEventBroker.AssertCalled(t, "Dispatch", fakedContestID, domain.ContenderDisqualifiedEvent{
+		mockedEventBroker.AssertCalled(t, "Dispatch", mock.Anything, fakedContestID, domain.ContenderDisqualifiedEvent{
 			ContenderID: fakedContenderID,
 		})
 
@@ -1108,6 +1590,7 @@ func TestPatchContender(t *testing.T) {
 		mockedScoreKeeper.AssertExpectations(t)
 		mockedEventBroker.AssertExpectations(t)
 		mockedRepo.AssertExpectations(t)
+		mockedTx.AssertExpectations(t)
 	})
 
 	t.Run("NameCannotBeChangedAfterScrubbed", func(t *testing.T) {
@@ -1151,7 +1634,7 @@ func TestPatchContender(t *testing.T) {
 
 		contender, err := ucase.PatchContender(context.Background(), fakedContenderID, domain.ContenderPatch{
 			Name: domain.NewPatch("John Doe"),
-		})
+		}, 0)
 
 		assert.ErrorIs(t, err, domain.ErrNotAllowed)
 		assert.Empty(t, contender)
@@ -1196,7 +1679,7 @@ func TestPatchContender(t *testing.T) {
 
 		contender, err := ucase.PatchContender(context.Background(), fakedContenderID, domain.ContenderPatch{
 			Name: domain.NewPatch(string(whitespaceCharacters)),
-		})
+		}, 0)
 
 		assert.ErrorIs(t, err, domain.ErrInvalidData)
 		assert.ErrorIs(t, err, domain.ErrEmptyName)
@@ -1265,7 +1748,7 @@ func TestPatchContender(t *testing.T) {
 
 		mockedScoreKeeper.On("GetScore", fakedContenderID).Return(domain.Score{}, errMock)
 
-		mockedEventBroker.On("Dispatch", fakedContestID, mock.Anything).Return()
+		mockedEventBroker.On("Dispatch", mock.Anything, fakedContestID, mock.Anything).Return()
 
 		ucase := usecases.ContenderUseCase{
 			Repo:        mockedRepo,
@@ -1276,12 +1759,12 @@ func TestPatchContender(t *testing.T) {
 
 		contender, err := ucase.PatchContender(context.Background(), fakedContenderID, domain.ContenderPatch{
 			WithdrawnFromFinals: domain.NewPatch(false),
-		})
+		}, 0)
 
 		require.NoError(t, err)
 		assert.Equal(t, false, contender.WithdrawnFromFinals)
 
-		mockedEventBroker.AssertCalled(t, "Dispatch", fakedContestID, domain.ContenderReenteredFinalsEvent{
+		mockedEventBroker.AssertCalled(t, "Dispatch", mock.Anything, fakedContestID, domain.ContenderReenteredFinalsEvent{
 			ContenderID: fakedContenderID,
 		})
 
@@ -1344,36 +1827,112 @@ func TestPatchContender(t *testing.T) {
 				Disqualified:        false,
 			}, nil)
 
-		mockedAuthorizer.
-			On("HasOwnership", mock.Anything, fakedOwnership).
-			Return(domain.OrganizerRole, nil)
+		mockedAuthorizer.
+			On("HasOwnership", mock.Anything, fakedOwnership).
+			Return(domain.OrganizerRole, nil)
+
+		mockedScoreKeeper.On("GetScore", fakedContenderID).Return(domain.Score{}, errMock)
+
+		mockedEventBroker.On("Dispatch", mock.Anything, fakedContestID, mock.Anything).Return()
+
+		ucase := usecases.ContenderUseCase{
+			Repo:        mockedRepo,
+			Authorizer:  mockedAuthorizer,
+			ScoreKeeper: mockedScoreKeeper,
+			EventBroker: mockedEventBroker,
+		}
+
+		contender, err := ucase.PatchContender(context.Background(), fakedContenderID, domain.ContenderPatch{
+			Disqualified: domain.NewPatch(false),
+		}, 0)
+
+		require.NoError(t, err)
+		assert.Equal(t, false, contender.Disqualified)
+
+		mockedEventBroker.AssertCalled(t, "Dispatch", mock.Anything, fakedContestID, domain.ContenderRequalifiedEvent{
+			ContenderID: fakedContenderID,
+		})
+
+		mockedAuthorizer.AssertExpectations(t)
+		mockedScoreKeeper.AssertExpectations(t)
+		mockedEventBroker.AssertExpectations(t)
+		mockedRepo.AssertExpectations(t)
+	})
+
+	t.Run("JoinWaitlistWhenCompClassFull", func(t *testing.T) {
+		synctest.Test(t, func(t *testing.T) {
+			mockedAuthorizer := new(authorizerMock)
+			mockedScoreKeeper := new(scoreKeeperMock)
+			mockedEventBroker := new(eventBrokerMock)
+
+			mockedAuthorizer.
+				On("HasOwnership", mock.Anything, fakedOwnership).
+				Return(domain.ContenderRole, nil)
+
+			fakedContender := domain.Contender{
+				ID:               fakedContenderID,
+				Ownership:        fakedOwnership,
+				ContestID:        fakedContestID,
+				RegistrationCode: "ABCD1234",
+			}
+
+			now := time.Now()
+
+			fakedFullCompClass := domain.CompClass{
+				ID:        fakedCompClassID,
+				TimeBegin: now.Add(-1 * time.Hour),
+				TimeEnd:   now.Add(time.Hour),
+				Capacity:  1,
+			}
+
+			mockedRepo := makeMockedRepo(fakedContender)
+
+			mockedTx := expectCompClassSwitch(mockedRepo)
+
+			mockedRepo.
+				On("GetCompClass", mock.Anything, mock.Anything, fakedCompClassID).
+				Return(fakedFullCompClass, nil)
+
+			mockedRepo.
+				On("GetContendersByCompClass", mock.Anything, mockedTx, fakedCompClassID).
+				Return([]domain.Contender{{}}, nil)
 
-		mockedScoreKeeper.On("GetScore", fakedContenderID).Return(domain.Score{}, errMock)
+			expected := domain.Contender{
+				ID:                  fakedContenderID,
+				Ownership:           fakedOwnership,
+				ContestID:           fakedContestID,
+				RegistrationCode:    "ABCD1234",
+				WaitlistCompClassID: fakedCompClassID,
+				WaitlistedAt:        now,
+			}
 
-		mockedEventBroker.On("Dispatch", fakedContestID, mock.Anything).Return()
+			mockedRepo.
+				On("StoreContender", mock.Anything, mockedTx, expected).
+				Return(expected, nil)
 
-		ucase := usecases.ContenderUseCase{
-			Repo:        mockedRepo,
-			Authorizer:  mockedAuthorizer,
-			ScoreKeeper: mockedScoreKeeper,
-			EventBroker: mockedEventBroker,
-		}
+			mockedScoreKeeper.On("GetScore", fakedContenderID).Return(domain.Score{}, errMock)
 
-		contender, err := ucase.PatchContender(context.Background(), fakedContenderID, domain.ContenderPatch{
-			Disqualified: domain.NewPatch(false),
-		})
+			ucase := usecases.ContenderUseCase{
+				Repo:        mockedRepo,
+				Authorizer:  mockedAuthorizer,
+				ScoreKeeper: mockedScoreKeeper,
+				EventBroker: mockedEventBroker,
+			}
 
-		require.NoError(t, err)
-		assert.Equal(t, false, contender.Disqualified)
+			contender, err := ucase.PatchContender(context.Background(), fakedContenderID, domain.ContenderPatch{
+				CompClassID: domain.NewPatch(fakedCompClassID),
+			}, 0)
 
-		mockedEventBroker.AssertCalled(t, "Dispatch", fakedContestID, domain.ContenderRequalifiedEvent{
-			ContenderID: fakedContenderID,
-		})
+			require.NoError(t, err)
+			assert.Equal(t, domain.CompClassID(0), contender.CompClassID)
+			assert.Equal(t, fakedCompClassID, contender.WaitlistCompClassID)
+			assert.Equal(t, now, contender.WaitlistedAt)
 
-		mockedAuthorizer.AssertExpectations(t)
-		mockedScoreKeeper.AssertExpectations(t)
-		mockedEventBroker.AssertExpectations(t)
-		mockedRepo.AssertExpectations(t)
+			mockedAuthorizer.AssertExpectations(t)
+			mockedEventBroker.AssertNotCalled(t, "Dispatch", mock.Anything, mock.Anything, mock.Anything)
+			mockedRepo.AssertExpectations(t)
+			mockedTx.AssertExpectations(t)
+		})
 	})
 
 	t.Run("CannotSwitchToAnEndedCompClass", func(t *testing.T) {
@@ -1422,7 +1981,7 @@ func TestPatchContender(t *testing.T) {
 
 		contender, err := ucase.PatchContender(context.Background(), fakedContenderID, domain.ContenderPatch{
 			CompClassID: domain.NewPatch(fakedOtherCompClass.ID),
-		})
+		}, 0)
 
 		assert.ErrorIs(t, err, domain.ErrContestEnded)
 		assert.Empty(t, contender)
@@ -1467,7 +2026,7 @@ func TestPatchContender(t *testing.T) {
 			Authorizer: mockedAuthorizer,
 		}
 
-		contender, err := ucase.PatchContender(context.Background(), fakedContenderID, domain.ContenderPatch{})
+		contender, err := ucase.PatchContender(context.Background(), fakedContenderID, domain.ContenderPatch{}, 0)
 		assert.ErrorIs(t, err, domain.ErrContestEnded)
 		assert.Empty(t, contender)
 
@@ -1486,7 +2045,7 @@ func TestPatchContender(t *testing.T) {
 
 		mockedScoreKeeper.On("GetScore", fakedContenderID).Return(domain.Score{}, errMock)
 
-		mockedEventBroker.On("Dispatch", fakedContestID, mock.Anything).Return()
+		mockedEventBroker.On("Dispatch", mock.Anything, fakedContestID, mock.Anything).Return()
 
 		fakedSecondCompClass := domain.CompClass{
 			ID:        testutils.RandomResourceID[domain.CompClassID](),
@@ -1514,6 +2073,8 @@ func TestPatchContender(t *testing.T) {
 
 		mockedRepo := makeMockedRepo(fakedContender)
 
+		mockedTx := expectCompClassSwitch(mockedRepo)
+
 		mockedRepo.
 			On("GetCompClass", mock.Anything, mock.Anything, fakedSecondCompClass.ID).
 			Return(fakedSecondCompClass, nil)
@@ -1523,7 +2084,7 @@ func TestPatchContender(t *testing.T) {
 			Return(fakedThirdCompClass, nil)
 
 		mockedRepo.
-			On("StoreContender", mock.Anything, mock.Anything,
+			On("StoreContender", mock.Anything, mockedTx,
 				domain.Contender{
 					ID:                  fakedContenderID,
 					Ownership:           fakedOwnership,
@@ -1557,7 +2118,7 @@ func TestPatchContender(t *testing.T) {
 
 		contender, err := ucase.PatchContender(context.Background(), fakedContenderID, domain.ContenderPatch{
 			CompClassID: domain.NewPatch(fakedThirdCompClass.ID),
-		})
+		}, 0)
 
 		require.NoError(t, err)
 		assert.Equal(t, fakedThirdCompClass.ID, contender.CompClassID)
@@ -1566,6 +2127,7 @@ func TestPatchContender(t *testing.T) {
 		mockedScoreKeeper.AssertExpectations(t)
 		mockedEventBroker.AssertExpectations(t)
 		mockedRepo.AssertExpectations(t)
+		mockedTx.AssertExpectations(t)
 	})
 
 	t.Run("BadCredentials", func(t *testing.T) {
@@ -1588,7 +2150,7 @@ func TestPatchContender(t *testing.T) {
 			Authorizer: mockedAuthorizer,
 		}
 
-		contender, err := ucase.PatchContender(context.Background(), fakedContenderID, domain.ContenderPatch{})
+		contender, err := ucase.PatchContender(context.Background(), fakedContenderID, domain.ContenderPatch{}, 0)
 
 		assert.ErrorIs(t, err, domain.ErrNoOwnership)
 		assert.Empty(t, contender)
@@ -1659,7 +2221,7 @@ func TestScrubContender(t *testing.T) {
 				}, nil)
 
 			mockedEventBroker.
-				On("Dispatch", fakedContestID, domain.ContenderPublicInfoUpdatedEvent{
+				On("Dispatch", mock.Anything, fakedContestID, domain.ContenderPublicInfoUpdatedEvent{
 					ContenderID:         fakedContenderID,
 					CompClassID:         fakedCompClassID,
 					Name:                "",
@@ -1670,7 +2232,7 @@ func TestScrubContender(t *testing.T) {
 				Return()
 
 			mockedEventBroker.
-				On("Dispatch", fakedContestID, domain.ContenderWithdrewFromFinalsEvent{
+				On("Dispatch", mock.Anything, fakedContestID, domain.ContenderWithdrewFromFinalsEvent{
 					ContenderID: fakedContenderID,
 				}).
 				Return()
@@ -1731,6 +2293,295 @@ func TestScrubContender(t *testing.T) {
 	})
 }
 
+func TestExportContenderData(t *testing.T) {
+	fakedContenderID := testutils.RandomResourceID[domain.ContenderID]()
+	fakedOwnership := domain.OwnershipData{
+		OrganizerID: testutils.RandomResourceID[domain.OrganizerID](),
+		ContenderID: &fakedContenderID,
+	}
+	fakedContestID := testutils.RandomResourceID[domain.ContestID]()
+
+	t.Run("HappyPath", func(t *testing.T) {
+		synctest.Test(t, func(t *testing.T) {
+			mockedAuthorizer := new(authorizerMock)
+			mockedRepo := new(repositoryMock)
+			mockedScoreKeeper := new(scoreKeeperMock)
+
+			fakedContender := domain.Contender{
+				ID:        fakedContenderID,
+				Ownership: fakedOwnership,
+				ContestID: fakedContestID,
+				Name:      "John Doe",
+			}
+
+			fakedScore := domain.Score{
+				ContenderID: fakedContenderID,
+				Score:       1000,
+				Placement:   1,
+			}
+
+			fakedTicks := []domain.Tick{
+				{
+					ID:        testutils.RandomResourceID[domain.TickID](),
+					ProblemID: testutils.RandomResourceID[domain.ProblemID](),
+					Top:       true,
+				},
+			}
+
+			fakedRevisions := []domain.TickRevision{
+				{
+					ID:          testutils.RandomResourceID[domain.TickRevisionID](),
+					ContestID:   fakedContestID,
+					ContenderID: fakedContenderID,
+					ProblemID:   fakedTicks[0].ProblemID,
+					TickID:      fakedTicks[0].ID,
+					Action:      domain.TickRevisionActionPut,
+					ActorRole:   domain.ContenderRole,
+					Top:         true,
+				},
+			}
+
+			fakedDisputes := []domain.TickDispute{
+				{
+					ID:          testutils.RandomResourceID[domain.TickDisputeID](),
+					ContestID:   fakedContestID,
+					ContenderID: fakedContenderID,
+					ProblemID:   fakedTicks[0].ProblemID,
+					Status:      domain.TickDisputeStatusOpen,
+					Comment:     "I flashed it",
+				},
+			}
+
+			fakedWinners := []domain.RaffleWinner{
+				{
+					ID:            testutils.RandomResourceID[domain.RaffleWinnerID](),
+					ContenderID:   fakedContenderID,
+					ContenderName: "John Doe",
+				},
+			}
+
+			mockedRepo.
+				On("GetContender", mock.Anything, nil, fakedContenderID).
+				Return(fakedContender, nil)
+
+			mockedAuthorizer.
+				On("HasOwnership", mock.Anything, fakedOwnership).
+				Return(domain.ContenderRole, nil)
+
+			mockedRepo.
+				On("GetTicksByContender", mock.Anything, nil, fakedContenderID).
+				Return(fakedTicks, nil)
+
+			mockedRepo.
+				On("GetTickRevisionsByContender", mock.Anything, nil, fakedContenderID).
+				Return(fakedRevisions, nil)
+
+			mockedRepo.
+				On("GetTickDisputesByContender", mock.Anything, nil, fakedContenderID).
+				Return(fakedDisputes, nil)
+
+			mockedRepo.
+				On("GetRaffleWinnersByContender", mock.Anything, nil, fakedContenderID).
+				Return(fakedWinners, nil)
+
+			mockedScoreKeeper.On("GetScore", fakedContenderID).Return(fakedScore, nil)
+
+			ucase := usecases.ContenderUseCase{
+				Repo:        mockedRepo,
+				Authorizer:  mockedAuthorizer,
+				ScoreKeeper: mockedScoreKeeper,
+			}
+
+			export, err := ucase.ExportContenderData(context.Background(), fakedContenderID)
+
+			require.NoError(t, err)
+			assert.Equal(t, fakedContenderID, export.Contender.ID)
+			assert.Equal(t, "John Doe", export.Contender.Name)
+			require.NotNil(t, export.Contender.Score)
+			assert.Equal(t, fakedScore, *export.Contender.Score)
+			assert.Equal(t, fakedTicks, export.Ticks)
+			assert.Equal(t, fakedRevisions, export.TickRevisions)
+			assert.Equal(t, fakedDisputes, export.TickDisputes)
+			assert.Equal(t, fakedWinners, export.RaffleWins)
+			assert.Equal(t, time.Now(), export.ExportedAt)
+
+			mockedAuthorizer.AssertExpectations(t)
+			mockedRepo.AssertExpectations(t)
+			mockedScoreKeeper.AssertExpectations(t)
+		})
+	})
+
+	t.Run("BadCredentials", func(t *testing.T) {
+		mockedAuthorizer := new(authorizerMock)
+		mockedRepo := new(repositoryMock)
+
+		mockedRepo.
+			On("GetContender", mock.Anything, nil, fakedContenderID).
+			Return(domain.Contender{ID: fakedContenderID, Ownership: fakedOwnership}, nil)
+
+		mockedAuthorizer.
+			On("HasOwnership", mock.Anything, fakedOwnership).
+			Return(domain.NilRole, domain.ErrNoOwnership)
+
+		ucase := usecases.ContenderUseCase{
+			Repo:       mockedRepo,
+			Authorizer: mockedAuthorizer,
+		}
+
+		export, err := ucase.ExportContenderData(context.Background(), fakedContenderID)
+
+		assert.ErrorIs(t, err, domain.ErrNoOwnership)
+		assert.Empty(t, export)
+
+		mockedRepo.AssertExpectations(t)
+		mockedAuthorizer.AssertExpectations(t)
+	})
+}
+
+func TestEraseContender(t *testing.T) {
+	fakedContenderID := testutils.RandomResourceID[domain.ContenderID]()
+	fakedOrganizerID := testutils.RandomResourceID[domain.OrganizerID]()
+	fakedOwnership := domain.OwnershipData{
+		OrganizerID: fakedOrganizerID,
+		ContenderID: &fakedContenderID,
+	}
+	fakedContestID := testutils.RandomResourceID[domain.ContestID]()
+	fakedCompClassID := testutils.RandomResourceID[domain.CompClassID]()
+
+	t.Run("HappyPath", func(t *testing.T) {
+		synctest.Test(t, func(t *testing.T) {
+			mockedAuthorizer := new(authorizerMock)
+			mockedRepo := new(repositoryMock)
+			mockedScoreKeeper := new(scoreKeeperMock)
+			mockedEventBroker := new(eventBrokerMock)
+			mockedTx := new(transactionMock)
+
+			fakedContender := domain.Contender{
+				ID:               fakedContenderID,
+				Ownership:        fakedOwnership,
+				ContestID:        fakedContestID,
+				CompClassID:      fakedCompClassID,
+				RegistrationCode: "ABCD1234",
+				Name:             "John Doe",
+			}
+
+			erased := domain.Contender{
+				ID:               fakedContenderID,
+				Ownership:        fakedOwnership,
+				ContestID:        fakedContestID,
+				CompClassID:      fakedCompClassID,
+				RegistrationCode: "ABCD1234",
+				ScrubbedAt:       time.Now(),
+			}
+
+			fakedWinner := domain.RaffleWinner{
+				ID:          testutils.RandomResourceID[domain.RaffleWinnerID](),
+				RaffleID:    testutils.RandomResourceID[domain.RaffleID](),
+				ContenderID: fakedContenderID,
+				PrizeName:   "Chalk bag",
+				ClaimedAt:   time.Now().Add(-1 * time.Hour),
+			}
+
+			mockedRepo.
+				On("GetContender", mock.Anything, nil, fakedContenderID).
+				Return(fakedContender, nil)
+
+			mockedAuthorizer.
+				On("HasOwnership", mock.Anything, fakedOwnership).
+				Return(domain.ContenderRole, nil)
+
+			mockedRepo.On("Begin").Return(mockedTx, nil)
+
+			mockedRepo.
+				On("StoreContender", mock.Anything, mockedTx, erased).
+				Return(erased, nil)
+
+			mockedRepo.
+				On("StoreAuditEntry", mock.Anything, mockedTx, domain.AuditEntry{
+					Ownership: domain.OwnershipData{
+						OrganizerID: fakedOrganizerID,
+					},
+					ContestID:   fakedContestID,
+					ContenderID: fakedContenderID,
+					Action:      domain.AuditActionContenderErased,
+					Timestamp:   time.Now(),
+				}).
+				Return(domain.AuditEntry{}, nil)
+
+			mockedRepo.
+				On("GetRaffleWinnersByContender", mock.Anything, mockedTx, fakedContenderID).
+				Return([]domain.RaffleWinner{fakedWinner}, nil)
+
+			mockedTx.On("Commit").Return(nil)
+			mockedTx.On("Rollback").Return()
+
+			mockedEventBroker.
+				On("Dispatch", mock.Anything, fakedContestID, domain.ContenderPublicInfoUpdatedEvent{
+					ContenderID: fakedContenderID,
+					CompClassID: fakedCompClassID,
+					ScrubbedAt:  time.Now(),
+				}).
+				Return()
+
+			mockedEventBroker.
+				On("Dispatch", mock.Anything, fakedContestID, domain.RaffleWinnerUpdatedEvent{
+					RaffleWinnerID: fakedWinner.ID,
+					RaffleID:       fakedWinner.RaffleID,
+					ContenderID:    fakedContenderID,
+					PrizeName:      "Chalk bag",
+					ClaimedAt:      fakedWinner.ClaimedAt,
+				}).
+				Return()
+
+			mockedScoreKeeper.On("GetScore", fakedContenderID).Return(domain.Score{}, domain.ErrNotFound)
+
+			ucase := usecases.ContenderUseCase{
+				Repo:        mockedRepo,
+				Authorizer:  mockedAuthorizer,
+				EventBroker: mockedEventBroker,
+				ScoreKeeper: mockedScoreKeeper,
+			}
+
+			contender, err := ucase.EraseContender(context.Background(), fakedContenderID)
+
+			require.NoError(t, err)
+			assert.Equal(t, "", contender.Name)
+			assert.Equal(t, time.Now(), contender.ScrubbedAt)
+
+			mockedAuthorizer.AssertExpectations(t)
+			mockedRepo.AssertExpectations(t)
+			mockedTx.AssertExpectations(t)
+			mockedEventBroker.AssertExpectations(t)
+		})
+	})
+
+	t.Run("BadCredentials", func(t *testing.T) {
+		mockedAuthorizer := new(authorizerMock)
+		mockedRepo := new(repositoryMock)
+
+		mockedRepo.
+			On("GetContender", mock.Anything, nil, fakedContenderID).
+			Return(domain.Contender{ID: fakedContenderID, Ownership: fakedOwnership}, nil)
+
+		mockedAuthorizer.
+			On("HasOwnership", mock.Anything, fakedOwnership).
+			Return(domain.NilRole, domain.ErrNoOwnership)
+
+		ucase := usecases.ContenderUseCase{
+			Repo:       mockedRepo,
+			Authorizer: mockedAuthorizer,
+		}
+
+		contender, err := ucase.EraseContender(context.Background(), fakedContenderID)
+
+		assert.ErrorIs(t, err, domain.ErrNoOwnership)
+		assert.Empty(t, contender)
+
+		mockedRepo.AssertExpectations(t)
+		mockedAuthorizer.AssertExpectations(t)
+	})
+}
+
 func TestScrubContenders(t *testing.T) {
 	fakedContestID := testutils.RandomResourceID[domain.ContestID]()
 	fakedDeadline := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
@@ -1789,14 +2640,14 @@ func TestScrubContenders(t *testing.T) {
 			mockedTx.On("Rollback").Return()
 
 			mockedEventBroker.
-				On("Dispatch", fakedContestID, domain.ContenderPublicInfoUpdatedEvent{
+				On("Dispatch", mock.Anything, fakedContestID, domain.ContenderPublicInfoUpdatedEvent{
 					ContenderID: fakedContenders[0].ID,
 					CompClassID: fakedContenders[0].CompClassID,
 					ScrubbedAt:  time.Now(),
 				}).Return()
 
 			mockedEventBroker.
-				On("Dispatch", fakedContestID, domain.ContenderPublicInfoUpdatedEvent{
+				On("Dispatch", mock.Anything, fakedContestID, domain.ContenderPublicInfoUpdatedEvent{
 					ContenderID:         fakedContenders[1].ID,
 					CompClassID:         fakedContenders[1].CompClassID,
 					WithdrawnFromFinals: true,
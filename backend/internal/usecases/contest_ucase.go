@@ -2,10 +2,12 @@ package usecases
 
 import (
 	"context"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/climblive/platform/backend/internal/domain"
+	"github.com/climblive/platform/backend/internal/scores"
 	"github.com/climblive/platform/backend/internal/usecases/validators"
 	"github.com/go-errors/errors"
 	"github.com/microcosm-cc/bluemonday"
@@ -17,8 +19,10 @@ type contestUseCaseRepository interface {
 	domain.Transactor
 
 	GetContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) (domain.Contest, error)
-	GetAllContests(ctx context.Context, tx domain.Transaction) ([]domain.Contest, error)
+	GetContestVersionForUpdate(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) (int, error)
+	GetContests(ctx context.Context, tx domain.Transaction, filter domain.ContestFilter, page domain.PageRequest) ([]domain.Contest, error)
 	GetContendersByContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) ([]domain.Contender, error)
+	GetPublishedScoresByContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) ([]domain.Score, error)
 	GetContestsByOrganizer(ctx context.Context, tx domain.Transaction, organizerID domain.OrganizerID) ([]domain.Contest, error)
 	GetOrganizer(ctx context.Context, tx domain.Transaction, organizerID domain.OrganizerID) (domain.Organizer, error)
 	StoreContest(ctx context.Context, tx domain.Transaction, contest domain.Contest) (domain.Contest, error)
@@ -37,10 +41,22 @@ type contestUseCaseRepository interface {
 	GetRaffleWinners(ctx context.Context, tx domain.Transaction, raffleID domain.RaffleID) ([]domain.RaffleWinner, error)
 	DeleteRaffleWinner(ctx context.Context, tx domain.Transaction, raffleWinnerID domain.RaffleWinnerID) error
 	StoreRaffleWinner(ctx context.Context, tx domain.Transaction, winner domain.RaffleWinner) (domain.RaffleWinner, error)
+	GetRafflePrizes(ctx context.Context, tx domain.Transaction, raffleID domain.RaffleID) ([]domain.RafflePrize, error)
+	DeleteRafflePrize(ctx context.Context, tx domain.Transaction, prizeID domain.RafflePrizeID) error
+	StoreRafflePrize(ctx context.Context, tx domain.Transaction, prize domain.RafflePrize) (domain.RafflePrize, error)
 	GetTicksByContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) ([]domain.Tick, error)
 	DeleteTick(ctx context.Context, tx domain.Transaction, tickID domain.TickID) error
 	StoreTick(ctx context.Context, tx domain.Transaction, tick domain.Tick) (domain.Tick, error)
 	StoreScore(ctx context.Context, tx domain.Transaction, score domain.Score) error
+	GetRoundsByContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) ([]domain.Round, error)
+	StoreRound(ctx context.Context, tx domain.Transaction, round domain.Round) (domain.Round, error)
+	DeleteRound(ctx context.Context, tx domain.Transaction, roundID domain.RoundID) error
+	GetStartList(ctx context.Context, tx domain.Transaction, roundID domain.RoundID) ([]domain.StartListEntry, error)
+	StoreStartListEntry(ctx context.Context, tx domain.Transaction, entry domain.StartListEntry) error
+	GetTeamsByContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) ([]domain.Team, error)
+	StoreTeam(ctx context.Context, tx domain.Transaction, team domain.Team) (domain.Team, error)
+	DeleteTeam(ctx context.Context, tx domain.Transaction, teamID domain.TeamID) error
+	GetTickDisputesByContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) ([]domain.TickDispute, error)
 }
 
 type ContestUseCase struct {
@@ -62,24 +78,26 @@ func (uc *ContestUseCase) GetContest(ctx context.Context, contestID domain.Conte
 	return contest, nil
 }
 
-func (uc *ContestUseCase) GetAllContests(ctx context.Context) ([]domain.Contest, error) {
+func (uc *ContestUseCase) GetAllContests(ctx context.Context, filter domain.ContestFilter, page domain.PageRequest) (domain.Page[domain.Contest], error) {
 	var role domain.AuthRole
 	var err error
 
 	if role, err = uc.Authorizer.HasOwnership(ctx, domain.OwnershipData{}); err != nil {
-		return nil, errors.Wrap(err, 0)
+		return domain.Page[domain.Contest]{}, errors.Wrap(err, 0)
 	}
 
 	if role != domain.AdminRole {
-		return nil, domain.ErrNotAuthorized
+		return domain.Page[domain.Contest]{}, domain.ErrNotAuthorized
 	}
 
-	contests, err := uc.Repo.GetAllContests(ctx, nil)
+	contests, err := uc.Repo.GetContests(ctx, nil, filter, lookAhead(page))
 	if err != nil {
-		return nil, errors.Wrap(err, 0)
+		return domain.Page[domain.Contest]{}, errors.Wrap(err, 0)
 	}
 
-	return contests, nil
+	return toPage(contests, page, func(contest domain.Contest) domain.ResourceID {
+		return domain.ResourceID(contest.ID)
+	}), nil
 }
 
 func (uc *ContestUseCase) GetContestsByOrganizer(ctx context.Context, organizerID domain.OrganizerID) ([]domain.Contest, error) {
@@ -101,11 +119,37 @@ func (uc *ContestUseCase) GetContestsByOrganizer(ctx context.Context, organizerI
 }
 
 func (uc *ContestUseCase) GetScoreboard(ctx context.Context, contestID domain.ContestID) ([]domain.ScoreboardEntry, error) {
+	contest, err := uc.Repo.GetContest(ctx, nil, contestID)
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	frozen := contest.ScoreboardFrozen(time.Now())
+
+	if frozen {
+		if _, err := uc.Authorizer.HasOwnership(ctx, contest.Ownership); err == nil {
+			frozen = false
+		}
+	}
+
 	contenders, err := uc.Repo.GetContendersByContest(ctx, nil, contestID)
 	if err != nil {
 		return nil, errors.Wrap(err, 0)
 	}
 
+	publishedScores := make(map[domain.ContenderID]domain.Score)
+
+	if frozen {
+		scores, err := uc.Repo.GetPublishedScoresByContest(ctx, nil, contestID)
+		if err != nil {
+			return nil, errors.Wrap(err, 0)
+		}
+
+		for _, score := range scores {
+			publishedScores[score.ContenderID] = score
+		}
+	}
+
 	entries := make([]domain.ScoreboardEntry, 0)
 
 	for _, contender := range contenders {
@@ -116,6 +160,7 @@ func (uc *ContestUseCase) GetScoreboard(ctx context.Context, contestID domain.Co
 		entry := domain.ScoreboardEntry{
 			ContenderID:         contender.ID,
 			CompClassID:         contender.CompClassID,
+			TeamID:              contender.TeamID,
 			Name:                contender.Name,
 			WithdrawnFromFinals: contender.WithdrawnFromFinals,
 			Disqualified:        contender.Disqualified,
@@ -123,6 +168,26 @@ func (uc *ContestUseCase) GetScoreboard(ctx context.Context, contestID domain.Co
 			Score:               contender.Score,
 		}
 
+		if frozen {
+			entry.Score = nil
+
+			if score := contender.Score; score != nil && score.Timestamp.Before(contest.ScoreboardFrozenFrom()) {
+				entry.Score = score
+			}
+
+			if score, found := publishedScores[contender.ID]; found {
+				entry.Score = &score
+			}
+
+			if score, err := uc.ScoreKeeper.GetPublishedScore(contender.ID); err == nil {
+				entry.Score = &score
+			}
+
+			entries = append(entries, entry)
+
+			continue
+		}
+
 		if score, err := uc.ScoreKeeper.GetScore(contender.ID); err == nil {
 			entry.Score = &score
 		}
@@ -133,7 +198,72 @@ func (uc *ContestUseCase) GetScoreboard(ctx context.Context, contestID domain.Co
 	return entries, nil
 }
 
-func (uc *ContestUseCase) PatchContest(ctx context.Context, contestID domain.ContestID, patch domain.ContestPatch) (domain.Contest, error) {
+func (uc *ContestUseCase) GetTeamScoreboard(ctx context.Context, contestID domain.ContestID) ([]domain.TeamScoreboardEntry, error) {
+	scoreboard, err := uc.GetScoreboard(ctx, contestID)
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	teams, err := uc.Repo.GetTeamsByContest(ctx, nil, contestID)
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	entries := make([]domain.TeamScoreboardEntry, 0)
+	rankedTeams := make(map[domain.TeamID]*scores.Team)
+
+	for _, team := range teams {
+		entries = append(entries, domain.TeamScoreboardEntry{
+			TeamID:  team.ID,
+			Name:    team.Name,
+			Members: make([]domain.ContenderID, 0),
+			Score:   nil,
+		})
+
+		rankedTeams[team.ID] = &scores.Team{
+			ID:             team.ID,
+			CountedMembers: team.CountedMembers,
+			MemberScores:   nil,
+		}
+	}
+
+	for _, entry := range scoreboard {
+		rankedTeam, found := rankedTeams[entry.TeamID]
+		if !found {
+			continue
+		}
+
+		index := slices.IndexFunc(entries, func(teamEntry domain.TeamScoreboardEntry) bool {
+			return teamEntry.TeamID == entry.TeamID
+		})
+
+		entries[index].Members = append(entries[index].Members, entry.ContenderID)
+
+		if entry.Score != nil {
+			rankedTeam.MemberScores = append(rankedTeam.MemberScores, entry.Score.Score)
+		}
+	}
+
+	teamValues := func(yield func(scores.Team) bool) {
+		for _, team := range rankedTeams {
+			if !yield(*team) {
+				return
+			}
+		}
+	}
+
+	for _, score := range scores.NewTeamRanker().RankTeams(teamValues) {
+		index := slices.IndexFunc(entries, func(teamEntry domain.TeamScoreboardEntry) bool {
+			return teamEntry.TeamID == score.TeamID
+		})
+
+		entries[index].Score = &score
+	}
+
+	return entries, nil
+}
+
+func (uc *ContestUseCase) PatchContest(ctx context.Context, contestID domain.ContestID, patch domain.ContestPatch, expectedVersion int) (domain.Contest, error) {
 	var mty domain.Contest
 
 	contest, err := uc.Repo.GetContest(ctx, nil, contestID)
@@ -146,15 +276,22 @@ func (uc *ContestUseCase) PatchContest(ctx context.Context, contestID domain.Con
 		return mty, errors.Wrap(err, 0)
 	}
 
+	if err := checkVersion(expectedVersion, contest.Version); err != nil {
+		return mty, err
+	}
+
 	if !contest.ArchivedAt.IsZero() {
 		return mty, errors.Wrap(domain.ErrArchived, 0)
 	}
 
 	rulesUpdateEventBaseline := domain.RulesUpdatedEvent{
+		RoundID:            0,
 		QualifyingProblems: contest.QualifyingProblems,
 		Finalists:          contest.Finalists,
 	}
 
+	frozenFromBaseline := contest.ScoreboardFrozenFrom()
+
 	if patch.Location.Present {
 		contest.Location = strings.TrimSpace(patch.Location.Value)
 	}
@@ -191,26 +328,92 @@ func (uc *ContestUseCase) PatchContest(ctx context.Context, contestID domain.Con
 		contest.GracePeriod = patch.GracePeriod.Value
 	}
 
+	if patch.ScoreboardFreeze.Present {
+		contest.ScoreboardFreeze = patch.ScoreboardFreeze.Value
+	}
+
+	if patch.SelfRegistration.Present {
+		contest.SelfRegistration = patch.SelfRegistration.Value
+	}
+
 	if err := (validators.ContestValidator{}).Validate(contest); err != nil {
 		return mty, errors.Wrap(err, 0)
 	}
 
-	if _, err = uc.Repo.StoreContest(ctx, nil, contest); err != nil {
+	stored, err := storeVersioned(ctx, uc.Repo, expectedVersion,
+		func(ctx context.Context, tx domain.Transaction) (int, error) {
+			return uc.Repo.GetContestVersionForUpdate(ctx, tx, contestID)
+		},
+		func(ctx context.Context, tx domain.Transaction) (domain.Contest, error) {
+			return uc.Repo.StoreContest(ctx, tx, contest)
+		})
+	if err != nil {
 		return mty, errors.Wrap(err, 0)
 	}
 
+	contest.Version = stored.Version
+
 	event := domain.RulesUpdatedEvent{
+		RoundID:            0,
 		QualifyingProblems: contest.QualifyingProblems,
 		Finalists:          contest.Finalists,
 	}
 
 	if event != rulesUpdateEventBaseline {
-		uc.EventBroker.Dispatch(contestID, event)
+		uc.EventBroker.Dispatch(ctx, contestID, event)
+	}
+
+	if frozenFrom := contest.ScoreboardFrozenFrom(); !frozenFrom.Equal(frozenFromBaseline) {
+		uc.EventBroker.Dispatch(ctx, contestID, domain.ScoreboardFreezeUpdatedEvent{
+			FrozenFrom: frozenFrom,
+		})
 	}
 
 	return contest, nil
 }
 
+func (uc *ContestUseCase) AuthorizeLiveScores(ctx context.Context, contestID domain.ContestID) error {
+	contest, err := uc.Repo.GetContest(ctx, nil, contestID)
+	if err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	if _, err := uc.Authorizer.HasOwnership(ctx, contest.Ownership); err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	return nil
+}
+
+func (uc *ContestUseCase) RevealScoreboard(ctx context.Context, contestID domain.ContestID) (domain.Contest, error) {
+	contest, err := uc.Repo.GetContest(ctx, nil, contestID)
+	if err != nil {
+		return domain.Contest{}, errors.Wrap(err, 0)
+	}
+
+	if _, err = uc.Authorizer.HasOwnership(ctx, contest.Ownership); err != nil {
+		return domain.Contest{}, errors.Wrap(err, 0)
+	}
+
+	now := time.Now()
+
+	if !contest.ScoreboardFrozen(now) {
+		return domain.Contest{}, errors.Wrap(domain.ErrNotAllowed, 0)
+	}
+
+	contest.ScoreboardRevealedAt = now
+
+	if _, err = uc.Repo.StoreContest(ctx, nil, contest); err != nil {
+		return domain.Contest{}, errors.Wrap(err, 0)
+	}
+
+	uc.EventBroker.Dispatch(ctx, contestID, domain.ScoreboardRevealedEvent{
+		Timestamp: now,
+	})
+
+	return contest, nil
+}
+
 func (uc *ContestUseCase) ArchiveContest(ctx context.Context, contestID domain.ContestID) (domain.Contest, error) {
 	contest, err := uc.Repo.GetContest(ctx, nil, contestID)
 	if err != nil {
@@ -221,6 +424,17 @@ func (uc *ContestUseCase) ArchiveContest(ctx context.Context, contestID domain.C
 		return domain.Contest{}, errors.Wrap(err, 0)
 	}
 
+	disputes, err := uc.Repo.GetTickDisputesByContest(ctx, nil, contestID)
+	if err != nil {
+		return domain.Contest{}, errors.Wrap(err, 0)
+	}
+
+	if slices.ContainsFunc(disputes, func(dispute domain.TickDispute) bool {
+		return dispute.Status == domain.TickDisputeStatusOpen
+	}) {
+		return domain.Contest{}, errors.Wrap(domain.ErrNotAllowed, 0)
+	}
+
 	contest.ArchivedAt = time.Now()
 
 	engines, err := uc.ScoreEngineManager.ListScoreEnginesByContest(ctx, contestID)
@@ -312,7 +526,11 @@ func (uc *ContestUseCase) CreateContest(ctx context.Context, organizerID domain.
 		Info:                 sanitizationPolicy.Sanitize(tmpl.Info),
 		GracePeriod:          tmpl.GracePeriod,
 		NameRetentionTime:    tmpl.NameRetentionTime,
+		ScoreboardFreeze:     tmpl.ScoreboardFreeze,
+		ScoreboardRevealedAt: time.Time{},
+		SelfRegistration:     false,
 		Created:              time.Now(),
+		Version:              0,
 	}
 
 	if err := (validators.ContestValidator{}).Validate(contest); err != nil {
@@ -351,9 +569,15 @@ func (uc *ContestUseCase) DuplicateContest(ctx context.Context, contestID domain
 		return domain.Contest{}, errors.Wrap(err, 0)
 	}
 
+	rounds, err := uc.Repo.GetRoundsByContest(ctx, nil, contestID)
+	if err != nil {
+		return domain.Contest{}, errors.Wrap(err, 0)
+	}
+
 	duplicatedContest := contest
 	duplicatedContest.ID = 0
 	duplicatedContest.Name += " (Copy)"
+	duplicatedContest.ScoreboardRevealedAt = time.Time{}
 
 	tx, err := uc.Repo.Begin()
 	if err != nil {
@@ -366,19 +590,49 @@ func (uc *ContestUseCase) DuplicateContest(ctx context.Context, contestID domain
 			return domain.Contest{}, err
 		}
 
+		compClassIDs := make(map[domain.CompClassID]domain.CompClassID)
+
 		for _, compClass := range compClasses {
+			originalCompClassID := compClass.ID
+
 			compClass.ID = 0
 			compClass.ContestID = createdContest.ID
 
-			_, err = uc.Repo.StoreCompClass(ctx, tx, compClass)
+			createdCompClass, err := uc.Repo.StoreCompClass(ctx, tx, compClass)
+			if err != nil {
+				return domain.Contest{}, err
+			}
+
+			compClassIDs[originalCompClassID] = createdCompClass.ID
+		}
+
+		roundIDs := make(map[domain.RoundID]domain.RoundID)
+
+		for _, round := range rounds {
+			originalRoundID := round.ID
+
+			round.ID = 0
+			round.ContestID = createdContest.ID
+
+			createdRound, err := uc.Repo.StoreRound(ctx, tx, round)
 			if err != nil {
 				return domain.Contest{}, err
 			}
+
+			roundIDs[originalRoundID] = createdRound.ID
 		}
 
 		for _, problem := range problems {
 			problem.ID = 0
 			problem.ContestID = createdContest.ID
+			problem.RoundID = roundIDs[problem.RoundID]
+
+			availability := make([]domain.CompClassID, 0, len(problem.CompClassIDs))
+			for _, compClassID := range problem.CompClassIDs {
+				availability = append(availability, compClassIDs[compClassID])
+			}
+
+			problem.CompClassIDs = availability
 
 			_, err = uc.Repo.StoreProblem(ctx, tx, problem)
 			if err != nil {
@@ -447,6 +701,7 @@ func (uc *ContestUseCase) TransferContest(ctx context.Context, contestID domain.
 	}
 
 	allRaffleWinners := make([]domain.RaffleWinner, 0)
+	allRafflePrizes := make([]domain.RafflePrize, 0)
 
 	for _, raffle := range raffles {
 		winners, err := uc.Repo.GetRaffleWinners(ctx, nil, raffle.ID)
@@ -455,6 +710,13 @@ func (uc *ContestUseCase) TransferContest(ctx context.Context, contestID domain.
 		}
 
 		allRaffleWinners = append(allRaffleWinners, winners...)
+
+		prizes, err := uc.Repo.GetRafflePrizes(ctx, nil, raffle.ID)
+		if err != nil {
+			return domain.Contest{}, errors.Wrap(err, 0)
+		}
+
+		allRafflePrizes = append(allRafflePrizes, prizes...)
 	}
 
 	ticks, err := uc.Repo.GetTicksByContest(ctx, nil, contestID)
@@ -462,6 +724,27 @@ func (uc *ContestUseCase) TransferContest(ctx context.Context, contestID domain.
 		return domain.Contest{}, errors.Wrap(err, 0)
 	}
 
+	rounds, err := uc.Repo.GetRoundsByContest(ctx, nil, contestID)
+	if err != nil {
+		return domain.Contest{}, errors.Wrap(err, 0)
+	}
+
+	allStartListEntries := make([]domain.StartListEntry, 0)
+
+	for _, round := range rounds {
+		entries, err := uc.Repo.GetStartList(ctx, nil, round.ID)
+		if err != nil {
+			return domain.Contest{}, errors.Wrap(err, 0)
+		}
+
+		allStartListEntries = append(allStartListEntries, entries...)
+	}
+
+	teams, err := uc.Repo.GetTeamsByContest(ctx, nil, contestID)
+	if err != nil {
+		return domain.Contest{}, errors.Wrap(err, 0)
+	}
+
 	tx, err := uc.Repo.Begin()
 	if err != nil {
 		return domain.Contest{}, errors.Wrap(err, 0)
@@ -482,6 +765,13 @@ func (uc *ContestUseCase) TransferContest(ctx context.Context, contestID domain.
 			}
 		}
 
+		for _, prize := range allRafflePrizes {
+			err = uc.Repo.DeleteRafflePrize(ctx, tx, prize.ID)
+			if err != nil {
+				return err
+			}
+		}
+
 		for _, raffle := range raffles {
 			err = uc.Repo.DeleteRaffle(ctx, tx, raffle.ID)
 			if err != nil {
@@ -496,6 +786,13 @@ func (uc *ContestUseCase) TransferContest(ctx context.Context, contestID domain.
 			}
 		}
 
+		for _, team := range teams {
+			err = uc.Repo.DeleteTeam(ctx, tx, team.ID)
+			if err != nil {
+				return err
+			}
+		}
+
 		for _, problem := range problems {
 			err = uc.Repo.DeleteProblem(ctx, tx, problem.ID)
 			if err != nil {
@@ -503,6 +800,13 @@ func (uc *ContestUseCase) TransferContest(ctx context.Context, contestID domain.
 			}
 		}
 
+		for _, round := range rounds {
+			err = uc.Repo.DeleteRound(ctx, tx, round.ID)
+			if err != nil {
+				return err
+			}
+		}
+
 		for _, compClass := range compClasses {
 			err = uc.Repo.DeleteCompClass(ctx, tx, compClass.ID)
 			if err != nil {
@@ -531,6 +835,13 @@ func (uc *ContestUseCase) TransferContest(ctx context.Context, contestID domain.
 			}
 		}
 
+		for _, round := range rounds {
+			_, err = uc.Repo.StoreRound(ctx, tx, round)
+			if err != nil {
+				return err
+			}
+		}
+
 		for _, problem := range problems {
 			_, err = uc.Repo.StoreProblem(ctx, tx, problem)
 			if err != nil {
@@ -538,6 +849,13 @@ func (uc *ContestUseCase) TransferContest(ctx context.Context, contestID domain.
 			}
 		}
 
+		for _, team := range teams {
+			_, err = uc.Repo.StoreTeam(ctx, tx, team)
+			if err != nil {
+				return err
+			}
+		}
+
 		for _, contender := range contenders {
 			_, err = uc.Repo.StoreContender(ctx, tx, contender)
 			if err != nil {
@@ -555,6 +873,13 @@ func (uc *ContestUseCase) TransferContest(ctx context.Context, contestID domain.
 			}
 		}
 
+		for _, entry := range allStartListEntries {
+			err = uc.Repo.StoreStartListEntry(ctx, tx, entry)
+			if err != nil {
+				return err
+			}
+		}
+
 		for _, raffle := range raffles {
 			_, err = uc.Repo.StoreRaffle(ctx, tx, raffle)
 			if err != nil {
@@ -562,6 +887,13 @@ func (uc *ContestUseCase) TransferContest(ctx context.Context, contestID domain.
 			}
 		}
 
+		for _, prize := range allRafflePrizes {
+			_, err = uc.Repo.StoreRafflePrize(ctx, tx, prize)
+			if err != nil {
+				return err
+			}
+		}
+
 		for _, winner := range allRaffleWinners {
 			_, err = uc.Repo.StoreRaffleWinner(ctx, tx, winner)
 			if err != nil {
@@ -591,10 +923,18 @@ func (uc *ContestUseCase) TransferContest(ctx context.Context, contestID domain.
 		compClasses[index].Ownership.OrganizerID = newOrganizerID
 	}
 
+	for index := range rounds {
+		rounds[index].Ownership.OrganizerID = newOrganizerID
+	}
+
 	for index := range problems {
 		problems[index].Ownership.OrganizerID = newOrganizerID
 	}
 
+	for index := range teams {
+		teams[index].Ownership.OrganizerID = newOrganizerID
+	}
+
 	for index := range contenders {
 		contenders[index].Ownership.OrganizerID = newOrganizerID
 	}
@@ -603,6 +943,10 @@ func (uc *ContestUseCase) TransferContest(ctx context.Context, contestID domain.
 		raffles[index].Ownership.OrganizerID = newOrganizerID
 	}
 
+	for index := range allRafflePrizes {
+		allRafflePrizes[index].Ownership.OrganizerID = newOrganizerID
+	}
+
 	for index := range allRaffleWinners {
 		allRaffleWinners[index].Ownership.OrganizerID = newOrganizerID
 	}
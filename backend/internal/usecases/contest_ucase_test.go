@@ -78,6 +78,10 @@ func TestGetScoreboard(t *testing.T) {
 		contenders = append(contenders, fakedContender)
 	}
 
+	mockedRepo.
+		On("GetContest", mock.Anything, nil, fakedContestID).
+		Return(domain.Contest{ID: fakedContestID}, nil)
+
 	mockedRepo.
 		On("GetContendersByContest", mock.Anything, mock.Anything, fakedContestID).
 		Return(contenders, nil)
@@ -145,6 +149,10 @@ func TestGetScoreboard_Empty(t *testing.T) {
 
 	mockedRepo := new(repositoryMock)
 
+	mockedRepo.
+		On("GetContest", mock.Anything, nil, fakedContestID).
+		Return(domain.Contest{ID: fakedContestID}, nil)
+
 	mockedRepo.
 		On("GetContendersByContest", mock.Anything, mock.Anything, fakedContestID).
 		Return([]domain.Contender{}, nil)
@@ -162,6 +170,309 @@ func TestGetScoreboard_Empty(t *testing.T) {
 	mockedRepo.AssertExpectations(t)
 }
 
+func TestGetScoreboard_Frozen(t *testing.T) {
+	fakedContestID := testutils.RandomResourceID[domain.ContestID]()
+	fakedCompClassID := testutils.RandomResourceID[domain.CompClassID]()
+	fakedOwnership := domain.OwnershipData{
+		OrganizerID: testutils.RandomResourceID[domain.OrganizerID](),
+	}
+
+	now := time.Now()
+	frozenFrom := now.Add(-5 * time.Minute)
+
+	fakedContest := domain.Contest{
+		ID:               fakedContestID,
+		Ownership:        fakedOwnership,
+		TimeEnd:          now.Add(10 * time.Minute),
+		ScoreboardFreeze: 15 * time.Minute,
+	}
+
+	preFreezeScore := domain.Score{
+		Timestamp:   frozenFrom.Add(-time.Minute),
+		ContenderID: 1,
+		Score:       100,
+		Placement:   1,
+	}
+
+	postFreezeScore := domain.Score{
+		Timestamp:   frozenFrom.Add(time.Minute),
+		ContenderID: 2,
+		Score:       200,
+		Placement:   1,
+	}
+
+	publishedScore := domain.Score{
+		Timestamp:   frozenFrom.Add(-2 * time.Minute),
+		ContenderID: 3,
+		Score:       50,
+		Placement:   2,
+	}
+
+	liveScore := domain.Score{
+		Timestamp:   now,
+		ContenderID: 3,
+		Score:       500,
+		Placement:   1,
+	}
+
+	persistedScore := domain.Score{
+		Timestamp:   frozenFrom.Add(-3 * time.Minute),
+		ContenderID: 2,
+		Score:       150,
+		Placement:   2,
+	}
+
+	makeMocks := func() (*repositoryMock, *authorizerMock, *scoreKeeperMock) {
+		mockedRepo := new(repositoryMock)
+		mockedAuthorizer := new(authorizerMock)
+		mockedScoreKeeper := new(scoreKeeperMock)
+
+		mockedRepo.
+			On("GetContest", mock.Anything, nil, fakedContestID).
+			Return(fakedContest, nil)
+
+		mockedRepo.
+			On("GetContendersByContest", mock.Anything, nil, fakedContestID).
+			Return([]domain.Contender{
+				{ID: 1, CompClassID: fakedCompClassID, Score: &preFreezeScore},
+				{ID: 2, CompClassID: fakedCompClassID, Score: &postFreezeScore},
+				{ID: 3, CompClassID: fakedCompClassID},
+			}, nil)
+
+		return mockedRepo, mockedAuthorizer, mockedScoreKeeper
+	}
+
+	t.Run("Public", func(t *testing.T) {
+		mockedRepo, mockedAuthorizer, mockedScoreKeeper := makeMocks()
+
+		mockedAuthorizer.
+			On("HasOwnership", mock.Anything, fakedOwnership).
+			Return(domain.NilRole, domain.ErrNotAuthenticated)
+
+		mockedRepo.
+			On("GetPublishedScoresByContest", mock.Anything, nil, fakedContestID).
+			Return([]domain.Score{persistedScore}, nil)
+
+		mockedScoreKeeper.On("GetPublishedScore", domain.ContenderID(3)).Return(publishedScore, nil)
+		mockedScoreKeeper.On("GetPublishedScore", mock.Anything).Return(domain.Score{}, domain.ErrNotFound)
+
+		ucase := usecases.ContestUseCase{
+			Repo:        mockedRepo,
+			Authorizer:  mockedAuthorizer,
+			ScoreKeeper: mockedScoreKeeper,
+		}
+
+		scoreboard, err := ucase.GetScoreboard(context.Background(), fakedContestID)
+
+		require.NoError(t, err)
+		require.Len(t, scoreboard, 3)
+
+		assert.Equal(t, &preFreezeScore, scoreboard[0].Score)
+		assert.Equal(t, &persistedScore, scoreboard[1].Score)
+		assert.Equal(t, &publishedScore, scoreboard[2].Score)
+
+		mockedRepo.AssertExpectations(t)
+		mockedAuthorizer.AssertExpectations(t)
+		mockedScoreKeeper.AssertExpectations(t)
+	})
+
+	t.Run("Organizer", func(t *testing.T) {
+		mockedRepo, mockedAuthorizer, mockedScoreKeeper := makeMocks()
+
+		mockedAuthorizer.
+			On("HasOwnership", mock.Anything, fakedOwnership).
+			Return(domain.OrganizerRole, nil)
+
+		mockedScoreKeeper.On("GetScore", domain.ContenderID(3)).Return(liveScore, nil)
+		mockedScoreKeeper.On("GetScore", mock.Anything).Return(domain.Score{}, domain.ErrNotFound)
+
+		ucase := usecases.ContestUseCase{
+			Repo:        mockedRepo,
+			Authorizer:  mockedAuthorizer,
+			ScoreKeeper: mockedScoreKeeper,
+		}
+
+		scoreboard, err := ucase.GetScoreboard(context.Background(), fakedContestID)
+
+		require.NoError(t, err)
+		require.Len(t, scoreboard, 3)
+
+		assert.Equal(t, &preFreezeScore, scoreboard[0].Score)
+		assert.Equal(t, &postFreezeScore, scoreboard[1].Score)
+		assert.Equal(t, &liveScore, scoreboard[2].Score)
+
+		mockedRepo.AssertExpectations(t)
+		mockedAuthorizer.AssertExpectations(t)
+		mockedScoreKeeper.AssertExpectations(t)
+	})
+}
+
+func TestAuthorizeLiveScores(t *testing.T) {
+	fakedContestID := testutils.RandomResourceID[domain.ContestID]()
+	fakedOwnership := domain.OwnershipData{
+		OrganizerID: testutils.RandomResourceID[domain.OrganizerID](),
+	}
+
+	makeMocks := func() (*repositoryMock, *authorizerMock) {
+		mockedRepo := new(repositoryMock)
+		mockedAuthorizer := new(authorizerMock)
+
+		mockedRepo.
+			On("GetContest", mock.Anything, nil, fakedContestID).
+			Return(domain.Contest{ID: fakedContestID, Ownership: fakedOwnership}, nil)
+
+		return mockedRepo, mockedAuthorizer
+	}
+
+	t.Run("Organizer", func(t *testing.T) {
+		mockedRepo, mockedAuthorizer := makeMocks()
+
+		mockedAuthorizer.
+			On("HasOwnership", mock.Anything, fakedOwnership).
+			Return(domain.OrganizerRole, nil)
+
+		ucase := usecases.ContestUseCase{
+			Repo:       mockedRepo,
+			Authorizer: mockedAuthorizer,
+		}
+
+		err := ucase.AuthorizeLiveScores(context.Background(), fakedContestID)
+
+		require.NoError(t, err)
+
+		mockedRepo.AssertExpectations(t)
+		mockedAuthorizer.AssertExpectations(t)
+	})
+
+	t.Run("Public", func(t *testing.T) {
+		mockedRepo, mockedAuthorizer := makeMocks()
+
+		mockedAuthorizer.
+			On("HasOwnership", mock.Anything, fakedOwnership).
+			Return(domain.NilRole, domain.ErrNotAuthenticated)
+
+		ucase := usecases.ContestUseCase{
+			Repo:       mockedRepo,
+			Authorizer: mockedAuthorizer,
+		}
+
+		err := ucase.AuthorizeLiveScores(context.Background(), fakedContestID)
+
+		require.ErrorIs(t, err, domain.ErrNotAuthenticated)
+
+		mockedRepo.AssertExpectations(t)
+		mockedAuthorizer.AssertExpectations(t)
+	})
+}
+
+func TestRevealScoreboard(t *testing.T) {
+	fakedContestID := testutils.RandomResourceID[domain.ContestID]()
+	fakedOwnership := domain.OwnershipData{
+		OrganizerID: testutils.RandomResourceID[domain.OrganizerID](),
+	}
+
+	now := time.Now()
+
+	fakedContest := domain.Contest{
+		ID:               fakedContestID,
+		Ownership:        fakedOwnership,
+		TimeEnd:          now.Add(10 * time.Minute),
+		ScoreboardFreeze: 15 * time.Minute,
+	}
+
+	t.Run("HappyCase", func(t *testing.T) {
+		mockedRepo := new(repositoryMock)
+		mockedAuthorizer := new(authorizerMock)
+		mockedEventBroker := new(eventBrokerMock)
+
+		mockedRepo.
+			On("GetContest", mock.Anything, nil, fakedContestID).
+			Return(fakedContest, nil)
+
+		mockedAuthorizer.
+			On("HasOwnership", mock.Anything, fakedOwnership).
+			Return(domain.OrganizerRole, nil)
+
+		mockedRepo.
+			On("StoreContest", mock.Anything, nil, mock.MatchedBy(func(contest domain.Contest) bool {
+				return !contest.ScoreboardRevealedAt.IsZero() && !contest.ScoreboardFrozen(time.Now())
+			})).
+			Return(domain.Contest{}, nil)
+
+		mockedEventBroker.
+			On("Dispatch", mock.Anything, fakedContestID, mock.AnythingOfType("domain.ScoreboardRevealedEvent")).
+			Return()
+
+		ucase := usecases.ContestUseCase{
+			Repo:        mockedRepo,
+			Authorizer:  mockedAuthorizer,
+			EventBroker: mockedEventBroker,
+		}
+
+		contest, err := ucase.RevealScoreboard(context.Background(), fakedContestID)
+
+		require.NoError(t, err)
+		assert.False(t, contest.ScoreboardFrozen(time.Now()))
+
+		mockedRepo.AssertExpectations(t)
+		mockedAuthorizer.AssertExpectations(t)
+		mockedEventBroker.AssertExpectations(t)
+	})
+
+	t.Run("NotFrozen", func(t *testing.T) {
+		mockedRepo := new(repositoryMock)
+		mockedAuthorizer := new(authorizerMock)
+
+		contest := fakedContest
+		contest.ScoreboardFreeze = 0
+
+		mockedRepo.
+			On("GetContest", mock.Anything, nil, fakedContestID).
+			Return(contest, nil)
+
+		mockedAuthorizer.
+			On("HasOwnership", mock.Anything, fakedOwnership).
+			Return(domain.OrganizerRole, nil)
+
+		ucase := usecases.ContestUseCase{
+			Repo:       mockedRepo,
+			Authorizer: mockedAuthorizer,
+		}
+
+		_, err := ucase.RevealScoreboard(context.Background(), fakedContestID)
+
+		require.ErrorIs(t, err, domain.ErrNotAllowed)
+
+		mockedRepo.AssertExpectations(t)
+		mockedAuthorizer.AssertExpectations(t)
+	})
+
+	t.Run("BadCredentials", func(t *testing.T) {
+		mockedRepo := new(repositoryMock)
+		mockedAuthorizer := new(authorizerMock)
+
+		mockedRepo.
+			On("GetContest", mock.Anything, nil, fakedContestID).
+			Return(fakedContest, nil)
+
+		mockedAuthorizer.
+			On("HasOwnership", mock.Anything, fakedOwnership).
+			Return(domain.NilRole, domain.ErrNoOwnership)
+
+		ucase := usecases.ContestUseCase{
+			Repo:       mockedRepo,
+			Authorizer: mockedAuthorizer,
+		}
+
+		_, err := ucase.RevealScoreboard(context.Background(), fakedContestID)
+
+		require.ErrorIs(t, err, domain.ErrNoOwnership)
+
+		mockedRepo.AssertExpectations(t)
+		mockedAuthorizer.AssertExpectations(t)
+	})
+}
+
 func TestGetContestsByOrganizer(t *testing.T) {
 	fakedOrganizerID := testutils.RandomResourceID[domain.OrganizerID]()
 	fakedContestID := testutils.RandomResourceID[domain.ContestID]()
@@ -258,8 +569,14 @@ func TestGetAllContests(t *testing.T) {
 			On("HasOwnership", mock.Anything, mock.AnythingOfType("domain.OwnershipData")).
 			Return(domain.AdminRole, nil)
 
+		archived := false
+		filter := domain.ContestFilter{
+			Country:  "SE",
+			Archived: &archived,
+		}
+
 		mockedRepo.
-			On("GetAllContests", mock.Anything, nil).
+			On("GetContests", mock.Anything, nil, filter, domain.PageRequest{Limit: 2}).
 			Return([]domain.Contest{
 				{
 					ID:        fakedContestID,
@@ -272,11 +589,12 @@ func TestGetAllContests(t *testing.T) {
 			Authorizer: mockedAuthorizer,
 		}
 
-		contests, err := ucase.GetAllContests(context.Background())
+		contests, err := ucase.GetAllContests(context.Background(), filter, domain.PageRequest{Limit: 1})
 
 		require.NoError(t, err)
-		require.Len(t, contests, 1)
-		assert.Equal(t, fakedContestID, contests[0].ID)
+		require.Len(t, contests.Items, 1)
+		assert.Equal(t, fakedContestID, contests.Items[0].ID)
+		assert.Zero(t, contests.Next)
 
 		mockedRepo.AssertExpectations(t)
 		mockedAuthorizer.AssertExpectations(t)
@@ -294,7 +612,7 @@ func TestGetAllContests(t *testing.T) {
 			Authorizer: mockedAuthorizer,
 		}
 
-		_, err := ucase.GetAllContests(context.Background())
+		_, err := ucase.GetAllContests(context.Background(), domain.ContestFilter{}, domain.PageRequest{})
 
 		require.ErrorIs(t, err, domain.ErrNoOwnership)
 
@@ -314,7 +632,7 @@ func TestGetAllContests(t *testing.T) {
 			Authorizer: mockedAuthorizer,
 		}
 
-		_, err := ucase.GetAllContests(context.Background())
+		_, err := ucase.GetAllContests(context.Background(), domain.ContestFilter{}, domain.PageRequest{})
 
 		require.ErrorIs(t, err, domain.ErrNotAuthorized)
 
@@ -637,6 +955,9 @@ func TestDuplicateContest(t *testing.T) {
 	fakedDuplicatedContestID := testutils.RandomResourceID[domain.ContestID]()
 	fakedCompClassID := testutils.RandomResourceID[domain.CompClassID]()
 	fakedProblemID := testutils.RandomResourceID[domain.ProblemID]()
+	fakedRoundID := testutils.RandomResourceID[domain.RoundID]()
+	fakedDuplicatedRoundID := testutils.RandomResourceID[domain.RoundID]()
+	fakedDuplicatedCompClassID := testutils.RandomResourceID[domain.CompClassID]()
 	fakedOwnership := domain.OwnershipData{
 		OrganizerID: testutils.RandomResourceID[domain.OrganizerID](),
 	}
@@ -669,14 +990,28 @@ func TestDuplicateContest(t *testing.T) {
 		TimeEnd:     timeEnd,
 	}
 
+	fakedRound := domain.Round{
+		ID:                 fakedRoundID,
+		Ownership:          fakedOwnership,
+		ContestID:          fakedContestID,
+		Number:             1,
+		Name:               "Final",
+		QualifyingProblems: 4,
+		Finalists:          0,
+	}
+
 	fakedProblem := domain.Problem{
 		ID:                 fakedProblemID,
 		Ownership:          fakedOwnership,
 		ContestID:          fakedContestID,
+		RoundID:            fakedRoundID,
 		Number:             42,
 		HoldColorPrimary:   "#FF0000",
 		HoldColorSecondary: "#00FF00",
 		Description:        "Test Problem",
+		Sector:             "Cave",
+		Tags:               []string{"crimpy"},
+		CompClassIDs:       []domain.CompClassID{fakedCompClassID},
 		Zone1Enabled:       true,
 		Zone2Enabled:       true,
 		ProblemValue: domain.ProblemValue{
@@ -717,6 +1052,10 @@ func TestDuplicateContest(t *testing.T) {
 			On("GetProblemsByContest", mock.Anything, nil, fakedContestID).
 			Return([]domain.Problem{fakedProblem}, nil)
 
+		mockedRepo.
+			On("GetRoundsByContest", mock.Anything, nil, fakedContestID).
+			Return([]domain.Round{fakedRound}, nil)
+
 		mockedTx := new(transactionMock)
 
 		mockedRepo.
@@ -740,15 +1079,27 @@ func TestDuplicateContest(t *testing.T) {
 
 				return compClass == expected
 			})).
-			Return(domain.CompClass{}, nil)
+			Return(domain.CompClass{ID: fakedDuplicatedCompClassID}, nil)
+
+		mockedRepo.
+			On("StoreRound", mock.Anything, mockedTx, mock.MatchedBy(func(round domain.Round) bool {
+				expected := fakedRound
+				expected.ID = 0
+				expected.ContestID = fakedDuplicatedContestID
+
+				return round == expected
+			})).
+			Return(domain.Round{ID: fakedDuplicatedRoundID}, nil)
 
 		mockedRepo.
 			On("StoreProblem", mock.Anything, mockedTx, mock.MatchedBy(func(problem domain.Problem) bool {
 				expected := fakedProblem
 				expected.ID = 0
 				expected.ContestID = fakedDuplicatedContestID
+				expected.RoundID = fakedDuplicatedRoundID
+				expected.CompClassIDs = []domain.CompClassID{fakedDuplicatedCompClassID}
 
-				return problem == expected
+				return assert.ObjectsAreEqual(expected, problem)
 			})).
 			Return(domain.Problem{}, nil)
 
@@ -843,8 +1194,11 @@ func TestTransferContest(t *testing.T) {
 	fakedContenderID := testutils.RandomResourceID[domain.ContenderID]()
 	fakedRaffleID := testutils.RandomResourceID[domain.RaffleID]()
 	fakedRaffleWinnerID := testutils.RandomResourceID[domain.RaffleWinnerID]()
+	fakedRafflePrizeID := testutils.RandomResourceID[domain.RafflePrizeID]()
 	fakedTickID := testutils.RandomResourceID[domain.TickID]()
 	fakedSeriesID := testutils.RandomResourceID[domain.SeriesID]()
+	fakedRoundID := testutils.RandomResourceID[domain.RoundID]()
+	fakedTeamID := testutils.RandomResourceID[domain.TeamID]()
 
 	now := time.Now()
 
@@ -898,6 +1252,30 @@ func TestTransferContest(t *testing.T) {
 		},
 	}
 
+	fakedRound := domain.Round{
+		ID:                 fakedRoundID,
+		Ownership:          fakedOldOwnership,
+		ContestID:          fakedContestID,
+		Number:             1,
+		Name:               "Final",
+		QualifyingProblems: 4,
+		Finalists:          3,
+	}
+
+	fakedStartListEntry := domain.StartListEntry{
+		RoundID:           fakedRoundID,
+		ContenderID:       fakedContenderID,
+		PreviousPlacement: 3,
+	}
+
+	fakedTeam := domain.Team{
+		ID:             fakedTeamID,
+		Ownership:      fakedOldOwnership,
+		ContestID:      fakedContestID,
+		Name:           "Boulder Buddies",
+		CountedMembers: 3,
+	}
+
 	fakedScore := domain.Score{
 		Timestamp:   now.Add(time.Duration(rand.Int())),
 		ContenderID: fakedContenderID,
@@ -912,6 +1290,7 @@ func TestTransferContest(t *testing.T) {
 		Ownership:           domain.OwnershipData{OrganizerID: fakedOldOrganizerID, ContenderID: &fakedContenderID},
 		ContestID:           fakedContestID,
 		CompClassID:         fakedCompClassID,
+		TeamID:              fakedTeamID,
 		RegistrationCode:    "ABCD1234",
 		Name:                "John Doe",
 		Entered:             now.Add(time.Duration(rand.Int())),
@@ -926,12 +1305,22 @@ func TestTransferContest(t *testing.T) {
 		ContestID: fakedContestID,
 	}
 
+	fakedPrize := domain.RafflePrize{
+		ID:        fakedRafflePrizeID,
+		Ownership: fakedOldOwnership,
+		RaffleID:  fakedRaffleID,
+		Name:      "Chalk bag",
+		Quantity:  2,
+	}
+
 	fakedWinner := domain.RaffleWinner{
 		ID:            fakedRaffleWinnerID,
 		Ownership:     fakedOldOwnership,
 		RaffleID:      fakedRaffleID,
 		ContenderID:   fakedContenderID,
 		ContenderName: "John Doe",
+		PrizeID:       fakedRafflePrizeID,
+		PrizeName:     "Chalk bag",
 		Timestamp:     now.Add(time.Duration(rand.Int())),
 	}
 
@@ -989,17 +1378,32 @@ func TestTransferContest(t *testing.T) {
 		mockedRepo.
 			On("GetRaffleWinners", mock.Anything, nil, fakedRaffleID).
 			Return([]domain.RaffleWinner{fakedWinner}, nil)
+		mockedRepo.
+			On("GetRafflePrizes", mock.Anything, nil, fakedRaffleID).
+			Return([]domain.RafflePrize{fakedPrize}, nil)
 		mockedRepo.
 			On("GetTicksByContest", mock.Anything, nil, fakedContestID).
 			Return([]domain.Tick{fakedTick}, nil)
+		mockedRepo.
+			On("GetRoundsByContest", mock.Anything, nil, fakedContestID).
+			Return([]domain.Round{fakedRound}, nil)
+		mockedRepo.
+			On("GetStartList", mock.Anything, nil, fakedRoundID).
+			Return([]domain.StartListEntry{fakedStartListEntry}, nil)
+		mockedRepo.
+			On("GetTeamsByContest", mock.Anything, nil, fakedContestID).
+			Return([]domain.Team{fakedTeam}, nil)
 
 		mockedRepo.On("Begin").Return(mockedTx, nil)
 
 		mockedRepo.On("DeleteTick", mock.Anything, mockedTx, fakedTickID).Return(nil)
 		mockedRepo.On("DeleteRaffleWinner", mock.Anything, mockedTx, fakedRaffleWinnerID).Return(nil)
+		mockedRepo.On("DeleteRafflePrize", mock.Anything, mockedTx, fakedRafflePrizeID).Return(nil)
 		mockedRepo.On("DeleteRaffle", mock.Anything, mockedTx, fakedRaffleID).Return(nil)
 		mockedRepo.On("DeleteContender", mock.Anything, mockedTx, fakedContenderID).Return(nil)
+		mockedRepo.On("DeleteTeam", mock.Anything, mockedTx, fakedTeamID).Return(nil)
 		mockedRepo.On("DeleteProblem", mock.Anything, mockedTx, fakedProblemID).Return(nil)
+		mockedRepo.On("DeleteRound", mock.Anything, mockedTx, fakedRoundID).Return(nil)
 		mockedRepo.On("DeleteCompClass", mock.Anything, mockedTx, fakedCompClassID).Return(nil)
 		mockedRepo.On("DeleteContest", mock.Anything, mockedTx, fakedContestID).Return(nil)
 
@@ -1033,6 +1437,18 @@ func TestTransferContest(t *testing.T) {
 			}).
 			Return(domain.CompClass{}, nil)
 
+		mockedRepo.
+			On("StoreRound", mock.Anything, mockedTx, domain.Round{
+				ID:                 fakedRoundID,
+				Ownership:          fakedNewOwnership,
+				ContestID:          fakedContestID,
+				Number:             1,
+				Name:               "Final",
+				QualifyingProblems: 4,
+				Finalists:          3,
+			}).
+			Return(domain.Round{}, nil)
+
 		mockedRepo.
 			On("StoreProblem", mock.Anything, mockedTx, domain.Problem{
 				ID:                 fakedProblemID,
@@ -1052,12 +1468,23 @@ func TestTransferContest(t *testing.T) {
 				},
 			}).Return(domain.Problem{}, nil)
 
+		mockedRepo.
+			On("StoreTeam", mock.Anything, mockedTx, domain.Team{
+				ID:             fakedTeamID,
+				Ownership:      fakedNewOwnership,
+				ContestID:      fakedContestID,
+				Name:           "Boulder Buddies",
+				CountedMembers: 3,
+			}).
+			Return(domain.Team{}, nil)
+
 		mockedRepo.
 			On("StoreContender", mock.Anything, mockedTx, domain.Contender{
 				ID:                  fakedContenderID,
 				Ownership:           domain.OwnershipData{OrganizerID: fakedNewOrganizerID, ContenderID: &fakedContenderID},
 				ContestID:           fakedContestID,
 				CompClassID:         fakedCompClassID,
+				TeamID:              fakedTeamID,
 				RegistrationCode:    "ABCD1234",
 				Name:                "John Doe",
 				Entered:             fakedContender.Entered,
@@ -1071,6 +1498,10 @@ func TestTransferContest(t *testing.T) {
 			On("StoreScore", mock.Anything, mockedTx, fakedScore).
 			Return(nil)
 
+		mockedRepo.
+			On("StoreStartListEntry", mock.Anything, mockedTx, fakedStartListEntry).
+			Return(nil)
+
 		mockedRepo.
 			On("StoreRaffle", mock.Anything, mockedTx, domain.Raffle{
 				ID:        fakedRaffleID,
@@ -1079,6 +1510,16 @@ func TestTransferContest(t *testing.T) {
 			}).
 			Return(domain.Raffle{}, nil)
 
+		mockedRepo.
+			On("StoreRafflePrize", mock.Anything, mockedTx, domain.RafflePrize{
+				ID:        fakedRafflePrizeID,
+				Ownership: fakedNewOwnership,
+				RaffleID:  fakedRaffleID,
+				Name:      "Chalk bag",
+				Quantity:  2,
+			}).
+			Return(domain.RafflePrize{}, nil)
+
 		mockedRepo.
 			On("StoreRaffleWinner", mock.Anything, mockedTx, domain.RaffleWinner{
 				ID:            fakedRaffleWinnerID,
@@ -1086,6 +1527,8 @@ func TestTransferContest(t *testing.T) {
 				RaffleID:      fakedRaffleID,
 				ContenderID:   fakedContenderID,
 				ContenderName: "John Doe",
+				PrizeID:       fakedRafflePrizeID,
+				PrizeName:     "Chalk bag",
 				Timestamp:     fakedWinner.Timestamp,
 			}).
 			Return(domain.RaffleWinner{}, nil)
@@ -1271,7 +1714,7 @@ func TestPatchContest(t *testing.T) {
 			}, nil)
 
 		mockedEventBroker.
-			On("Dispatch", fakedContestID, domain.RulesUpdatedEvent{
+			On("Dispatch", mock.Anything, fakedContestID, domain.RulesUpdatedEvent{
 				QualifyingProblems: 20,
 				Finalists:          5,
 			}).
@@ -1295,7 +1738,7 @@ func TestPatchContest(t *testing.T) {
 			GracePeriod:        domain.NewPatch(time.Hour),
 		}
 
-		contest, err := ucase.PatchContest(context.Background(), fakedContestID, patch)
+		contest, err := ucase.PatchContest(context.Background(), fakedContestID, patch, 0)
 
 		require.NoError(t, err)
 		assert.Equal(t, "The garage", contest.Location)
@@ -1314,6 +1757,54 @@ func TestPatchContest(t *testing.T) {
 		mockedEventBroker.AssertExpectations(t)
 	})
 
+	t.Run("ScoreboardFreezeUpdated", func(t *testing.T) {
+		mockedRepo, mockedAuthorizer, mockedEventBroker := makeMocks()
+
+		timeEnd := time.Now().Add(time.Hour)
+
+		mockedAuthorizer.
+			On("HasOwnership", mock.Anything, fakedOwnership).
+			Return(domain.OrganizerRole, nil)
+
+		mockedRepo.
+			On("GetContest", mock.Anything, nil, fakedContestID).
+			Return(domain.Contest{
+				ID:                fakedContestID,
+				Ownership:         fakedOwnership,
+				Name:              "Swedish Championships",
+				Country:           "SE",
+				NameRetentionTime: 14 * 24 * time.Hour,
+				TimeEnd:           timeEnd,
+			}, nil)
+
+		mockedRepo.
+			On("StoreContest", mock.Anything, nil, mock.AnythingOfType("domain.Contest")).
+			Return(domain.Contest{}, nil)
+
+		mockedEventBroker.
+			On("Dispatch", mock.Anything, fakedContestID, domain.ScoreboardFreezeUpdatedEvent{
+				FrozenFrom: timeEnd.Add(-15 * time.Minute),
+			}).
+			Return()
+
+		ucase := usecases.ContestUseCase{
+			Repo:        mockedRepo,
+			Authorizer:  mockedAuthorizer,
+			EventBroker: mockedEventBroker,
+		}
+
+		contest, err := ucase.PatchContest(context.Background(), fakedContestID, domain.ContestPatch{
+			ScoreboardFreeze: domain.NewPatch(15 * time.Minute),
+		}, 0)
+
+		require.NoError(t, err)
+		assert.Equal(t, 15*time.Minute, contest.ScoreboardFreeze)
+
+		mockedRepo.AssertExpectations(t)
+		mockedAuthorizer.AssertExpectations(t)
+		mockedEventBroker.AssertExpectations(t)
+	})
+
 	t.Run("BadCredentials", func(t *testing.T) {
 		mockedRepo, mockedAuthorizer, _ := makeMocks()
 
@@ -1333,7 +1824,7 @@ func TestPatchContest(t *testing.T) {
 			Authorizer: mockedAuthorizer,
 		}
 
-		_, err := ucase.PatchContest(context.Background(), fakedContestID, domain.ContestPatch{})
+		_, err := ucase.PatchContest(context.Background(), fakedContestID, domain.ContestPatch{}, 0)
 
 		assert.ErrorIs(t, err, domain.ErrNoOwnership)
 
@@ -1360,7 +1851,7 @@ func TestPatchContest(t *testing.T) {
 			Authorizer: mockedAuthorizer,
 		}
 
-		_, err := ucase.PatchContest(context.Background(), fakedContestID, domain.ContestPatch{})
+		_, err := ucase.PatchContest(context.Background(), fakedContestID, domain.ContestPatch{}, 0)
 
 		assert.ErrorIs(t, err, domain.ErrInvalidData)
 		assert.True(t, validators.ContestValidator{}.IsValidationError(err))
@@ -1391,13 +1882,140 @@ func TestPatchContest(t *testing.T) {
 
 		_, err := ucase.PatchContest(context.Background(), fakedContestID, domain.ContestPatch{
 			Name: domain.NewPatch("Norweigan Championships"),
-		})
+		}, 0)
 
 		assert.ErrorIs(t, err, domain.ErrArchived)
 
 		mockedRepo.AssertExpectations(t)
 		mockedAuthorizer.AssertExpectations(t)
 	})
+
+	fakedVersionedContest := domain.Contest{
+		ID:                fakedContestID,
+		Ownership:         fakedOwnership,
+		Name:              "Swedish Championships",
+		Country:           "SE",
+		NameRetentionTime: 14 * 24 * time.Hour,
+		Version:           3,
+	}
+
+	t.Run("MatchingVersion", func(t *testing.T) {
+		mockedRepo, mockedAuthorizer, _ := makeMocks()
+		mockedTx := new(transactionMock)
+
+		mockedAuthorizer.
+			On("HasOwnership", mock.Anything, fakedOwnership).
+			Return(domain.OrganizerRole, nil)
+
+		mockedRepo.
+			On("GetContest", mock.Anything, nil, fakedContestID).
+			Return(fakedVersionedContest, nil)
+
+		mockedRepo.
+			On("Begin").
+			Return(mockedTx, nil)
+
+		mockedRepo.
+			On("GetContestVersionForUpdate", mock.Anything, mockedTx, fakedContestID).
+			Return(3, nil)
+
+		expected := fakedVersionedContest
+		expected.Name = "Norwegian Championships"
+
+		stored := expected
+		stored.Version = 4
+
+		mockedRepo.
+			On("StoreContest", mock.Anything, mockedTx, expected).
+			Return(stored, nil)
+
+		mockedTx.
+			On("Commit").
+			Return(nil)
+
+		ucase := usecases.ContestUseCase{
+			Repo:       mockedRepo,
+			Authorizer: mockedAuthorizer,
+		}
+
+		contest, err := ucase.PatchContest(context.Background(), fakedContestID, domain.ContestPatch{
+			Name: domain.NewPatch("Norwegian Championships"),
+		}, 3)
+
+		require.NoError(t, err)
+		assert.Equal(t, "Norwegian Championships", contest.Name)
+		assert.Equal(t, 4, contest.Version)
+
+		mockedRepo.AssertExpectations(t)
+		mockedAuthorizer.AssertExpectations(t)
+		mockedTx.AssertExpectations(t)
+	})
+
+	t.Run("StaleVersion", func(t *testing.T) {
+		mockedRepo, mockedAuthorizer, _ := makeMocks()
+
+		mockedAuthorizer.
+			On("HasOwnership", mock.Anything, fakedOwnership).
+			Return(domain.OrganizerRole, nil)
+
+		mockedRepo.
+			On("GetContest", mock.Anything, nil, fakedContestID).
+			Return(fakedVersionedContest, nil)
+
+		ucase := usecases.ContestUseCase{
+			Repo:       mockedRepo,
+			Authorizer: mockedAuthorizer,
+		}
+
+		_, err := ucase.PatchContest(context.Background(), fakedContestID, domain.ContestPatch{
+			Name: domain.NewPatch("Norwegian Championships"),
+		}, 2)
+
+		assert.ErrorIs(t, err, domain.ErrPreconditionFailed)
+
+		mockedRepo.AssertExpectations(t)
+		mockedAuthorizer.AssertExpectations(t)
+	})
+
+	t.Run("ConcurrentModification", func(t *testing.T) {
+		mockedRepo, mockedAuthorizer, _ := makeMocks()
+		mockedTx := new(transactionMock)
+
+		mockedAuthorizer.
+			On("HasOwnership", mock.Anything, fakedOwnership).
+			Return(domain.OrganizerRole, nil)
+
+		mockedRepo.
+			On("GetContest", mock.Anything, nil, fakedContestID).
+			Return(fakedVersionedContest, nil)
+
+		mockedRepo.
+			On("Begin").
+			Return(mockedTx, nil)
+
+		mockedRepo.
+			On("GetContestVersionForUpdate", mock.Anything, mockedTx, fakedContestID).
+			Return(4, nil)
+
+		mockedTx.
+			On("Rollback").
+			Return()
+
+		ucase := usecases.ContestUseCase{
+			Repo:       mockedRepo,
+			Authorizer: mockedAuthorizer,
+		}
+
+		_, err := ucase.PatchContest(context.Background(), fakedContestID, domain.ContestPatch{
+			Name: domain.NewPatch("Norwegian Championships"),
+		}, 3)
+
+		assert.ErrorIs(t, err, domain.ErrPreconditionFailed)
+
+		mockedRepo.AssertExpectations(t)
+		mockedAuthorizer.AssertExpectations(t)
+		mockedTx.AssertExpectations(t)
+	})
 }
 
 func TestArchiveContest(t *testing.T) {
@@ -1461,6 +2079,15 @@ func TestArchiveContest(t *testing.T) {
 				On("StopScoreEngine", mock.Anything, fakedScoreEngineInstanceID).
 				Return(nil)
 
+			mockedRepo.
+				On("GetTickDisputesByContest", mock.Anything, nil, fakedContestID).
+				Return([]domain.TickDispute{
+					{
+						ID:     testutils.RandomResourceID[domain.TickDisputeID](),
+						Status: domain.TickDisputeStatusRejected,
+					},
+				}, nil)
+
 			ucase := usecases.ContestUseCase{
 				Repo:               mockedRepo,
 				Authorizer:         mockedAuthorizer,
@@ -1478,6 +2105,47 @@ func TestArchiveContest(t *testing.T) {
 		})
 	})
 
+	t.Run("OpenDisputes", func(t *testing.T) {
+		mockedRepo := new(repositoryMock)
+		mockedAuthorizer := new(authorizerMock)
+
+		mockedAuthorizer.
+			On("HasOwnership", mock.Anything, fakedOwnership).
+			Return(domain.OrganizerRole, nil)
+
+		mockedRepo.
+			On("GetContest", mock.Anything, nil, fakedContestID).
+			Return(domain.Contest{
+				ID:        fakedContestID,
+				Ownership: fakedOwnership,
+			}, nil)
+
+		mockedRepo.
+			On("GetTickDisputesByContest", mock.Anything, nil, fakedContestID).
+			Return([]domain.TickDispute{
+				{
+					ID:     testutils.RandomResourceID[domain.TickDisputeID](),
+					Status: domain.TickDisputeStatusAccepted,
+				},
+				{
+					ID:     testutils.RandomResourceID[domain.TickDisputeID](),
+					Status: domain.TickDisputeStatusOpen,
+				},
+			}, nil)
+
+		ucase := usecases.ContestUseCase{
+			Repo:       mockedRepo,
+			Authorizer: mockedAuthorizer,
+		}
+
+		_, err := ucase.ArchiveContest(context.Background(), fakedContestID)
+
+		require.ErrorIs(t, err, domain.ErrNotAllowed)
+
+		mockedRepo.AssertExpectations(t)
+		mockedAuthorizer.AssertExpectations(t)
+	})
+
 	t.Run("BadCredentials", func(t *testing.T) {
 		mockedRepo := new(repositoryMock)
 		mockedAuthorizer := new(authorizerMock)
@@ -0,0 +1,224 @@
+package usecases
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/climblive/platform/backend/internal/domain"
+	"github.com/climblive/platform/backend/internal/usecases/validators"
+	"github.com/go-errors/errors"
+)
+
+type tickDisputeUseCaseRepository interface {
+	GetContender(ctx context.Context, tx domain.Transaction, contenderID domain.ContenderID) (domain.Contender, error)
+	GetContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) (domain.Contest, error)
+	GetProblem(ctx context.Context, tx domain.Transaction, problemID domain.ProblemID) (domain.Problem, error)
+	GetTickDispute(ctx context.Context, tx domain.Transaction, disputeID domain.TickDisputeID) (domain.TickDispute, error)
+	GetTickDisputesByContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) ([]domain.TickDispute, error)
+	GetTickDisputesByContender(ctx context.Context, tx domain.Transaction, contenderID domain.ContenderID) ([]domain.TickDispute, error)
+	StoreTickDispute(ctx context.Context, tx domain.Transaction, dispute domain.TickDispute) (domain.TickDispute, error)
+}
+
+type tickUseCase interface {
+	PutTickWithin(ctx context.Context, contenderID domain.ContenderID, tick domain.Tick, within func(ctx context.Context, tx domain.Transaction) error) (domain.Tick, error)
+}
+
+type TickDisputeUseCase struct {
+	Authorizer  domain.Authorizer
+	Repo        tickDisputeUseCaseRepository
+	EventBroker domain.EventBroker
+	TickUseCase tickUseCase
+}
+
+func (uc *TickDisputeUseCase) GetTickDisputesByContest(ctx context.Context, contestID domain.ContestID) ([]domain.TickDispute, error) {
+	contest, err := uc.Repo.GetContest(ctx, nil, contestID)
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	if _, err := uc.Authorizer.HasOwnership(ctx, contest.Ownership); err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	disputes, err := uc.Repo.GetTickDisputesByContest(ctx, nil, contestID)
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	return disputes, nil
+}
+
+func (uc *TickDisputeUseCase) GetTickDisputesByContender(ctx context.Context, contenderID domain.ContenderID) ([]domain.TickDispute, error) {
+	contender, err := uc.Repo.GetContender(ctx, nil, contenderID)
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	if _, err := uc.Authorizer.HasOwnership(ctx, contender.Ownership); err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	disputes, err := uc.Repo.GetTickDisputesByContender(ctx, nil, contenderID)
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	return disputes, nil
+}
+
+func (uc *TickDisputeUseCase) OpenTickDispute(ctx context.Context, contenderID domain.ContenderID, tmpl domain.TickDisputeTemplate) (domain.TickDispute, error) {
+	contender, err := uc.Repo.GetContender(ctx, nil, contenderID)
+	if err != nil {
+		return domain.TickDispute{}, errors.Wrap(err, 0)
+	}
+
+	if _, err := uc.Authorizer.HasOwnership(ctx, contender.Ownership); err != nil {
+		return domain.TickDispute{}, errors.Wrap(err, 0)
+	}
+
+	contest, err := uc.Repo.GetContest(ctx, nil, contender.ContestID)
+	if err != nil {
+		return domain.TickDispute{}, errors.Errorf("%w: %w", domain.ErrRepositoryIntegrityViolation, err)
+	}
+
+	if !contest.ArchivedAt.IsZero() {
+		return domain.TickDispute{}, errors.Wrap(domain.ErrArchived, 0)
+	}
+
+	problem, err := uc.Repo.GetProblem(ctx, nil, tmpl.ProblemID)
+	if err != nil {
+		return domain.TickDispute{}, errors.Wrap(err, 0)
+	}
+
+	if problem.ContestID != contest.ID {
+		return domain.TickDispute{}, errors.New(domain.ErrProblemNotInContest)
+	}
+
+	disputes, err := uc.Repo.GetTickDisputesByContender(ctx, nil, contenderID)
+	if err != nil {
+		return domain.TickDispute{}, errors.Wrap(err, 0)
+	}
+
+	if slices.ContainsFunc(disputes, func(dispute domain.TickDispute) bool {
+		return dispute.ProblemID == problem.ID && dispute.Status == domain.TickDisputeStatusOpen
+	}) {
+		return domain.TickDispute{}, errors.Wrap(domain.ErrDuplicate, 0)
+	}
+
+	dispute := domain.TickDispute{
+		ID:            0,
+		Ownership:     contender.Ownership,
+		ContestID:     contest.ID,
+		ContenderID:   contenderID,
+		ProblemID:     problem.ID,
+		Status:        domain.TickDisputeStatusOpen,
+		Comment:       tmpl.Comment,
+		Response:      "",
+		Zone1:         tmpl.Zone1,
+		AttemptsZone1: tmpl.AttemptsZone1,
+		Zone2:         tmpl.Zone2,
+		AttemptsZone2: tmpl.AttemptsZone2,
+		Top:           tmpl.Top,
+		AttemptsTop:   tmpl.AttemptsTop,
+		Created:       time.Now(),
+		Resolved:      time.Time{},
+		ResolvedBy:    "",
+	}
+
+	if err := (validators.TickDisputeValidator{}).Validate(dispute); err != nil {
+		return domain.TickDispute{}, errors.Wrap(err, 0)
+	}
+
+	dispute, err = uc.Repo.StoreTickDispute(ctx, nil, dispute)
+	if err != nil {
+		return domain.TickDispute{}, errors.Wrap(err, 0)
+	}
+
+	uc.EventBroker.Dispatch(ctx, contest.ID, domain.TickDisputeOpenedEvent{
+		DisputeID:   dispute.ID,
+		ContenderID: dispute.ContenderID,
+		ProblemID:   dispute.ProblemID,
+	})
+
+	return dispute, nil
+}
+
+func (uc *TickDisputeUseCase) ResolveTickDispute(ctx context.Context, disputeID domain.TickDisputeID, resolution domain.TickDisputeResolution) (domain.TickDispute, error) {
+	dispute, err := uc.Repo.GetTickDispute(ctx, nil, disputeID)
+	if err != nil {
+		return domain.TickDispute{}, errors.Wrap(err, 0)
+	}
+
+	role, err := uc.Authorizer.HasOwnership(ctx, dispute.Ownership)
+	if err != nil {
+		return domain.TickDispute{}, errors.Wrap(err, 0)
+	}
+
+	if !role.OneOf(domain.AdminRole, domain.OrganizerRole) {
+		return domain.TickDispute{}, errors.Wrap(domain.ErrInsufficientRole, 0)
+	}
+
+	if dispute.Status != domain.TickDisputeStatusOpen {
+		return domain.TickDispute{}, errors.Wrap(domain.ErrNotAllowed, 0)
+	}
+
+	if !resolution.Status.Resolved() {
+		return domain.TickDispute{}, errors.Wrap(domain.ErrInvalidData, 0)
+	}
+
+	authentication, err := uc.Authorizer.GetAuthentication(ctx)
+	if err != nil {
+		return domain.TickDispute{}, errors.Wrap(err, 0)
+	}
+
+	dispute.Status = resolution.Status
+	dispute.Response = resolution.Response
+	dispute.Resolved = time.Now()
+	dispute.ResolvedBy = authentication.Username
+
+	if err := (validators.TickDisputeValidator{}).Validate(dispute); err != nil {
+		return domain.TickDispute{}, errors.Wrap(err, 0)
+	}
+
+	store := func(ctx context.Context, tx domain.Transaction) error {
+		stored, err := uc.Repo.StoreTickDispute(ctx, tx, dispute)
+		if err != nil {
+			return errors.Wrap(err, 0)
+		}
+
+		dispute = stored
+
+		return nil
+	}
+
+	if dispute.Status == domain.TickDisputeStatusAccepted {
+		_, err := uc.TickUseCase.PutTickWithin(ctx, dispute.ContenderID, domain.Tick{
+			ID:            0,
+			Ownership:     dispute.Ownership,
+			Timestamp:     time.Time{},
+			ContestID:     dispute.ContestID,
+			ProblemID:     dispute.ProblemID,
+			Zone1:         dispute.Zone1,
+			AttemptsZone1: dispute.AttemptsZone1,
+			Zone2:         dispute.Zone2,
+			AttemptsZone2: dispute.AttemptsZone2,
+			Top:           dispute.Top,
+			AttemptsTop:   dispute.AttemptsTop,
+		}, store)
+		if err != nil {
+			return domain.TickDispute{}, errors.Wrap(err, 0)
+		}
+	} else if err := store(ctx, nil); err != nil {
+		return domain.TickDispute{}, errors.Wrap(err, 0)
+	}
+
+	uc.EventBroker.Dispatch(ctx, dispute.ContestID, domain.TickDisputeResolvedEvent{
+		DisputeID:   dispute.ID,
+		ContenderID: dispute.ContenderID,
+		ProblemID:   dispute.ProblemID,
+		Status:      dispute.Status,
+	})
+
+	return dispute, nil
+}
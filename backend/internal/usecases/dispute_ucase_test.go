@@ -0,0 +1,524 @@
+package usecases_test
+
+import (
+	"context"
+	"testing"
+	"testing/synctest"
+	"time"
+
+	"github.com/climblive/platform/backend/internal/domain"
+	"github.com/climblive/platform/backend/internal/testutils"
+	"github.com/climblive/platform/backend/internal/usecases"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpenTickDispute(t *testing.T) {
+	fakedContenderID := testutils.RandomResourceID[domain.ContenderID]()
+	fakedContestID := testutils.RandomResourceID[domain.ContestID]()
+	fakedProblemID := testutils.RandomResourceID[domain.ProblemID]()
+	fakedOwnership := domain.OwnershipData{
+		OrganizerID: testutils.RandomResourceID[domain.OrganizerID](),
+		ContenderID: &fakedContenderID,
+	}
+
+	fakedTemplate := domain.TickDisputeTemplate{
+		ProblemID:     fakedProblemID,
+		Comment:       "The judge missed my top",
+		Zone1:         true,
+		AttemptsZone1: 1,
+		Zone2:         true,
+		AttemptsZone2: 2,
+		Top:           true,
+		AttemptsTop:   3,
+	}
+
+	makeMocks := func() (*repositoryMock, *authorizerMock, *eventBrokerMock) {
+		mockedRepo := new(repositoryMock)
+		mockedAuthorizer := new(authorizerMock)
+		mockedEventBroker := new(eventBrokerMock)
+
+		mockedRepo.
+			On("GetContender", mock.Anything, nil, fakedContenderID).
+			Return(domain.Contender{
+				ID:        fakedContenderID,
+				Ownership: fakedOwnership,
+				ContestID: fakedContestID,
+			}, nil)
+
+		return mockedRepo, mockedAuthorizer, mockedEventBroker
+	}
+
+	t.Run("HappyPath", func(t *testing.T) {
+		synctest.Test(t, func(t *testing.T) {
+			mockedRepo, mockedAuthorizer, mockedEventBroker := makeMocks()
+
+			fakedDisputeID := testutils.RandomResourceID[domain.TickDisputeID]()
+
+			mockedAuthorizer.
+				On("HasOwnership", mock.Anything, fakedOwnership).
+				Return(domain.ContenderRole, nil)
+
+			mockedRepo.
+				On("GetContest", mock.Anything, nil, fakedContestID).
+				Return(domain.Contest{
+					ID: fakedContestID,
+				}, nil)
+
+			mockedRepo.
+				On("GetProblem", mock.Anything, nil, fakedProblemID).
+				Return(domain.Problem{
+					ID:        fakedProblemID,
+					ContestID: fakedContestID,
+				}, nil)
+
+			mockedRepo.
+				On("GetTickDisputesByContender", mock.Anything, nil, fakedContenderID).
+				Return([]domain.TickDispute{
+					{
+						ProblemID: fakedProblemID,
+						Status:    domain.TickDisputeStatusRejected,
+					},
+				}, nil)
+
+			expected := domain.TickDispute{
+				Ownership:     fakedOwnership,
+				ContestID:     fakedContestID,
+				ContenderID:   fakedContenderID,
+				ProblemID:     fakedProblemID,
+				Status:        domain.TickDisputeStatusOpen,
+				Comment:       "The judge missed my top",
+				Zone1:         true,
+				AttemptsZone1: 1,
+				Zone2:         true,
+				AttemptsZone2: 2,
+				Top:           true,
+				AttemptsTop:   3,
+				Created:       time.Now(),
+			}
+
+			stored := expected
+			stored.ID = fakedDisputeID
+
+			mockedRepo.
+				On("StoreTickDispute", mock.Anything, nil, expected).
+				Return(stored, nil)
+
+			mockedEventBroker.
+				On("Dispatch", mock.Anything, fakedContestID, domain.TickDisputeOpenedEvent{
+					DisputeID:   fakedDisputeID,
+					ContenderID: fakedContenderID,
+					ProblemID:   fakedProblemID,
+				}).
+				Return()
+
+			ucase := usecases.TickDisputeUseCase{
+				Repo:        mockedRepo,
+				Authorizer:  mockedAuthorizer,
+				EventBroker: mockedEventBroker,
+			}
+
+			dispute, err := ucase.OpenTickDispute(context.Background(), fakedContenderID, fakedTemplate)
+
+			require.NoError(t, err)
+			assert.Equal(t, stored, dispute)
+
+			mockedRepo.AssertExpectations(t)
+			mockedAuthorizer.AssertExpectations(t)
+			mockedEventBroker.AssertExpectations(t)
+		})
+	})
+
+	t.Run("DisputeAlreadyOpen", func(t *testing.T) {
+		mockedRepo, mockedAuthorizer, mockedEventBroker := makeMocks()
+
+		mockedAuthorizer.
+			On("HasOwnership", mock.Anything, fakedOwnership).
+			Return(domain.ContenderRole, nil)
+
+		mockedRepo.
+			On("GetContest", mock.Anything, nil, fakedContestID).
+			Return(domain.Contest{
+				ID: fakedContestID,
+			}, nil)
+
+		mockedRepo.
+			On("GetProblem", mock.Anything, nil, fakedProblemID).
+			Return(domain.Problem{
+				ID:        fakedProblemID,
+				ContestID: fakedContestID,
+			}, nil)
+
+		mockedRepo.
+			On("GetTickDisputesByContender", mock.Anything, nil, fakedContenderID).
+			Return([]domain.TickDispute{
+				{
+					ProblemID: fakedProblemID,
+					Status:    domain.TickDisputeStatusOpen,
+				},
+			}, nil)
+
+		ucase := usecases.TickDisputeUseCase{
+			Repo:        mockedRepo,
+			Authorizer:  mockedAuthorizer,
+			EventBroker: mockedEventBroker,
+		}
+
+		_, err := ucase.OpenTickDispute(context.Background(), fakedContenderID, fakedTemplate)
+
+		assert.ErrorIs(t, err, domain.ErrDuplicate)
+
+		mockedRepo.AssertExpectations(t)
+		mockedAuthorizer.AssertExpectations(t)
+		mockedEventBroker.AssertExpectations(t)
+	})
+
+	t.Run("ProblemNotInContest", func(t *testing.T) {
+		mockedRepo, mockedAuthorizer, mockedEventBroker := makeMocks()
+
+		mockedAuthorizer.
+			On("HasOwnership", mock.Anything, fakedOwnership).
+			Return(domain.ContenderRole, nil)
+
+		mockedRepo.
+			On("GetContest", mock.Anything, nil, fakedContestID).
+			Return(domain.Contest{
+				ID: fakedContestID,
+			}, nil)
+
+		mockedRepo.
+			On("GetProblem", mock.Anything, nil, fakedProblemID).
+			Return(domain.Problem{
+				ID:        fakedProblemID,
+				ContestID: testutils.RandomResourceID[domain.ContestID](),
+			}, nil)
+
+		ucase := usecases.TickDisputeUseCase{
+			Repo:        mockedRepo,
+			Authorizer:  mockedAuthorizer,
+			EventBroker: mockedEventBroker,
+		}
+
+		_, err := ucase.OpenTickDispute(context.Background(), fakedContenderID, fakedTemplate)
+
+		assert.ErrorIs(t, err, domain.ErrProblemNotInContest)
+
+		mockedRepo.AssertExpectations(t)
+		mockedAuthorizer.AssertExpectations(t)
+		mockedEventBroker.AssertExpectations(t)
+	})
+
+	t.Run("ContestArchived", func(t *testing.T) {
+		mockedRepo, mockedAuthorizer, mockedEventBroker := makeMocks()
+
+		mockedAuthorizer.
+			On("HasOwnership", mock.Anything, fakedOwnership).
+			Return(domain.ContenderRole, nil)
+
+		mockedRepo.
+			On("GetContest", mock.Anything, nil, fakedContestID).
+			Return(domain.Contest{
+				ID:         fakedContestID,
+				ArchivedAt: time.Now(),
+			}, nil)
+
+		ucase := usecases.TickDisputeUseCase{
+			Repo:        mockedRepo,
+			Authorizer:  mockedAuthorizer,
+			EventBroker: mockedEventBroker,
+		}
+
+		_, err := ucase.OpenTickDispute(context.Background(), fakedContenderID, fakedTemplate)
+
+		assert.ErrorIs(t, err, domain.ErrArchived)
+
+		mockedRepo.AssertExpectations(t)
+		mockedAuthorizer.AssertExpectations(t)
+		mockedEventBroker.AssertExpectations(t)
+	})
+
+	t.Run("BadCredentials", func(t *testing.T) {
+		mockedRepo, mockedAuthorizer, mockedEventBroker := makeMocks()
+
+		mockedAuthorizer.
+			On("HasOwnership", mock.Anything, fakedOwnership).
+			Return(domain.NilRole, domain.ErrNoOwnership)
+
+		ucase := usecases.TickDisputeUseCase{
+			Repo:        mockedRepo,
+			Authorizer:  mockedAuthorizer,
+			EventBroker: mockedEventBroker,
+		}
+
+		_, err := ucase.OpenTickDispute(context.Background(), fakedContenderID, fakedTemplate)
+
+		assert.ErrorIs(t, err, domain.ErrNoOwnership)
+
+		mockedRepo.AssertExpectations(t)
+		mockedAuthorizer.AssertExpectations(t)
+		mockedEventBroker.AssertExpectations(t)
+	})
+}
+
+func TestResolveTickDispute(t *testing.T) {
+	fakedDisputeID := testutils.RandomResourceID[domain.TickDisputeID]()
+	fakedContenderID := testutils.RandomResourceID[domain.ContenderID]()
+	fakedContestID := testutils.RandomResourceID[domain.ContestID]()
+	fakedProblemID := testutils.RandomResourceID[domain.ProblemID]()
+	fakedOwnership := domain.OwnershipData{
+		OrganizerID: testutils.RandomResourceID[domain.OrganizerID](),
+		ContenderID: &fakedContenderID,
+	}
+
+	fakedDispute := domain.TickDispute{
+		ID:            fakedDisputeID,
+		Ownership:     fakedOwnership,
+		ContestID:     fakedContestID,
+		ContenderID:   fakedContenderID,
+		ProblemID:     fakedProblemID,
+		Status:        domain.TickDisputeStatusOpen,
+		Comment:       "The judge missed my top",
+		Zone1:         true,
+		AttemptsZone1: 1,
+		Zone2:         true,
+		AttemptsZone2: 2,
+		Top:           true,
+		AttemptsTop:   3,
+	}
+
+	makeMocks := func(dispute domain.TickDispute) (*repositoryMock, *authorizerMock, *eventBrokerMock, *tickUseCaseMock) {
+		mockedRepo := new(repositoryMock)
+		mockedAuthorizer := new(authorizerMock)
+		mockedEventBroker := new(eventBrokerMock)
+		mockedTickUseCase := new(tickUseCaseMock)
+
+		mockedRepo.
+			On("GetTickDispute", mock.Anything, nil, fakedDisputeID).
+			Return(dispute, nil)
+
+		return mockedRepo, mockedAuthorizer, mockedEventBroker, mockedTickUseCase
+	}
+
+	t.Run("Accept", func(t *testing.T) {
+		synctest.Test(t, func(t *testing.T) {
+			mockedRepo, mockedAuthorizer, mockedEventBroker, mockedTickUseCase := makeMocks(fakedDispute)
+			mockedTx := new(transactionMock)
+
+			mockedAuthorizer.
+				On("HasOwnership", mock.Anything, fakedOwnership).
+				Return(domain.OrganizerRole, nil)
+
+			mockedAuthorizer.
+				On("GetAuthentication", mock.Anything).
+				Return(domain.Authentication{Username: "judge@example.com"}, nil)
+
+			mockedTickUseCase.
+				On("PutTickWithin", mock.Anything, fakedContenderID, domain.Tick{
+					Ownership:     fakedOwnership,
+					ContestID:     fakedContestID,
+					ProblemID:     fakedProblemID,
+					Zone1:         true,
+					AttemptsZone1: 1,
+					Zone2:         true,
+					AttemptsZone2: 2,
+					Top:           true,
+					AttemptsTop:   3,
+				}, mock.Anything).
+				Run(func(args mock.Arguments) {
+					within := args.Get(3).(func(context.Context, domain.Transaction) error)
+					assert.NoError(t, within(args.Get(0).(context.Context), mockedTx))
+				}).
+				Return(domain.Tick{}, nil)
+
+			expected := fakedDispute
+			expected.Status = domain.TickDisputeStatusAccepted
+			expected.Response = "Confirmed on video"
+			expected.Resolved = time.Now()
+			expected.ResolvedBy = "judge@example.com"
+
+			mockedRepo.
+				On("StoreTickDispute", mock.Anything, mockedTx, expected).
+				Return(expected, nil)
+
+			mockedEventBroker.
+				On("Dispatch", mock.Anything, fakedContestID, domain.TickDisputeResolvedEvent{
+					DisputeID:   fakedDisputeID,
+					ContenderID: fakedContenderID,
+					ProblemID:   fakedProblemID,
+					Status:      domain.TickDisputeStatusAccepted,
+				}).
+				Return()
+
+			ucase := usecases.TickDisputeUseCase{
+				Repo:        mockedRepo,
+				Authorizer:  mockedAuthorizer,
+				EventBroker: mockedEventBroker,
+				TickUseCase: mockedTickUseCase,
+			}
+
+			dispute, err := ucase.ResolveTickDispute(context.Background(), fakedDisputeID, domain.TickDisputeResolution{
+				Status:   domain.TickDisputeStatusAccepted,
+				Response: "Confirmed on video",
+			})
+
+			require.NoError(t, err)
+			assert.Equal(t, expected, dispute)
+
+			mockedRepo.AssertExpectations(t)
+			mockedAuthorizer.AssertExpectations(t)
+			mockedEventBroker.AssertExpectations(t)
+			mockedTickUseCase.AssertExpectations(t)
+		})
+	})
+
+	t.Run("Reject", func(t *testing.T) {
+		synctest.Test(t, func(t *testing.T) {
+			mockedRepo, mockedAuthorizer, mockedEventBroker, mockedTickUseCase := makeMocks(fakedDispute)
+
+			mockedAuthorizer.
+				On("HasOwnership", mock.Anything, fakedOwnership).
+				Return(domain.OrganizerRole, nil)
+
+			mockedAuthorizer.
+				On("GetAuthentication", mock.Anything).
+				Return(domain.Authentication{Username: "judge@example.com"}, nil)
+
+			expected := fakedDispute
+			expected.Status = domain.TickDisputeStatusRejected
+			expected.Resolved = time.Now()
+			expected.ResolvedBy = "judge@example.com"
+
+			mockedRepo.
+				On("StoreTickDispute", mock.Anything, nil, expected).
+				Return(expected, nil)
+
+			mockedEventBroker.
+				On("Dispatch", mock.Anything, fakedContestID, domain.TickDisputeResolvedEvent{
+					DisputeID:   fakedDisputeID,
+					ContenderID: fakedContenderID,
+					ProblemID:   fakedProblemID,
+					Status:      domain.TickDisputeStatusRejected,
+				}).
+				Return()
+
+			ucase := usecases.TickDisputeUseCase{
+				Repo:        mockedRepo,
+				Authorizer:  mockedAuthorizer,
+				EventBroker: mockedEventBroker,
+				TickUseCase: mockedTickUseCase,
+			}
+
+			_, err := ucase.ResolveTickDispute(context.Background(), fakedDisputeID, domain.TickDisputeResolution{
+				Status: domain.TickDisputeStatusRejected,
+			})
+
+			require.NoError(t, err)
+
+			mockedRepo.AssertExpectations(t)
+			mockedAuthorizer.AssertExpectations(t)
+			mockedEventBroker.AssertExpectations(t)
+			mockedTickUseCase.AssertNotCalled(t, "PutTickWithin", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		})
+	})
+
+	t.Run("AlreadyResolved", func(t *testing.T) {
+		resolvedDispute := fakedDispute
+		resolvedDispute.Status = domain.TickDisputeStatusRejected
+
+		mockedRepo, mockedAuthorizer, _, _ := makeMocks(resolvedDispute)
+
+		mockedAuthorizer.
+			On("HasOwnership", mock.Anything, fakedOwnership).
+			Return(domain.OrganizerRole, nil)
+
+		ucase := usecases.TickDisputeUseCase{
+			Repo:       mockedRepo,
+			Authorizer: mockedAuthorizer,
+		}
+
+		_, err := ucase.ResolveTickDispute(context.Background(), fakedDisputeID, domain.TickDisputeResolution{
+			Status: domain.TickDisputeStatusAccepted,
+		})
+
+		assert.ErrorIs(t, err, domain.ErrNotAllowed)
+
+		mockedRepo.AssertExpectations(t)
+		mockedAuthorizer.AssertExpectations(t)
+	})
+
+	t.Run("InvalidStatus", func(t *testing.T) {
+		mockedRepo, mockedAuthorizer, _, _ := makeMocks(fakedDispute)
+
+		mockedAuthorizer.
+			On("HasOwnership", mock.Anything, fakedOwnership).
+			Return(domain.OrganizerRole, nil)
+
+		ucase := usecases.TickDisputeUseCase{
+			Repo:       mockedRepo,
+			Authorizer: mockedAuthorizer,
+		}
+
+		_, err := ucase.ResolveTickDispute(context.Background(), fakedDisputeID, domain.TickDisputeResolution{
+			Status: domain.TickDisputeStatusOpen,
+		})
+
+		assert.ErrorIs(t, err, domain.ErrInvalidData)
+
+		mockedRepo.AssertExpectations(t)
+		mockedAuthorizer.AssertExpectations(t)
+	})
+
+	t.Run("InsufficientRole", func(t *testing.T) {
+		mockedRepo, mockedAuthorizer, _, _ := makeMocks(fakedDispute)
+
+		mockedAuthorizer.
+			On("HasOwnership", mock.Anything, fakedOwnership).
+			Return(domain.ContenderRole, nil)
+
+		ucase := usecases.TickDisputeUseCase{
+			Repo:       mockedRepo,
+			Authorizer: mockedAuthorizer,
+		}
+
+		_, err := ucase.ResolveTickDispute(context.Background(), fakedDisputeID, domain.TickDisputeResolution{
+			Status: domain.TickDisputeStatusAccepted,
+		})
+
+		assert.ErrorIs(t, err, domain.ErrInsufficientRole)
+
+		mockedRepo.AssertExpectations(t)
+		mockedAuthorizer.AssertExpectations(t)
+	})
+
+	t.Run("BadCredentials", func(t *testing.T) {
+		mockedRepo, mockedAuthorizer, _, _ := makeMocks(fakedDispute)
+
+		mockedAuthorizer.
+			On("HasOwnership", mock.Anything, fakedOwnership).
+			Return(domain.NilRole, domain.ErrNoOwnership)
+
+		ucase := usecases.TickDisputeUseCase{
+			Repo:       mockedRepo,
+			Authorizer: mockedAuthorizer,
+		}
+
+		_, err := ucase.ResolveTickDispute(context.Background(), fakedDisputeID, domain.TickDisputeResolution{
+			Status: domain.TickDisputeStatusAccepted,
+		})
+
+		assert.ErrorIs(t, err, domain.ErrNoOwnership)
+
+		mockedRepo.AssertExpectations(t)
+		mockedAuthorizer.AssertExpectations(t)
+	})
+}
+
+type tickUseCaseMock struct {
+	mock.Mock
+}
+
+func (m *tickUseCaseMock) PutTickWithin(ctx context.Context, contenderID domain.ContenderID, tick domain.Tick, within func(ctx context.Context, tx domain.Transaction) error) (domain.Tick, error) {
+	args := m.Called(ctx, contenderID, tick, within)
+	return args.Get(0).(domain.Tick), args.Error(1)
+}
@@ -14,11 +14,13 @@ type scoreEngineManager interface {
 	GetScoreEngine(ctx context.Context, instanceID domain.ScoreEngineInstanceID) (scores.ScoreEngineDescriptor, error)
 	ListScoreEnginesByContest(ctx context.Context, contestID domain.ContestID) ([]scores.ScoreEngineDescriptor, error)
 	StopScoreEngine(ctx context.Context, instanceID domain.ScoreEngineInstanceID) error
-	StartScoreEngine(ctx context.Context, contestID domain.ContestID, terminatedBy time.Time) (domain.ScoreEngineInstanceID, error)
+	StartScoreEngine(ctx context.Context, contestID domain.ContestID, roundID domain.RoundID, terminatedBy time.Time) (domain.ScoreEngineInstanceID, error)
+	GetProblemStats(ctx context.Context, contestID domain.ContestID, byCompClass bool) (map[domain.RoundID][]domain.ProblemStats, error)
 }
 
 type scoreEngineUseCaseRepository interface {
 	GetContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) (domain.Contest, error)
+	GetRound(ctx context.Context, tx domain.Transaction, roundID domain.RoundID) (domain.Round, error)
 }
 
 type ScoreEngineUseCase struct {
@@ -74,7 +76,7 @@ func (uc *ScoreEngineUseCase) StopScoreEngine(ctx context.Context, instanceID do
 	return nil
 }
 
-func (uc *ScoreEngineUseCase) StartScoreEngine(ctx context.Context, contestID domain.ContestID, terminatedBy time.Time) (domain.ScoreEngineInstanceID, error) {
+func (uc *ScoreEngineUseCase) StartScoreEngine(ctx context.Context, contestID domain.ContestID, roundID domain.RoundID, terminatedBy time.Time) (domain.ScoreEngineInstanceID, error) {
 	contest, err := uc.Repo.GetContest(ctx, nil, contestID)
 	if err != nil {
 		return uuid.Nil, errors.Wrap(err, 0)
@@ -102,7 +104,18 @@ func (uc *ScoreEngineUseCase) StartScoreEngine(ctx context.Context, contestID do
 		return uuid.Nil, errors.Wrap(domain.ErrNotAllowed, 0)
 	}
 
-	instanceID, err := uc.ScoreEngineManager.StartScoreEngine(ctx, contestID, terminatedBy)
+	if roundID != 0 {
+		round, err := uc.Repo.GetRound(ctx, nil, roundID)
+		if err != nil {
+			return uuid.Nil, errors.Wrap(err, 0)
+		}
+
+		if round.ContestID != contestID {
+			return uuid.Nil, errors.Wrap(domain.ErrNotFound, 0)
+		}
+	}
+
+	instanceID, err := uc.ScoreEngineManager.StartScoreEngine(ctx, contestID, roundID, terminatedBy)
 	if err != nil {
 		return uuid.Nil, errors.Wrap(err, 0)
 	}
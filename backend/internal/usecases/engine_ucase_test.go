@@ -117,7 +117,7 @@ func TestScoreEngineUseCase(t *testing.T) {
 			fakedInstanceID := domain.ScoreEngineInstanceID(uuid.New())
 
 			mockedScoreEngineManager.
-				On("StartScoreEngine", mock.Anything, fakedContestID, endTime.Add(time.Hour)).
+				On("StartScoreEngine", mock.Anything, fakedContestID, domain.RoundID(0), endTime.Add(time.Hour)).
 				Return(fakedInstanceID, nil)
 
 			ucase := usecases.ScoreEngineUseCase{
@@ -126,7 +126,7 @@ func TestScoreEngineUseCase(t *testing.T) {
 				ScoreEngineManager: mockedScoreEngineManager,
 			}
 
-			instanceID, err := ucase.StartScoreEngine(context.Background(), fakedContestID, endTime.Add(time.Hour))
+			instanceID, err := ucase.StartScoreEngine(context.Background(), fakedContestID, 0, endTime.Add(time.Hour))
 
 			require.NoError(t, err)
 			assert.Equal(t, fakedInstanceID, instanceID)
@@ -149,7 +149,7 @@ func TestScoreEngineUseCase(t *testing.T) {
 				Authorizer: mockedAuthorizer,
 			}
 
-			_, err := ucase.StartScoreEngine(context.Background(), fakedContestID, time.Now().Add(time.Hour))
+			_, err := ucase.StartScoreEngine(context.Background(), fakedContestID, 0, time.Now().Add(time.Hour))
 
 			require.ErrorIs(t, err, domain.ErrNotAllowed)
 		})
@@ -219,7 +219,7 @@ func TestScoreEngineUseCase(t *testing.T) {
 					fakedInstanceID := domain.ScoreEngineInstanceID(uuid.New())
 
 					mockedScoreEngineManager.
-						On("StartScoreEngine", mock.Anything, fakedContestID, scenario.terminatedBy).
+						On("StartScoreEngine", mock.Anything, fakedContestID, domain.RoundID(0), scenario.terminatedBy).
 						Return(fakedInstanceID, nil)
 
 					ucase := usecases.ScoreEngineUseCase{
@@ -228,7 +228,7 @@ func TestScoreEngineUseCase(t *testing.T) {
 						ScoreEngineManager: mockedScoreEngineManager,
 					}
 
-					instanceID, err := ucase.StartScoreEngine(context.Background(), fakedContestID, scenario.terminatedBy)
+					instanceID, err := ucase.StartScoreEngine(context.Background(), fakedContestID, 0, scenario.terminatedBy)
 
 					if scenario.expected == nil {
 						require.NoError(t, err)
@@ -254,7 +254,7 @@ func TestScoreEngineUseCase(t *testing.T) {
 				Authorizer: mockedAuthorizer,
 			}
 
-			instanceID, err := ucase.StartScoreEngine(context.Background(), fakedContestID, time.Now().Add(time.Hour))
+			instanceID, err := ucase.StartScoreEngine(context.Background(), fakedContestID, 0, time.Now().Add(time.Hour))
 
 			require.ErrorIs(t, err, domain.ErrNoOwnership)
 			assert.Empty(t, instanceID)
@@ -345,7 +345,12 @@ func (m *scoreEngineManagerMock) StopScoreEngine(ctx context.Context, instanceID
 	return args.Error(0)
 }
 
-func (m *scoreEngineManagerMock) StartScoreEngine(ctx context.Context, contestID domain.ContestID, terminatedBy time.Time) (domain.ScoreEngineInstanceID, error) {
-	args := m.Called(ctx, contestID, terminatedBy)
+func (m *scoreEngineManagerMock) StartScoreEngine(ctx context.Context, contestID domain.ContestID, roundID domain.RoundID, terminatedBy time.Time) (domain.ScoreEngineInstanceID, error) {
+	args := m.Called(ctx, contestID, roundID, terminatedBy)
 	return args.Get(0).(domain.ScoreEngineInstanceID), args.Error(1)
 }
+
+func (m *scoreEngineManagerMock) GetProblemStats(ctx context.Context, contestID domain.ContestID, byCompClass bool) (map[domain.RoundID][]domain.ProblemStats, error) {
+	args := m.Called(ctx, contestID, byCompClass)
+	return args.Get(0).(map[domain.RoundID][]domain.ProblemStats), args.Error(1)
+}
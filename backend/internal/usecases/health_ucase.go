@@ -4,18 +4,39 @@ import (
 	"context"
 
 	"github.com/climblive/platform/backend/internal/domain"
+	"github.com/go-errors/errors"
 )
 
+type retentionPolicyStatusReporter interface {
+	GetRetentionPolicyStatuses() []domain.ServiceStatus
+}
+
 type HealthUseCase struct {
+	Authorizer         domain.Authorizer
 	ScoreEngineManager domain.StatusReporter
 	ScoreKeeper        domain.StatusReporter
-	Scrubber           domain.StatusReporter
+	Scrubber           domain.MultiStatusReporter
+	RetentionPolicies  retentionPolicyStatusReporter
 }
 
 func (uc *HealthUseCase) GetHealth(_ context.Context) ([]domain.ServiceStatus, error) {
-	return []domain.ServiceStatus{
+	statuses := []domain.ServiceStatus{
 		uc.ScoreEngineManager.GetStatus(),
 		uc.ScoreKeeper.GetStatus(),
-		uc.Scrubber.GetStatus(),
-	}, nil
+	}
+
+	return append(statuses, uc.Scrubber.GetStatuses()...), nil
+}
+
+func (uc *HealthUseCase) GetRetentionPolicyHealth(ctx context.Context) ([]domain.ServiceStatus, error) {
+	role, err := uc.Authorizer.HasOwnership(ctx, domain.OwnershipData{})
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	if role != domain.AdminRole {
+		return nil, errors.Wrap(domain.ErrNotAuthorized, 0)
+	}
+
+	return uc.RetentionPolicies.GetRetentionPolicyStatuses(), nil
 }
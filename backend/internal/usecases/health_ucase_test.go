@@ -0,0 +1,66 @@
+package usecases_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/climblive/platform/backend/internal/domain"
+	"github.com/climblive/platform/backend/internal/usecases"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type retentionPolicyStatusReporterStub struct {
+	statuses []domain.ServiceStatus
+}
+
+func (s *retentionPolicyStatusReporterStub) GetRetentionPolicyStatuses() []domain.ServiceStatus {
+	return s.statuses
+}
+
+func TestGetRetentionPolicyHealth(t *testing.T) {
+	statuses := []domain.ServiceStatus{
+		{Name: "Retention policy 1", Healthy: true, CheckedAt: time.Now()},
+	}
+
+	t.Run("HappyPath", func(t *testing.T) {
+		mockedAuthorizer := new(authorizerMock)
+
+		mockedAuthorizer.
+			On("HasOwnership", mock.Anything, domain.OwnershipData{}).
+			Return(domain.AdminRole, nil)
+
+		ucase := usecases.HealthUseCase{
+			Authorizer:        mockedAuthorizer,
+			RetentionPolicies: &retentionPolicyStatusReporterStub{statuses: statuses},
+		}
+
+		health, err := ucase.GetRetentionPolicyHealth(context.Background())
+
+		require.NoError(t, err)
+		assert.Equal(t, statuses, health)
+
+		mockedAuthorizer.AssertExpectations(t)
+	})
+
+	t.Run("NotAuthorized", func(t *testing.T) {
+		mockedAuthorizer := new(authorizerMock)
+
+		mockedAuthorizer.
+			On("HasOwnership", mock.Anything, domain.OwnershipData{}).
+			Return(domain.OrganizerRole, nil)
+
+		ucase := usecases.HealthUseCase{
+			Authorizer:        mockedAuthorizer,
+			RetentionPolicies: &retentionPolicyStatusReporterStub{statuses: statuses},
+		}
+
+		_, err := ucase.GetRetentionPolicyHealth(context.Background())
+
+		assert.ErrorIs(t, err, domain.ErrNotAuthorized)
+
+		mockedAuthorizer.AssertExpectations(t)
+	})
+}
@@ -48,6 +48,11 @@ func (m *repositoryMock) GetContender(ctx context.Context, tx domain.Transaction
 	return args.Get(0).(domain.Contender), args.Error(1)
 }
 
+func (m *repositoryMock) GetContenderVersionForUpdate(ctx context.Context, tx domain.Transaction, contenderID domain.ContenderID) (int, error) {
+	args := m.Called(ctx, tx, contenderID)
+	return args.Get(0).(int), args.Error(1)
+}
+
 func (m *repositoryMock) GetContenderByCode(ctx context.Context, tx domain.Transaction, registrationCode string) (domain.Contender, error) {
 	args := m.Called(ctx, tx, registrationCode)
 	return args.Get(0).(domain.Contender), args.Error(1)
@@ -58,11 +63,31 @@ func (m *repositoryMock) GetContendersByCompClass(ctx context.Context, tx domain
 	return args.Get(0).([]domain.Contender), args.Error(1)
 }
 
+func (m *repositoryMock) GetWaitlistedContenders(ctx context.Context, tx domain.Transaction, compClassID domain.CompClassID) ([]domain.Contender, error) {
+	args := m.Called(ctx, tx, compClassID)
+	return args.Get(0).([]domain.Contender), args.Error(1)
+}
+
+func (m *repositoryMock) GetContendersByTeam(ctx context.Context, tx domain.Transaction, teamID domain.TeamID) ([]domain.Contender, error) {
+	args := m.Called(ctx, tx, teamID)
+	return args.Get(0).([]domain.Contender), args.Error(1)
+}
+
 func (m *repositoryMock) GetContendersByContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) ([]domain.Contender, error) {
 	args := m.Called(ctx, tx, contestID)
 	return args.Get(0).([]domain.Contender), args.Error(1)
 }
 
+func (m *repositoryMock) GetPublishedScoresByContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) ([]domain.Score, error) {
+	args := m.Called(ctx, tx, contestID)
+	return args.Get(0).([]domain.Score), args.Error(1)
+}
+
+func (m *repositoryMock) GetContendersByContestFiltered(ctx context.Context, tx domain.Transaction, contestID domain.ContestID, filter domain.ContenderFilter, page domain.PageRequest) ([]domain.Contender, error) {
+	args := m.Called(ctx, tx, contestID, filter, page)
+	return args.Get(0).([]domain.Contender), args.Error(1)
+}
+
 func (m *repositoryMock) StoreContender(ctx context.Context, tx domain.Transaction, contender domain.Contender) (domain.Contender, error) {
 	args := m.Called(ctx, tx, contender)
 	return args.Get(0).(domain.Contender), args.Error(1)
@@ -83,8 +108,13 @@ func (m *repositoryMock) GetContest(ctx context.Context, tx domain.Transaction,
 	return args.Get(0).(domain.Contest), args.Error(1)
 }
 
-func (m *repositoryMock) GetAllContests(ctx context.Context, tx domain.Transaction) ([]domain.Contest, error) {
-	args := m.Called(ctx, tx)
+func (m *repositoryMock) GetContestVersionForUpdate(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) (int, error) {
+	args := m.Called(ctx, tx, contestID)
+	return args.Get(0).(int), args.Error(1)
+}
+
+func (m *repositoryMock) GetContests(ctx context.Context, tx domain.Transaction, filter domain.ContestFilter, page domain.PageRequest) ([]domain.Contest, error) {
+	args := m.Called(ctx, tx, filter, page)
 	return args.Get(0).([]domain.Contest), args.Error(1)
 }
 
@@ -143,6 +173,11 @@ func (m *repositoryMock) GetTicksByContest(ctx context.Context, tx domain.Transa
 	return args.Get(0).([]domain.Tick), args.Error(1)
 }
 
+func (m *repositoryMock) GetTicksByContestFiltered(ctx context.Context, tx domain.Transaction, contestID domain.ContestID, filter domain.TickFilter, page domain.PageRequest) ([]domain.Tick, error) {
+	args := m.Called(ctx, tx, contestID, filter, page)
+	return args.Get(0).([]domain.Tick), args.Error(1)
+}
+
 func (m *repositoryMock) DeleteTick(ctx context.Context, tx domain.Transaction, tickID domain.TickID) error {
 	args := m.Called(ctx, tx, tickID)
 	return args.Error(0)
@@ -173,6 +208,11 @@ func (m *repositoryMock) GetProblem(ctx context.Context, tx domain.Transaction,
 	return args.Get(0).(domain.Problem), args.Error(1)
 }
 
+func (m *repositoryMock) GetProblemVersionForUpdate(ctx context.Context, tx domain.Transaction, problemID domain.ProblemID) (int, error) {
+	args := m.Called(ctx, tx, problemID)
+	return args.Get(0).(int), args.Error(1)
+}
+
 func (m *repositoryMock) GetProblemByNumber(ctx context.Context, tx domain.Transaction, contestID domain.ContestID, problemNumber int) (domain.Problem, error) {
 	args := m.Called(ctx, tx, contestID, problemNumber)
 	return args.Get(0).(domain.Problem), args.Error(1)
@@ -218,6 +258,16 @@ func (m *repositoryMock) DeleteRaffle(ctx context.Context, tx domain.Transaction
 	return args.Error(0)
 }
 
+func (m *repositoryMock) GetRaffleWinnersByContender(ctx context.Context, tx domain.Transaction, contenderID domain.ContenderID) ([]domain.RaffleWinner, error) {
+	args := m.Called(ctx, tx, contenderID)
+	return args.Get(0).([]domain.RaffleWinner), args.Error(1)
+}
+
+func (m *repositoryMock) StoreAuditEntry(ctx context.Context, tx domain.Transaction, entry domain.AuditEntry) (domain.AuditEntry, error) {
+	args := m.Called(ctx, tx, entry)
+	return args.Get(0).(domain.AuditEntry), args.Error(1)
+}
+
 func (m *repositoryMock) GetRaffleWinners(ctx context.Context, tx domain.Transaction, raffleID domain.RaffleID) ([]domain.RaffleWinner, error) {
 	args := m.Called(ctx, tx, raffleID)
 	return args.Get(0).([]domain.RaffleWinner), args.Error(1)
@@ -233,11 +283,41 @@ func (m *repositoryMock) DeleteRaffleWinner(ctx context.Context, tx domain.Trans
 	return args.Error(0)
 }
 
+func (m *repositoryMock) GetRaffleWinner(ctx context.Context, tx domain.Transaction, raffleWinnerID domain.RaffleWinnerID) (domain.RaffleWinner, error) {
+	args := m.Called(ctx, tx, raffleWinnerID)
+	return args.Get(0).(domain.RaffleWinner), args.Error(1)
+}
+
+func (m *repositoryMock) GetRafflePrize(ctx context.Context, tx domain.Transaction, prizeID domain.RafflePrizeID) (domain.RafflePrize, error) {
+	args := m.Called(ctx, tx, prizeID)
+	return args.Get(0).(domain.RafflePrize), args.Error(1)
+}
+
+func (m *repositoryMock) GetRafflePrizes(ctx context.Context, tx domain.Transaction, raffleID domain.RaffleID) ([]domain.RafflePrize, error) {
+	args := m.Called(ctx, tx, raffleID)
+	return args.Get(0).([]domain.RafflePrize), args.Error(1)
+}
+
+func (m *repositoryMock) StoreRafflePrize(ctx context.Context, tx domain.Transaction, prize domain.RafflePrize) (domain.RafflePrize, error) {
+	args := m.Called(ctx, tx, prize)
+	return args.Get(0).(domain.RafflePrize), args.Error(1)
+}
+
+func (m *repositoryMock) DeleteRafflePrize(ctx context.Context, tx domain.Transaction, prizeID domain.RafflePrizeID) error {
+	args := m.Called(ctx, tx, prizeID)
+	return args.Error(0)
+}
+
 func (m *repositoryMock) GetUserByUsername(ctx context.Context, tx domain.Transaction, username string) (domain.User, error) {
 	args := m.Called(ctx, tx, username)
 	return args.Get(0).(domain.User), args.Error(1)
 }
 
+func (m *repositoryMock) StoreUser(ctx context.Context, tx domain.Transaction, user domain.User) (domain.User, error) {
+	args := m.Called(ctx, tx, user)
+	return args.Get(0).(domain.User), args.Error(1)
+}
+
 func (m *repositoryMock) GetUsersByOrganizer(ctx context.Context, tx domain.Transaction, organizerID domain.OrganizerID) ([]domain.User, error) {
 	args := m.Called(ctx, tx, organizerID)
 	return args.Get(0).([]domain.User), args.Error(1)
@@ -273,6 +353,66 @@ func (m *repositoryMock) StoreOrganizerInvite(ctx context.Context, tx domain.Tra
 	return args.Error(0)
 }
 
+func (m *repositoryMock) GetRound(ctx context.Context, tx domain.Transaction, roundID domain.RoundID) (domain.Round, error) {
+	args := m.Called(ctx, tx, roundID)
+	return args.Get(0).(domain.Round), args.Error(1)
+}
+
+func (m *repositoryMock) GetRoundsByContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) ([]domain.Round, error) {
+	args := m.Called(ctx, tx, contestID)
+	return args.Get(0).([]domain.Round), args.Error(1)
+}
+
+func (m *repositoryMock) StoreRound(ctx context.Context, tx domain.Transaction, round domain.Round) (domain.Round, error) {
+	args := m.Called(ctx, tx, round)
+	return args.Get(0).(domain.Round), args.Error(1)
+}
+
+func (m *repositoryMock) DeleteRound(ctx context.Context, tx domain.Transaction, roundID domain.RoundID) error {
+	args := m.Called(ctx, tx, roundID)
+	return args.Error(0)
+}
+
+func (m *repositoryMock) GetTeam(ctx context.Context, tx domain.Transaction, teamID domain.TeamID) (domain.Team, error) {
+	args := m.Called(ctx, tx, teamID)
+	return args.Get(0).(domain.Team), args.Error(1)
+}
+
+func (m *repositoryMock) GetTeamsByContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) ([]domain.Team, error) {
+	args := m.Called(ctx, tx, contestID)
+	return args.Get(0).([]domain.Team), args.Error(1)
+}
+
+func (m *repositoryMock) StoreTeam(ctx context.Context, tx domain.Transaction, team domain.Team) (domain.Team, error) {
+	args := m.Called(ctx, tx, team)
+	return args.Get(0).(domain.Team), args.Error(1)
+}
+
+func (m *repositoryMock) DeleteTeam(ctx context.Context, tx domain.Transaction, teamID domain.TeamID) error {
+	args := m.Called(ctx, tx, teamID)
+	return args.Error(0)
+}
+
+func (m *repositoryMock) GetStartList(ctx context.Context, tx domain.Transaction, roundID domain.RoundID) ([]domain.StartListEntry, error) {
+	args := m.Called(ctx, tx, roundID)
+	return args.Get(0).([]domain.StartListEntry), args.Error(1)
+}
+
+func (m *repositoryMock) GetStartListEntry(ctx context.Context, tx domain.Transaction, roundID domain.RoundID, contenderID domain.ContenderID) (domain.StartListEntry, error) {
+	args := m.Called(ctx, tx, roundID, contenderID)
+	return args.Get(0).(domain.StartListEntry), args.Error(1)
+}
+
+func (m *repositoryMock) StoreStartListEntry(ctx context.Context, tx domain.Transaction, entry domain.StartListEntry) error {
+	args := m.Called(ctx, tx, entry)
+	return args.Error(0)
+}
+
+func (m *repositoryMock) DeleteStartList(ctx context.Context, tx domain.Transaction, roundID domain.RoundID) error {
+	args := m.Called(ctx, tx, roundID)
+	return args.Error(0)
+}
+
 func (m *repositoryMock) StoreScore(ctx context.Context, tx domain.Transaction, score domain.Score) error {
 	args := m.Called(ctx, tx, score)
 	return args.Error(0)
@@ -301,12 +441,22 @@ func (m *scoreKeeperMock) GetScore(contenderID domain.ContenderID) (domain.Score
 	return args.Get(0).(domain.Score), args.Error(1)
 }
 
+func (m *scoreKeeperMock) GetPublishedScore(contenderID domain.ContenderID) (domain.Score, error) {
+	args := m.Called(contenderID)
+	return args.Get(0).(domain.Score), args.Error(1)
+}
+
+func (m *scoreKeeperMock) GetRoundScore(roundID domain.RoundID, contenderID domain.ContenderID) (domain.Score, error) {
+	args := m.Called(roundID, contenderID)
+	return args.Get(0).(domain.Score), args.Error(1)
+}
+
 type eventBrokerMock struct {
 	mock.Mock
 }
 
-func (m *eventBrokerMock) Dispatch(contestID domain.ContestID, event any) {
-	m.Called(contestID, event)
+func (m *eventBrokerMock) Dispatch(ctx context.Context, contestID domain.ContestID, event any) {
+	m.Called(ctx, contestID, event)
 }
 
 func (m *eventBrokerMock) Subscribe(filter domain.EventFilter, bufferCapacity int) (domain.SubscriptionID, domain.EventReader) {
@@ -326,3 +476,143 @@ func (m *uuidGeneratorMock) Generate() uuid.UUID {
 	args := m.Called()
 	return args.Get(0).(uuid.UUID)
 }
+
+func (m *repositoryMock) GetRetentionPolicy(ctx context.Context, tx domain.Transaction, policyID domain.RetentionPolicyID) (domain.RetentionPolicy, error) {
+	args := m.Called(ctx, tx, policyID)
+	return args.Get(0).(domain.RetentionPolicy), args.Error(1)
+}
+
+func (m *repositoryMock) GetRetentionPoliciesByOrganizer(ctx context.Context, tx domain.Transaction, organizerID domain.OrganizerID) ([]domain.RetentionPolicy, error) {
+	args := m.Called(ctx, tx, organizerID)
+	return args.Get(0).([]domain.RetentionPolicy), args.Error(1)
+}
+
+func (m *repositoryMock) GetEnabledRetentionPolicies(ctx context.Context, tx domain.Transaction) ([]domain.RetentionPolicy, error) {
+	args := m.Called(ctx, tx)
+	return args.Get(0).([]domain.RetentionPolicy), args.Error(1)
+}
+
+func (m *repositoryMock) StoreRetentionPolicy(ctx context.Context, tx domain.Transaction, policy domain.RetentionPolicy) (domain.RetentionPolicy, error) {
+	args := m.Called(ctx, tx, policy)
+	return args.Get(0).(domain.RetentionPolicy), args.Error(1)
+}
+
+func (m *repositoryMock) DeleteRetentionPolicy(ctx context.Context, tx domain.Transaction, policyID domain.RetentionPolicyID) error {
+	args := m.Called(ctx, tx, policyID)
+	return args.Error(0)
+}
+
+func (m *repositoryMock) GetRetentionPolicyExecutions(ctx context.Context, tx domain.Transaction, policyID domain.RetentionPolicyID) ([]domain.RetentionPolicyExecution, error) {
+	args := m.Called(ctx, tx, policyID)
+	return args.Get(0).([]domain.RetentionPolicyExecution), args.Error(1)
+}
+
+func (m *repositoryMock) StoreRetentionPolicyExecution(ctx context.Context, tx domain.Transaction, execution domain.RetentionPolicyExecution) (domain.RetentionPolicyExecution, error) {
+	args := m.Called(ctx, tx, execution)
+	return args.Get(0).(domain.RetentionPolicyExecution), args.Error(1)
+}
+
+func (m *repositoryMock) DeleteTicksByContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) (int, error) {
+	args := m.Called(ctx, tx, contestID)
+	return args.Get(0).(int), args.Error(1)
+}
+
+func (m *repositoryMock) DeleteContendersByContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) (int, error) {
+	args := m.Called(ctx, tx, contestID)
+	return args.Get(0).(int), args.Error(1)
+}
+
+func (m *repositoryMock) DeleteRaffleWinnersByContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) error {
+	args := m.Called(ctx, tx, contestID)
+	return args.Error(0)
+}
+
+func (m *repositoryMock) DeleteRafflePrizesByContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) error {
+	args := m.Called(ctx, tx, contestID)
+	return args.Error(0)
+}
+
+func (m *repositoryMock) DeleteRafflesByContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) error {
+	args := m.Called(ctx, tx, contestID)
+	return args.Error(0)
+}
+
+func (m *repositoryMock) DeleteProblemsByContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) error {
+	args := m.Called(ctx, tx, contestID)
+	return args.Error(0)
+}
+
+func (m *repositoryMock) DeleteRoundsByContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) error {
+	args := m.Called(ctx, tx, contestID)
+	return args.Error(0)
+}
+
+func (m *repositoryMock) DeleteTeamsByContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) error {
+	args := m.Called(ctx, tx, contestID)
+	return args.Error(0)
+}
+
+func (m *repositoryMock) DeleteCompClassesByContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) error {
+	args := m.Called(ctx, tx, contestID)
+	return args.Error(0)
+}
+
+func (m *repositoryMock) DeleteTickRevisionsByContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) error {
+	args := m.Called(ctx, tx, contestID)
+	return args.Error(0)
+}
+
+func (m *repositoryMock) DeleteTickDisputesByContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) error {
+	args := m.Called(ctx, tx, contestID)
+	return args.Error(0)
+}
+
+func (m *repositoryMock) DeleteScoresByContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) error {
+	args := m.Called(ctx, tx, contestID)
+	return args.Error(0)
+}
+
+func (m *repositoryMock) DeletePublishedScoresByContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) error {
+	args := m.Called(ctx, tx, contestID)
+	return args.Error(0)
+}
+
+func (m *repositoryMock) GetTickRevisionsByContender(ctx context.Context, tx domain.Transaction, contenderID domain.ContenderID) ([]domain.TickRevision, error) {
+	args := m.Called(ctx, tx, contenderID)
+	return args.Get(0).([]domain.TickRevision), args.Error(1)
+}
+
+func (m *repositoryMock) GetTickRevisionsByContenderAndProblem(ctx context.Context, tx domain.Transaction, contenderID domain.ContenderID, problemID domain.ProblemID) ([]domain.TickRevision, error) {
+	args := m.Called(ctx, tx, contenderID, problemID)
+	return args.Get(0).([]domain.TickRevision), args.Error(1)
+}
+
+func (m *repositoryMock) GetTickRevisionByIdempotencyKey(ctx context.Context, tx domain.Transaction, contenderID domain.ContenderID, idempotencyKey string) (domain.TickRevision, error) {
+	args := m.Called(ctx, tx, contenderID, idempotencyKey)
+	return args.Get(0).(domain.TickRevision), args.Error(1)
+}
+
+func (m *repositoryMock) StoreTickRevision(ctx context.Context, tx domain.Transaction, revision domain.TickRevision) (domain.TickRevision, error) {
+	args := m.Called(ctx, tx, revision)
+	return args.Get(0).(domain.TickRevision), args.Error(1)
+}
+
+func (m *repositoryMock) GetTickDispute(ctx context.Context, tx domain.Transaction, disputeID domain.TickDisputeID) (domain.TickDispute, error) {
+	args := m.Called(ctx, tx, disputeID)
+	return args.Get(0).(domain.TickDispute), args.Error(1)
+}
+
+func (m *repositoryMock) GetTickDisputesByContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) ([]domain.TickDispute, error) {
+	args := m.Called(ctx, tx, contestID)
+	return args.Get(0).([]domain.TickDispute), args.Error(1)
+}
+
+func (m *repositoryMock) GetTickDisputesByContender(ctx context.Context, tx domain.Transaction, contenderID domain.ContenderID) ([]domain.TickDispute, error) {
+	args := m.Called(ctx, tx, contenderID)
+	return args.Get(0).([]domain.TickDispute), args.Error(1)
+}
+
+func (m *repositoryMock) StoreTickDispute(ctx context.Context, tx domain.Transaction, dispute domain.TickDispute) (domain.TickDispute, error) {
+	args := m.Called(ctx, tx, dispute)
+	return args.Get(0).(domain.TickDispute), args.Error(1)
+}
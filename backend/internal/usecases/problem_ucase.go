@@ -1,10 +1,15 @@
 package usecases
 
 import (
+	"cmp"
 	"context"
+	"iter"
+	"maps"
+	"slices"
 	"strings"
 
 	"github.com/climblive/platform/backend/internal/domain"
+	"github.com/climblive/platform/backend/internal/scores"
 	"github.com/climblive/platform/backend/internal/usecases/validators"
 	"github.com/go-errors/errors"
 )
@@ -17,16 +22,22 @@ type problemUseCaseRepository interface {
 	GetProblemsByContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) ([]domain.Problem, error)
 	StoreProblem(ctx context.Context, tx domain.Transaction, problem domain.Problem) (domain.Problem, error)
 	GetProblem(ctx context.Context, tx domain.Transaction, problemID domain.ProblemID) (domain.Problem, error)
+	GetProblemVersionForUpdate(ctx context.Context, tx domain.Transaction, problemID domain.ProblemID) (int, error)
 	GetProblemByNumber(ctx context.Context, tx domain.Transaction, contestID domain.ContestID, problemNumber int) (domain.Problem, error)
 	GetContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) (domain.Contest, error)
 	DeleteProblem(ctx context.Context, tx domain.Transaction, problemID domain.ProblemID) error
 	GetTicksByProblem(ctx context.Context, tx domain.Transaction, problemID domain.ProblemID) ([]domain.Tick, error)
+	GetRound(ctx context.Context, tx domain.Transaction, roundID domain.RoundID) (domain.Round, error)
+	GetCompClassesByContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) ([]domain.CompClass, error)
+	GetContendersByContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) ([]domain.Contender, error)
+	GetTicksByContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) ([]domain.Tick, error)
 }
 
 type ProblemUseCase struct {
-	Authorizer  domain.Authorizer
-	Repo        problemUseCaseRepository
-	EventBroker domain.EventBroker
+	Authorizer         domain.Authorizer
+	Repo               problemUseCaseRepository
+	EventBroker        domain.EventBroker
+	ScoreEngineManager scoreEngineManager
 }
 
 func (uc *ProblemUseCase) GetProblem(ctx context.Context, problemID domain.ProblemID) (domain.Problem, error) {
@@ -47,7 +58,7 @@ func (uc *ProblemUseCase) GetProblemsByContest(ctx context.Context, contestID do
 	return problems, nil
 }
 
-func (uc *ProblemUseCase) PatchProblem(ctx context.Context, problemID domain.ProblemID, patch domain.ProblemPatch) (domain.Problem, error) {
+func (uc *ProblemUseCase) PatchProblem(ctx context.Context, problemID domain.ProblemID, patch domain.ProblemPatch, expectedVersion int) (domain.Problem, error) {
 	var mty domain.Problem
 
 	problem, err := uc.Repo.GetProblem(ctx, nil, problemID)
@@ -60,11 +71,13 @@ func (uc *ProblemUseCase) PatchProblem(ctx context.Context, problemID domain.Pro
 		return mty, errors.Wrap(err, 0)
 	}
 
-	problemUpdatedEventBaseline := domain.ProblemUpdatedEvent{
-		ProblemID:    problemID,
-		ProblemValue: problem.ProblemValue,
+	if err := checkVersion(expectedVersion, problem.Version); err != nil {
+		return mty, err
 	}
 
+	problemValueBaseline := problem.ProblemValue
+	compClassIDsBaseline := problem.CompClassIDs
+
 	if patch.Number.PresentAndDistinct(problem.Number) {
 		_, err = uc.Repo.GetProblemByNumber(ctx, nil, problem.ContestID, patch.Number.Value)
 		switch {
@@ -90,6 +103,23 @@ func (uc *ProblemUseCase) PatchProblem(ctx context.Context, problemID domain.Pro
 		problem.Description = strings.TrimSpace(patch.Description.Value)
 	}
 
+	if patch.Sector.Present {
+		problem.Sector = strings.TrimSpace(patch.Sector.Value)
+	}
+
+	if patch.Tags.Present {
+		problem.Tags = normalizeTags(patch.Tags.Value)
+	}
+
+	if patch.CompClassIDs.PresentAndDistinct(problem.CompClassIDs) {
+		compClassIDs, err := uc.checkCompClassIDs(ctx, problem.ContestID, patch.CompClassIDs.Value)
+		if err != nil {
+			return mty, errors.Wrap(err, 0)
+		}
+
+		problem.CompClassIDs = compClassIDs
+	}
+
 	if patch.Zone1Enabled.Present {
 		problem.Zone1Enabled = patch.Zone1Enabled.Value
 	}
@@ -118,17 +148,26 @@ func (uc *ProblemUseCase) PatchProblem(ctx context.Context, problemID domain.Pro
 		return mty, errors.Wrap(err, 0)
 	}
 
-	if _, err = uc.Repo.StoreProblem(ctx, nil, problem); err != nil {
+	stored, err := storeVersioned(ctx, uc.Repo, expectedVersion,
+		func(ctx context.Context, tx domain.Transaction) (int, error) {
+			return uc.Repo.GetProblemVersionForUpdate(ctx, tx, problemID)
+		},
+		func(ctx context.Context, tx domain.Transaction) (domain.Problem, error) {
+			return uc.Repo.StoreProblem(ctx, tx, problem)
+		})
+	if err != nil {
 		return mty, errors.Wrap(err, 0)
 	}
 
-	event := domain.ProblemUpdatedEvent{
-		ProblemID:    problemID,
-		ProblemValue: problem.ProblemValue,
-	}
+	problem.Version = stored.Version
 
-	if event != problemUpdatedEventBaseline {
-		uc.EventBroker.Dispatch(problem.ContestID, event)
+	if problem.ProblemValue != problemValueBaseline || !slices.Equal(problem.CompClassIDs, compClassIDsBaseline) {
+		uc.EventBroker.Dispatch(ctx, problem.ContestID, domain.ProblemUpdatedEvent{
+			ProblemID:    problemID,
+			RoundID:      problem.RoundID,
+			CompClassIDs: problem.CompClassIDs,
+			ProblemValue: problem.ProblemValue,
+		})
 	}
 
 	return problem, nil
@@ -163,16 +202,38 @@ func (uc *ProblemUseCase) CreateProblem(ctx context.Context, contestID domain.Co
 		return domain.Problem{}, errors.Wrap(err, 0)
 	}
 
+	if tmpl.RoundID != 0 {
+		round, err := uc.Repo.GetRound(ctx, nil, tmpl.RoundID)
+		switch {
+		case errors.Is(err, domain.ErrNotFound):
+			return domain.Problem{}, errors.Wrap(domain.ErrInvalidData, 0)
+		case err != nil:
+			return domain.Problem{}, errors.Wrap(err, 0)
+		case round.ContestID != contestID:
+			return domain.Problem{}, errors.Wrap(domain.ErrInvalidData, 0)
+		}
+	}
+
+	compClassIDs, err := uc.checkCompClassIDs(ctx, contestID, tmpl.CompClassIDs)
+	if err != nil {
+		return domain.Problem{}, errors.Wrap(err, 0)
+	}
+
 	problem := domain.Problem{
 		ID:                 0,
 		Ownership:          contest.Ownership,
 		ContestID:          contestID,
+		RoundID:            tmpl.RoundID,
 		Number:             tmpl.Number,
 		HoldColorPrimary:   strings.TrimSpace(tmpl.HoldColorPrimary),
 		HoldColorSecondary: strings.TrimSpace(tmpl.HoldColorSecondary),
 		Description:        strings.TrimSpace(tmpl.Description),
+		Sector:             strings.TrimSpace(tmpl.Sector),
+		Tags:               normalizeTags(tmpl.Tags),
+		CompClassIDs:       compClassIDs,
 		Zone1Enabled:       tmpl.Zone1Enabled,
 		Zone2Enabled:       tmpl.Zone2Enabled,
+		Version:            0,
 		ProblemValue:       tmpl.ProblemValue,
 	}
 
@@ -187,10 +248,12 @@ func (uc *ProblemUseCase) CreateProblem(ctx context.Context, contestID domain.Co
 
 	event := domain.ProblemAddedEvent{
 		ProblemID:    createdProblem.ID,
+		RoundID:      createdProblem.RoundID,
+		CompClassIDs: createdProblem.CompClassIDs,
 		ProblemValue: problem.ProblemValue,
 	}
 
-	uc.EventBroker.Dispatch(problem.ContestID, event)
+	uc.EventBroker.Dispatch(ctx, problem.ContestID, event)
 
 	return createdProblem, nil
 }
@@ -223,7 +286,163 @@ func (uc *ProblemUseCase) DeleteProblem(ctx context.Context, problemID domain.Pr
 		ProblemID: problem.ID,
 	}
 
-	uc.EventBroker.Dispatch(problem.ContestID, event)
+	uc.EventBroker.Dispatch(ctx, problem.ContestID, event)
 
 	return nil
 }
+
+func (uc *ProblemUseCase) GetProblemStats(ctx context.Context, contestID domain.ContestID, byCompClass bool) ([]domain.ProblemStats, error) {
+	contest, err := uc.Repo.GetContest(ctx, nil, contestID)
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	if _, err := uc.Authorizer.HasOwnership(ctx, contest.Ownership); err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	liveStats, err := uc.ScoreEngineManager.GetProblemStats(ctx, contestID, byCompClass)
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	problems, err := uc.Repo.GetProblemsByContest(ctx, nil, contestID)
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	stats := make([]domain.ProblemStats, 0)
+	problemsByRound := make(map[domain.RoundID][]scores.Problem)
+
+	for problem := range slices.Values(problems) {
+		if _, found := liveStats[problem.RoundID]; found {
+			continue
+		}
+
+		problemsByRound[problem.RoundID] = append(problemsByRound[problem.RoundID], scores.Problem{
+			ID:           problem.ID,
+			PointsZone1:  problem.PointsZone1,
+			PointsZone2:  problem.PointsZone2,
+			PointsTop:    problem.PointsTop,
+			FlashBonus:   problem.FlashBonus,
+			CompClassIDs: problem.CompClassIDs,
+		})
+	}
+
+	for roundStats := range maps.Values(liveStats) {
+		stats = append(stats, roundStats...)
+	}
+
+	if len(problemsByRound) > 0 {
+		contenders, err := uc.Repo.GetContendersByContest(ctx, nil, contestID)
+		if err != nil {
+			return nil, errors.Wrap(err, 0)
+		}
+
+		ticks, err := uc.Repo.GetTicksByContest(ctx, nil, contestID)
+		if err != nil {
+			return nil, errors.Wrap(err, 0)
+		}
+
+		ticksByContender := make(map[domain.ContenderID][]scores.Tick)
+
+		for tick := range slices.Values(ticks) {
+			if tick.Ownership.ContenderID == nil {
+				continue
+			}
+
+			contenderID := *tick.Ownership.ContenderID
+
+			ticksByContender[contenderID] = append(ticksByContender[contenderID], scores.Tick{
+				ProblemID:     tick.ProblemID,
+				Zone1:         tick.Zone1,
+				AttemptsZone1: tick.AttemptsZone1,
+				Zone2:         tick.Zone2,
+				AttemptsZone2: tick.AttemptsZone2,
+				Top:           tick.Top,
+				AttemptsTop:   tick.AttemptsTop,
+				Points:        0,
+				CompClassIDs:  nil,
+			})
+		}
+
+		scoresContenders := func(yield func(scores.Contender) bool) {
+			for contender := range slices.Values(contenders) {
+				if !yield(scores.Contender{
+					ID:                  contender.ID,
+					CompClassID:         contender.CompClassID,
+					Disqualified:        contender.Disqualified,
+					WithdrawnFromFinals: contender.WithdrawnFromFinals,
+					Score:               0,
+					PreviousPlacement:   0,
+				}) {
+					return
+				}
+			}
+		}
+
+		getTicks := func(contenderID domain.ContenderID) iter.Seq[scores.Tick] {
+			return slices.Values(ticksByContender[contenderID])
+		}
+
+		for roundProblems := range maps.Values(problemsByRound) {
+			stats = append(stats, scores.CalculateProblemStats(roundProblems, scoresContenders, getTicks, byCompClass)...)
+		}
+	}
+
+	slices.SortFunc(stats, func(s1, s2 domain.ProblemStats) int {
+		return cmp.Or(
+			cmp.Compare(s1.ProblemID, s2.ProblemID),
+			cmp.Compare(s1.CompClassID, s2.CompClassID),
+		)
+	})
+
+	return stats, nil
+}
+
+func (uc *ProblemUseCase) checkCompClassIDs(ctx context.Context, contestID domain.ContestID, compClassIDs []domain.CompClassID) ([]domain.CompClassID, error) {
+	checked := make([]domain.CompClassID, 0)
+
+	if len(compClassIDs) == 0 {
+		return checked, nil
+	}
+
+	compClasses, err := uc.Repo.GetCompClassesByContest(ctx, nil, contestID)
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	for _, compClassID := range compClassIDs {
+		found := slices.ContainsFunc(compClasses, func(compClass domain.CompClass) bool {
+			return compClass.ID == compClassID
+		})
+
+		if !found {
+			return nil, errors.Wrap(domain.ErrInvalidData, 0)
+		}
+
+		if !slices.Contains(checked, compClassID) {
+			checked = append(checked, compClassID)
+		}
+	}
+
+	slices.Sort(checked)
+
+	return checked, nil
+}
+
+func normalizeTags(tags []string) []string {
+	normalized := make([]string, 0)
+
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+
+		if tag == "" || slices.Contains(normalized, tag) {
+			continue
+		}
+
+		normalized = append(normalized, tag)
+	}
+
+	return normalized
+}
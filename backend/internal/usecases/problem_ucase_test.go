@@ -127,7 +127,7 @@ func TestPatchProblem(t *testing.T) {
 			}, nil)
 
 		mockedEventBroker.
-			On("Dispatch", fakedContestID, domain.ProblemUpdatedEvent{
+			On("Dispatch", mock.Anything, fakedContestID, domain.ProblemUpdatedEvent{
 				ProblemID: fakedProblemID,
 				ProblemValue: domain.ProblemValue{
 					PointsTop:   1000,
@@ -154,7 +154,7 @@ func TestPatchProblem(t *testing.T) {
 			PointsZone1:        domain.NewPatch(500),
 			PointsZone2:        domain.NewPatch(750),
 			FlashBonus:         domain.NewPatch(25),
-		})
+		}, 0)
 
 		require.NoError(t, err)
 		assert.Equal(t, 20, problem.Number)
@@ -187,7 +187,7 @@ func TestPatchProblem(t *testing.T) {
 
 		_, err := ucase.PatchProblem(context.Background(), fakedProblemID, domain.ProblemPatch{
 			HoldColorPrimary: domain.NewPatch("invalid"),
-		})
+		}, 0)
 
 		require.ErrorIs(t, err, domain.ErrInvalidData)
 		assert.True(t, validators.ProblemValidator{}.IsValidationError(err))
@@ -215,7 +215,7 @@ func TestPatchProblem(t *testing.T) {
 
 		_, err := ucase.PatchProblem(context.Background(), fakedProblemID, domain.ProblemPatch{
 			Number: domain.NewPatch(20),
-		})
+		}, 0)
 
 		require.ErrorIs(t, err, domain.ErrDuplicate)
 
@@ -245,7 +245,7 @@ func TestPatchProblem(t *testing.T) {
 			PointsZone1: domain.NewPatch(50),
 			PointsZone2: domain.NewPatch(75),
 			FlashBonus:  domain.NewPatch(10),
-		})
+		}, 0)
 
 		require.NoError(t, err)
 
@@ -266,7 +266,7 @@ func TestPatchProblem(t *testing.T) {
 			Authorizer: mockedAuthorizer,
 		}
 
-		_, err := ucase.PatchProblem(context.Background(), fakedProblemID, domain.ProblemPatch{})
+		_, err := ucase.PatchProblem(context.Background(), fakedProblemID, domain.ProblemPatch{}, 0)
 
 		require.ErrorIs(t, err, domain.ErrNoOwnership)
 
@@ -282,6 +282,7 @@ func TestCreateProblem(t *testing.T) {
 	}
 	fakedContestID := testutils.RandomResourceID[domain.ContestID]()
 	fakedProblemID := testutils.RandomResourceID[domain.ProblemID]()
+	fakedCompClassID := testutils.RandomResourceID[domain.CompClassID]()
 
 	makeMocks := func() (*repositoryMock, *authorizerMock) {
 		mockedRepo := new(repositoryMock)
@@ -304,8 +305,9 @@ func TestCreateProblem(t *testing.T) {
 		mockedEventBroker := new(eventBrokerMock)
 
 		mockedEventBroker.
-			On("Dispatch", fakedContestID, domain.ProblemAddedEvent{
-				ProblemID: fakedProblemID,
+			On("Dispatch", mock.Anything, fakedContestID, domain.ProblemAddedEvent{
+				ProblemID:    fakedProblemID,
+				CompClassIDs: []domain.CompClassID{fakedCompClassID},
 				ProblemValue: domain.ProblemValue{
 					PointsTop:   100,
 					PointsZone1: 50,
@@ -327,6 +329,10 @@ func TestCreateProblem(t *testing.T) {
 			On("GetProblemByNumber", mock.Anything, nil, fakedContestID, 10).
 			Return(domain.Problem{}, domain.ErrNotFound)
 
+		mockedRepo.
+			On("GetCompClassesByContest", mock.Anything, nil, fakedContestID).
+			Return([]domain.CompClass{{ID: fakedCompClassID}}, nil)
+
 		mockedRepo.
 			On("StoreProblem", mock.Anything, nil,
 				domain.Problem{
@@ -336,6 +342,9 @@ func TestCreateProblem(t *testing.T) {
 					HoldColorPrimary:   "#ffffff",
 					HoldColorSecondary: "#000",
 					Description:        "Crack volumes are included",
+					Sector:             "Cave",
+					Tags:               []string{"crimpy", "slab"},
+					CompClassIDs:       []domain.CompClassID{fakedCompClassID},
 					Zone1Enabled:       true,
 					Zone2Enabled:       true,
 					ProblemValue: domain.ProblemValue{
@@ -355,6 +364,9 @@ func TestCreateProblem(t *testing.T) {
 					HoldColorPrimary:   "#ffffff",
 					HoldColorSecondary: "#000",
 					Description:        "Crack volumes are included",
+					Sector:             "Cave",
+					Tags:               []string{"crimpy", "slab"},
+					CompClassIDs:       []domain.CompClassID{fakedCompClassID},
 					Zone1Enabled:       true,
 					Zone2Enabled:       true,
 					ProblemValue: domain.ProblemValue{
@@ -376,6 +388,9 @@ func TestCreateProblem(t *testing.T) {
 			HoldColorPrimary:   "#ffffff",
 			HoldColorSecondary: "#000",
 			Description:        "Crack volumes are included",
+			Sector:             " Cave ",
+			Tags:               []string{" Crimpy", "slab", "crimpy", ""},
+			CompClassIDs:       []domain.CompClassID{fakedCompClassID, fakedCompClassID},
 			Zone1Enabled:       true,
 			Zone2Enabled:       true,
 			ProblemValue: domain.ProblemValue{
@@ -394,6 +409,9 @@ func TestCreateProblem(t *testing.T) {
 		assert.Equal(t, "#ffffff", problem.HoldColorPrimary)
 		assert.Equal(t, "#000", problem.HoldColorSecondary)
 		assert.Equal(t, "Crack volumes are included", problem.Description)
+		assert.Equal(t, "Cave", problem.Sector)
+		assert.Equal(t, []string{"crimpy", "slab"}, problem.Tags)
+		assert.Equal(t, []domain.CompClassID{fakedCompClassID}, problem.CompClassIDs)
 		assert.True(t, problem.Zone1Enabled)
 		assert.True(t, problem.Zone2Enabled)
 		assert.Equal(t, 100, problem.PointsTop)
@@ -406,6 +424,42 @@ func TestCreateProblem(t *testing.T) {
 		mockedEventBroker.AssertExpectations(t)
 	})
 
+	t.Run("UnknownCompClass", func(t *testing.T) {
+		mockedRepo, mockedAuthorizer := makeMocks()
+
+		mockedAuthorizer.
+			On("HasOwnership", mock.Anything, fakedOwnership).
+			Return(domain.OrganizerRole, nil)
+
+		mockedRepo.
+			On("GetProblemsByContest", mock.Anything, nil, fakedContestID).
+			Return([]domain.Problem{}, nil)
+
+		mockedRepo.
+			On("GetProblemByNumber", mock.Anything, nil, fakedContestID, 10).
+			Return(domain.Problem{}, domain.ErrNotFound)
+
+		mockedRepo.
+			On("GetCompClassesByContest", mock.Anything, nil, fakedContestID).
+			Return([]domain.CompClass{{ID: fakedCompClassID}}, nil)
+
+		ucase := usecases.ProblemUseCase{
+			Repo:       mockedRepo,
+			Authorizer: mockedAuthorizer,
+		}
+
+		_, err := ucase.CreateProblem(context.Background(), fakedContestID, domain.ProblemTemplate{
+			Number:           10,
+			HoldColorPrimary: "#ffffff",
+			CompClassIDs:     []domain.CompClassID{fakedCompClassID + 1},
+		})
+
+		require.ErrorIs(t, err, domain.ErrInvalidData)
+
+		mockedRepo.AssertExpectations(t)
+		mockedAuthorizer.AssertExpectations(t)
+	})
+
 	t.Run("NumberAlreadyUsed", func(t *testing.T) {
 		mockedRepo, mockedAuthorizer := makeMocks()
 
@@ -566,7 +620,7 @@ func TestDeleteProblem(t *testing.T) {
 			Return(nil)
 
 		mockedEventBroker.
-			On("Dispatch", fakedContestID, domain.ProblemDeletedEvent{
+			On("Dispatch", mock.Anything, fakedContestID, domain.ProblemDeletedEvent{
 				ProblemID: fakedProblemID,
 			}).Return()
 
@@ -629,3 +683,106 @@ func TestDeleteProblem(t *testing.T) {
 		mockedAuthorizer.AssertExpectations(t)
 	})
 }
+
+func TestGetProblemStats(t *testing.T) {
+	fakedOwnership := domain.OwnershipData{
+		OrganizerID: testutils.RandomResourceID[domain.OrganizerID](),
+	}
+	fakedContestID := testutils.RandomResourceID[domain.ContestID]()
+	fakedRoundID := testutils.RandomResourceID[domain.RoundID]()
+	fakedContenderID := testutils.RandomResourceID[domain.ContenderID]()
+	fakedCompClassID := testutils.RandomResourceID[domain.CompClassID]()
+
+	makeMocks := func() (*repositoryMock, *authorizerMock, *scoreEngineManagerMock) {
+		mockedRepo := new(repositoryMock)
+		mockedAuthorizer := new(authorizerMock)
+		mockedManager := new(scoreEngineManagerMock)
+
+		mockedRepo.
+			On("GetContest", mock.Anything, nil, fakedContestID).
+			Return(domain.Contest{
+				ID:        fakedContestID,
+				Ownership: fakedOwnership,
+			}, nil)
+
+		return mockedRepo, mockedAuthorizer, mockedManager
+	}
+
+	t.Run("HappyCase", func(t *testing.T) {
+		mockedRepo, mockedAuthorizer, mockedManager := makeMocks()
+
+		mockedAuthorizer.
+			On("HasOwnership", mock.Anything, fakedOwnership).
+			Return(domain.OrganizerRole, nil)
+
+		mockedManager.
+			On("GetProblemStats", mock.Anything, fakedContestID, false).
+			Return(map[domain.RoundID][]domain.ProblemStats{
+				0: {{ProblemID: 1, Tops: 5, Attempts: 10}},
+			}, nil)
+
+		mockedRepo.
+			On("GetProblemsByContest", mock.Anything, nil, fakedContestID).
+			Return([]domain.Problem{
+				{ID: 1, ContestID: fakedContestID},
+				{ID: 2, ContestID: fakedContestID, RoundID: fakedRoundID},
+			}, nil)
+
+		mockedRepo.
+			On("GetContendersByContest", mock.Anything, nil, fakedContestID).
+			Return([]domain.Contender{
+				{ID: fakedContenderID, CompClassID: fakedCompClassID},
+			}, nil)
+
+		mockedRepo.
+			On("GetTicksByContest", mock.Anything, nil, fakedContestID).
+			Return([]domain.Tick{
+				{
+					Ownership:   domain.OwnershipData{ContenderID: &fakedContenderID},
+					ProblemID:   2,
+					Top:         true,
+					AttemptsTop: 1,
+				},
+			}, nil)
+
+		ucase := usecases.ProblemUseCase{
+			Repo:               mockedRepo,
+			Authorizer:         mockedAuthorizer,
+			ScoreEngineManager: mockedManager,
+		}
+
+		stats, err := ucase.GetProblemStats(context.Background(), fakedContestID, false)
+
+		require.NoError(t, err)
+		assert.Equal(t, []domain.ProblemStats{
+			{ProblemID: 1, Tops: 5, Attempts: 10},
+			{ProblemID: 2, Tops: 1, Flashes: 1, Attempts: 1, AverageAttemptsToTop: 1, TopPercentage: 100},
+		}, stats)
+
+		mockedRepo.AssertExpectations(t)
+		mockedAuthorizer.AssertExpectations(t)
+		mockedManager.AssertExpectations(t)
+	})
+
+	t.Run("BadCredentials", func(t *testing.T) {
+		mockedRepo, mockedAuthorizer, mockedManager := makeMocks()
+
+		mockedAuthorizer.
+			On("HasOwnership", mock.Anything, fakedOwnership).
+			Return(domain.NilRole, domain.ErrNoOwnership)
+
+		ucase := usecases.ProblemUseCase{
+			Repo:               mockedRepo,
+			Authorizer:         mockedAuthorizer,
+			ScoreEngineManager: mockedManager,
+		}
+
+		_, err := ucase.GetProblemStats(context.Background(), fakedContestID, false)
+
+		require.ErrorIs(t, err, domain.ErrNoOwnership)
+
+		mockedRepo.AssertExpectations(t)
+		mockedAuthorizer.AssertExpectations(t)
+		mockedManager.AssertExpectations(t)
+	})
+}
@@ -1,16 +1,29 @@
 package usecases
 
 import (
+	"cmp"
 	"context"
+	"crypto/hmac"
 	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"math"
 	"math/big"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/climblive/platform/backend/internal/domain"
+	"github.com/climblive/platform/backend/internal/usecases/validators"
 	"github.com/go-errors/errors"
 )
 
-const maxRafflesPerContest = 10
+const (
+	maxRafflesPerContest = 10
+	maxPrizesPerRaffle   = 20
+	raffleSeedSize       = 32
+)
 
 type raffleUseCaseRepository interface {
 	domain.Transactor
@@ -24,6 +37,13 @@ type raffleUseCaseRepository interface {
 	GetRaffleWinners(ctx context.Context, tx domain.Transaction, raffleID domain.RaffleID) ([]domain.RaffleWinner, error)
 	DeleteRaffleWinner(ctx context.Context, tx domain.Transaction, raffleWinnerID domain.RaffleWinnerID) error
 	DeleteRaffle(ctx context.Context, tx domain.Transaction, raffleID domain.RaffleID) error
+	GetCompClass(ctx context.Context, tx domain.Transaction, compClassID domain.CompClassID) (domain.CompClass, error)
+	GetTicksByContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) ([]domain.Tick, error)
+	GetRaffleWinner(ctx context.Context, tx domain.Transaction, raffleWinnerID domain.RaffleWinnerID) (domain.RaffleWinner, error)
+	GetRafflePrize(ctx context.Context, tx domain.Transaction, prizeID domain.RafflePrizeID) (domain.RafflePrize, error)
+	GetRafflePrizes(ctx context.Context, tx domain.Transaction, raffleID domain.RaffleID) ([]domain.RafflePrize, error)
+	StoreRafflePrize(ctx context.Context, tx domain.Transaction, prize domain.RafflePrize) (domain.RafflePrize, error)
+	DeleteRafflePrize(ctx context.Context, tx domain.Transaction, prizeID domain.RafflePrizeID) error
 }
 
 type RaffleUseCase struct {
@@ -42,7 +62,7 @@ func (uc *RaffleUseCase) GetRaffle(ctx context.Context, raffleID domain.RaffleID
 		return domain.Raffle{}, errors.Wrap(err, 0)
 	}
 
-	return raffle, nil
+	return withSealedSeed(raffle), nil
 }
 
 func (uc *RaffleUseCase) GetRafflesByContest(ctx context.Context, contestID domain.ContestID) ([]domain.Raffle, error) {
@@ -60,10 +80,14 @@ func (uc *RaffleUseCase) GetRafflesByContest(ctx context.Context, contestID doma
 		return nil, errors.Wrap(err, 0)
 	}
 
+	for index := range raffles {
+		raffles[index] = withSealedSeed(raffles[index])
+	}
+
 	return raffles, nil
 }
 
-func (uc *RaffleUseCase) CreateRaffle(ctx context.Context, contestID domain.ContestID) (domain.Raffle, error) {
+func (uc *RaffleUseCase) CreateRaffle(ctx context.Context, contestID domain.ContestID, tmpl domain.RaffleTemplate) (domain.Raffle, error) {
 	contest, err := uc.Repo.GetContest(ctx, nil, contestID)
 	if err != nil {
 		return domain.Raffle{}, errors.Wrap(err, 0)
@@ -83,10 +107,41 @@ func (uc *RaffleUseCase) CreateRaffle(ctx context.Context, contestID domain.Cont
 		return domain.Raffle{}, errors.New(domain.ErrLimitExceeded)
 	}
 
+	if tmpl.Rules.CompClassID != 0 {
+		compClass, err := uc.Repo.GetCompClass(ctx, nil, tmpl.Rules.CompClassID)
+		switch {
+		case errors.Is(err, domain.ErrNotFound):
+			return domain.Raffle{}, errors.Wrap(domain.ErrInvalidData, 0)
+		case err != nil:
+			return domain.Raffle{}, errors.Wrap(err, 0)
+		case compClass.ContestID != contestID:
+			return domain.Raffle{}, errors.Wrap(domain.ErrInvalidData, 0)
+		}
+	}
+
 	raffle := domain.Raffle{
-		ID:        0,
-		Ownership: contest.Ownership,
-		ContestID: contestID,
+		ID:             0,
+		Ownership:      contest.Ownership,
+		ContestID:      contestID,
+		Rules:          tmpl.Rules,
+		Seed:           "",
+		SeedCommitment: "",
+		SeedRevealedAt: time.Time{},
+	}
+
+	if err := (validators.RaffleValidator{}).Validate(raffle); err != nil {
+		return domain.Raffle{}, errors.Wrap(err, 0)
+	}
+
+	if tmpl.Verifiable {
+		seed := make([]byte, raffleSeedSize)
+
+		if _, err := rand.Read(seed); err != nil {
+			return domain.Raffle{}, errors.Wrap(err, 0)
+		}
+
+		raffle.Seed = hex.EncodeToString(seed)
+		raffle.SeedCommitment = raffleSeedCommitment(seed)
 	}
 
 	createdRaffle, err := uc.Repo.StoreRaffle(ctx, nil, raffle)
@@ -94,7 +149,7 @@ func (uc *RaffleUseCase) CreateRaffle(ctx context.Context, contestID domain.Cont
 		return domain.Raffle{}, errors.Wrap(err, 0)
 	}
 
-	return createdRaffle, nil
+	return withSealedSeed(createdRaffle), nil
 }
 
 func (uc *RaffleUseCase) DrawRaffleWinner(ctx context.Context, raffleID domain.RaffleID) (domain.RaffleWinner, error) {
@@ -107,6 +162,10 @@ func (uc *RaffleUseCase) DrawRaffleWinner(ctx context.Context, raffleID domain.R
 		return domain.RaffleWinner{}, errors.Wrap(err, 0)
 	}
 
+	if !raffle.SeedRevealedAt.IsZero() {
+		return domain.RaffleWinner{}, errors.Wrap(domain.ErrNotAllowed, 0)
+	}
+
 	contenders, err := uc.Repo.GetContendersByContest(ctx, nil, raffle.ContestID)
 	if err != nil {
 		return domain.RaffleWinner{}, errors.Wrap(err, 0)
@@ -126,7 +185,51 @@ func (uc *RaffleUseCase) DrawRaffleWinner(ctx context.Context, raffleID domain.R
 		winnersSet[winner.ContenderID] = struct{}{}
 	}
 
-	candidates := make([]domain.Contender, 0)
+	prizes, err := uc.Repo.GetRafflePrizes(ctx, nil, raffleID)
+	if err != nil {
+		return domain.RaffleWinner{}, errors.Wrap(err, 0)
+	}
+
+	var prize domain.RafflePrize
+
+	if len(prizes) > 0 {
+		var found bool
+
+		prize, found = nextUnawardedPrize(prizes, winners)
+		if !found {
+			return domain.RaffleWinner{}, domain.ErrAllWinnersDrawn
+		}
+	}
+
+	rules := raffle.Rules
+
+	tickCounts := make(map[domain.ContenderID]int)
+	topCounts := make(map[domain.ContenderID]int)
+
+	if rules.MinTicks > 0 || rules.Weighting != domain.RaffleWeightingNone {
+		ticks, err := uc.Repo.GetTicksByContest(ctx, nil, raffle.ContestID)
+		if err != nil {
+			return domain.RaffleWinner{}, errors.Wrap(err, 0)
+		}
+
+		for _, tick := range ticks {
+			if tick.Ownership.ContenderID == nil {
+				continue
+			}
+
+			contenderID := *tick.Ownership.ContenderID
+
+			tickCounts[contenderID]++
+
+			if tick.Top {
+				topCounts[contenderID]++
+			}
+		}
+	}
+
+	contendersByID := make(map[domain.ContenderID]domain.Contender)
+	candidates := make([]domain.RaffleCandidate, 0)
+	var totalWeight int64
 
 	for _, contender := range contenders {
 		if contender.Entered.IsZero() {
@@ -141,28 +244,89 @@ func (uc *RaffleUseCase) DrawRaffleWinner(ctx context.Context, raffleID domain.R
 			continue
 		}
 
-		if _, alreadyDrawn := winnersSet[contender.ID]; !alreadyDrawn {
-			candidates = append(candidates, contender)
+		if rules.CompClassID != 0 && contender.CompClassID != rules.CompClassID {
+			continue
+		}
+
+		if tickCounts[contender.ID] < rules.MinTicks {
+			continue
+		}
+
+		if _, alreadyDrawn := winnersSet[contender.ID]; alreadyDrawn {
+			continue
+		}
+
+		weight := int64(1)
+
+		if rules.Weighting == domain.RaffleWeightingTops {
+			weight = int64(topCounts[contender.ID])
+		}
+
+		if weight == 0 {
+			continue
 		}
+
+		contendersByID[contender.ID] = contender
+		candidates = append(candidates, domain.RaffleCandidate{
+			ContenderID: contender.ID,
+			Weight:      weight,
+		})
+		totalWeight += weight
 	}
 
 	if len(candidates) == 0 {
 		return domain.RaffleWinner{}, domain.ErrAllWinnersDrawn
 	}
 
-	winnerIndex, err := rand.Int(rand.Reader, big.NewInt(int64(len(candidates))))
-	if err != nil {
-		return domain.RaffleWinner{}, errors.Wrap(err, 0)
+	slices.SortFunc(candidates, func(a, b domain.RaffleCandidate) int {
+		return cmp.Compare(a.ContenderID, b.ContenderID)
+	})
+
+	var ticket int64
+	var draw *domain.RaffleDraw
+
+	if raffle.SeedCommitment != "" {
+		seed, err := hex.DecodeString(raffle.Seed)
+		if err != nil {
+			return domain.RaffleWinner{}, errors.Wrap(err, 0)
+		}
+
+		draw = &domain.RaffleDraw{
+			Number:      len(winners),
+			Candidates:  candidates,
+			ContenderID: 0,
+		}
+
+		ticket = seededRaffleTicket(seed, draw.Number, totalWeight)
+	} else {
+		randomTicket, err := rand.Int(rand.Reader, big.NewInt(totalWeight))
+		if err != nil {
+			return domain.RaffleWinner{}, errors.Wrap(err, 0)
+		}
+
+		ticket = randomTicket.Int64()
+	}
+
+	drawn := contendersByID[pickRaffleCandidate(candidates, ticket)]
+
+	if draw != nil {
+		draw.ContenderID = drawn.ID
 	}
 
 	winner := domain.RaffleWinner{
 		ID:                  0,
 		Ownership:           raffle.Ownership,
 		RaffleID:            raffle.ID,
-		ContenderID:         candidates[winnerIndex.Int64()].ID,
-		ContenderName:       candidates[winnerIndex.Int64()].Name,
-		ContenderScrubbedAt: candidates[winnerIndex.Int64()].ScrubbedAt,
+		ContenderID:         drawn.ID,
+		ContenderName:       drawn.Name,
+		ContenderScrubbedAt: drawn.ScrubbedAt,
+		PrizeID:             prize.ID,
+		PrizeName:           prize.Name,
 		Timestamp:           time.Now(),
+		Rules:               rules,
+		ClaimedAt:           time.Time{},
+		VoidedAt:            time.Time{},
+		Draw:                draw,
 	}
 
 	createdWinner, err := uc.Repo.StoreRaffleWinner(ctx, nil, winner)
@@ -170,15 +334,328 @@ func (uc *RaffleUseCase) DrawRaffleWinner(ctx context.Context, raffleID domain.R
 		return domain.RaffleWinner{}, errors.Wrap(err, 0)
 	}
 
-	uc.EventBroker.Dispatch(raffle.ContestID, domain.RaffleWinnerDrawnEvent{
+	uc.EventBroker.Dispatch(ctx, raffle.ContestID, domain.RaffleWinnerDrawnEvent{
 		RaffleID:    createdWinner.RaffleID,
 		ContenderID: createdWinner.ContenderID,
+		PrizeID:     createdWinner.PrizeID,
+		PrizeName:   createdWinner.PrizeName,
 		Timestamp:   createdWinner.Timestamp,
 	})
 
 	return createdWinner, nil
 }
 
+func nextUnawardedPrize(prizes []domain.RafflePrize, winners []domain.RaffleWinner) (domain.RafflePrize, bool) {
+	awarded := make(map[domain.RafflePrizeID]int)
+
+	for _, winner := range winners {
+		if winner.PrizeID == 0 || !winner.VoidedAt.IsZero() {
+			continue
+		}
+
+		awarded[winner.PrizeID]++
+	}
+
+	for _, prize := range prizes {
+		if awarded[prize.ID] < prize.Quantity {
+			return prize, true
+		}
+	}
+
+	return domain.RafflePrize{}, false
+}
+
+func (uc *RaffleUseCase) RevealRaffleSeed(ctx context.Context, raffleID domain.RaffleID) (domain.Raffle, error) {
+	raffle, err := uc.Repo.GetRaffle(ctx, nil, raffleID)
+	if err != nil {
+		return domain.Raffle{}, errors.Wrap(err, 0)
+	}
+
+	if _, err := uc.Authorizer.HasOwnership(ctx, raffle.Ownership); err != nil {
+		return domain.Raffle{}, errors.Wrap(err, 0)
+	}
+
+	if raffle.SeedCommitment == "" {
+		return domain.Raffle{}, errors.Wrap(domain.ErrNotAllowed, 0)
+	}
+
+	if !raffle.SeedRevealedAt.IsZero() {
+		return raffle, nil
+	}
+
+	raffle.SeedRevealedAt = time.Now()
+
+	if _, err := uc.Repo.StoreRaffle(ctx, nil, raffle); err != nil {
+		return domain.Raffle{}, errors.Wrap(err, 0)
+	}
+
+	return raffle, nil
+}
+
+func (uc *RaffleUseCase) VerifyRaffle(ctx context.Context, raffleID domain.RaffleID) (domain.RaffleVerification, error) {
+	raffle, err := uc.Repo.GetRaffle(ctx, nil, raffleID)
+	if err != nil {
+		return domain.RaffleVerification{}, errors.Wrap(err, 0)
+	}
+
+	if raffle.SeedCommitment == "" {
+		return domain.RaffleVerification{}, errors.Wrap(domain.ErrNotAllowed, 0)
+	}
+
+	winners, err := uc.Repo.GetRaffleWinners(ctx, nil, raffleID)
+	if err != nil {
+		return domain.RaffleVerification{}, errors.Wrap(err, 0)
+	}
+
+	verification := domain.RaffleVerification{
+		RaffleID:       raffle.ID,
+		Seed:           "",
+		SeedCommitment: raffle.SeedCommitment,
+		Draws:          make([]domain.RaffleDraw, 0),
+		Verified:       false,
+	}
+
+	var seed []byte
+
+	if !raffle.SeedRevealedAt.IsZero() {
+		seed, err = hex.DecodeString(raffle.Seed)
+		if err != nil {
+			return domain.RaffleVerification{}, errors.Wrap(err, 0)
+		}
+
+		verification.Seed = raffle.Seed
+		verification.Verified = raffleSeedCommitment(seed) == raffle.SeedCommitment
+	}
+
+	for _, winner := range winners {
+		if winner.Draw == nil {
+			verification.Verified = false
+			continue
+		}
+
+		draw := *winner.Draw
+		draw.ContenderID = winner.ContenderID
+
+		if seed != nil {
+			var totalWeight int64
+			for _, candidate := range draw.Candidates {
+				totalWeight += candidate.Weight
+			}
+
+			if totalWeight <= 0 || pickRaffleCandidate(draw.Candidates, seededRaffleTicket(seed, draw.Number, totalWeight)) != draw.ContenderID {
+				verification.Verified = false
+			}
+		}
+
+		verification.Draws = append(verification.Draws, draw)
+	}
+
+	slices.SortFunc(verification.Draws, func(a, b domain.RaffleDraw) int {
+		return cmp.Compare(a.Number, b.Number)
+	})
+
+	return verification, nil
+}
+
+func withSealedSeed(raffle domain.Raffle) domain.Raffle {
+	if raffle.SeedRevealedAt.IsZero() {
+		raffle.Seed = ""
+	}
+
+	return raffle
+}
+
+func raffleSeedCommitment(seed []byte) string {
+	sum := sha256.Sum256(seed)
+	return hex.EncodeToString(sum[:])
+}
+
+// seededRaffleTicket derives a ticket in [0, totalWeight) from
+// HMAC-SHA256(seed, draw number || counter), rejecting values that would
+// bias the modulo reduction.
+func seededRaffleTicket(seed []byte, number int, totalWeight int64) int64 {
+	limit := math.MaxUint64 - math.MaxUint64%uint64(totalWeight)
+
+	for counter := uint64(0); ; counter++ {
+		mac := hmac.New(sha256.New, seed)
+		mac.Write(binary.BigEndian.AppendUint64(binary.BigEndian.AppendUint64(nil, uint64(number)), counter))
+
+		value := binary.BigEndian.Uint64(mac.Sum(nil))
+		if value < limit {
+			return int64(value % uint64(totalWeight))
+		}
+	}
+}
+
+func pickRaffleCandidate(candidates []domain.RaffleCandidate, ticket int64) domain.ContenderID {
+	remaining := ticket
+
+	for _, candidate := range candidates {
+		if remaining < candidate.Weight {
+			return candidate.ContenderID
+		}
+
+		remaining -= candidate.Weight
+	}
+
+	return 0
+}
+
+func (uc *RaffleUseCase) ClaimRafflePrize(ctx context.Context, raffleWinnerID domain.RaffleWinnerID) (domain.RaffleWinner, error) {
+	return uc.updateRaffleWinner(ctx, raffleWinnerID, func(winner *domain.RaffleWinner) error {
+		if !winner.VoidedAt.IsZero() {
+			return errors.Wrap(domain.ErrNotAllowed, 0)
+		}
+
+		if winner.ClaimedAt.IsZero() {
+			winner.ClaimedAt = time.Now()
+		}
+
+		return nil
+	})
+}
+
+func (uc *RaffleUseCase) VoidRaffleWinner(ctx context.Context, raffleWinnerID domain.RaffleWinnerID) (domain.RaffleWinner, error) {
+	return uc.updateRaffleWinner(ctx, raffleWinnerID, func(winner *domain.RaffleWinner) error {
+		if !winner.ClaimedAt.IsZero() {
+			return errors.Wrap(domain.ErrNotAllowed, 0)
+		}
+
+		if winner.VoidedAt.IsZero() {
+			winner.VoidedAt = time.Now()
+		}
+
+		return nil
+	})
+}
+
+func (uc *RaffleUseCase) updateRaffleWinner(ctx context.Context, raffleWinnerID domain.RaffleWinnerID, update func(*domain.RaffleWinner) error) (domain.RaffleWinner, error) {
+	winner, err := uc.Repo.GetRaffleWinner(ctx, nil, raffleWinnerID)
+	if err != nil {
+		return domain.RaffleWinner{}, errors.Wrap(err, 0)
+	}
+
+	if _, err := uc.Authorizer.HasOwnership(ctx, winner.Ownership); err != nil {
+		return domain.RaffleWinner{}, errors.Wrap(err, 0)
+	}
+
+	raffle, err := uc.Repo.GetRaffle(ctx, nil, winner.RaffleID)
+	if err != nil {
+		return domain.RaffleWinner{}, errors.Wrap(err, 0)
+	}
+
+	baseline := winner
+
+	if err := update(&winner); err != nil {
+		return domain.RaffleWinner{}, errors.Wrap(err, 0)
+	}
+
+	if winner == baseline {
+		return winner, nil
+	}
+
+	if _, err := uc.Repo.StoreRaffleWinner(ctx, nil, winner); err != nil {
+		return domain.RaffleWinner{}, errors.Wrap(err, 0)
+	}
+
+	uc.EventBroker.Dispatch(ctx, raffle.ContestID, domain.RaffleWinnerUpdatedEvent{
+		RaffleWinnerID: winner.ID,
+		RaffleID:       winner.RaffleID,
+		ContenderID:    winner.ContenderID,
+		PrizeID:        winner.PrizeID,
+		PrizeName:      winner.PrizeName,
+		ClaimedAt:      winner.ClaimedAt,
+		VoidedAt:       winner.VoidedAt,
+	})
+
+	return winner, nil
+}
+
+func (uc *RaffleUseCase) GetRafflePrizes(ctx context.Context, raffleID domain.RaffleID) ([]domain.RafflePrize, error) {
+	raffle, err := uc.Repo.GetRaffle(ctx, nil, raffleID)
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	if _, err := uc.Authorizer.HasOwnership(ctx, raffle.Ownership); err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	prizes, err := uc.Repo.GetRafflePrizes(ctx, nil, raffleID)
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	return prizes, nil
+}
+
+func (uc *RaffleUseCase) CreateRafflePrize(ctx context.Context, raffleID domain.RaffleID, tmpl domain.RafflePrizeTemplate) (domain.RafflePrize, error) {
+	raffle, err := uc.Repo.GetRaffle(ctx, nil, raffleID)
+	if err != nil {
+		return domain.RafflePrize{}, errors.Wrap(err, 0)
+	}
+
+	if _, err := uc.Authorizer.HasOwnership(ctx, raffle.Ownership); err != nil {
+		return domain.RafflePrize{}, errors.Wrap(err, 0)
+	}
+
+	prizes, err := uc.Repo.GetRafflePrizes(ctx, nil, raffleID)
+	if err != nil {
+		return domain.RafflePrize{}, errors.Wrap(err, 0)
+	}
+
+	if len(prizes) >= maxPrizesPerRaffle {
+		return domain.RafflePrize{}, errors.New(domain.ErrLimitExceeded)
+	}
+
+	prize := domain.RafflePrize{
+		ID:        0,
+		Ownership: raffle.Ownership,
+		RaffleID:  raffleID,
+		Name:      strings.TrimSpace(tmpl.Name),
+		Quantity:  tmpl.Quantity,
+	}
+
+	if err := (validators.RafflePrizeValidator{}).Validate(prize); err != nil {
+		return domain.RafflePrize{}, errors.Wrap(err, 0)
+	}
+
+	createdPrize, err := uc.Repo.StoreRafflePrize(ctx, nil, prize)
+	if err != nil {
+		return domain.RafflePrize{}, errors.Wrap(err, 0)
+	}
+
+	return createdPrize, nil
+}
+
+func (uc *RaffleUseCase) DeleteRafflePrize(ctx context.Context, prizeID domain.RafflePrizeID) error {
+	prize, err := uc.Repo.GetRafflePrize(ctx, nil, prizeID)
+	if err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	if _, err := uc.Authorizer.HasOwnership(ctx, prize.Ownership); err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	winners, err := uc.Repo.GetRaffleWinners(ctx, nil, prize.RaffleID)
+	if err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	for _, winner := range winners {
+		if winner.PrizeID == prizeID {
+			return errors.Wrap(domain.ErrNotAllowed, 0)
+		}
+	}
+
+	if err := uc.Repo.DeleteRafflePrize(ctx, nil, prizeID); err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	return nil
+}
+
 func (uc *RaffleUseCase) GetRaffleWinners(ctx context.Context, raffleID domain.RaffleID) ([]domain.RaffleWinner, error) {
 	raffle, err := uc.Repo.GetRaffle(ctx, nil, raffleID)
 	if err != nil {
@@ -212,6 +689,11 @@ func (uc *RaffleUseCase) DeleteRaffle(ctx context.Context, raffleID domain.Raffl
 		return errors.Wrap(err, 0)
 	}
 
+	prizes, err := uc.Repo.GetRafflePrizes(ctx, nil, raffleID)
+	if err != nil {
+		return errors.Wrap(err, 0)
+	}
+
 	tx, err := uc.Repo.Begin()
 	if err != nil {
 		return errors.Wrap(err, 0)
@@ -225,6 +707,13 @@ func (uc *RaffleUseCase) DeleteRaffle(ctx context.Context, raffleID domain.Raffl
 		}
 	}
 
+	for _, prize := range prizes {
+		err = uc.Repo.DeleteRafflePrize(ctx, tx, prize.ID)
+		if err != nil {
+			return errors.Wrap(err, 0)
+		}
+	}
+
 	err = uc.Repo.DeleteRaffle(ctx, tx, raffleID)
 	if err != nil {
 		return errors.Wrap(err, 0)
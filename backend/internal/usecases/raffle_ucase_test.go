@@ -2,6 +2,8 @@ package usecases_test
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"testing"
 	"testing/synctest"
@@ -10,6 +12,7 @@ import (
 	"github.com/climblive/platform/backend/internal/domain"
 	"github.com/climblive/platform/backend/internal/testutils"
 	"github.com/climblive/platform/backend/internal/usecases"
+	"github.com/climblive/platform/backend/internal/usecases/validators"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -68,7 +71,7 @@ func TestCreateRaffle(t *testing.T) {
 			Authorizer: mockedAuthorizer,
 		}
 
-		raffle, err := ucase.CreateRaffle(context.Background(), fakedContestID)
+		raffle, err := ucase.CreateRaffle(context.Background(), fakedContestID, domain.RaffleTemplate{})
 
 		require.NoError(t, err)
 		assert.Equal(t, fakedRaffleID, raffle.ID)
@@ -79,6 +82,53 @@ func TestCreateRaffle(t *testing.T) {
 		mockedAuthorizer.AssertExpectations(t)
 	})
 
+	t.Run("Verifiable", func(t *testing.T) {
+		mockedRepo, mockedAuthorizer := makeMocks()
+
+		mockedAuthorizer.
+			On("HasOwnership", mock.Anything, fakedOwnership).
+			Return(domain.OrganizerRole, nil)
+
+		mockedRepo.
+			On("GetRafflesByContest", mock.Anything, nil, fakedContestID).
+			Return([]domain.Raffle{}, nil)
+
+		var storedRaffle domain.Raffle
+
+		storeCall := mockedRepo.On("StoreRaffle", mock.Anything, nil, mock.AnythingOfType("domain.Raffle"))
+		storeCall.Run(func(args mock.Arguments) {
+			storedRaffle = args.Get(2).(domain.Raffle)
+			storedRaffle.ID = fakedRaffleID
+
+			storeCall.ReturnArguments = mock.Arguments{storedRaffle, nil}
+		})
+
+		ucase := usecases.RaffleUseCase{
+			Repo:       mockedRepo,
+			Authorizer: mockedAuthorizer,
+		}
+
+		raffle, err := ucase.CreateRaffle(context.Background(), fakedContestID, domain.RaffleTemplate{
+			Verifiable: true,
+		})
+
+		require.NoError(t, err)
+
+		seed, err := hex.DecodeString(storedRaffle.Seed)
+		require.NoError(t, err)
+		require.Len(t, seed, 32)
+
+		commitment := sha256.Sum256(seed)
+		assert.Equal(t, hex.EncodeToString(commitment[:]), storedRaffle.SeedCommitment)
+
+		assert.Equal(t, fakedRaffleID, raffle.ID)
+		assert.Equal(t, storedRaffle.SeedCommitment, raffle.SeedCommitment)
+		assert.Empty(t, raffle.Seed)
+
+		mockedRepo.AssertExpectations(t)
+		mockedAuthorizer.AssertExpectations(t)
+	})
+
 	t.Run("LimitExceeded", func(t *testing.T) {
 		mockedRepo, mockedAuthorizer := makeMocks()
 
@@ -96,7 +146,7 @@ func TestCreateRaffle(t *testing.T) {
 			Authorizer: mockedAuthorizer,
 		}
 
-		_, err := ucase.CreateRaffle(context.Background(), fakedContestID)
+		_, err := ucase.CreateRaffle(context.Background(), fakedContestID, domain.RaffleTemplate{})
 
 		require.ErrorIs(t, err, domain.ErrLimitExceeded)
 
@@ -116,13 +166,75 @@ func TestCreateRaffle(t *testing.T) {
 			Authorizer: mockedAuthorizer,
 		}
 
-		_, err := ucase.CreateRaffle(context.Background(), fakedContestID)
+		_, err := ucase.CreateRaffle(context.Background(), fakedContestID, domain.RaffleTemplate{})
 
 		require.ErrorIs(t, err, domain.ErrNoOwnership)
 
 		mockedRepo.AssertExpectations(t)
 		mockedAuthorizer.AssertExpectations(t)
 	})
+
+	t.Run("CompClassFromOtherContest", func(t *testing.T) {
+		mockedRepo, mockedAuthorizer := makeMocks()
+
+		fakedCompClassID := testutils.RandomResourceID[domain.CompClassID]()
+
+		mockedAuthorizer.
+			On("HasOwnership", mock.Anything, fakedOwnership).
+			Return(domain.OrganizerRole, nil)
+
+		mockedRepo.
+			On("GetRafflesByContest", mock.Anything, nil, fakedContestID).
+			Return([]domain.Raffle{}, nil)
+
+		mockedRepo.
+			On("GetCompClass", mock.Anything, nil, fakedCompClassID).
+			Return(domain.CompClass{
+				ID:        fakedCompClassID,
+				ContestID: fakedContestID + 1,
+			}, nil)
+
+		ucase := usecases.RaffleUseCase{
+			Repo:       mockedRepo,
+			Authorizer: mockedAuthorizer,
+		}
+
+		_, err := ucase.CreateRaffle(context.Background(), fakedContestID, domain.RaffleTemplate{
+			Rules: domain.RaffleRules{CompClassID: fakedCompClassID},
+		})
+
+		require.ErrorIs(t, err, domain.ErrInvalidData)
+
+		mockedRepo.AssertExpectations(t)
+		mockedAuthorizer.AssertExpectations(t)
+	})
+
+	t.Run("InvalidRules", func(t *testing.T) {
+		mockedRepo, mockedAuthorizer := makeMocks()
+
+		mockedAuthorizer.
+			On("HasOwnership", mock.Anything, fakedOwnership).
+			Return(domain.OrganizerRole, nil)
+
+		mockedRepo.
+			On("GetRafflesByContest", mock.Anything, nil, fakedContestID).
+			Return([]domain.Raffle{}, nil)
+
+		ucase := usecases.RaffleUseCase{
+			Repo:       mockedRepo,
+			Authorizer: mockedAuthorizer,
+		}
+
+		_, err := ucase.CreateRaffle(context.Background(), fakedContestID, domain.RaffleTemplate{
+			Rules: domain.RaffleRules{Weighting: "zones"},
+		})
+
+		require.ErrorIs(t, err, domain.ErrInvalidData)
+		assert.True(t, validators.RaffleValidator{}.IsValidationError(err))
+
+		mockedRepo.AssertExpectations(t)
+		mockedAuthorizer.AssertExpectations(t)
+	})
 }
 
 func TestGetRaffle(t *testing.T) {
@@ -289,6 +401,11 @@ func TestDrawRaffleWinner(t *testing.T) {
 				ContestID: fakedContestID,
 			}, nil)
 
+		mockedRepo.
+			On("GetRafflePrizes", mock.Anything, nil, fakedRaffleID).
+			Return([]domain.RafflePrize{}, nil).
+			Maybe()
+
 		mockedAuthorizer := new(authorizerMock)
 
 		mockedEventBroker := new(eventBrokerMock)
@@ -369,7 +486,7 @@ func TestDrawRaffleWinner(t *testing.T) {
 				}, nil)
 
 			mockedEventBroker.
-				On("Dispatch", fakedContestID, domain.RaffleWinnerDrawnEvent{
+				On("Dispatch", mock.Anything, fakedContestID, domain.RaffleWinnerDrawnEvent{
 					RaffleID:    fakedRaffleID,
 					ContenderID: fakedContenderID,
 					Timestamp:   time.Now(),
@@ -425,7 +542,7 @@ func TestDrawRaffleWinner(t *testing.T) {
 			}
 
 			mockedEventBroker.
-				On("Dispatch", fakedContestID, mock.AnythingOfType("domain.RaffleWinnerDrawnEvent")).
+				On("Dispatch", mock.Anything, fakedContestID, mock.AnythingOfType("domain.RaffleWinnerDrawnEvent")).
 				Return()
 
 			ucase := usecases.RaffleUseCase{
@@ -516,7 +633,7 @@ func TestDrawRaffleWinner(t *testing.T) {
 				}, nil)
 
 			mockedEventBroker.
-				On("Dispatch", fakedContestID, domain.RaffleWinnerDrawnEvent{
+				On("Dispatch", mock.Anything, fakedContestID, domain.RaffleWinnerDrawnEvent{
 					RaffleID:    fakedRaffleID,
 					ContenderID: domain.ContenderID(0),
 					Timestamp:   time.Now(),
@@ -650,106 +767,124 @@ func TestDrawRaffleWinner(t *testing.T) {
 		mockedRepo.AssertExpectations(t)
 		mockedAuthorizer.AssertExpectations(t)
 	})
-}
 
-func TestGetRaffleWinners(t *testing.T) {
-	fakedOrganizerID := testutils.RandomResourceID[domain.OrganizerID]()
-	fakedOwnership := domain.OwnershipData{
-		OrganizerID: fakedOrganizerID,
-	}
-	fakedContestID := testutils.RandomResourceID[domain.ContestID]()
-	fakedRaffleID := testutils.RandomResourceID[domain.RaffleID]()
+	t.Run("AssignNextPrize", func(t *testing.T) {
+		synctest.Test(t, func(t *testing.T) {
+			mockedRepo := new(repositoryMock)
+			mockedAuthorizer := new(authorizerMock)
+			mockedEventBroker := new(eventBrokerMock)
 
-	makeMocks := func() (*repositoryMock, *authorizerMock) {
-		mockedRepo := new(repositoryMock)
+			mockedRepo.
+				On("GetRaffle", mock.Anything, nil, fakedRaffleID).
+				Return(domain.Raffle{
+					ID:        fakedRaffleID,
+					Ownership: fakedOwnership,
+					ContestID: fakedContestID,
+				}, nil)
 
-		mockedRepo.
-			On("GetRaffle", mock.Anything, nil, fakedRaffleID).
-			Return(domain.Raffle{
-				ID:        fakedRaffleID,
-				Ownership: fakedOwnership,
-				ContestID: fakedContestID,
-			}, nil)
+			mockedAuthorizer.
+				On("HasOwnership", mock.Anything, fakedOwnership).
+				Return(domain.OrganizerRole, nil)
 
-		mockedAuthorizer := new(authorizerMock)
+			mockedRepo.
+				On("GetContendersByContest", mock.Anything, nil, fakedContestID).
+				Return(makeContenders(3), nil)
 
-		return mockedRepo, mockedAuthorizer
-	}
+			winners := makeWinners(2)
+			winners[0].PrizeID = 1
+			winners[1].PrizeID = 2
+			winners[1].VoidedAt = time.Now()
 
-	t.Run("HappyCase", func(t *testing.T) {
-		mockedRepo, mockedAuthorizer := makeMocks()
+			mockedRepo.
+				On("GetRaffleWinners", mock.Anything, nil, fakedRaffleID).
+				Return(winners, nil)
 
-		mockedAuthorizer.
-			On("HasOwnership", mock.Anything, fakedOwnership).
-			Return(domain.OrganizerRole, nil)
+			mockedRepo.
+				On("GetRafflePrizes", mock.Anything, nil, fakedRaffleID).
+				Return([]domain.RafflePrize{
+					{ID: 1, RaffleID: fakedRaffleID, Name: "Shoes", Quantity: 1},
+					{ID: 2, RaffleID: fakedRaffleID, Name: "Chalk", Quantity: 1},
+				}, nil)
 
-		fakedWinners := []domain.RaffleWinner{
-			{
-				ID:            testutils.RandomResourceID[domain.RaffleWinnerID](),
-				RaffleID:      fakedRaffleID,
-				ContenderID:   testutils.RandomResourceID[domain.ContenderID](),
-				ContenderName: "Winner 1",
-				Timestamp:     time.Now(),
-			},
-			{
-				ID:            testutils.RandomResourceID[domain.RaffleWinnerID](),
-				RaffleID:      fakedRaffleID,
-				ContenderID:   testutils.RandomResourceID[domain.ContenderID](),
-				ContenderName: "Winner 2",
-				Timestamp:     time.Now(),
-			},
-		}
+			mockedRepo.
+				On("StoreRaffleWinner", mock.Anything, nil, domain.RaffleWinner{
+					Ownership:   fakedOwnership,
+					RaffleID:    fakedRaffleID,
+					ContenderID: 2,
+					PrizeID:     2,
+					PrizeName:   "Chalk",
+					Timestamp:   time.Now(),
+				}).
+				Return(domain.RaffleWinner{
+					ID:          testutils.RandomResourceID[domain.RaffleWinnerID](),
+					Ownership:   fakedOwnership,
+					RaffleID:    fakedRaffleID,
+					ContenderID: 2,
+					PrizeID:     2,
+					PrizeName:   "Chalk",
+					Timestamp:   time.Now(),
+				}, nil)
 
-		mockedRepo.
-			On("GetRaffleWinners", mock.Anything, nil, fakedRaffleID).
-			Return(fakedWinners, nil)
+			mockedEventBroker.
+				On("Dispatch", mock.Anything, fakedContestID, domain.RaffleWinnerDrawnEvent{
+					RaffleID:    fakedRaffleID,
+					ContenderID: 2,
+					PrizeID:     2,
+					PrizeName:   "Chalk",
+					Timestamp:   time.Now(),
+				}).
+				Return()
 
-		ucase := usecases.RaffleUseCase{
-			Repo:       mockedRepo,
-			Authorizer: mockedAuthorizer,
-		}
+			ucase := usecases.RaffleUseCase{
+				Repo:        mockedRepo,
+				Authorizer:  mockedAuthorizer,
+				EventBroker: mockedEventBroker,
+			}
 
-		winners, err := ucase.GetRaffleWinners(context.Background(), fakedRaffleID)
+			winner, err := ucase.DrawRaffleWinner(context.Background(), fakedRaffleID)
 
-		require.NoError(t, err)
-		assert.Equal(t, fakedWinners, winners)
+			require.NoError(t, err)
+			assert.Equal(t, domain.RafflePrizeID(2), winner.PrizeID)
 
-		mockedRepo.AssertExpectations(t)
-		mockedAuthorizer.AssertExpectations(t)
+			mockedRepo.AssertExpectations(t)
+			mockedEventBroker.AssertExpectations(t)
+			mockedAuthorizer.AssertExpectations(t)
+		})
 	})
 
-	t.Run("BadCredentials", func(t *testing.T) {
-		mockedRepo, mockedAuthorizer := makeMocks()
+	t.Run("SeedRevealed", func(t *testing.T) {
+		mockedRepo := new(repositoryMock)
+		mockedAuthorizer := new(authorizerMock)
+
+		mockedRepo.
+			On("GetRaffle", mock.Anything, nil, fakedRaffleID).
+			Return(domain.Raffle{
+				ID:             fakedRaffleID,
+				Ownership:      fakedOwnership,
+				ContestID:      fakedContestID,
+				SeedCommitment: "commitment",
+				SeedRevealedAt: time.Now(),
+			}, nil)
 
 		mockedAuthorizer.
 			On("HasOwnership", mock.Anything, fakedOwnership).
-			Return(domain.NilRole, domain.ErrNoOwnership)
+			Return(domain.OrganizerRole, nil)
 
 		ucase := usecases.RaffleUseCase{
 			Repo:       mockedRepo,
 			Authorizer: mockedAuthorizer,
 		}
 
-		_, err := ucase.GetRaffleWinners(context.Background(), fakedRaffleID)
-
-		require.ErrorIs(t, err, domain.ErrNoOwnership)
+		_, err := ucase.DrawRaffleWinner(context.Background(), fakedRaffleID)
+		require.ErrorIs(t, err, domain.ErrNotAllowed)
 
 		mockedRepo.AssertExpectations(t)
 		mockedAuthorizer.AssertExpectations(t)
 	})
-}
-
-func TestDeleteRaffle(t *testing.T) {
-	fakedOrganizerID := testutils.RandomResourceID[domain.OrganizerID]()
-	fakedOwnership := domain.OwnershipData{
-		OrganizerID: fakedOrganizerID,
-	}
-	fakedContestID := testutils.RandomResourceID[domain.ContestID]()
-	fakedRaffleID := testutils.RandomResourceID[domain.RaffleID]()
-	fakedRaffleWinnerID := testutils.RandomResourceID[domain.RaffleWinnerID]()
 
-	makeMocks := func() (*repositoryMock, *transactionMock, *authorizerMock) {
+	t.Run("AllPrizesAwarded", func(t *testing.T) {
 		mockedRepo := new(repositoryMock)
+		mockedAuthorizer := new(authorizerMock)
 
 		mockedRepo.
 			On("GetRaffle", mock.Anything, nil, fakedRaffleID).
@@ -759,66 +894,324 @@ func TestDeleteRaffle(t *testing.T) {
 				ContestID: fakedContestID,
 			}, nil)
 
-		mockedAuthorizer := new(authorizerMock)
-
-		mockedTx := new(transactionMock)
-
-		return mockedRepo, mockedTx, mockedAuthorizer
-	}
-
-	t.Run("HappyCase", func(t *testing.T) {
-		mockedRepo, mockedTx, mockedAuthorizer := makeMocks()
-
 		mockedAuthorizer.
 			On("HasOwnership", mock.Anything, fakedOwnership).
 			Return(domain.OrganizerRole, nil)
 
 		mockedRepo.
-			On("GetRaffleWinners", mock.Anything, nil, fakedRaffleID).
-			Return([]domain.RaffleWinner{
-				{ID: fakedRaffleWinnerID},
-			}, nil)
+			On("GetContendersByContest", mock.Anything, nil, fakedContestID).
+			Return(makeContenders(3), nil)
 
-		mockedRepo.On("Begin").Return(mockedTx, nil)
+		winners := makeWinners(1)
+		winners[0].PrizeID = 1
 
 		mockedRepo.
-			On("DeleteRaffleWinner", mock.Anything, mockedTx, fakedRaffleWinnerID).
-			Return(nil)
+			On("GetRaffleWinners", mock.Anything, nil, fakedRaffleID).
+			Return(winners, nil)
 
 		mockedRepo.
-			On("DeleteRaffle", mock.Anything, mockedTx, fakedRaffleID).
-			Return(nil)
-
-		mockedTx.On("Commit").Return(nil)
-		mockedTx.On("Rollback").Return()
+			On("GetRafflePrizes", mock.Anything, nil, fakedRaffleID).
+			Return([]domain.RafflePrize{
+				{ID: 1, RaffleID: fakedRaffleID, Name: "Shoes", Quantity: 1},
+			}, nil)
 
 		ucase := usecases.RaffleUseCase{
 			Repo:       mockedRepo,
 			Authorizer: mockedAuthorizer,
 		}
 
-		err := ucase.DeleteRaffle(context.Background(), fakedRaffleID)
-
-		require.NoError(t, err)
+		_, err := ucase.DrawRaffleWinner(context.Background(), fakedRaffleID)
+		require.ErrorIs(t, err, domain.ErrAllWinnersDrawn)
 
 		mockedRepo.AssertExpectations(t)
-		mockedTx.AssertExpectations(t)
 		mockedAuthorizer.AssertExpectations(t)
 	})
 
-	t.Run("BadCredentials", func(t *testing.T) {
-		mockedRepo, _, mockedAuthorizer := makeMocks()
+	t.Run("EligibilityRules", func(t *testing.T) {
+		synctest.Test(t, func(t *testing.T) {
+			mockedRepo := new(repositoryMock)
+			mockedAuthorizer := new(authorizerMock)
+			mockedEventBroker := new(eventBrokerMock)
+
+			fakedCompClassID := testutils.RandomResourceID[domain.CompClassID]()
+			fakedRules := domain.RaffleRules{
+				CompClassID: fakedCompClassID,
+				MinTicks:    2,
+				Weighting:   domain.RaffleWeightingTops,
+			}
 
-		mockedAuthorizer.
-			On("HasOwnership", mock.Anything, fakedOwnership).
-			Return(domain.NilRole, domain.ErrNoOwnership)
+			mockedRepo.
+				On("GetRaffle", mock.Anything, nil, fakedRaffleID).
+				Return(domain.Raffle{
+					ID:        fakedRaffleID,
+					Ownership: fakedOwnership,
+					ContestID: fakedContestID,
+					Rules:     fakedRules,
+				}, nil)
 
-		ucase := usecases.RaffleUseCase{
-			Repo:       mockedRepo,
-			Authorizer: mockedAuthorizer,
-		}
+			mockedRepo.
+				On("GetRafflePrizes", mock.Anything, nil, fakedRaffleID).
+				Return([]domain.RafflePrize{}, nil)
 
-		err := ucase.DeleteRaffle(context.Background(), fakedRaffleID)
+			mockedAuthorizer.
+				On("HasOwnership", mock.Anything, fakedOwnership).
+				Return(domain.OrganizerRole, nil)
+
+			contenders := makeContenders(4)
+			for i := range contenders {
+				contenders[i].CompClassID = fakedCompClassID
+			}
+			contenders[3].CompClassID = fakedCompClassID + 1
+
+			mockedRepo.
+				On("GetContendersByContest", mock.Anything, nil, fakedContestID).
+				Return(contenders, nil)
+
+			mockedRepo.
+				On("GetRaffleWinners", mock.Anything, nil, fakedRaffleID).
+				Return([]domain.RaffleWinner{}, nil)
+
+			makeTick := func(contenderID domain.ContenderID, top bool) domain.Tick {
+				return domain.Tick{
+					Ownership: domain.OwnershipData{ContenderID: &contenderID},
+					Top:       top,
+				}
+			}
+
+			mockedRepo.
+				On("GetTicksByContest", mock.Anything, nil, fakedContestID).
+				Return([]domain.Tick{
+					makeTick(0, false),
+					makeTick(0, false),
+					makeTick(1, true),
+					makeTick(1, false),
+					makeTick(2, true),
+					makeTick(3, true),
+					makeTick(3, true),
+				}, nil)
+
+			mockedRepo.
+				On("StoreRaffleWinner", mock.Anything, nil, domain.RaffleWinner{
+					Ownership:   fakedOwnership,
+					RaffleID:    fakedRaffleID,
+					ContenderID: 1,
+					Timestamp:   time.Now(),
+					Rules:       fakedRules,
+				}).
+				Return(domain.RaffleWinner{
+					ID:          testutils.RandomResourceID[domain.RaffleWinnerID](),
+					Ownership:   fakedOwnership,
+					RaffleID:    fakedRaffleID,
+					ContenderID: 1,
+					Timestamp:   time.Now(),
+					Rules:       fakedRules,
+				}, nil)
+
+			mockedEventBroker.
+				On("Dispatch", mock.Anything, fakedContestID, domain.RaffleWinnerDrawnEvent{
+					RaffleID:    fakedRaffleID,
+					ContenderID: 1,
+					Timestamp:   time.Now(),
+				}).
+				Return()
+
+			ucase := usecases.RaffleUseCase{
+				Repo:        mockedRepo,
+				Authorizer:  mockedAuthorizer,
+				EventBroker: mockedEventBroker,
+			}
+
+			winner, err := ucase.DrawRaffleWinner(context.Background(), fakedRaffleID)
+
+			require.NoError(t, err)
+			assert.Equal(t, domain.ContenderID(1), winner.ContenderID)
+			assert.Equal(t, fakedRules, winner.Rules)
+
+			mockedRepo.AssertExpectations(t)
+			mockedEventBroker.AssertExpectations(t)
+			mockedAuthorizer.AssertExpectations(t)
+		})
+	})
+}
+
+func TestGetRaffleWinners(t *testing.T) {
+	fakedOrganizerID := testutils.RandomResourceID[domain.OrganizerID]()
+	fakedOwnership := domain.OwnershipData{
+		OrganizerID: fakedOrganizerID,
+	}
+	fakedContestID := testutils.RandomResourceID[domain.ContestID]()
+	fakedRaffleID := testutils.RandomResourceID[domain.RaffleID]()
+
+	makeMocks := func() (*repositoryMock, *authorizerMock) {
+		mockedRepo := new(repositoryMock)
+
+		mockedRepo.
+			On("GetRaffle", mock.Anything, nil, fakedRaffleID).
+			Return(domain.Raffle{
+				ID:        fakedRaffleID,
+				Ownership: fakedOwnership,
+				ContestID: fakedContestID,
+			}, nil)
+
+		mockedAuthorizer := new(authorizerMock)
+
+		return mockedRepo, mockedAuthorizer
+	}
+
+	t.Run("HappyCase", func(t *testing.T) {
+		mockedRepo, mockedAuthorizer := makeMocks()
+
+		mockedAuthorizer.
+			On("HasOwnership", mock.Anything, fakedOwnership).
+			Return(domain.OrganizerRole, nil)
+
+		fakedWinners := []domain.RaffleWinner{
+			{
+				ID:            testutils.RandomResourceID[domain.RaffleWinnerID](),
+				RaffleID:      fakedRaffleID,
+				ContenderID:   testutils.RandomResourceID[domain.ContenderID](),
+				ContenderName: "Winner 1",
+				Timestamp:     time.Now(),
+			},
+			{
+				ID:            testutils.RandomResourceID[domain.RaffleWinnerID](),
+				RaffleID:      fakedRaffleID,
+				ContenderID:   testutils.RandomResourceID[domain.ContenderID](),
+				ContenderName: "Winner 2",
+				Timestamp:     time.Now(),
+			},
+		}
+
+		mockedRepo.
+			On("GetRaffleWinners", mock.Anything, nil, fakedRaffleID).
+			Return(fakedWinners, nil)
+
+		ucase := usecases.RaffleUseCase{
+			Repo:       mockedRepo,
+			Authorizer: mockedAuthorizer,
+		}
+
+		winners, err := ucase.GetRaffleWinners(context.Background(), fakedRaffleID)
+
+		require.NoError(t, err)
+		assert.Equal(t, fakedWinners, winners)
+
+		mockedRepo.AssertExpectations(t)
+		mockedAuthorizer.AssertExpectations(t)
+	})
+
+	t.Run("BadCredentials", func(t *testing.T) {
+		mockedRepo, mockedAuthorizer := makeMocks()
+
+		mockedAuthorizer.
+			On("HasOwnership", mock.Anything, fakedOwnership).
+			Return(domain.NilRole, domain.ErrNoOwnership)
+
+		ucase := usecases.RaffleUseCase{
+			Repo:       mockedRepo,
+			Authorizer: mockedAuthorizer,
+		}
+
+		_, err := ucase.GetRaffleWinners(context.Background(), fakedRaffleID)
+
+		require.ErrorIs(t, err, domain.ErrNoOwnership)
+
+		mockedRepo.AssertExpectations(t)
+		mockedAuthorizer.AssertExpectations(t)
+	})
+}
+
+func TestDeleteRaffle(t *testing.T) {
+	fakedOrganizerID := testutils.RandomResourceID[domain.OrganizerID]()
+	fakedOwnership := domain.OwnershipData{
+		OrganizerID: fakedOrganizerID,
+	}
+	fakedContestID := testutils.RandomResourceID[domain.ContestID]()
+	fakedRaffleID := testutils.RandomResourceID[domain.RaffleID]()
+	fakedRaffleWinnerID := testutils.RandomResourceID[domain.RaffleWinnerID]()
+	fakedRafflePrizeID := testutils.RandomResourceID[domain.RafflePrizeID]()
+
+	makeMocks := func() (*repositoryMock, *transactionMock, *authorizerMock) {
+		mockedRepo := new(repositoryMock)
+
+		mockedRepo.
+			On("GetRaffle", mock.Anything, nil, fakedRaffleID).
+			Return(domain.Raffle{
+				ID:        fakedRaffleID,
+				Ownership: fakedOwnership,
+				ContestID: fakedContestID,
+			}, nil)
+
+		mockedAuthorizer := new(authorizerMock)
+
+		mockedTx := new(transactionMock)
+
+		return mockedRepo, mockedTx, mockedAuthorizer
+	}
+
+	t.Run("HappyCase", func(t *testing.T) {
+		mockedRepo, mockedTx, mockedAuthorizer := makeMocks()
+
+		mockedAuthorizer.
+			On("HasOwnership", mock.Anything, fakedOwnership).
+			Return(domain.OrganizerRole, nil)
+
+		mockedRepo.
+			On("GetRaffleWinners", mock.Anything, nil, fakedRaffleID).
+			Return([]domain.RaffleWinner{
+				{ID: fakedRaffleWinnerID},
+			}, nil)
+
+		mockedRepo.
+			On("GetRafflePrizes", mock.Anything, nil, fakedRaffleID).
+			Return([]domain.RafflePrize{
+				{ID: fakedRafflePrizeID},
+			}, nil)
+
+		mockedRepo.On("Begin").Return(mockedTx, nil)
+
+		mockedRepo.
+			On("DeleteRaffleWinner", mock.Anything, mockedTx, fakedRaffleWinnerID).
+			Return(nil)
+
+		mockedRepo.
+			On("DeleteRafflePrize", mock.Anything, mockedTx, fakedRafflePrizeID).
+			Return(nil)
+
+		mockedRepo.
+			On("DeleteRaffle", mock.Anything, mockedTx, fakedRaffleID).
+			Return(nil)
+
+		mockedTx.On("Commit").Return(nil)
+		mockedTx.On("Rollback").Return()
+
+		ucase := usecases.RaffleUseCase{
+			Repo:       mockedRepo,
+			Authorizer: mockedAuthorizer,
+		}
+
+		err := ucase.DeleteRaffle(context.Background(), fakedRaffleID)
+
+		require.NoError(t, err)
+
+		mockedRepo.AssertExpectations(t)
+		mockedTx.AssertExpectations(t)
+		mockedAuthorizer.AssertExpectations(t)
+	})
+
+	t.Run("BadCredentials", func(t *testing.T) {
+		mockedRepo, _, mockedAuthorizer := makeMocks()
+
+		mockedAuthorizer.
+			On("HasOwnership", mock.Anything, fakedOwnership).
+			Return(domain.NilRole, domain.ErrNoOwnership)
+
+		ucase := usecases.RaffleUseCase{
+			Repo:       mockedRepo,
+			Authorizer: mockedAuthorizer,
+		}
+
+		err := ucase.DeleteRaffle(context.Background(), fakedRaffleID)
 
 		require.ErrorIs(t, err, domain.ErrNoOwnership)
 
@@ -826,3 +1219,612 @@ func TestDeleteRaffle(t *testing.T) {
 		mockedAuthorizer.AssertExpectations(t)
 	})
 }
+
+func TestVoidRaffleWinner(t *testing.T) {
+	fakedOwnership := domain.OwnershipData{
+		OrganizerID: testutils.RandomResourceID[domain.OrganizerID](),
+	}
+	fakedContestID := testutils.RandomResourceID[domain.ContestID]()
+	fakedRaffleID := testutils.RandomResourceID[domain.RaffleID]()
+	fakedRaffleWinnerID := testutils.RandomResourceID[domain.RaffleWinnerID]()
+	fakedContenderID := testutils.RandomResourceID[domain.ContenderID]()
+	fakedRafflePrizeID := testutils.RandomResourceID[domain.RafflePrizeID]()
+
+	fakedWinner := domain.RaffleWinner{
+		ID:          fakedRaffleWinnerID,
+		Ownership:   fakedOwnership,
+		RaffleID:    fakedRaffleID,
+		ContenderID: fakedContenderID,
+		PrizeID:     fakedRafflePrizeID,
+		PrizeName:   "Chalk bag",
+	}
+
+	makeMocks := func(winner domain.RaffleWinner) (*repositoryMock, *eventBrokerMock, *authorizerMock) {
+		mockedRepo := new(repositoryMock)
+		mockedAuthorizer := new(authorizerMock)
+		mockedEventBroker := new(eventBrokerMock)
+
+		mockedRepo.
+			On("GetRaffleWinner", mock.Anything, nil, fakedRaffleWinnerID).
+			Return(winner, nil)
+
+		mockedRepo.
+			On("GetRaffle", mock.Anything, nil, fakedRaffleID).
+			Return(domain.Raffle{
+				ID:        fakedRaffleID,
+				Ownership: fakedOwnership,
+				ContestID: fakedContestID,
+			}, nil)
+
+		mockedAuthorizer.
+			On("HasOwnership", mock.Anything, fakedOwnership).
+			Return(domain.OrganizerRole, nil)
+
+		return mockedRepo, mockedEventBroker, mockedAuthorizer
+	}
+
+	t.Run("HappyCase", func(t *testing.T) {
+		synctest.Test(t, func(t *testing.T) {
+			mockedRepo, mockedEventBroker, mockedAuthorizer := makeMocks(fakedWinner)
+
+			voidedWinner := fakedWinner
+			voidedWinner.VoidedAt = time.Now()
+
+			mockedRepo.
+				On("StoreRaffleWinner", mock.Anything, nil, voidedWinner).
+				Return(voidedWinner, nil)
+
+			mockedEventBroker.
+				On("Dispatch", mock.Anything, fakedContestID, domain.RaffleWinnerUpdatedEvent{
+					RaffleWinnerID: fakedRaffleWinnerID,
+					RaffleID:       fakedRaffleID,
+					ContenderID:    fakedContenderID,
+					PrizeID:        fakedRafflePrizeID,
+					PrizeName:      "Chalk bag",
+					VoidedAt:       time.Now(),
+				}).
+				Return()
+
+			ucase := usecases.RaffleUseCase{
+				Repo:        mockedRepo,
+				Authorizer:  mockedAuthorizer,
+				EventBroker: mockedEventBroker,
+			}
+
+			winner, err := ucase.VoidRaffleWinner(context.Background(), fakedRaffleWinnerID)
+
+			require.NoError(t, err)
+			assert.Equal(t, voidedWinner, winner)
+
+			mockedRepo.AssertExpectations(t)
+			mockedEventBroker.AssertExpectations(t)
+			mockedAuthorizer.AssertExpectations(t)
+		})
+	})
+
+	t.Run("AlreadyClaimed", func(t *testing.T) {
+		claimedWinner := fakedWinner
+		claimedWinner.ClaimedAt = time.Now()
+
+		mockedRepo, mockedEventBroker, mockedAuthorizer := makeMocks(claimedWinner)
+
+		ucase := usecases.RaffleUseCase{
+			Repo:        mockedRepo,
+			Authorizer:  mockedAuthorizer,
+			EventBroker: mockedEventBroker,
+		}
+
+		_, err := ucase.VoidRaffleWinner(context.Background(), fakedRaffleWinnerID)
+
+		require.ErrorIs(t, err, domain.ErrNotAllowed)
+
+		mockedRepo.AssertExpectations(t)
+		mockedEventBroker.AssertExpectations(t)
+		mockedAuthorizer.AssertExpectations(t)
+	})
+}
+
+func TestClaimRafflePrize(t *testing.T) {
+	fakedOwnership := domain.OwnershipData{
+		OrganizerID: testutils.RandomResourceID[domain.OrganizerID](),
+	}
+	fakedContestID := testutils.RandomResourceID[domain.ContestID]()
+	fakedRaffleID := testutils.RandomResourceID[domain.RaffleID]()
+	fakedRaffleWinnerID := testutils.RandomResourceID[domain.RaffleWinnerID]()
+	fakedContenderID := testutils.RandomResourceID[domain.ContenderID]()
+
+	fakedWinner := domain.RaffleWinner{
+		ID:          fakedRaffleWinnerID,
+		Ownership:   fakedOwnership,
+		RaffleID:    fakedRaffleID,
+		ContenderID: fakedContenderID,
+	}
+
+	makeMocks := func(winner domain.RaffleWinner) (*repositoryMock, *eventBrokerMock, *authorizerMock) {
+		mockedRepo := new(repositoryMock)
+		mockedAuthorizer := new(authorizerMock)
+		mockedEventBroker := new(eventBrokerMock)
+
+		mockedRepo.
+			On("GetRaffleWinner", mock.Anything, nil, fakedRaffleWinnerID).
+			Return(winner, nil)
+
+		mockedRepo.
+			On("GetRaffle", mock.Anything, nil, fakedRaffleID).
+			Return(domain.Raffle{
+				ID:        fakedRaffleID,
+				Ownership: fakedOwnership,
+				ContestID: fakedContestID,
+			}, nil)
+
+		mockedAuthorizer.
+			On("HasOwnership", mock.Anything, fakedOwnership).
+			Return(domain.OrganizerRole, nil)
+
+		return mockedRepo, mockedEventBroker, mockedAuthorizer
+	}
+
+	t.Run("HappyCase", func(t *testing.T) {
+		synctest.Test(t, func(t *testing.T) {
+			mockedRepo, mockedEventBroker, mockedAuthorizer := makeMocks(fakedWinner)
+
+			claimedWinner := fakedWinner
+			claimedWinner.ClaimedAt = time.Now()
+
+			mockedRepo.
+				On("StoreRaffleWinner", mock.Anything, nil, claimedWinner).
+				Return(claimedWinner, nil)
+
+			mockedEventBroker.
+				On("Dispatch", mock.Anything, fakedContestID, domain.RaffleWinnerUpdatedEvent{
+					RaffleWinnerID: fakedRaffleWinnerID,
+					RaffleID:       fakedRaffleID,
+					ContenderID:    fakedContenderID,
+					ClaimedAt:      time.Now(),
+				}).
+				Return()
+
+			ucase := usecases.RaffleUseCase{
+				Repo:        mockedRepo,
+				Authorizer:  mockedAuthorizer,
+				EventBroker: mockedEventBroker,
+			}
+
+			winner, err := ucase.ClaimRafflePrize(context.Background(), fakedRaffleWinnerID)
+
+			require.NoError(t, err)
+			assert.Equal(t, claimedWinner, winner)
+
+			mockedRepo.AssertExpectations(t)
+			mockedEventBroker.AssertExpectations(t)
+			mockedAuthorizer.AssertExpectations(t)
+		})
+	})
+
+	t.Run("Voided", func(t *testing.T) {
+		voidedWinner := fakedWinner
+		voidedWinner.VoidedAt = time.Now()
+
+		mockedRepo, mockedEventBroker, mockedAuthorizer := makeMocks(voidedWinner)
+
+		ucase := usecases.RaffleUseCase{
+			Repo:        mockedRepo,
+			Authorizer:  mockedAuthorizer,
+			EventBroker: mockedEventBroker,
+		}
+
+		_, err := ucase.ClaimRafflePrize(context.Background(), fakedRaffleWinnerID)
+
+		require.ErrorIs(t, err, domain.ErrNotAllowed)
+
+		mockedRepo.AssertExpectations(t)
+		mockedEventBroker.AssertExpectations(t)
+		mockedAuthorizer.AssertExpectations(t)
+	})
+}
+
+func TestCreateRafflePrize(t *testing.T) {
+	fakedOwnership := domain.OwnershipData{
+		OrganizerID: testutils.RandomResourceID[domain.OrganizerID](),
+	}
+	fakedRaffleID := testutils.RandomResourceID[domain.RaffleID]()
+	fakedRafflePrizeID := testutils.RandomResourceID[domain.RafflePrizeID]()
+
+	makeMocks := func() (*repositoryMock, *authorizerMock) {
+		mockedRepo := new(repositoryMock)
+		mockedAuthorizer := new(authorizerMock)
+
+		mockedRepo.
+			On("GetRaffle", mock.Anything, nil, fakedRaffleID).
+			Return(domain.Raffle{
+				ID:        fakedRaffleID,
+				Ownership: fakedOwnership,
+			}, nil)
+
+		mockedAuthorizer.
+			On("HasOwnership", mock.Anything, fakedOwnership).
+			Return(domain.OrganizerRole, nil)
+
+		mockedRepo.
+			On("GetRafflePrizes", mock.Anything, nil, fakedRaffleID).
+			Return([]domain.RafflePrize{}, nil)
+
+		return mockedRepo, mockedAuthorizer
+	}
+
+	t.Run("HappyCase", func(t *testing.T) {
+		mockedRepo, mockedAuthorizer := makeMocks()
+
+		mockedRepo.
+			On("StoreRafflePrize", mock.Anything, nil, domain.RafflePrize{
+				Ownership: fakedOwnership,
+				RaffleID:  fakedRaffleID,
+				Name:      "Chalk bag",
+				Quantity:  3,
+			}).
+			Return(domain.RafflePrize{
+				ID:        fakedRafflePrizeID,
+				Ownership: fakedOwnership,
+				RaffleID:  fakedRaffleID,
+				Name:      "Chalk bag",
+				Quantity:  3,
+			}, nil)
+
+		ucase := usecases.RaffleUseCase{
+			Repo:       mockedRepo,
+			Authorizer: mockedAuthorizer,
+		}
+
+		prize, err := ucase.CreateRafflePrize(context.Background(), fakedRaffleID, domain.RafflePrizeTemplate{
+			Name:     " Chalk bag ",
+			Quantity: 3,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, fakedRafflePrizeID, prize.ID)
+
+		mockedRepo.AssertExpectations(t)
+		mockedAuthorizer.AssertExpectations(t)
+	})
+
+	t.Run("InvalidData", func(t *testing.T) {
+		mockedRepo, mockedAuthorizer := makeMocks()
+
+		ucase := usecases.RaffleUseCase{
+			Repo:       mockedRepo,
+			Authorizer: mockedAuthorizer,
+		}
+
+		_, err := ucase.CreateRafflePrize(context.Background(), fakedRaffleID, domain.RafflePrizeTemplate{
+			Name:     "Chalk bag",
+			Quantity: 0,
+		})
+
+		require.ErrorIs(t, err, domain.ErrInvalidData)
+		assert.True(t, validators.RafflePrizeValidator{}.IsValidationError(err))
+
+		mockedRepo.AssertExpectations(t)
+		mockedAuthorizer.AssertExpectations(t)
+	})
+}
+
+func TestDeleteRafflePrize(t *testing.T) {
+	fakedOwnership := domain.OwnershipData{
+		OrganizerID: testutils.RandomResourceID[domain.OrganizerID](),
+	}
+	fakedRaffleID := testutils.RandomResourceID[domain.RaffleID]()
+	fakedRafflePrizeID := testutils.RandomResourceID[domain.RafflePrizeID]()
+
+	makeMocks := func() (*repositoryMock, *authorizerMock) {
+		mockedRepo := new(repositoryMock)
+		mockedAuthorizer := new(authorizerMock)
+
+		mockedRepo.
+			On("GetRafflePrize", mock.Anything, nil, fakedRafflePrizeID).
+			Return(domain.RafflePrize{
+				ID:        fakedRafflePrizeID,
+				Ownership: fakedOwnership,
+				RaffleID:  fakedRaffleID,
+			}, nil)
+
+		mockedAuthorizer.
+			On("HasOwnership", mock.Anything, fakedOwnership).
+			Return(domain.OrganizerRole, nil)
+
+		return mockedRepo, mockedAuthorizer
+	}
+
+	t.Run("HappyCase", func(t *testing.T) {
+		mockedRepo, mockedAuthorizer := makeMocks()
+
+		mockedRepo.
+			On("GetRaffleWinners", mock.Anything, nil, fakedRaffleID).
+			Return([]domain.RaffleWinner{}, nil)
+
+		mockedRepo.
+			On("DeleteRafflePrize", mock.Anything, nil, fakedRafflePrizeID).
+			Return(nil)
+
+		ucase := usecases.RaffleUseCase{
+			Repo:       mockedRepo,
+			Authorizer: mockedAuthorizer,
+		}
+
+		err := ucase.DeleteRafflePrize(context.Background(), fakedRafflePrizeID)
+
+		require.NoError(t, err)
+
+		mockedRepo.AssertExpectations(t)
+		mockedAuthorizer.AssertExpectations(t)
+	})
+
+	t.Run("AlreadyAwarded", func(t *testing.T) {
+		mockedRepo, mockedAuthorizer := makeMocks()
+
+		mockedRepo.
+			On("GetRaffleWinners", mock.Anything, nil, fakedRaffleID).
+			Return([]domain.RaffleWinner{{PrizeID: fakedRafflePrizeID}}, nil)
+
+		ucase := usecases.RaffleUseCase{
+			Repo:       mockedRepo,
+			Authorizer: mockedAuthorizer,
+		}
+
+		err := ucase.DeleteRafflePrize(context.Background(), fakedRafflePrizeID)
+
+		require.ErrorIs(t, err, domain.ErrNotAllowed)
+
+		mockedRepo.AssertExpectations(t)
+		mockedAuthorizer.AssertExpectations(t)
+	})
+}
+
+func TestRevealRaffleSeed(t *testing.T) {
+	fakedOwnership := domain.OwnershipData{
+		OrganizerID: testutils.RandomResourceID[domain.OrganizerID](),
+	}
+	fakedRaffleID := testutils.RandomResourceID[domain.RaffleID]()
+
+	t.Run("HappyCase", func(t *testing.T) {
+		synctest.Test(t, func(t *testing.T) {
+			mockedRepo := new(repositoryMock)
+			mockedAuthorizer := new(authorizerMock)
+
+			fakedRaffle := domain.Raffle{
+				ID:             fakedRaffleID,
+				Ownership:      fakedOwnership,
+				Seed:           "seed",
+				SeedCommitment: "commitment",
+			}
+
+			mockedRepo.
+				On("GetRaffle", mock.Anything, nil, fakedRaffleID).
+				Return(fakedRaffle, nil)
+
+			mockedAuthorizer.
+				On("HasOwnership", mock.Anything, fakedOwnership).
+				Return(domain.OrganizerRole, nil)
+
+			revealedRaffle := fakedRaffle
+			revealedRaffle.SeedRevealedAt = time.Now()
+
+			mockedRepo.
+				On("StoreRaffle", mock.Anything, nil, revealedRaffle).
+				Return(revealedRaffle, nil)
+
+			ucase := usecases.RaffleUseCase{
+				Repo:       mockedRepo,
+				Authorizer: mockedAuthorizer,
+			}
+
+			raffle, err := ucase.RevealRaffleSeed(context.Background(), fakedRaffleID)
+
+			require.NoError(t, err)
+			assert.Equal(t, revealedRaffle, raffle)
+
+			mockedRepo.AssertExpectations(t)
+			mockedAuthorizer.AssertExpectations(t)
+		})
+	})
+
+	t.Run("NotVerifiable", func(t *testing.T) {
+		mockedRepo := new(repositoryMock)
+		mockedAuthorizer := new(authorizerMock)
+
+		mockedRepo.
+			On("GetRaffle", mock.Anything, nil, fakedRaffleID).
+			Return(domain.Raffle{
+				ID:        fakedRaffleID,
+				Ownership: fakedOwnership,
+			}, nil)
+
+		mockedAuthorizer.
+			On("HasOwnership", mock.Anything, fakedOwnership).
+			Return(domain.OrganizerRole, nil)
+
+		ucase := usecases.RaffleUseCase{
+			Repo:       mockedRepo,
+			Authorizer: mockedAuthorizer,
+		}
+
+		_, err := ucase.RevealRaffleSeed(context.Background(), fakedRaffleID)
+
+		require.ErrorIs(t, err, domain.ErrNotAllowed)
+
+		mockedRepo.AssertExpectations(t)
+		mockedAuthorizer.AssertExpectations(t)
+	})
+}
+
+func TestVerifyRaffle(t *testing.T) {
+	fakedOwnership := domain.OwnershipData{
+		OrganizerID: testutils.RandomResourceID[domain.OrganizerID](),
+	}
+	fakedContestID := testutils.RandomResourceID[domain.ContestID]()
+	fakedRaffleID := testutils.RandomResourceID[domain.RaffleID]()
+
+	seed := make([]byte, 32)
+	for i := range seed {
+		seed[i] = byte(i)
+	}
+
+	commitment := sha256.Sum256(seed)
+
+	fakedRaffle := domain.Raffle{
+		ID:             fakedRaffleID,
+		Ownership:      fakedOwnership,
+		ContestID:      fakedContestID,
+		Seed:           hex.EncodeToString(seed),
+		SeedCommitment: hex.EncodeToString(commitment[:]),
+	}
+
+	drawWinner := func(t *testing.T) domain.RaffleWinner {
+		mockedRepo := new(repositoryMock)
+		mockedAuthorizer := new(authorizerMock)
+		mockedEventBroker := new(eventBrokerMock)
+
+		mockedRepo.
+			On("GetRaffle", mock.Anything, nil, fakedRaffleID).
+			Return(fakedRaffle, nil)
+
+		mockedAuthorizer.
+			On("HasOwnership", mock.Anything, fakedOwnership).
+			Return(domain.OrganizerRole, nil)
+
+		var contenders []domain.Contender
+		for _, id := range []domain.ContenderID{5, 3, 1, 4, 2} {
+			contenders = append(contenders, domain.Contender{
+				ID:      id,
+				Entered: time.Now().Add(-time.Hour),
+			})
+		}
+
+		mockedRepo.
+			On("GetContendersByContest", mock.Anything, nil, fakedContestID).
+			Return(contenders, nil)
+
+		mockedRepo.
+			On("GetRaffleWinners", mock.Anything, nil, fakedRaffleID).
+			Return([]domain.RaffleWinner{}, nil)
+
+		mockedRepo.
+			On("GetRafflePrizes", mock.Anything, nil, fakedRaffleID).
+			Return([]domain.RafflePrize{}, nil)
+
+		storeCall := mockedRepo.On("StoreRaffleWinner", mock.Anything, nil, mock.AnythingOfType("domain.RaffleWinner"))
+		storeCall.Run(func(args mock.Arguments) {
+			storeCall.ReturnArguments = mock.Arguments{args.Get(2), nil}
+		})
+
+		mockedEventBroker.
+			On("Dispatch", mock.Anything, fakedContestID, mock.AnythingOfType("domain.RaffleWinnerDrawnEvent")).
+			Return()
+
+		ucase := usecases.RaffleUseCase{
+			Repo:        mockedRepo,
+			Authorizer:  mockedAuthorizer,
+			EventBroker: mockedEventBroker,
+		}
+
+		winner, err := ucase.DrawRaffleWinner(context.Background(), fakedRaffleID)
+		require.NoError(t, err)
+
+		require.NotNil(t, winner.Draw)
+		assert.Equal(t, 0, winner.Draw.Number)
+		assert.Equal(t, winner.ContenderID, winner.Draw.ContenderID)
+		assert.Equal(t, []domain.RaffleCandidate{
+			{ContenderID: 1, Weight: 1},
+			{ContenderID: 2, Weight: 1},
+			{ContenderID: 3, Weight: 1},
+			{ContenderID: 4, Weight: 1},
+			{ContenderID: 5, Weight: 1},
+		}, winner.Draw.Candidates)
+
+		return winner
+	}
+
+	verify := func(t *testing.T, raffle domain.Raffle, winners []domain.RaffleWinner) domain.RaffleVerification {
+		mockedRepo := new(repositoryMock)
+
+		mockedRepo.
+			On("GetRaffle", mock.Anything, nil, fakedRaffleID).
+			Return(raffle, nil)
+
+		mockedRepo.
+			On("GetRaffleWinners", mock.Anything, nil, fakedRaffleID).
+			Return(winners, nil)
+
+		ucase := usecases.RaffleUseCase{
+			Repo: mockedRepo,
+		}
+
+		verification, err := ucase.VerifyRaffle(context.Background(), fakedRaffleID)
+		require.NoError(t, err)
+
+		mockedRepo.AssertExpectations(t)
+
+		return verification
+	}
+
+	revealedRaffle := fakedRaffle
+	revealedRaffle.SeedRevealedAt = time.Now()
+
+	t.Run("HappyCase", func(t *testing.T) {
+		winner := drawWinner(t)
+
+		verification := verify(t, revealedRaffle, []domain.RaffleWinner{winner})
+
+		assert.True(t, verification.Verified)
+		assert.Equal(t, fakedRaffle.Seed, verification.Seed)
+		assert.Equal(t, fakedRaffle.SeedCommitment, verification.SeedCommitment)
+		assert.Equal(t, []domain.RaffleDraw{*winner.Draw}, verification.Draws)
+	})
+
+	t.Run("Deterministic", func(t *testing.T) {
+		first := drawWinner(t)
+		second := drawWinner(t)
+
+		assert.Equal(t, first.ContenderID, second.ContenderID)
+	})
+
+	t.Run("TamperedWinner", func(t *testing.T) {
+		winner := drawWinner(t)
+		winner.ContenderID = winner.ContenderID%5 + 1
+
+		verification := verify(t, revealedRaffle, []domain.RaffleWinner{winner})
+
+		assert.False(t, verification.Verified)
+	})
+
+	t.Run("SeedNotRevealed", func(t *testing.T) {
+		winner := drawWinner(t)
+
+		verification := verify(t, fakedRaffle, []domain.RaffleWinner{winner})
+
+		assert.False(t, verification.Verified)
+		assert.Empty(t, verification.Seed)
+		assert.Equal(t, fakedRaffle.SeedCommitment, verification.SeedCommitment)
+		assert.Len(t, verification.Draws, 1)
+	})
+
+	t.Run("NotVerifiable", func(t *testing.T) {
+		mockedRepo := new(repositoryMock)
+
+		mockedRepo.
+			On("GetRaffle", mock.Anything, nil, fakedRaffleID).
+			Return(domain.Raffle{
+				ID:        fakedRaffleID,
+				Ownership: fakedOwnership,
+			}, nil)
+
+		ucase := usecases.RaffleUseCase{
+			Repo: mockedRepo,
+		}
+
+		_, err := ucase.VerifyRaffle(context.Background(), fakedRaffleID)
+
+		require.ErrorIs(t, err, domain.ErrNotAllowed)
+
+		mockedRepo.AssertExpectations(t)
+	})
+}
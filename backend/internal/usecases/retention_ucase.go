@@ -0,0 +1,374 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/climblive/platform/backend/internal/domain"
+	"github.com/climblive/platform/backend/internal/usecases/validators"
+	"github.com/go-errors/errors"
+)
+
+const maxRetentionPoliciesPerOrganizer = 10
+
+type retentionUseCaseRepository interface {
+	domain.Transactor
+
+	GetOrganizer(ctx context.Context, tx domain.Transaction, organizerID domain.OrganizerID) (domain.Organizer, error)
+	GetRetentionPolicy(ctx context.Context, tx domain.Transaction, policyID domain.RetentionPolicyID) (domain.RetentionPolicy, error)
+	GetRetentionPoliciesByOrganizer(ctx context.Context, tx domain.Transaction, organizerID domain.OrganizerID) ([]domain.RetentionPolicy, error)
+	GetEnabledRetentionPolicies(ctx context.Context, tx domain.Transaction) ([]domain.RetentionPolicy, error)
+	StoreRetentionPolicy(ctx context.Context, tx domain.Transaction, policy domain.RetentionPolicy) (domain.RetentionPolicy, error)
+	DeleteRetentionPolicy(ctx context.Context, tx domain.Transaction, policyID domain.RetentionPolicyID) error
+	GetRetentionPolicyExecutions(ctx context.Context, tx domain.Transaction, policyID domain.RetentionPolicyID) ([]domain.RetentionPolicyExecution, error)
+	StoreRetentionPolicyExecution(ctx context.Context, tx domain.Transaction, execution domain.RetentionPolicyExecution) (domain.RetentionPolicyExecution, error)
+	GetContestsByOrganizer(ctx context.Context, tx domain.Transaction, organizerID domain.OrganizerID) ([]domain.Contest, error)
+	GetContendersByContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) ([]domain.Contender, error)
+	GetTicksByContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) ([]domain.Tick, error)
+	DeleteTicksByContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) (int, error)
+	DeleteTickRevisionsByContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) error
+	DeleteTickDisputesByContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) error
+	DeleteScoresByContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) error
+	DeletePublishedScoresByContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) error
+	DeleteRaffleWinnersByContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) error
+	DeleteContendersByContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) (int, error)
+	DeleteRafflePrizesByContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) error
+	DeleteRafflesByContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) error
+	DeleteProblemsByContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) error
+	DeleteRoundsByContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) error
+	DeleteTeamsByContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) error
+	DeleteCompClassesByContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) error
+	DeleteContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) error
+}
+
+type RetentionUseCase struct {
+	Authorizer domain.Authorizer
+	Repo       retentionUseCaseRepository
+}
+
+func (uc *RetentionUseCase) GetRetentionPoliciesByOrganizer(ctx context.Context, organizerID domain.OrganizerID) ([]domain.RetentionPolicy, error) {
+	organizer, err := uc.Repo.GetOrganizer(ctx, nil, organizerID)
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	if _, err := uc.Authorizer.HasOwnership(ctx, organizer.Ownership); err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	policies, err := uc.Repo.GetRetentionPoliciesByOrganizer(ctx, nil, organizerID)
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	return policies, nil
+}
+
+func (uc *RetentionUseCase) CreateRetentionPolicy(ctx context.Context, organizerID domain.OrganizerID, tmpl domain.RetentionPolicyTemplate) (domain.RetentionPolicy, error) {
+	organizer, err := uc.Repo.GetOrganizer(ctx, nil, organizerID)
+	if err != nil {
+		return domain.RetentionPolicy{}, errors.Wrap(err, 0)
+	}
+
+	if _, err := uc.Authorizer.HasOwnership(ctx, organizer.Ownership); err != nil {
+		return domain.RetentionPolicy{}, errors.Wrap(err, 0)
+	}
+
+	policies, err := uc.Repo.GetRetentionPoliciesByOrganizer(ctx, nil, organizerID)
+	if err != nil {
+		return domain.RetentionPolicy{}, errors.Wrap(err, 0)
+	}
+
+	if len(policies) >= maxRetentionPoliciesPerOrganizer {
+		return domain.RetentionPolicy{}, errors.New(domain.ErrLimitExceeded)
+	}
+
+	policy := domain.RetentionPolicy{
+		ID:              0,
+		Ownership:       organizer.Ownership,
+		Target:          tmpl.Target,
+		RetentionPeriod: tmpl.RetentionPeriod,
+		Enabled:         tmpl.Enabled,
+		Created:         time.Now(),
+	}
+
+	if err := (validators.RetentionPolicyValidator{}).Validate(policy); err != nil {
+		return domain.RetentionPolicy{}, errors.Wrap(err, 0)
+	}
+
+	createdPolicy, err := uc.Repo.StoreRetentionPolicy(ctx, nil, policy)
+	if err != nil {
+		return domain.RetentionPolicy{}, errors.Wrap(err, 0)
+	}
+
+	return createdPolicy, nil
+}
+
+func (uc *RetentionUseCase) PatchRetentionPolicy(ctx context.Context, policyID domain.RetentionPolicyID, patch domain.RetentionPolicyPatch) (domain.RetentionPolicy, error) {
+	policy, err := uc.Repo.GetRetentionPolicy(ctx, nil, policyID)
+	if err != nil {
+		return domain.RetentionPolicy{}, errors.Wrap(err, 0)
+	}
+
+	if _, err := uc.Authorizer.HasOwnership(ctx, policy.Ownership); err != nil {
+		return domain.RetentionPolicy{}, errors.Wrap(err, 0)
+	}
+
+	if patch.RetentionPeriod.Present {
+		policy.RetentionPeriod = patch.RetentionPeriod.Value
+	}
+
+	if patch.Enabled.Present {
+		policy.Enabled = patch.Enabled.Value
+	}
+
+	if err := (validators.RetentionPolicyValidator{}).Validate(policy); err != nil {
+		return domain.RetentionPolicy{}, errors.Wrap(err, 0)
+	}
+
+	if _, err := uc.Repo.StoreRetentionPolicy(ctx, nil, policy); err != nil {
+		return domain.RetentionPolicy{}, errors.Wrap(err, 0)
+	}
+
+	return policy, nil
+}
+
+func (uc *RetentionUseCase) DeleteRetentionPolicy(ctx context.Context, policyID domain.RetentionPolicyID) error {
+	policy, err := uc.Repo.GetRetentionPolicy(ctx, nil, policyID)
+	if err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	if _, err := uc.Authorizer.HasOwnership(ctx, policy.Ownership); err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	if err := uc.Repo.DeleteRetentionPolicy(ctx, nil, policyID); err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	return nil
+}
+
+func (uc *RetentionUseCase) DryRunRetentionPolicy(ctx context.Context, policyID domain.RetentionPolicyID) ([]domain.RetentionCandidate, error) {
+	policy, err := uc.Repo.GetRetentionPolicy(ctx, nil, policyID)
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	if _, err := uc.Authorizer.HasOwnership(ctx, policy.Ownership); err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	candidates, err := uc.retentionCandidates(ctx, policy, time.Now())
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	return candidates, nil
+}
+
+func (uc *RetentionUseCase) GetRetentionPolicyExecutions(ctx context.Context, policyID domain.RetentionPolicyID) ([]domain.RetentionPolicyExecution, error) {
+	policy, err := uc.Repo.GetRetentionPolicy(ctx, nil, policyID)
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	if _, err := uc.Authorizer.HasOwnership(ctx, policy.Ownership); err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	executions, err := uc.Repo.GetRetentionPolicyExecutions(ctx, nil, policyID)
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	return executions, nil
+}
+
+func (uc *RetentionUseCase) ApplyRetentionPolicies(ctx context.Context) ([]domain.RetentionPolicyExecution, error) {
+	policies, err := uc.Repo.GetEnabledRetentionPolicies(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	executions := make([]domain.RetentionPolicyExecution, 0, len(policies))
+
+	for _, policy := range policies {
+		execution := uc.applyRetentionPolicy(ctx, policy, time.Now())
+
+		execution, err := uc.Repo.StoreRetentionPolicyExecution(ctx, nil, execution)
+		if err != nil {
+			return executions, errors.Wrap(err, 0)
+		}
+
+		executions = append(executions, execution)
+	}
+
+	return executions, nil
+}
+
+func (uc *RetentionUseCase) applyRetentionPolicy(ctx context.Context, policy domain.RetentionPolicy, now time.Time) domain.RetentionPolicyExecution {
+	execution := domain.RetentionPolicyExecution{
+		ID:        0,
+		Ownership: policy.Ownership,
+		PolicyID:  policy.ID,
+		Timestamp: now,
+	}
+
+	candidates, err := uc.retentionCandidates(ctx, policy, now)
+	if err != nil {
+		execution.Error = err.Error()
+		return execution
+	}
+
+	for _, candidate := range candidates {
+		contenders, ticks, err := uc.purgeContest(ctx, policy.Target, candidate.ContestID)
+		if err != nil {
+			execution.Error = err.Error()
+			return execution
+		}
+
+		execution.Contests++
+		execution.Contenders += contenders
+		execution.Ticks += ticks
+	}
+
+	return execution
+}
+
+func (uc *RetentionUseCase) retentionCandidates(ctx context.Context, policy domain.RetentionPolicy, now time.Time) ([]domain.RetentionCandidate, error) {
+	contests, err := uc.Repo.GetContestsByOrganizer(ctx, nil, policy.Ownership.OrganizerID)
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	candidates := make([]domain.RetentionCandidate, 0)
+
+	for _, contest := range contests {
+		var deadline time.Time
+
+		switch policy.Target {
+		case domain.RetentionPolicyTargetArchivedContests:
+			if contest.ArchivedAt.IsZero() {
+				continue
+			}
+
+			deadline = contest.ArchivedAt.Add(policy.RetentionPeriod)
+		default:
+			if contest.TimeEnd.IsZero() {
+				continue
+			}
+
+			deadline = contest.TimeEnd.Add(policy.RetentionPeriod)
+		}
+
+		if now.Before(deadline) {
+			continue
+		}
+
+		ticks, err := uc.Repo.GetTicksByContest(ctx, nil, contest.ID)
+		if err != nil {
+			return nil, errors.Wrap(err, 0)
+		}
+
+		candidate := domain.RetentionCandidate{
+			ContestID:   contest.ID,
+			ContestName: contest.Name,
+			Deadline:    deadline,
+			Contenders:  0,
+			Ticks:       len(ticks),
+		}
+
+		if policy.Target != domain.RetentionPolicyTargetTicks {
+			contenders, err := uc.Repo.GetContendersByContest(ctx, nil, contest.ID)
+			if err != nil {
+				return nil, errors.Wrap(err, 0)
+			}
+
+			candidate.Contenders = len(contenders)
+		}
+
+		if policy.Target != domain.RetentionPolicyTargetArchivedContests && candidate.Contenders == 0 && candidate.Ticks == 0 {
+			continue
+		}
+
+		candidates = append(candidates, candidate)
+	}
+
+	return candidates, nil
+}
+
+func (uc *RetentionUseCase) purgeContest(ctx context.Context, target domain.RetentionPolicyTarget, contestID domain.ContestID) (int, int, error) {
+	tx, err := uc.Repo.Begin()
+	if err != nil {
+		return 0, 0, errors.Wrap(err, 0)
+	}
+	defer tx.Rollback()
+
+	ticks, err := uc.Repo.DeleteTicksByContest(ctx, tx, contestID)
+	if err != nil {
+		return 0, 0, errors.Wrap(err, 0)
+	}
+
+	if err := uc.Repo.DeleteTickRevisionsByContest(ctx, tx, contestID); err != nil {
+		return 0, 0, errors.Wrap(err, 0)
+	}
+
+	if err := uc.Repo.DeleteTickDisputesByContest(ctx, tx, contestID); err != nil {
+		return 0, 0, errors.Wrap(err, 0)
+	}
+
+	if err := uc.Repo.DeleteScoresByContest(ctx, tx, contestID); err != nil {
+		return 0, 0, errors.Wrap(err, 0)
+	}
+
+	if err := uc.Repo.DeletePublishedScoresByContest(ctx, tx, contestID); err != nil {
+		return 0, 0, errors.Wrap(err, 0)
+	}
+
+	var contenders int
+
+	if target != domain.RetentionPolicyTargetTicks {
+		if err := uc.Repo.DeleteRaffleWinnersByContest(ctx, tx, contestID); err != nil {
+			return 0, 0, errors.Wrap(err, 0)
+		}
+
+		contenders, err = uc.Repo.DeleteContendersByContest(ctx, tx, contestID)
+		if err != nil {
+			return 0, 0, errors.Wrap(err, 0)
+		}
+	}
+
+	if target == domain.RetentionPolicyTargetArchivedContests {
+		if err := uc.purgeContestStructure(ctx, tx, contestID); err != nil {
+			return 0, 0, errors.Wrap(err, 0)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, 0, errors.Wrap(err, 0)
+	}
+
+	return contenders, ticks, nil
+}
+
+func (uc *RetentionUseCase) purgeContestStructure(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) error {
+	deletions := []func(context.Context, domain.Transaction, domain.ContestID) error{
+		uc.Repo.DeleteRafflePrizesByContest,
+		uc.Repo.DeleteRafflesByContest,
+		uc.Repo.DeleteProblemsByContest,
+		uc.Repo.DeleteRoundsByContest,
+		uc.Repo.DeleteTeamsByContest,
+		uc.Repo.DeleteCompClassesByContest,
+		uc.Repo.DeleteContest,
+	}
+
+	for _, deletion := range deletions {
+		if err := deletion(ctx, tx, contestID); err != nil {
+			return errors.Wrap(err, 0)
+		}
+	}
+
+	return nil
+}
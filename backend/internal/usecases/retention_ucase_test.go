@@ -0,0 +1,499 @@
+package usecases_test
+
+import (
+	"context"
+	"testing"
+	"testing/synctest"
+	"time"
+
+	"github.com/climblive/platform/backend/internal/domain"
+	"github.com/climblive/platform/backend/internal/testutils"
+	"github.com/climblive/platform/backend/internal/usecases"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateRetentionPolicy(t *testing.T) {
+	fakedOrganizerID := testutils.RandomResourceID[domain.OrganizerID]()
+	fakedOwnership := domain.OwnershipData{
+		OrganizerID: fakedOrganizerID,
+	}
+
+	makeMocks := func() (*repositoryMock, *authorizerMock) {
+		mockedRepo := new(repositoryMock)
+		mockedAuthorizer := new(authorizerMock)
+
+		mockedRepo.
+			On("GetOrganizer", mock.Anything, nil, fakedOrganizerID).
+			Return(domain.Organizer{
+				ID:        fakedOrganizerID,
+				Ownership: fakedOwnership,
+			}, nil)
+
+		return mockedRepo, mockedAuthorizer
+	}
+
+	t.Run("HappyPath", func(t *testing.T) {
+		synctest.Test(t, func(t *testing.T) {
+			mockedRepo, mockedAuthorizer := makeMocks()
+
+			mockedAuthorizer.
+				On("HasOwnership", mock.Anything, fakedOwnership).
+				Return(domain.OrganizerRole, nil)
+
+			mockedRepo.
+				On("GetRetentionPoliciesByOrganizer", mock.Anything, nil, fakedOrganizerID).
+				Return([]domain.RetentionPolicy{}, nil)
+
+			expected := domain.RetentionPolicy{
+				Ownership:       fakedOwnership,
+				Target:          domain.RetentionPolicyTargetContenders,
+				RetentionPeriod: 180 * 24 * time.Hour,
+				Enabled:         true,
+				Created:         time.Now(),
+			}
+
+			mockedRepo.
+				On("StoreRetentionPolicy", mock.Anything, nil, expected).
+				Return(domain.RetentionPolicy{
+					ID:              1,
+					Ownership:       fakedOwnership,
+					Target:          domain.RetentionPolicyTargetContenders,
+					RetentionPeriod: 180 * 24 * time.Hour,
+					Enabled:         true,
+					Created:         time.Now(),
+				}, nil)
+
+			ucase := usecases.RetentionUseCase{
+				Repo:       mockedRepo,
+				Authorizer: mockedAuthorizer,
+			}
+
+			policy, err := ucase.CreateRetentionPolicy(context.Background(), fakedOrganizerID, domain.RetentionPolicyTemplate{
+				Target:          domain.RetentionPolicyTargetContenders,
+				RetentionPeriod: 180 * 24 * time.Hour,
+				Enabled:         true,
+			})
+
+			require.NoError(t, err)
+			assert.Equal(t, domain.RetentionPolicyID(1), policy.ID)
+
+			mockedRepo.AssertExpectations(t)
+			mockedAuthorizer.AssertExpectations(t)
+		})
+	})
+
+	t.Run("InvalidData", func(t *testing.T) {
+		mockedRepo, mockedAuthorizer := makeMocks()
+
+		mockedAuthorizer.
+			On("HasOwnership", mock.Anything, fakedOwnership).
+			Return(domain.OrganizerRole, nil)
+
+		mockedRepo.
+			On("GetRetentionPoliciesByOrganizer", mock.Anything, nil, fakedOrganizerID).
+			Return([]domain.RetentionPolicy{}, nil)
+
+		ucase := usecases.RetentionUseCase{
+			Repo:       mockedRepo,
+			Authorizer: mockedAuthorizer,
+		}
+
+		_, err := ucase.CreateRetentionPolicy(context.Background(), fakedOrganizerID, domain.RetentionPolicyTemplate{
+			Target:          "everything",
+			RetentionPeriod: 180 * 24 * time.Hour,
+		})
+
+		assert.ErrorIs(t, err, domain.ErrInvalidData)
+
+		mockedRepo.AssertExpectations(t)
+		mockedAuthorizer.AssertExpectations(t)
+	})
+
+	t.Run("LimitExceeded", func(t *testing.T) {
+		mockedRepo, mockedAuthorizer := makeMocks()
+
+		mockedAuthorizer.
+			On("HasOwnership", mock.Anything, fakedOwnership).
+			Return(domain.OrganizerRole, nil)
+
+		mockedRepo.
+			On("GetRetentionPoliciesByOrganizer", mock.Anything, nil, fakedOrganizerID).
+			Return(make([]domain.RetentionPolicy, 10), nil)
+
+		ucase := usecases.RetentionUseCase{
+			Repo:       mockedRepo,
+			Authorizer: mockedAuthorizer,
+		}
+
+		_, err := ucase.CreateRetentionPolicy(context.Background(), fakedOrganizerID, domain.RetentionPolicyTemplate{
+			Target:          domain.RetentionPolicyTargetTicks,
+			RetentionPeriod: 180 * 24 * time.Hour,
+		})
+
+		assert.ErrorIs(t, err, domain.ErrLimitExceeded)
+
+		mockedRepo.AssertExpectations(t)
+		mockedAuthorizer.AssertExpectations(t)
+	})
+
+	t.Run("BadCredentials", func(t *testing.T) {
+		mockedRepo, mockedAuthorizer := makeMocks()
+
+		mockedAuthorizer.
+			On("HasOwnership", mock.Anything, fakedOwnership).
+			Return(domain.NilRole, domain.ErrNoOwnership)
+
+		ucase := usecases.RetentionUseCase{
+			Repo:       mockedRepo,
+			Authorizer: mockedAuthorizer,
+		}
+
+		_, err := ucase.CreateRetentionPolicy(context.Background(), fakedOrganizerID, domain.RetentionPolicyTemplate{})
+
+		assert.ErrorIs(t, err, domain.ErrNoOwnership)
+
+		mockedRepo.AssertExpectations(t)
+		mockedAuthorizer.AssertExpectations(t)
+	})
+}
+
+func TestDryRunRetentionPolicy(t *testing.T) {
+	fakedOrganizerID := testutils.RandomResourceID[domain.OrganizerID]()
+	fakedOwnership := domain.OwnershipData{
+		OrganizerID: fakedOrganizerID,
+	}
+	fakedPolicyID := testutils.RandomResourceID[domain.RetentionPolicyID]()
+
+	t.Run("ListsEndedContests", func(t *testing.T) {
+		synctest.Test(t, func(t *testing.T) {
+			mockedRepo := new(repositoryMock)
+			mockedAuthorizer := new(authorizerMock)
+
+			now := time.Now()
+
+			fakedEndedContest := domain.Contest{
+				ID:      testutils.RandomResourceID[domain.ContestID](),
+				Name:    "Old Boulder Bash",
+				TimeEnd: now.Add(-60 * 24 * time.Hour),
+			}
+
+			fakedRecentContest := domain.Contest{
+				ID:      testutils.RandomResourceID[domain.ContestID](),
+				Name:    "Recent Boulder Bash",
+				TimeEnd: now.Add(-1 * time.Hour),
+			}
+
+			fakedEmptyContest := domain.Contest{
+				ID:      testutils.RandomResourceID[domain.ContestID](),
+				Name:    "Empty Boulder Bash",
+				TimeEnd: now.Add(-60 * 24 * time.Hour),
+			}
+
+			mockedRepo.
+				On("GetRetentionPolicy", mock.Anything, nil, fakedPolicyID).
+				Return(domain.RetentionPolicy{
+					ID:              fakedPolicyID,
+					Ownership:       fakedOwnership,
+					Target:          domain.RetentionPolicyTargetContenders,
+					RetentionPeriod: 30 * 24 * time.Hour,
+					Enabled:         true,
+				}, nil)
+
+			mockedAuthorizer.
+				On("HasOwnership", mock.Anything, fakedOwnership).
+				Return(domain.OrganizerRole, nil)
+
+			mockedRepo.
+				On("GetContestsByOrganizer", mock.Anything, nil, fakedOrganizerID).
+				Return([]domain.Contest{fakedEndedContest, fakedRecentContest, fakedEmptyContest}, nil)
+
+			mockedRepo.
+				On("GetTicksByContest", mock.Anything, nil, fakedEndedContest.ID).
+				Return(make([]domain.Tick, 7), nil)
+
+			mockedRepo.
+				On("GetContendersByContest", mock.Anything, nil, fakedEndedContest.ID).
+				Return(make([]domain.Contender, 3), nil)
+
+			mockedRepo.
+				On("GetTicksByContest", mock.Anything, nil, fakedEmptyContest.ID).
+				Return([]domain.Tick{}, nil)
+
+			mockedRepo.
+				On("GetContendersByContest", mock.Anything, nil, fakedEmptyContest.ID).
+				Return([]domain.Contender{}, nil)
+
+			ucase := usecases.RetentionUseCase{
+				Repo:       mockedRepo,
+				Authorizer: mockedAuthorizer,
+			}
+
+			candidates, err := ucase.DryRunRetentionPolicy(context.Background(), fakedPolicyID)
+
+			require.NoError(t, err)
+			assert.Equal(t, []domain.RetentionCandidate{
+				{
+					ContestID:   fakedEndedContest.ID,
+					ContestName: "Old Boulder Bash",
+					Deadline:    now.Add(-30 * 24 * time.Hour),
+					Contenders:  3,
+					Ticks:       7,
+				},
+			}, candidates)
+
+			mockedRepo.AssertExpectations(t)
+			mockedAuthorizer.AssertExpectations(t)
+		})
+	})
+
+	t.Run("BadCredentials", func(t *testing.T) {
+		mockedRepo := new(repositoryMock)
+		mockedAuthorizer := new(authorizerMock)
+
+		mockedRepo.
+			On("GetRetentionPolicy", mock.Anything, nil, fakedPolicyID).
+			Return(domain.RetentionPolicy{ID: fakedPolicyID, Ownership: fakedOwnership}, nil)
+
+		mockedAuthorizer.
+			On("HasOwnership", mock.Anything, fakedOwnership).
+			Return(domain.NilRole, domain.ErrNoOwnership)
+
+		ucase := usecases.RetentionUseCase{
+			Repo:       mockedRepo,
+			Authorizer: mockedAuthorizer,
+		}
+
+		candidates, err := ucase.DryRunRetentionPolicy(context.Background(), fakedPolicyID)
+
+		assert.ErrorIs(t, err, domain.ErrNoOwnership)
+		assert.Nil(t, candidates)
+
+		mockedRepo.AssertExpectations(t)
+		mockedAuthorizer.AssertExpectations(t)
+	})
+}
+
+func TestApplyRetentionPolicies(t *testing.T) {
+	fakedOrganizerID := testutils.RandomResourceID[domain.OrganizerID]()
+	fakedOwnership := domain.OwnershipData{
+		OrganizerID: fakedOrganizerID,
+	}
+
+	t.Run("DeletesArchivedContests", func(t *testing.T) {
+		synctest.Test(t, func(t *testing.T) {
+			mockedRepo := new(repositoryMock)
+			mockedTx := new(transactionMock)
+
+			fakedPolicyID := testutils.RandomResourceID[domain.RetentionPolicyID]()
+			fakedContestID := testutils.RandomResourceID[domain.ContestID]()
+
+			mockedRepo.
+				On("GetEnabledRetentionPolicies", mock.Anything, nil).
+				Return([]domain.RetentionPolicy{
+					{
+						ID:              fakedPolicyID,
+						Ownership:       fakedOwnership,
+						Target:          domain.RetentionPolicyTargetArchivedContests,
+						RetentionPeriod: 365 * 24 * time.Hour,
+						Enabled:         true,
+					},
+				}, nil)
+
+			mockedRepo.
+				On("GetContestsByOrganizer", mock.Anything, nil, fakedOrganizerID).
+				Return([]domain.Contest{
+					{
+						ID:         fakedContestID,
+						ArchivedAt: time.Now().Add(-400 * 24 * time.Hour),
+					},
+					{
+						ID:      testutils.RandomResourceID[domain.ContestID](),
+						TimeEnd: time.Now().Add(-400 * 24 * time.Hour),
+					},
+				}, nil)
+
+			mockedRepo.
+				On("GetTicksByContest", mock.Anything, nil, fakedContestID).
+				Return(make([]domain.Tick, 5), nil)
+
+			mockedRepo.
+				On("GetContendersByContest", mock.Anything, nil, fakedContestID).
+				Return(make([]domain.Contender, 2), nil)
+
+			mockedRepo.On("Begin").Return(mockedTx, nil)
+			mockedTx.On("Commit").Return(nil)
+			mockedTx.On("Rollback").Return()
+
+			mockedRepo.On("DeleteTicksByContest", mock.Anything, mockedTx, fakedContestID).Return(5, nil)
+			mockedRepo.On("DeleteTickRevisionsByContest", mock.Anything, mockedTx, fakedContestID).Return(nil)
+			mockedRepo.On("DeleteTickDisputesByContest", mock.Anything, mockedTx, fakedContestID).Return(nil)
+			mockedRepo.On("DeleteScoresByContest", mock.Anything, mockedTx, fakedContestID).Return(nil)
+			mockedRepo.On("DeletePublishedScoresByContest", mock.Anything, mockedTx, fakedContestID).Return(nil)
+			mockedRepo.On("DeleteRaffleWinnersByContest", mock.Anything, mockedTx, fakedContestID).Return(nil)
+			mockedRepo.On("DeleteContendersByContest", mock.Anything, mockedTx, fakedContestID).Return(2, nil)
+			mockedRepo.On("DeleteRafflePrizesByContest", mock.Anything, mockedTx, fakedContestID).Return(nil)
+			mockedRepo.On("DeleteRafflesByContest", mock.Anything, mockedTx, fakedContestID).Return(nil)
+			mockedRepo.On("DeleteProblemsByContest", mock.Anything, mockedTx, fakedContestID).Return(nil)
+			mockedRepo.On("DeleteRoundsByContest", mock.Anything, mockedTx, fakedContestID).Return(nil)
+			mockedRepo.On("DeleteTeamsByContest", mock.Anything, mockedTx, fakedContestID).Return(nil)
+			mockedRepo.On("DeleteCompClassesByContest", mock.Anything, mockedTx, fakedContestID).Return(nil)
+			mockedRepo.On("DeleteContest", mock.Anything, mockedTx, fakedContestID).Return(nil)
+
+			expected := domain.RetentionPolicyExecution{
+				Ownership:  fakedOwnership,
+				PolicyID:   fakedPolicyID,
+				Timestamp:  time.Now(),
+				Contests:   1,
+				Contenders: 2,
+				Ticks:      5,
+			}
+
+			mockedRepo.
+				On("StoreRetentionPolicyExecution", mock.Anything, nil, expected).
+				Return(expected, nil)
+
+			ucase := usecases.RetentionUseCase{
+				Repo: mockedRepo,
+			}
+
+			executions, err := ucase.ApplyRetentionPolicies(context.Background())
+
+			require.NoError(t, err)
+			assert.Equal(t, []domain.RetentionPolicyExecution{expected}, executions)
+
+			mockedRepo.AssertExpectations(t)
+			mockedTx.AssertExpectations(t)
+		})
+	})
+
+	t.Run("PurgeTicksAndScores", func(t *testing.T) {
+		synctest.Test(t, func(t *testing.T) {
+			mockedRepo := new(repositoryMock)
+			mockedTx := new(transactionMock)
+
+			fakedPolicyID := testutils.RandomResourceID[domain.RetentionPolicyID]()
+			fakedContestID := testutils.RandomResourceID[domain.ContestID]()
+
+			mockedRepo.
+				On("GetEnabledRetentionPolicies", mock.Anything, nil).
+				Return([]domain.RetentionPolicy{
+					{
+						ID:              fakedPolicyID,
+						Ownership:       fakedOwnership,
+						Target:          domain.RetentionPolicyTargetTicks,
+						RetentionPeriod: 30 * 24 * time.Hour,
+						Enabled:         true,
+					},
+				}, nil)
+
+			mockedRepo.
+				On("GetContestsByOrganizer", mock.Anything, nil, fakedOrganizerID).
+				Return([]domain.Contest{
+					{
+						ID:      fakedContestID,
+						TimeEnd: time.Now().Add(-60 * 24 * time.Hour),
+					},
+				}, nil)
+
+			mockedRepo.
+				On("GetTicksByContest", mock.Anything, nil, fakedContestID).
+				Return(make([]domain.Tick, 5), nil)
+
+			mockedRepo.On("Begin").Return(mockedTx, nil)
+			mockedTx.On("Commit").Return(nil)
+			mockedTx.On("Rollback").Return()
+
+			mockedRepo.On("DeleteTicksByContest", mock.Anything, mockedTx, fakedContestID).Return(5, nil)
+			mockedRepo.On("DeleteTickRevisionsByContest", mock.Anything, mockedTx, fakedContestID).Return(nil)
+			mockedRepo.On("DeleteTickDisputesByContest", mock.Anything, mockedTx, fakedContestID).Return(nil)
+			mockedRepo.On("DeleteScoresByContest", mock.Anything, mockedTx, fakedContestID).Return(nil)
+			mockedRepo.On("DeletePublishedScoresByContest", mock.Anything, mockedTx, fakedContestID).Return(nil)
+
+			expected := domain.RetentionPolicyExecution{
+				Ownership: fakedOwnership,
+				PolicyID:  fakedPolicyID,
+				Timestamp: time.Now(),
+				Contests:  1,
+				Ticks:     5,
+			}
+
+			mockedRepo.
+				On("StoreRetentionPolicyExecution", mock.Anything, nil, expected).
+				Return(expected, nil)
+
+			ucase := usecases.RetentionUseCase{
+				Repo: mockedRepo,
+			}
+
+			executions, err := ucase.ApplyRetentionPolicies(context.Background())
+
+			require.NoError(t, err)
+			assert.Equal(t, []domain.RetentionPolicyExecution{expected}, executions)
+
+			mockedRepo.AssertExpectations(t)
+			mockedTx.AssertExpectations(t)
+			mockedRepo.AssertNotCalled(t, "DeleteContendersByContest", mock.Anything, mock.Anything, mock.Anything)
+		})
+	})
+
+	t.Run("RecordsFailure", func(t *testing.T) {
+		synctest.Test(t, func(t *testing.T) {
+			mockedRepo := new(repositoryMock)
+			mockedTx := new(transactionMock)
+
+			fakedPolicyID := testutils.RandomResourceID[domain.RetentionPolicyID]()
+			fakedContestID := testutils.RandomResourceID[domain.ContestID]()
+
+			mockedRepo.
+				On("GetEnabledRetentionPolicies", mock.Anything, nil).
+				Return([]domain.RetentionPolicy{
+					{
+						ID:              fakedPolicyID,
+						Ownership:       fakedOwnership,
+						Target:          domain.RetentionPolicyTargetTicks,
+						RetentionPeriod: 30 * 24 * time.Hour,
+						Enabled:         true,
+					},
+				}, nil)
+
+			mockedRepo.
+				On("GetContestsByOrganizer", mock.Anything, nil, fakedOrganizerID).
+				Return([]domain.Contest{
+					{
+						ID:      fakedContestID,
+						TimeEnd: time.Now().Add(-60 * 24 * time.Hour),
+					},
+				}, nil)
+
+			mockedRepo.
+				On("GetTicksByContest", mock.Anything, nil, fakedContestID).
+				Return(make([]domain.Tick, 5), nil)
+
+			mockedRepo.On("Begin").Return(mockedTx, nil)
+			mockedTx.On("Rollback").Return()
+
+			mockedRepo.On("DeleteTicksByContest", mock.Anything, mockedTx, fakedContestID).Return(0, errMock)
+
+			mockedRepo.
+				On("StoreRetentionPolicyExecution", mock.Anything, nil, mock.MatchedBy(func(execution domain.RetentionPolicyExecution) bool {
+					return execution.PolicyID == fakedPolicyID && execution.Error != "" && execution.Ticks == 0
+				})).
+				Return(domain.RetentionPolicyExecution{PolicyID: fakedPolicyID, Error: errMock.Error()}, nil)
+
+			ucase := usecases.RetentionUseCase{
+				Repo: mockedRepo,
+			}
+
+			executions, err := ucase.ApplyRetentionPolicies(context.Background())
+
+			require.NoError(t, err)
+			require.Len(t, executions, 1)
+			assert.NotEmpty(t, executions[0].Error)
+
+			mockedRepo.AssertExpectations(t)
+			mockedTx.AssertExpectations(t)
+		})
+	})
+}
@@ -0,0 +1,401 @@
+package usecases
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/climblive/platform/backend/internal/domain"
+	"github.com/climblive/platform/backend/internal/usecases/validators"
+	"github.com/go-errors/errors"
+)
+
+const maxRoundsPerContest = 5
+
+type roundUseCaseRepository interface {
+	domain.Transactor
+
+	GetContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) (domain.Contest, error)
+	GetRound(ctx context.Context, tx domain.Transaction, roundID domain.RoundID) (domain.Round, error)
+	GetRoundsByContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) ([]domain.Round, error)
+	StoreRound(ctx context.Context, tx domain.Transaction, round domain.Round) (domain.Round, error)
+	DeleteRound(ctx context.Context, tx domain.Transaction, roundID domain.RoundID) error
+	GetProblemsByContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) ([]domain.Problem, error)
+	GetContendersByContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) ([]domain.Contender, error)
+	GetStartList(ctx context.Context, tx domain.Transaction, roundID domain.RoundID) ([]domain.StartListEntry, error)
+	StoreStartListEntry(ctx context.Context, tx domain.Transaction, entry domain.StartListEntry) error
+	DeleteStartList(ctx context.Context, tx domain.Transaction, roundID domain.RoundID) error
+}
+
+type RoundUseCase struct {
+	Authorizer  domain.Authorizer
+	Repo        roundUseCaseRepository
+	ScoreKeeper domain.ScoreKeeper
+	EventBroker domain.EventBroker
+}
+
+func (uc *RoundUseCase) GetRound(ctx context.Context, roundID domain.RoundID) (domain.Round, error) {
+	round, err := uc.Repo.GetRound(ctx, nil, roundID)
+	if err != nil {
+		return domain.Round{}, errors.Wrap(err, 0)
+	}
+
+	return round, nil
+}
+
+func (uc *RoundUseCase) GetRoundsByContest(ctx context.Context, contestID domain.ContestID) ([]domain.Round, error) {
+	rounds, err := uc.Repo.GetRoundsByContest(ctx, nil, contestID)
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	return rounds, nil
+}
+
+func (uc *RoundUseCase) CreateRound(ctx context.Context, contestID domain.ContestID, tmpl domain.RoundTemplate) (domain.Round, error) {
+	contest, err := uc.Repo.GetContest(ctx, nil, contestID)
+	if err != nil {
+		return domain.Round{}, errors.Wrap(err, 0)
+	}
+
+	if _, err := uc.Authorizer.HasOwnership(ctx, contest.Ownership); err != nil {
+		return domain.Round{}, errors.Wrap(err, 0)
+	}
+
+	if !contest.ArchivedAt.IsZero() {
+		return domain.Round{}, errors.Wrap(domain.ErrArchived, 0)
+	}
+
+	rounds, err := uc.Repo.GetRoundsByContest(ctx, nil, contestID)
+	if err != nil {
+		return domain.Round{}, errors.Wrap(err, 0)
+	}
+
+	if len(rounds) >= maxRoundsPerContest {
+		return domain.Round{}, errors.New(domain.ErrLimitExceeded)
+	}
+
+	for _, round := range rounds {
+		if round.Number == tmpl.Number {
+			return domain.Round{}, errors.Wrap(domain.ErrDuplicate, 0)
+		}
+	}
+
+	round := domain.Round{
+		ID:                 0,
+		Ownership:          contest.Ownership,
+		ContestID:          contestID,
+		Number:             tmpl.Number,
+		Name:               strings.TrimSpace(tmpl.Name),
+		QualifyingProblems: tmpl.QualifyingProblems,
+		Finalists:          tmpl.Finalists,
+		TimeBegin:          tmpl.TimeBegin,
+		TimeEnd:            tmpl.TimeEnd,
+	}
+
+	if err := (validators.RoundValidator{}).Validate(round); err != nil {
+		return domain.Round{}, errors.Wrap(err, 0)
+	}
+
+	createdRound, err := uc.Repo.StoreRound(ctx, nil, round)
+	if err != nil {
+		return domain.Round{}, errors.Wrap(err, 0)
+	}
+
+	return createdRound, nil
+}
+
+func (uc *RoundUseCase) PatchRound(ctx context.Context, roundID domain.RoundID, patch domain.RoundPatch) (domain.Round, error) {
+	round, err := uc.Repo.GetRound(ctx, nil, roundID)
+	if err != nil {
+		return domain.Round{}, errors.Wrap(err, 0)
+	}
+
+	if _, err := uc.Authorizer.HasOwnership(ctx, round.Ownership); err != nil {
+		return domain.Round{}, errors.Wrap(err, 0)
+	}
+
+	contest, err := uc.Repo.GetContest(ctx, nil, round.ContestID)
+	if err != nil {
+		return domain.Round{}, errors.Wrap(err, 0)
+	}
+
+	if !contest.ArchivedAt.IsZero() {
+		return domain.Round{}, errors.Wrap(domain.ErrArchived, 0)
+	}
+
+	rulesUpdateEventBaseline := domain.RulesUpdatedEvent{
+		RoundID:            roundID,
+		QualifyingProblems: round.QualifyingProblems,
+		Finalists:          round.Finalists,
+	}
+
+	if patch.Name.Present {
+		round.Name = strings.TrimSpace(patch.Name.Value)
+	}
+
+	if patch.QualifyingProblems.Present {
+		round.QualifyingProblems = patch.QualifyingProblems.Value
+	}
+
+	if patch.Finalists.Present {
+		round.Finalists = patch.Finalists.Value
+	}
+
+	if patch.TimeBegin.Present {
+		round.TimeBegin = patch.TimeBegin.Value
+	}
+
+	if patch.TimeEnd.Present {
+		round.TimeEnd = patch.TimeEnd.Value
+	}
+
+	if err := (validators.RoundValidator{}).Validate(round); err != nil {
+		return domain.Round{}, errors.Wrap(err, 0)
+	}
+
+	if _, err := uc.Repo.StoreRound(ctx, nil, round); err != nil {
+		return domain.Round{}, errors.Wrap(err, 0)
+	}
+
+	event := domain.RulesUpdatedEvent{
+		RoundID:            roundID,
+		QualifyingProblems: round.QualifyingProblems,
+		Finalists:          round.Finalists,
+	}
+
+	if event != rulesUpdateEventBaseline {
+		uc.EventBroker.Dispatch(ctx, round.ContestID, event)
+	}
+
+	return round, nil
+}
+
+func (uc *RoundUseCase) DeleteRound(ctx context.Context, roundID domain.RoundID) error {
+	round, err := uc.Repo.GetRound(ctx, nil, roundID)
+	if err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	if _, err := uc.Authorizer.HasOwnership(ctx, round.Ownership); err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	problems, err := uc.Repo.GetProblemsByContest(ctx, nil, round.ContestID)
+	if err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	for _, problem := range problems {
+		if problem.RoundID == roundID {
+			return errors.Wrap(domain.ErrNotAllowed, 0)
+		}
+	}
+
+	if err := uc.Repo.DeleteRound(ctx, nil, roundID); err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	return nil
+}
+
+func (uc *RoundUseCase) GetStartList(ctx context.Context, roundID domain.RoundID) ([]domain.StartListEntry, error) {
+	if _, err := uc.Repo.GetRound(ctx, nil, roundID); err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	entries, err := uc.Repo.GetStartList(ctx, nil, roundID)
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	return entries, nil
+}
+
+func (uc *RoundUseCase) GenerateStartList(ctx context.Context, roundID domain.RoundID) ([]domain.StartListEntry, error) {
+	round, err := uc.Repo.GetRound(ctx, nil, roundID)
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	if _, err := uc.Authorizer.HasOwnership(ctx, round.Ownership); err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	if !round.TimeBegin.IsZero() && !time.Now().Before(round.TimeBegin) {
+		return nil, errors.Wrap(domain.ErrNotAllowed, 0)
+	}
+
+	currentStartList, err := uc.Repo.GetStartList(ctx, nil, roundID)
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	hasScores := slices.ContainsFunc(currentStartList, func(entry domain.StartListEntry) bool {
+		return entry.Score != nil
+	})
+
+	if hasScores {
+		return nil, errors.Wrap(domain.ErrNotAllowed, 0)
+	}
+
+	rounds, err := uc.Repo.GetRoundsByContest(ctx, nil, round.ContestID)
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	var previousRound *domain.Round
+
+	for _, candidate := range rounds {
+		if candidate.Number >= round.Number {
+			continue
+		}
+
+		if previousRound == nil || candidate.Number > previousRound.Number {
+			previousRound = &candidate
+		}
+	}
+
+	var previousScores []domain.Score
+
+	if previousRound == nil {
+		contenders, err := uc.Repo.GetContendersByContest(ctx, nil, round.ContestID)
+		if err != nil {
+			return nil, errors.Wrap(err, 0)
+		}
+
+		for _, contender := range contenders {
+			if contender.CompClassID == 0 {
+				continue
+			}
+
+			if score, err := uc.ScoreKeeper.GetScore(contender.ID); err == nil {
+				contender.Score = &score
+			}
+
+			if contender.Score != nil {
+				previousScores = append(previousScores, *contender.Score)
+			}
+		}
+	} else {
+		entries, err := uc.Repo.GetStartList(ctx, nil, previousRound.ID)
+		if err != nil {
+			return nil, errors.Wrap(err, 0)
+		}
+
+		for _, entry := range entries {
+			if score, err := uc.ScoreKeeper.GetRoundScore(previousRound.ID, entry.ContenderID); err == nil {
+				entry.Score = &score
+			}
+
+			if entry.Score != nil {
+				previousScores = append(previousScores, *entry.Score)
+			}
+		}
+	}
+
+	startList := make([]domain.StartListEntry, 0)
+
+	for _, score := range previousScores {
+		if !score.Finalist {
+			continue
+		}
+
+		startList = append(startList, domain.StartListEntry{
+			RoundID:           roundID,
+			ContenderID:       score.ContenderID,
+			PreviousPlacement: score.Placement,
+			Score:             nil,
+		})
+	}
+
+	tx, err := uc.Repo.Begin()
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	defer tx.Rollback()
+
+	if err := uc.Repo.DeleteStartList(ctx, tx, roundID); err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	for _, entry := range startList {
+		if err := uc.Repo.StoreStartListEntry(ctx, tx, entry); err != nil {
+			return nil, errors.Wrap(err, 0)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	return startList, nil
+}
+
+func (uc *RoundUseCase) GetScoreboard(ctx context.Context, roundID domain.RoundID) ([]domain.ScoreboardEntry, error) {
+	round, err := uc.Repo.GetRound(ctx, nil, roundID)
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	startList, err := uc.Repo.GetStartList(ctx, nil, roundID)
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	startListEntries := make(map[domain.ContenderID]domain.StartListEntry)
+
+	for _, entry := range startList {
+		startListEntries[entry.ContenderID] = entry
+	}
+
+	contenders, err := uc.Repo.GetContendersByContest(ctx, nil, round.ContestID)
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	entries := make([]domain.ScoreboardEntry, 0)
+
+	for _, contender := range contenders {
+		startListEntry, found := startListEntries[contender.ID]
+		if !found {
+			continue
+		}
+
+		entry := domain.ScoreboardEntry{
+			ContenderID:         contender.ID,
+			CompClassID:         contender.CompClassID,
+			TeamID:              contender.TeamID,
+			Name:                contender.Name,
+			WithdrawnFromFinals: contender.WithdrawnFromFinals,
+			Disqualified:        contender.Disqualified,
+			ScrubbedAt:          contender.ScrubbedAt,
+			Score:               startListEntry.Score,
+		}
+
+		if score, err := uc.ScoreKeeper.GetRoundScore(roundID, contender.ID); err == nil {
+			entry.Score = &score
+		}
+
+		entries = append(entries, entry)
+	}
+
+	slices.SortFunc(entries, func(a, b domain.ScoreboardEntry) int {
+		switch {
+		case a.Score == nil && b.Score == nil:
+			return int(a.ContenderID) - int(b.ContenderID)
+		case a.Score == nil:
+			return 1
+		case b.Score == nil:
+			return -1
+		case a.Score.RankOrder != b.Score.RankOrder:
+			return a.Score.RankOrder - b.Score.RankOrder
+		default:
+			return int(a.ContenderID) - int(b.ContenderID)
+		}
+	})
+
+	return entries, nil
+}
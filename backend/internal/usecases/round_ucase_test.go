@@ -0,0 +1,663 @@
+package usecases_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/climblive/platform/backend/internal/domain"
+	"github.com/climblive/platform/backend/internal/testutils"
+	"github.com/climblive/platform/backend/internal/usecases"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateRound(t *testing.T) {
+	fakedOwnership := domain.OwnershipData{
+		OrganizerID: testutils.RandomResourceID[domain.OrganizerID](),
+	}
+	fakedContestID := testutils.RandomResourceID[domain.ContestID]()
+	fakedRoundID := testutils.RandomResourceID[domain.RoundID]()
+
+	fakedTemplate := domain.RoundTemplate{
+		Number:             1,
+		Name:               " Final ",
+		QualifyingProblems: 4,
+		Finalists:          0,
+	}
+
+	makeMocks := func() (*repositoryMock, *authorizerMock) {
+		mockedRepo := new(repositoryMock)
+		mockedAuthorizer := new(authorizerMock)
+
+		mockedRepo.
+			On("GetContest", mock.Anything, nil, fakedContestID).
+			Return(domain.Contest{
+				ID:        fakedContestID,
+				Ownership: fakedOwnership,
+			}, nil)
+
+		return mockedRepo, mockedAuthorizer
+	}
+
+	t.Run("HappyCase", func(t *testing.T) {
+		mockedRepo, mockedAuthorizer := makeMocks()
+
+		mockedAuthorizer.
+			On("HasOwnership", mock.Anything, fakedOwnership).
+			Return(domain.OrganizerRole, nil)
+
+		mockedRepo.
+			On("GetRoundsByContest", mock.Anything, nil, fakedContestID).
+			Return([]domain.Round{}, nil)
+
+		mockedRepo.
+			On("StoreRound", mock.Anything, nil, domain.Round{
+				Ownership:          fakedOwnership,
+				ContestID:          fakedContestID,
+				Number:             1,
+				Name:               "Final",
+				QualifyingProblems: 4,
+				Finalists:          0,
+			}).
+			Return(domain.Round{
+				ID:                 fakedRoundID,
+				Ownership:          fakedOwnership,
+				ContestID:          fakedContestID,
+				Number:             1,
+				Name:               "Final",
+				QualifyingProblems: 4,
+				Finalists:          0,
+			}, nil)
+
+		ucase := usecases.RoundUseCase{
+			Repo:       mockedRepo,
+			Authorizer: mockedAuthorizer,
+		}
+
+		round, err := ucase.CreateRound(context.Background(), fakedContestID, fakedTemplate)
+
+		require.NoError(t, err)
+		assert.Equal(t, fakedRoundID, round.ID)
+		assert.Equal(t, "Final", round.Name)
+
+		mockedRepo.AssertExpectations(t)
+		mockedAuthorizer.AssertExpectations(t)
+	})
+
+	t.Run("BadCredentials", func(t *testing.T) {
+		mockedRepo, mockedAuthorizer := makeMocks()
+
+		mockedAuthorizer.
+			On("HasOwnership", mock.Anything, fakedOwnership).
+			Return(domain.NilRole, domain.ErrNoOwnership)
+
+		ucase := usecases.RoundUseCase{
+			Repo:       mockedRepo,
+			Authorizer: mockedAuthorizer,
+		}
+
+		_, err := ucase.CreateRound(context.Background(), fakedContestID, fakedTemplate)
+
+		require.ErrorIs(t, err, domain.ErrNoOwnership)
+
+		mockedRepo.AssertExpectations(t)
+		mockedAuthorizer.AssertExpectations(t)
+	})
+
+	t.Run("DuplicateNumber", func(t *testing.T) {
+		mockedRepo, mockedAuthorizer := makeMocks()
+
+		mockedAuthorizer.
+			On("HasOwnership", mock.Anything, fakedOwnership).
+			Return(domain.OrganizerRole, nil)
+
+		mockedRepo.
+			On("GetRoundsByContest", mock.Anything, nil, fakedContestID).
+			Return([]domain.Round{{ID: fakedRoundID, Number: 1}}, nil)
+
+		ucase := usecases.RoundUseCase{
+			Repo:       mockedRepo,
+			Authorizer: mockedAuthorizer,
+		}
+
+		_, err := ucase.CreateRound(context.Background(), fakedContestID, fakedTemplate)
+
+		require.ErrorIs(t, err, domain.ErrDuplicate)
+
+		mockedRepo.AssertExpectations(t)
+		mockedAuthorizer.AssertExpectations(t)
+	})
+
+	t.Run("LimitExceeded", func(t *testing.T) {
+		mockedRepo, mockedAuthorizer := makeMocks()
+
+		mockedAuthorizer.
+			On("HasOwnership", mock.Anything, fakedOwnership).
+			Return(domain.OrganizerRole, nil)
+
+		rounds := make([]domain.Round, 5)
+		for i := range rounds {
+			rounds[i] = domain.Round{Number: i + 2}
+		}
+
+		mockedRepo.
+			On("GetRoundsByContest", mock.Anything, nil, fakedContestID).
+			Return(rounds, nil)
+
+		ucase := usecases.RoundUseCase{
+			Repo:       mockedRepo,
+			Authorizer: mockedAuthorizer,
+		}
+
+		_, err := ucase.CreateRound(context.Background(), fakedContestID, fakedTemplate)
+
+		require.ErrorIs(t, err, domain.ErrLimitExceeded)
+
+		mockedRepo.AssertExpectations(t)
+		mockedAuthorizer.AssertExpectations(t)
+	})
+}
+
+func TestPatchRound(t *testing.T) {
+	fakedOwnership := domain.OwnershipData{
+		OrganizerID: testutils.RandomResourceID[domain.OrganizerID](),
+	}
+	fakedContestID := testutils.RandomResourceID[domain.ContestID]()
+	fakedRoundID := testutils.RandomResourceID[domain.RoundID]()
+
+	fakedRound := domain.Round{
+		ID:                 fakedRoundID,
+		Ownership:          fakedOwnership,
+		ContestID:          fakedContestID,
+		Number:             1,
+		Name:               "Final",
+		QualifyingProblems: 4,
+		Finalists:          0,
+	}
+
+	t.Run("HappyCase", func(t *testing.T) {
+		mockedRepo := new(repositoryMock)
+		mockedAuthorizer := new(authorizerMock)
+		mockedEventBroker := new(eventBrokerMock)
+
+		mockedRepo.
+			On("GetRound", mock.Anything, nil, fakedRoundID).
+			Return(fakedRound, nil)
+
+		mockedAuthorizer.
+			On("HasOwnership", mock.Anything, fakedOwnership).
+			Return(domain.OrganizerRole, nil)
+
+		mockedRepo.
+			On("GetContest", mock.Anything, nil, fakedContestID).
+			Return(domain.Contest{ID: fakedContestID, Ownership: fakedOwnership}, nil)
+
+		expected := fakedRound
+		expected.QualifyingProblems = 3
+
+		mockedRepo.
+			On("StoreRound", mock.Anything, nil, expected).
+			Return(expected, nil)
+
+		mockedEventBroker.
+			On("Dispatch", mock.Anything, fakedContestID, domain.RulesUpdatedEvent{
+				RoundID:            fakedRoundID,
+				QualifyingProblems: 3,
+				Finalists:          0,
+			}).
+			Return()
+
+		ucase := usecases.RoundUseCase{
+			Repo:        mockedRepo,
+			Authorizer:  mockedAuthorizer,
+			EventBroker: mockedEventBroker,
+		}
+
+		round, err := ucase.PatchRound(context.Background(), fakedRoundID, domain.RoundPatch{
+			QualifyingProblems: domain.NewPatch(3),
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, expected, round)
+
+		mockedRepo.AssertExpectations(t)
+		mockedAuthorizer.AssertExpectations(t)
+		mockedEventBroker.AssertExpectations(t)
+	})
+
+	t.Run("TimeWindow", func(t *testing.T) {
+		mockedRepo := new(repositoryMock)
+		mockedAuthorizer := new(authorizerMock)
+		mockedEventBroker := new(eventBrokerMock)
+
+		timeBegin := time.Now().Add(24 * time.Hour).Truncate(time.Second)
+		timeEnd := timeBegin.Add(2 * time.Hour)
+
+		mockedRepo.
+			On("GetRound", mock.Anything, nil, fakedRoundID).
+			Return(fakedRound, nil)
+
+		mockedAuthorizer.
+			On("HasOwnership", mock.Anything, fakedOwnership).
+			Return(domain.OrganizerRole, nil)
+
+		mockedRepo.
+			On("GetContest", mock.Anything, nil, fakedContestID).
+			Return(domain.Contest{ID: fakedContestID, Ownership: fakedOwnership}, nil)
+
+		expected := fakedRound
+		expected.TimeBegin = timeBegin
+		expected.TimeEnd = timeEnd
+
+		mockedRepo.
+			On("StoreRound", mock.Anything, nil, expected).
+			Return(expected, nil)
+
+		ucase := usecases.RoundUseCase{
+			Repo:        mockedRepo,
+			Authorizer:  mockedAuthorizer,
+			EventBroker: mockedEventBroker,
+		}
+
+		round, err := ucase.PatchRound(context.Background(), fakedRoundID, domain.RoundPatch{
+			TimeBegin: domain.NewPatch(timeBegin),
+			TimeEnd:   domain.NewPatch(timeEnd),
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, expected, round)
+
+		mockedRepo.AssertExpectations(t)
+		mockedAuthorizer.AssertExpectations(t)
+		mockedEventBroker.AssertExpectations(t)
+	})
+
+	t.Run("ArchivedContest", func(t *testing.T) {
+		mockedRepo := new(repositoryMock)
+		mockedAuthorizer := new(authorizerMock)
+
+		mockedRepo.
+			On("GetRound", mock.Anything, nil, fakedRoundID).
+			Return(fakedRound, nil)
+
+		mockedAuthorizer.
+			On("HasOwnership", mock.Anything, fakedOwnership).
+			Return(domain.OrganizerRole, nil)
+
+		mockedRepo.
+			On("GetContest", mock.Anything, nil, fakedContestID).
+			Return(domain.Contest{ID: fakedContestID, Ownership: fakedOwnership, ArchivedAt: time.Now()}, nil)
+
+		ucase := usecases.RoundUseCase{
+			Repo:       mockedRepo,
+			Authorizer: mockedAuthorizer,
+		}
+
+		_, err := ucase.PatchRound(context.Background(), fakedRoundID, domain.RoundPatch{
+			QualifyingProblems: domain.NewPatch(3),
+		})
+
+		assert.ErrorIs(t, err, domain.ErrArchived)
+
+		mockedRepo.AssertExpectations(t)
+		mockedAuthorizer.AssertExpectations(t)
+	})
+}
+
+func TestDeleteRound(t *testing.T) {
+	fakedOwnership := domain.OwnershipData{
+		OrganizerID: testutils.RandomResourceID[domain.OrganizerID](),
+	}
+	fakedContestID := testutils.RandomResourceID[domain.ContestID]()
+	fakedRoundID := testutils.RandomResourceID[domain.RoundID]()
+
+	makeMocks := func() (*repositoryMock, *authorizerMock) {
+		mockedRepo := new(repositoryMock)
+		mockedAuthorizer := new(authorizerMock)
+
+		mockedRepo.
+			On("GetRound", mock.Anything, nil, fakedRoundID).
+			Return(domain.Round{
+				ID:        fakedRoundID,
+				Ownership: fakedOwnership,
+				ContestID: fakedContestID,
+			}, nil)
+
+		mockedAuthorizer.
+			On("HasOwnership", mock.Anything, fakedOwnership).
+			Return(domain.OrganizerRole, nil)
+
+		return mockedRepo, mockedAuthorizer
+	}
+
+	t.Run("HappyCase", func(t *testing.T) {
+		mockedRepo, mockedAuthorizer := makeMocks()
+
+		mockedRepo.
+			On("GetProblemsByContest", mock.Anything, nil, fakedContestID).
+			Return([]domain.Problem{{ContestID: fakedContestID}}, nil)
+
+		mockedRepo.
+			On("DeleteRound", mock.Anything, nil, fakedRoundID).
+			Return(nil)
+
+		ucase := usecases.RoundUseCase{
+			Repo:       mockedRepo,
+			Authorizer: mockedAuthorizer,
+		}
+
+		err := ucase.DeleteRound(context.Background(), fakedRoundID)
+
+		require.NoError(t, err)
+
+		mockedRepo.AssertExpectations(t)
+		mockedAuthorizer.AssertExpectations(t)
+	})
+
+	t.Run("RoundHasProblems", func(t *testing.T) {
+		mockedRepo, mockedAuthorizer := makeMocks()
+
+		mockedRepo.
+			On("GetProblemsByContest", mock.Anything, nil, fakedContestID).
+			Return([]domain.Problem{{ContestID: fakedContestID, RoundID: fakedRoundID}}, nil)
+
+		ucase := usecases.RoundUseCase{
+			Repo:       mockedRepo,
+			Authorizer: mockedAuthorizer,
+		}
+
+		err := ucase.DeleteRound(context.Background(), fakedRoundID)
+
+		require.ErrorIs(t, err, domain.ErrNotAllowed)
+
+		mockedRepo.AssertExpectations(t)
+		mockedAuthorizer.AssertExpectations(t)
+	})
+}
+
+func TestGenerateStartList(t *testing.T) {
+	fakedOwnership := domain.OwnershipData{
+		OrganizerID: testutils.RandomResourceID[domain.OrganizerID](),
+	}
+	fakedContestID := testutils.RandomResourceID[domain.ContestID]()
+	fakedSemiFinalID := testutils.RandomResourceID[domain.RoundID]()
+	fakedFinalID := testutils.RandomResourceID[domain.RoundID]()
+	fakedCompClassID := testutils.RandomResourceID[domain.CompClassID]()
+
+	fakedSemiFinal := domain.Round{
+		ID:        fakedSemiFinalID,
+		Ownership: fakedOwnership,
+		ContestID: fakedContestID,
+		Number:    1,
+	}
+
+	fakedFinal := domain.Round{
+		ID:        fakedFinalID,
+		Ownership: fakedOwnership,
+		ContestID: fakedContestID,
+		Number:    2,
+	}
+
+	t.Run("FromQualification", func(t *testing.T) {
+		mockedRepo := new(repositoryMock)
+		mockedAuthorizer := new(authorizerMock)
+		mockedScoreKeeper := new(scoreKeeperMock)
+		mockedTx := new(transactionMock)
+
+		mockedRepo.
+			On("GetRound", mock.Anything, nil, fakedSemiFinalID).
+			Return(fakedSemiFinal, nil)
+
+		mockedAuthorizer.
+			On("HasOwnership", mock.Anything, fakedOwnership).
+			Return(domain.OrganizerRole, nil)
+
+		mockedRepo.
+			On("GetStartList", mock.Anything, nil, fakedSemiFinalID).
+			Return([]domain.StartListEntry{}, nil)
+
+		mockedRepo.
+			On("GetRoundsByContest", mock.Anything, nil, fakedContestID).
+			Return([]domain.Round{fakedSemiFinal, fakedFinal}, nil)
+
+		mockedRepo.
+			On("GetContendersByContest", mock.Anything, nil, fakedContestID).
+			Return([]domain.Contender{
+				{ID: 1, CompClassID: fakedCompClassID},
+				{ID: 2, CompClassID: fakedCompClassID, Score: &domain.Score{ContenderID: 2, Placement: 1, Finalist: true}},
+				{ID: 3, CompClassID: fakedCompClassID},
+				{ID: 4},
+			}, nil)
+
+		mockedScoreKeeper.
+			On("GetScore", domain.ContenderID(1)).
+			Return(domain.Score{ContenderID: 1, Placement: 2, Finalist: true}, nil)
+
+		mockedScoreKeeper.
+			On("GetScore", domain.ContenderID(2)).
+			Return(domain.Score{}, domain.ErrNotFound)
+
+		mockedScoreKeeper.
+			On("GetScore", domain.ContenderID(3)).
+			Return(domain.Score{ContenderID: 3, Placement: 3, Finalist: false}, nil)
+
+		mockedRepo.On("Begin").Return(mockedTx, nil)
+
+		mockedRepo.
+			On("DeleteStartList", mock.Anything, mockedTx, fakedSemiFinalID).
+			Return(nil)
+
+		expected := []domain.StartListEntry{
+			{RoundID: fakedSemiFinalID, ContenderID: 1, PreviousPlacement: 2},
+			{RoundID: fakedSemiFinalID, ContenderID: 2, PreviousPlacement: 1},
+		}
+
+		for _, entry := range expected {
+			mockedRepo.
+				On("StoreStartListEntry", mock.Anything, mockedTx, entry).
+				Return(nil)
+		}
+
+		mockedTx.On("Commit").Return(nil)
+		mockedTx.On("Rollback").Return()
+
+		ucase := usecases.RoundUseCase{
+			Repo:        mockedRepo,
+			Authorizer:  mockedAuthorizer,
+			ScoreKeeper: mockedScoreKeeper,
+		}
+
+		startList, err := ucase.GenerateStartList(context.Background(), fakedSemiFinalID)
+
+		require.NoError(t, err)
+		assert.Equal(t, expected, startList)
+
+		mockedRepo.AssertExpectations(t)
+		mockedAuthorizer.AssertExpectations(t)
+		mockedScoreKeeper.AssertExpectations(t)
+		mockedTx.AssertExpectations(t)
+	})
+
+	t.Run("FromPreviousRound", func(t *testing.T) {
+		mockedRepo := new(repositoryMock)
+		mockedAuthorizer := new(authorizerMock)
+		mockedScoreKeeper := new(scoreKeeperMock)
+		mockedTx := new(transactionMock)
+
+		mockedRepo.
+			On("GetRound", mock.Anything, nil, fakedFinalID).
+			Return(fakedFinal, nil)
+
+		mockedAuthorizer.
+			On("HasOwnership", mock.Anything, fakedOwnership).
+			Return(domain.OrganizerRole, nil)
+
+		mockedRepo.
+			On("GetStartList", mock.Anything, nil, fakedFinalID).
+			Return([]domain.StartListEntry{
+				{RoundID: fakedFinalID, ContenderID: 3, PreviousPlacement: 1},
+			}, nil)
+
+		mockedRepo.
+			On("GetRoundsByContest", mock.Anything, nil, fakedContestID).
+			Return([]domain.Round{fakedSemiFinal, fakedFinal}, nil)
+
+		mockedRepo.
+			On("GetStartList", mock.Anything, nil, fakedSemiFinalID).
+			Return([]domain.StartListEntry{
+				{RoundID: fakedSemiFinalID, ContenderID: 1, PreviousPlacement: 2},
+				{RoundID: fakedSemiFinalID, ContenderID: 2, PreviousPlacement: 1},
+			}, nil)
+
+		mockedScoreKeeper.
+			On("GetRoundScore", fakedSemiFinalID, domain.ContenderID(1)).
+			Return(domain.Score{ContenderID: 1, Placement: 1, Finalist: true}, nil)
+
+		mockedScoreKeeper.
+			On("GetRoundScore", fakedSemiFinalID, domain.ContenderID(2)).
+			Return(domain.Score{ContenderID: 2, Placement: 2, Finalist: false}, nil)
+
+		mockedRepo.On("Begin").Return(mockedTx, nil)
+
+		mockedRepo.
+			On("DeleteStartList", mock.Anything, mockedTx, fakedFinalID).
+			Return(nil)
+
+		expected := domain.StartListEntry{RoundID: fakedFinalID, ContenderID: 1, PreviousPlacement: 1}
+
+		mockedRepo.
+			On("StoreStartListEntry", mock.Anything, mockedTx, expected).
+			Return(nil)
+
+		mockedTx.On("Commit").Return(nil)
+		mockedTx.On("Rollback").Return()
+
+		ucase := usecases.RoundUseCase{
+			Repo:        mockedRepo,
+			Authorizer:  mockedAuthorizer,
+			ScoreKeeper: mockedScoreKeeper,
+		}
+
+		startList, err := ucase.GenerateStartList(context.Background(), fakedFinalID)
+
+		require.NoError(t, err)
+		assert.Equal(t, []domain.StartListEntry{expected}, startList)
+
+		mockedRepo.AssertExpectations(t)
+		mockedAuthorizer.AssertExpectations(t)
+		mockedScoreKeeper.AssertExpectations(t)
+		mockedTx.AssertExpectations(t)
+	})
+	t.Run("RoundStarted", func(t *testing.T) {
+		mockedRepo := new(repositoryMock)
+		mockedAuthorizer := new(authorizerMock)
+
+		startedFinal := fakedFinal
+		startedFinal.TimeBegin = time.Now().Add(-time.Minute)
+
+		mockedRepo.
+			On("GetRound", mock.Anything, nil, fakedFinalID).
+			Return(startedFinal, nil)
+
+		mockedAuthorizer.
+			On("HasOwnership", mock.Anything, fakedOwnership).
+			Return(domain.OrganizerRole, nil)
+
+		ucase := usecases.RoundUseCase{
+			Repo:       mockedRepo,
+			Authorizer: mockedAuthorizer,
+		}
+
+		_, err := ucase.GenerateStartList(context.Background(), fakedFinalID)
+
+		assert.ErrorIs(t, err, domain.ErrNotAllowed)
+
+		mockedRepo.AssertExpectations(t)
+		mockedAuthorizer.AssertExpectations(t)
+	})
+
+	t.Run("RoundHasScores", func(t *testing.T) {
+		mockedRepo := new(repositoryMock)
+		mockedAuthorizer := new(authorizerMock)
+
+		mockedRepo.
+			On("GetRound", mock.Anything, nil, fakedFinalID).
+			Return(fakedFinal, nil)
+
+		mockedAuthorizer.
+			On("HasOwnership", mock.Anything, fakedOwnership).
+			Return(domain.OrganizerRole, nil)
+
+		mockedRepo.
+			On("GetStartList", mock.Anything, nil, fakedFinalID).
+			Return([]domain.StartListEntry{
+				{RoundID: fakedFinalID, ContenderID: 1, PreviousPlacement: 1, Score: &domain.Score{ContenderID: 1, Score: 100}},
+			}, nil)
+
+		ucase := usecases.RoundUseCase{
+			Repo:       mockedRepo,
+			Authorizer: mockedAuthorizer,
+		}
+
+		_, err := ucase.GenerateStartList(context.Background(), fakedFinalID)
+
+		assert.ErrorIs(t, err, domain.ErrNotAllowed)
+
+		mockedRepo.AssertExpectations(t)
+		mockedAuthorizer.AssertExpectations(t)
+	})
+}
+
+func TestGetRoundScoreboard(t *testing.T) {
+	fakedContestID := testutils.RandomResourceID[domain.ContestID]()
+	fakedRoundID := testutils.RandomResourceID[domain.RoundID]()
+
+	mockedRepo := new(repositoryMock)
+	mockedScoreKeeper := new(scoreKeeperMock)
+
+	mockedRepo.
+		On("GetRound", mock.Anything, nil, fakedRoundID).
+		Return(domain.Round{ID: fakedRoundID, ContestID: fakedContestID}, nil)
+
+	mockedRepo.
+		On("GetStartList", mock.Anything, nil, fakedRoundID).
+		Return([]domain.StartListEntry{
+			{RoundID: fakedRoundID, ContenderID: 1},
+			{RoundID: fakedRoundID, ContenderID: 2},
+			{RoundID: fakedRoundID, ContenderID: 3, Score: &domain.Score{ContenderID: 3, RankOrder: 1}},
+		}, nil)
+
+	mockedRepo.
+		On("GetContendersByContest", mock.Anything, nil, fakedContestID).
+		Return([]domain.Contender{{ID: 1}, {ID: 2}, {ID: 3}, {ID: 4}}, nil)
+
+	mockedScoreKeeper.
+		On("GetRoundScore", fakedRoundID, domain.ContenderID(1)).
+		Return(domain.Score{}, domain.ErrNotFound)
+
+	mockedScoreKeeper.
+		On("GetRoundScore", fakedRoundID, domain.ContenderID(2)).
+		Return(domain.Score{ContenderID: 2, RankOrder: 0}, nil)
+
+	mockedScoreKeeper.
+		On("GetRoundScore", fakedRoundID, domain.ContenderID(3)).
+		Return(domain.Score{}, domain.ErrNotFound)
+
+	ucase := usecases.RoundUseCase{
+		Repo:        mockedRepo,
+		ScoreKeeper: mockedScoreKeeper,
+	}
+
+	scoreboard, err := ucase.GetScoreboard(context.Background(), fakedRoundID)
+
+	require.NoError(t, err)
+	require.Len(t, scoreboard, 3)
+	assert.Equal(t, domain.ContenderID(2), scoreboard[0].ContenderID)
+	assert.Equal(t, domain.ContenderID(3), scoreboard[1].ContenderID)
+	assert.Equal(t, domain.ContenderID(1), scoreboard[2].ContenderID)
+
+	mockedRepo.AssertExpectations(t)
+	mockedScoreKeeper.AssertExpectations(t)
+}
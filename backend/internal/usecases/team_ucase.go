@@ -0,0 +1,161 @@
+package usecases
+
+import (
+	"context"
+	"strings"
+
+	"github.com/climblive/platform/backend/internal/domain"
+	"github.com/climblive/platform/backend/internal/usecases/validators"
+	"github.com/go-errors/errors"
+)
+
+const maxTeamsPerContest = 200
+
+type teamUseCaseRepository interface {
+	domain.Transactor
+
+	GetContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) (domain.Contest, error)
+	GetTeam(ctx context.Context, tx domain.Transaction, teamID domain.TeamID) (domain.Team, error)
+	GetTeamsByContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) ([]domain.Team, error)
+	StoreTeam(ctx context.Context, tx domain.Transaction, team domain.Team) (domain.Team, error)
+	DeleteTeam(ctx context.Context, tx domain.Transaction, teamID domain.TeamID) error
+	GetContendersByTeam(ctx context.Context, tx domain.Transaction, teamID domain.TeamID) ([]domain.Contender, error)
+}
+
+type TeamUseCase struct {
+	Authorizer  domain.Authorizer
+	Repo        teamUseCaseRepository
+	EventBroker domain.EventBroker
+}
+
+func (uc *TeamUseCase) GetTeam(ctx context.Context, teamID domain.TeamID) (domain.Team, error) {
+	team, err := uc.Repo.GetTeam(ctx, nil, teamID)
+	if err != nil {
+		return domain.Team{}, errors.Wrap(err, 0)
+	}
+
+	return team, nil
+}
+
+func (uc *TeamUseCase) GetTeamsByContest(ctx context.Context, contestID domain.ContestID) ([]domain.Team, error) {
+	teams, err := uc.Repo.GetTeamsByContest(ctx, nil, contestID)
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	return teams, nil
+}
+
+func (uc *TeamUseCase) CreateTeam(ctx context.Context, contestID domain.ContestID, tmpl domain.TeamTemplate) (domain.Team, error) {
+	contest, err := uc.Repo.GetContest(ctx, nil, contestID)
+	if err != nil {
+		return domain.Team{}, errors.Wrap(err, 0)
+	}
+
+	if _, err := uc.Authorizer.HasOwnership(ctx, contest.Ownership); err != nil {
+		return domain.Team{}, errors.Wrap(err, 0)
+	}
+
+	if !contest.ArchivedAt.IsZero() {
+		return domain.Team{}, errors.Wrap(domain.ErrArchived, 0)
+	}
+
+	teams, err := uc.Repo.GetTeamsByContest(ctx, nil, contestID)
+	if err != nil {
+		return domain.Team{}, errors.Wrap(err, 0)
+	}
+
+	if len(teams) >= maxTeamsPerContest {
+		return domain.Team{}, errors.New(domain.ErrLimitExceeded)
+	}
+
+	team := domain.Team{
+		ID:             0,
+		Ownership:      contest.Ownership,
+		ContestID:      contestID,
+		Name:           strings.TrimSpace(tmpl.Name),
+		CountedMembers: tmpl.CountedMembers,
+	}
+
+	if err := (validators.TeamValidator{}).Validate(team); err != nil {
+		return domain.Team{}, errors.Wrap(err, 0)
+	}
+
+	createdTeam, err := uc.Repo.StoreTeam(ctx, nil, team)
+	if err != nil {
+		return domain.Team{}, errors.Wrap(err, 0)
+	}
+
+	uc.EventBroker.Dispatch(ctx, contestID, domain.TeamAddedEvent{
+		TeamID:         createdTeam.ID,
+		Name:           createdTeam.Name,
+		CountedMembers: createdTeam.CountedMembers,
+	})
+
+	return createdTeam, nil
+}
+
+func (uc *TeamUseCase) PatchTeam(ctx context.Context, teamID domain.TeamID, patch domain.TeamPatch) (domain.Team, error) {
+	team, err := uc.Repo.GetTeam(ctx, nil, teamID)
+	if err != nil {
+		return domain.Team{}, errors.Wrap(err, 0)
+	}
+
+	if _, err := uc.Authorizer.HasOwnership(ctx, team.Ownership); err != nil {
+		return domain.Team{}, errors.Wrap(err, 0)
+	}
+
+	if patch.Name.Present {
+		team.Name = strings.TrimSpace(patch.Name.Value)
+	}
+
+	if patch.CountedMembers.Present {
+		team.CountedMembers = patch.CountedMembers.Value
+	}
+
+	if err := (validators.TeamValidator{}).Validate(team); err != nil {
+		return domain.Team{}, errors.Wrap(err, 0)
+	}
+
+	if _, err := uc.Repo.StoreTeam(ctx, nil, team); err != nil {
+		return domain.Team{}, errors.Wrap(err, 0)
+	}
+
+	uc.EventBroker.Dispatch(ctx, team.ContestID, domain.TeamUpdatedEvent{
+		TeamID:         team.ID,
+		Name:           team.Name,
+		CountedMembers: team.CountedMembers,
+	})
+
+	return team, nil
+}
+
+func (uc *TeamUseCase) DeleteTeam(ctx context.Context, teamID domain.TeamID) error {
+	team, err := uc.Repo.GetTeam(ctx, nil, teamID)
+	if err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	if _, err := uc.Authorizer.HasOwnership(ctx, team.Ownership); err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	members, err := uc.Repo.GetContendersByTeam(ctx, nil, teamID)
+	if err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	if len(members) > 0 {
+		return errors.Wrap(domain.ErrNotAllowed, 0)
+	}
+
+	if err := uc.Repo.DeleteTeam(ctx, nil, teamID); err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	uc.EventBroker.Dispatch(ctx, team.ContestID, domain.TeamDeletedEvent{
+		TeamID: teamID,
+	})
+
+	return nil
+}
@@ -0,0 +1,315 @@
+package usecases_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/climblive/platform/backend/internal/domain"
+	"github.com/climblive/platform/backend/internal/testutils"
+	"github.com/climblive/platform/backend/internal/usecases"
+	"github.com/climblive/platform/backend/internal/usecases/validators"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateTeam(t *testing.T) {
+	fakedOwnership := domain.OwnershipData{
+		OrganizerID: testutils.RandomResourceID[domain.OrganizerID](),
+	}
+	fakedContestID := testutils.RandomResourceID[domain.ContestID]()
+	fakedTeamID := testutils.RandomResourceID[domain.TeamID]()
+
+	fakedTemplate := domain.TeamTemplate{
+		Name:           " Boulder Buddies ",
+		CountedMembers: 3,
+	}
+
+	makeMocks := func() (*repositoryMock, *authorizerMock, *eventBrokerMock) {
+		mockedRepo := new(repositoryMock)
+		mockedAuthorizer := new(authorizerMock)
+		mockedEventBroker := new(eventBrokerMock)
+
+		mockedRepo.
+			On("GetContest", mock.Anything, nil, fakedContestID).
+			Return(domain.Contest{
+				ID:        fakedContestID,
+				Ownership: fakedOwnership,
+			}, nil)
+
+		return mockedRepo, mockedAuthorizer, mockedEventBroker
+	}
+
+	t.Run("HappyCase", func(t *testing.T) {
+		mockedRepo, mockedAuthorizer, mockedEventBroker := makeMocks()
+
+		mockedAuthorizer.
+			On("HasOwnership", mock.Anything, fakedOwnership).
+			Return(domain.OrganizerRole, nil)
+
+		mockedRepo.
+			On("GetTeamsByContest", mock.Anything, nil, fakedContestID).
+			Return([]domain.Team{}, nil)
+
+		mockedRepo.
+			On("StoreTeam", mock.Anything, nil, domain.Team{
+				Ownership:      fakedOwnership,
+				ContestID:      fakedContestID,
+				Name:           "Boulder Buddies",
+				CountedMembers: 3,
+			}).
+			Return(domain.Team{
+				ID:             fakedTeamID,
+				Ownership:      fakedOwnership,
+				ContestID:      fakedContestID,
+				Name:           "Boulder Buddies",
+				CountedMembers: 3,
+			}, nil)
+
+		mockedEventBroker.
+			On("Dispatch", mock.Anything, fakedContestID, domain.TeamAddedEvent{
+				TeamID:         fakedTeamID,
+				Name:           "Boulder Buddies",
+				CountedMembers: 3,
+			}).
+			Return()
+
+		ucase := usecases.TeamUseCase{
+			Repo:        mockedRepo,
+			Authorizer:  mockedAuthorizer,
+			EventBroker: mockedEventBroker,
+		}
+
+		team, err := ucase.CreateTeam(context.Background(), fakedContestID, fakedTemplate)
+
+		require.NoError(t, err)
+		assert.Equal(t, fakedTeamID, team.ID)
+		assert.Equal(t, "Boulder Buddies", team.Name)
+
+		mockedRepo.AssertExpectations(t)
+		mockedAuthorizer.AssertExpectations(t)
+		mockedEventBroker.AssertExpectations(t)
+	})
+
+	t.Run("BadCredentials", func(t *testing.T) {
+		mockedRepo, mockedAuthorizer, mockedEventBroker := makeMocks()
+
+		mockedAuthorizer.
+			On("HasOwnership", mock.Anything, fakedOwnership).
+			Return(domain.NilRole, domain.ErrNoOwnership)
+
+		ucase := usecases.TeamUseCase{
+			Repo:        mockedRepo,
+			Authorizer:  mockedAuthorizer,
+			EventBroker: mockedEventBroker,
+		}
+
+		_, err := ucase.CreateTeam(context.Background(), fakedContestID, fakedTemplate)
+
+		require.ErrorIs(t, err, domain.ErrNoOwnership)
+
+		mockedRepo.AssertExpectations(t)
+		mockedAuthorizer.AssertExpectations(t)
+		mockedEventBroker.AssertExpectations(t)
+	})
+
+	t.Run("InvalidData", func(t *testing.T) {
+		mockedRepo, mockedAuthorizer, mockedEventBroker := makeMocks()
+
+		mockedAuthorizer.
+			On("HasOwnership", mock.Anything, fakedOwnership).
+			Return(domain.OrganizerRole, nil)
+
+		mockedRepo.
+			On("GetTeamsByContest", mock.Anything, nil, fakedContestID).
+			Return([]domain.Team{}, nil)
+
+		ucase := usecases.TeamUseCase{
+			Repo:        mockedRepo,
+			Authorizer:  mockedAuthorizer,
+			EventBroker: mockedEventBroker,
+		}
+
+		_, err := ucase.CreateTeam(context.Background(), fakedContestID, domain.TeamTemplate{
+			Name:           "Boulder Buddies",
+			CountedMembers: 0,
+		})
+
+		require.ErrorIs(t, err, domain.ErrInvalidData)
+		assert.True(t, validators.TeamValidator{}.IsValidationError(err))
+
+		mockedRepo.AssertExpectations(t)
+		mockedAuthorizer.AssertExpectations(t)
+		mockedEventBroker.AssertExpectations(t)
+	})
+
+	t.Run("LimitExceeded", func(t *testing.T) {
+		mockedRepo, mockedAuthorizer, mockedEventBroker := makeMocks()
+
+		mockedAuthorizer.
+			On("HasOwnership", mock.Anything, fakedOwnership).
+			Return(domain.OrganizerRole, nil)
+
+		mockedRepo.
+			On("GetTeamsByContest", mock.Anything, nil, fakedContestID).
+			Return(make([]domain.Team, 200), nil)
+
+		ucase := usecases.TeamUseCase{
+			Repo:        mockedRepo,
+			Authorizer:  mockedAuthorizer,
+			EventBroker: mockedEventBroker,
+		}
+
+		_, err := ucase.CreateTeam(context.Background(), fakedContestID, fakedTemplate)
+
+		require.ErrorIs(t, err, domain.ErrLimitExceeded)
+
+		mockedRepo.AssertExpectations(t)
+		mockedAuthorizer.AssertExpectations(t)
+		mockedEventBroker.AssertExpectations(t)
+	})
+}
+
+func TestPatchTeam(t *testing.T) {
+	fakedOwnership := domain.OwnershipData{
+		OrganizerID: testutils.RandomResourceID[domain.OrganizerID](),
+	}
+	fakedContestID := testutils.RandomResourceID[domain.ContestID]()
+	fakedTeamID := testutils.RandomResourceID[domain.TeamID]()
+
+	fakedTeam := domain.Team{
+		ID:             fakedTeamID,
+		Ownership:      fakedOwnership,
+		ContestID:      fakedContestID,
+		Name:           "Boulder Buddies",
+		CountedMembers: 3,
+	}
+
+	t.Run("HappyCase", func(t *testing.T) {
+		mockedRepo := new(repositoryMock)
+		mockedAuthorizer := new(authorizerMock)
+		mockedEventBroker := new(eventBrokerMock)
+
+		mockedRepo.
+			On("GetTeam", mock.Anything, nil, fakedTeamID).
+			Return(fakedTeam, nil)
+
+		mockedAuthorizer.
+			On("HasOwnership", mock.Anything, fakedOwnership).
+			Return(domain.OrganizerRole, nil)
+
+		updatedTeam := fakedTeam
+		updatedTeam.Name = "Crimp Crew"
+		updatedTeam.CountedMembers = 2
+
+		mockedRepo.
+			On("StoreTeam", mock.Anything, nil, updatedTeam).
+			Return(updatedTeam, nil)
+
+		mockedEventBroker.
+			On("Dispatch", mock.Anything, fakedContestID, domain.TeamUpdatedEvent{
+				TeamID:         fakedTeamID,
+				Name:           "Crimp Crew",
+				CountedMembers: 2,
+			}).
+			Return()
+
+		ucase := usecases.TeamUseCase{
+			Repo:        mockedRepo,
+			Authorizer:  mockedAuthorizer,
+			EventBroker: mockedEventBroker,
+		}
+
+		team, err := ucase.PatchTeam(context.Background(), fakedTeamID, domain.TeamPatch{
+			Name:           domain.NewPatch("Crimp Crew"),
+			CountedMembers: domain.NewPatch(2),
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, updatedTeam, team)
+
+		mockedRepo.AssertExpectations(t)
+		mockedAuthorizer.AssertExpectations(t)
+		mockedEventBroker.AssertExpectations(t)
+	})
+}
+
+func TestDeleteTeam(t *testing.T) {
+	fakedOwnership := domain.OwnershipData{
+		OrganizerID: testutils.RandomResourceID[domain.OrganizerID](),
+	}
+	fakedContestID := testutils.RandomResourceID[domain.ContestID]()
+	fakedTeamID := testutils.RandomResourceID[domain.TeamID]()
+
+	makeMocks := func() (*repositoryMock, *authorizerMock, *eventBrokerMock) {
+		mockedRepo := new(repositoryMock)
+		mockedAuthorizer := new(authorizerMock)
+		mockedEventBroker := new(eventBrokerMock)
+
+		mockedRepo.
+			On("GetTeam", mock.Anything, nil, fakedTeamID).
+			Return(domain.Team{
+				ID:        fakedTeamID,
+				Ownership: fakedOwnership,
+				ContestID: fakedContestID,
+			}, nil)
+
+		mockedAuthorizer.
+			On("HasOwnership", mock.Anything, fakedOwnership).
+			Return(domain.OrganizerRole, nil)
+
+		return mockedRepo, mockedAuthorizer, mockedEventBroker
+	}
+
+	t.Run("HappyCase", func(t *testing.T) {
+		mockedRepo, mockedAuthorizer, mockedEventBroker := makeMocks()
+
+		mockedRepo.
+			On("GetContendersByTeam", mock.Anything, nil, fakedTeamID).
+			Return([]domain.Contender{}, nil)
+
+		mockedRepo.
+			On("DeleteTeam", mock.Anything, nil, fakedTeamID).
+			Return(nil)
+
+		mockedEventBroker.
+			On("Dispatch", mock.Anything, fakedContestID, domain.TeamDeletedEvent{TeamID: fakedTeamID}).
+			Return()
+
+		ucase := usecases.TeamUseCase{
+			Repo:        mockedRepo,
+			Authorizer:  mockedAuthorizer,
+			EventBroker: mockedEventBroker,
+		}
+
+		err := ucase.DeleteTeam(context.Background(), fakedTeamID)
+
+		require.NoError(t, err)
+
+		mockedRepo.AssertExpectations(t)
+		mockedAuthorizer.AssertExpectations(t)
+		mockedEventBroker.AssertExpectations(t)
+	})
+
+	t.Run("HasMembers", func(t *testing.T) {
+		mockedRepo, mockedAuthorizer, mockedEventBroker := makeMocks()
+
+		mockedRepo.
+			On("GetContendersByTeam", mock.Anything, nil, fakedTeamID).
+			Return([]domain.Contender{{TeamID: fakedTeamID}}, nil)
+
+		ucase := usecases.TeamUseCase{
+			Repo:        mockedRepo,
+			Authorizer:  mockedAuthorizer,
+			EventBroker: mockedEventBroker,
+		}
+
+		err := ucase.DeleteTeam(context.Background(), fakedTeamID)
+
+		require.ErrorIs(t, err, domain.ErrNotAllowed)
+
+		mockedRepo.AssertExpectations(t)
+		mockedAuthorizer.AssertExpectations(t)
+		mockedEventBroker.AssertExpectations(t)
+	})
+}
@@ -2,19 +2,28 @@ package usecases
 
 import (
 	"context"
+	"slices"
 	"time"
 
 	"github.com/climblive/platform/backend/internal/domain"
+	"github.com/climblive/platform/backend/internal/tracing"
 	"github.com/climblive/platform/backend/internal/usecases/validators"
 	"github.com/go-errors/errors"
 )
 
+const (
+	maxTickOperationsPerBatch = 100
+	tickSyncTolerance         = 15 * time.Minute
+)
+
+var errDuplicateTickOperation = errors.New("duplicate tick operation")
+
 type tickUseCaseRepository interface {
 	domain.Transactor
 
 	GetContender(ctx context.Context, tx domain.Transaction, contenderID domain.ContenderID) (domain.Contender, error)
 	GetTicksByContender(ctx context.Context, tx domain.Transaction, contenderID domain.ContenderID) ([]domain.Tick, error)
-	GetTicksByContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) ([]domain.Tick, error)
+	GetTicksByContestFiltered(ctx context.Context, tx domain.Transaction, contestID domain.ContestID, filter domain.TickFilter, page domain.PageRequest) ([]domain.Tick, error)
 	GetContest(ctx context.Context, tx domain.Transaction, contestID domain.ContestID) (domain.Contest, error)
 	GetCompClass(ctx context.Context, tx domain.Transaction, compClassID domain.CompClassID) (domain.CompClass, error)
 	GetProblem(ctx context.Context, tx domain.Transaction, problemID domain.ProblemID) (domain.Problem, error)
@@ -22,6 +31,12 @@ type tickUseCaseRepository interface {
 	StoreTick(ctx context.Context, tx domain.Transaction, tick domain.Tick) (domain.Tick, error)
 	GetTick(ctx context.Context, tx domain.Transaction, tickID domain.TickID) (domain.Tick, error)
 	GetTickByContenderAndProblem(ctx context.Context, tx domain.Transaction, contenderID domain.ContenderID, problemID domain.ProblemID) (domain.Tick, error)
+	GetStartListEntry(ctx context.Context, tx domain.Transaction, roundID domain.RoundID, contenderID domain.ContenderID) (domain.StartListEntry, error)
+	GetTickRevisionsByContender(ctx context.Context, tx domain.Transaction, contenderID domain.ContenderID) ([]domain.TickRevision, error)
+	GetTickRevisionsByContenderAndProblem(ctx context.Context, tx domain.Transaction, contenderID domain.ContenderID, problemID domain.ProblemID) ([]domain.TickRevision, error)
+	GetContenderVersionForUpdate(ctx context.Context, tx domain.Transaction, contenderID domain.ContenderID) (int, error)
+	GetTickRevisionByIdempotencyKey(ctx context.Context, tx domain.Transaction, contenderID domain.ContenderID, idempotencyKey string) (domain.TickRevision, error)
+	StoreTickRevision(ctx context.Context, tx domain.Transaction, revision domain.TickRevision) (domain.TickRevision, error)
 }
 
 type TickUseCase struct {
@@ -48,25 +63,30 @@ func (uc *TickUseCase) GetTicksByContender(ctx context.Context, contenderID doma
 	return ticks, nil
 }
 
-func (uc *TickUseCase) GetTicksByContest(ctx context.Context, contestID domain.ContestID) ([]domain.Tick, error) {
+func (uc *TickUseCase) GetTicksByContest(ctx context.Context, contestID domain.ContestID, filter domain.TickFilter, page domain.PageRequest) (domain.Page[domain.Tick], error) {
 	contest, err := uc.Repo.GetContest(ctx, nil, contestID)
 	if err != nil {
-		return nil, errors.Wrap(err, 0)
+		return domain.Page[domain.Tick]{}, errors.Wrap(err, 0)
 	}
 
 	if _, err := uc.Authorizer.HasOwnership(ctx, contest.Ownership); err != nil {
-		return nil, errors.Wrap(err, 0)
+		return domain.Page[domain.Tick]{}, errors.Wrap(err, 0)
 	}
 
-	ticks, err := uc.Repo.GetTicksByContest(ctx, nil, contestID)
+	ticks, err := uc.Repo.GetTicksByContestFiltered(ctx, nil, contestID, filter, lookAhead(page))
 	if err != nil {
-		return nil, errors.Wrap(err, 0)
+		return domain.Page[domain.Tick]{}, errors.Wrap(err, 0)
 	}
 
-	return ticks, nil
+	return toPage(ticks, page, func(tick domain.Tick) domain.ResourceID {
+		return domain.ResourceID(tick.ID)
+	}), nil
 }
 
 func (uc *TickUseCase) DeleteTick(ctx context.Context, tickID domain.TickID) error {
+	ctx, span := tracing.Start(ctx, "TickUseCase.DeleteTick")
+	defer span.End()
+
 	tick, err := uc.Repo.GetTick(ctx, nil, tickID)
 	if err != nil {
 		return errors.Wrap(err, 0)
@@ -102,21 +122,43 @@ func (uc *TickUseCase) DeleteTick(ctx context.Context, tickID domain.TickID) err
 		return errors.New(domain.ErrContestEnded)
 	}
 
-	err = uc.Repo.DeleteTick(ctx, nil, tickID)
-	if err != nil {
+	tick.Timestamp = time.Now()
+
+	if err := uc.removeTick(ctx, contest.ID, contender.ID, role, tick, ""); err != nil {
 		return errors.Wrap(err, 0)
 	}
 
-	uc.EventBroker.Dispatch(contest.ID, domain.AscentDeregisteredEvent{
-		TickID:      tickID,
-		ContenderID: contender.ID,
-		ProblemID:   tick.ProblemID,
-	})
-
 	return nil
 }
 
 func (uc *TickUseCase) PutTick(ctx context.Context, contenderID domain.ContenderID, tick domain.Tick) (domain.Tick, error) {
+	ctx, span := tracing.Start(ctx, "TickUseCase.PutTick")
+	defer span.End()
+
+	return uc.putTick(ctx, contenderID, tick, nil)
+}
+
+// PutTickWithin stores the tick like PutTick and runs within as part of the
+// same transaction, so that both are committed or rolled back together.
+func (uc *TickUseCase) PutTickWithin(
+	ctx context.Context,
+	contenderID domain.ContenderID,
+	tick domain.Tick,
+	within func(ctx context.Context, tx domain.Transaction) error,
+) (domain.Tick, error) {
+	ctx, span := tracing.Start(ctx, "TickUseCase.PutTickWithin")
+	defer span.End()
+
+	return uc.putTick(ctx, contenderID, tick, within)
+}
+
+func (uc *TickUseCase) putTick(
+	ctx context.Context,
+	contenderID domain.ContenderID,
+	tick domain.Tick,
+	within func(ctx context.Context, tx domain.Transaction) error,
+) (domain.Tick, error) {
+
 	contender, err := uc.Repo.GetContender(ctx, nil, contenderID)
 	if err != nil {
 		return domain.Tick{}, errors.Wrap(err, 0)
@@ -149,6 +191,201 @@ func (uc *TickUseCase) PutTick(ctx context.Context, contenderID domain.Contender
 		return domain.Tick{}, errors.New(domain.ErrContestEnded)
 	}
 
+	tick.Timestamp = time.Now()
+
+	storedTick, err := uc.storeTick(ctx, contest, contender, role, tick, "", within)
+	if err != nil {
+		return domain.Tick{}, errors.Wrap(err, 0)
+	}
+
+	return storedTick, nil
+}
+
+func (uc *TickUseCase) SyncTicks(ctx context.Context, contenderID domain.ContenderID, operations []domain.TickOperation) ([]domain.TickOperationResult, error) {
+	ctx, span := tracing.Start(ctx, "TickUseCase.SyncTicks")
+	defer span.End()
+
+	contender, err := uc.Repo.GetContender(ctx, nil, contenderID)
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	role, err := uc.Authorizer.HasOwnership(ctx, contender.Ownership)
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	if len(operations) > maxTickOperationsPerBatch {
+		return nil, errors.New(domain.ErrLimitExceeded)
+	}
+
+	contest, err := uc.Repo.GetContest(ctx, nil, contender.ContestID)
+	if err != nil {
+		return nil, errors.Errorf("%w: %w", domain.ErrRepositoryIntegrityViolation, err)
+	}
+
+	compClass, err := uc.Repo.GetCompClass(ctx, nil, contender.CompClassID)
+	if err != nil {
+		return nil, errors.Errorf("%w: %w", domain.ErrRepositoryIntegrityViolation, err)
+	}
+
+	results := make([]domain.TickOperationResult, 0, len(operations))
+
+	for _, operation := range operations {
+		result, err := uc.applyTickOperation(ctx, contest, compClass, contender, role, operation)
+		if err != nil {
+			return nil, errors.Wrap(err, 0)
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+func (uc *TickUseCase) applyTickOperation(
+	ctx context.Context,
+	contest domain.Contest,
+	compClass domain.CompClass,
+	contender domain.Contender,
+	role domain.AuthRole,
+	operation domain.TickOperation,
+) (domain.TickOperationResult, error) {
+	result := domain.TickOperationResult{
+		IdempotencyKey: operation.IdempotencyKey,
+		Status:         domain.TickOperationStatusApplied,
+		Tick:           nil,
+		Error:          "",
+	}
+
+	reject := func(err error) (domain.TickOperationResult, error) {
+		if errors.Is(err, errDuplicateTickOperation) {
+			result.Status = domain.TickOperationStatusDuplicate
+			return result, nil
+		}
+
+		if !isTickOperationRejection(err) {
+			return domain.TickOperationResult{}, err
+		}
+
+		result.Status = domain.TickOperationStatusRejected
+		result.Error = err.Error()
+
+		return result, nil
+	}
+
+	if err := (validators.TickOperationValidator{}).Validate(operation); err != nil {
+		return reject(err)
+	}
+
+	_, err := uc.Repo.GetTickRevisionByIdempotencyKey(ctx, nil, contender.ID, operation.IdempotencyKey)
+	switch {
+	case err == nil:
+		result.Status = domain.TickOperationStatusDuplicate
+		return result, nil
+	case errors.Is(err, domain.ErrNotFound):
+	default:
+		return domain.TickOperationResult{}, errors.Wrap(err, 0)
+	}
+
+	now := time.Now()
+
+	if operation.Timestamp.After(now.Add(tickSyncTolerance)) {
+		return reject(errors.New(domain.ErrInvalidData))
+	}
+
+	timestamp := operation.Timestamp
+	if timestamp.After(now) {
+		timestamp = now
+	}
+
+	if timestamp.Before(compClass.TimeBegin) {
+		return reject(errors.New(domain.ErrContestNotStarted))
+	}
+
+	gracePeriodEnd := compClass.TimeEnd.Add(contest.GracePeriod)
+
+	switch {
+	case role.OneOf(domain.OrganizerRole, domain.AdminRole):
+	case timestamp.After(gracePeriodEnd):
+		return reject(errors.New(domain.ErrContestEnded))
+	case now.After(gracePeriodEnd.Add(tickSyncTolerance)):
+		return reject(errors.New(domain.ErrContestEnded))
+	}
+
+	existingTick, err := uc.Repo.GetTickByContenderAndProblem(ctx, nil, contender.ID, operation.ProblemID)
+	switch {
+	case err == nil:
+	case errors.Is(err, domain.ErrNotFound):
+	default:
+		return domain.TickOperationResult{}, errors.Wrap(err, 0)
+	}
+
+	if !role.OneOf(domain.OrganizerRole, domain.AdminRole) {
+		revisions, err := uc.Repo.GetTickRevisionsByContenderAndProblem(ctx, nil, contender.ID, operation.ProblemID)
+		if err != nil {
+			return domain.TickOperationResult{}, errors.Wrap(err, 0)
+		}
+
+		overridden := slices.ContainsFunc(revisions, func(revision domain.TickRevision) bool {
+			return revision.ActorRole.OneOf(domain.OrganizerRole, domain.AdminRole) && revision.RecordedAt.After(timestamp)
+		})
+
+		if overridden {
+			result.Status = domain.TickOperationStatusConflict
+
+			if existingTick.ID != 0 {
+				result.Tick = &existingTick
+			}
+
+			return result, nil
+		}
+	}
+
+	switch operation.Action {
+	case domain.TickRevisionActionPut:
+		tick, err := uc.storeTick(ctx, contest, contender, role, domain.Tick{
+			ID:            0,
+			Ownership:     contender.Ownership,
+			Timestamp:     timestamp,
+			ContestID:     contest.ID,
+			ProblemID:     operation.ProblemID,
+			Zone1:         operation.Zone1,
+			AttemptsZone1: operation.AttemptsZone1,
+			Zone2:         operation.Zone2,
+			AttemptsZone2: operation.AttemptsZone2,
+			Top:           operation.Top,
+			AttemptsTop:   operation.AttemptsTop,
+		}, operation.IdempotencyKey, nil)
+		if err != nil {
+			return reject(err)
+		}
+
+		result.Tick = &tick
+	case domain.TickRevisionActionDelete:
+		if existingTick.ID == 0 {
+			return result, nil
+		}
+
+		existingTick.Timestamp = timestamp
+
+		if err := uc.removeTick(ctx, contest.ID, contender.ID, role, existingTick, operation.IdempotencyKey); err != nil {
+			return reject(err)
+		}
+	}
+
+	return result, nil
+}
+
+func (uc *TickUseCase) storeTick(
+	ctx context.Context,
+	contest domain.Contest,
+	contender domain.Contender,
+	role domain.AuthRole,
+	tick domain.Tick,
+	idempotencyKey string,
+	within func(ctx context.Context, tx domain.Transaction) error,
+) (domain.Tick, error) {
 	problem, err := uc.Repo.GetProblem(ctx, nil, tick.ProblemID)
 	if err != nil {
 		return domain.Tick{}, errors.Wrap(err, 0)
@@ -158,7 +395,21 @@ func (uc *TickUseCase) PutTick(ctx context.Context, contenderID domain.Contender
 		return domain.Tick{}, errors.New(domain.ErrProblemNotInContest)
 	}
 
-	existingTick, err := uc.Repo.GetTickByContenderAndProblem(ctx, nil, contenderID, problem.ID)
+	if !problem.AvailableTo(contender.CompClassID) {
+		return domain.Tick{}, errors.New(domain.ErrProblemNotAvailable)
+	}
+
+	if problem.RoundID != 0 {
+		_, err := uc.Repo.GetStartListEntry(ctx, nil, problem.RoundID, contender.ID)
+		switch {
+		case errors.Is(err, domain.ErrNotFound):
+			return domain.Tick{}, errors.New(domain.ErrNotAllowed)
+		case err != nil:
+			return domain.Tick{}, errors.Wrap(err, 0)
+		}
+	}
+
+	existingTick, err := uc.Repo.GetTickByContenderAndProblem(ctx, nil, contender.ID, problem.ID)
 	switch {
 	case err == nil:
 	case errors.Is(err, domain.ErrNotFound):
@@ -169,7 +420,7 @@ func (uc *TickUseCase) PutTick(ctx context.Context, contenderID domain.Contender
 	existingTick.Ownership = contender.Ownership
 	existingTick.ContestID = contest.ID
 	existingTick.ProblemID = problem.ID
-	existingTick.Timestamp = time.Now()
+	existingTick.Timestamp = tick.Timestamp
 
 	existingTick.Top = tick.Top
 	existingTick.AttemptsTop = tick.AttemptsTop
@@ -182,16 +433,49 @@ func (uc *TickUseCase) PutTick(ctx context.Context, contenderID domain.Contender
 		return domain.Tick{}, errors.Wrap(err, 0)
 	}
 
-	existingTick, err = uc.Repo.StoreTick(ctx, nil, existingTick)
+	authentication, err := uc.Authorizer.GetAuthentication(ctx)
+	if err != nil {
+		return domain.Tick{}, errors.Wrap(err, 0)
+	}
+
+	tx, err := uc.Repo.Begin()
+	if err != nil {
+		return domain.Tick{}, errors.Wrap(err, 0)
+	}
+	defer tx.Rollback()
+
+	if err := uc.claimIdempotencyKey(ctx, tx, contender.ID, idempotencyKey); err != nil {
+		return domain.Tick{}, errors.Wrap(err, 0)
+	}
+
+	existingTick, err = uc.Repo.StoreTick(ctx, tx, existingTick)
 	if err != nil {
 		return domain.Tick{}, errors.Wrap(err, 0)
 	}
 
-	uc.EventBroker.Dispatch(contest.ID, domain.AscentRegisteredEvent{
+	revision := newTickRevision(existingTick, contender.ID, domain.TickRevisionActionPut, role, authentication)
+	revision.IdempotencyKey = idempotencyKey
+
+	_, err = uc.Repo.StoreTickRevision(ctx, tx, revision)
+	if err != nil {
+		return domain.Tick{}, errors.Wrap(err, 0)
+	}
+
+	if within != nil {
+		if err := within(ctx, tx); err != nil {
+			return domain.Tick{}, errors.Wrap(err, 0)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return domain.Tick{}, errors.Wrap(err, 0)
+	}
+
+	uc.EventBroker.Dispatch(ctx, contest.ID, domain.AscentRegisteredEvent{
 		TickID:        existingTick.ID,
 		Timestamp:     existingTick.Timestamp,
-		ContenderID:   *existingTick.Ownership.ContenderID,
-		ProblemID:     existingTick.ProblemID,
+		ContenderID:   contender.ID,
+		ProblemID:     problem.ID,
 		Top:           existingTick.Top,
 		AttemptsTop:   existingTick.AttemptsTop,
 		Zone1:         existingTick.Zone1,
@@ -202,3 +486,133 @@ func (uc *TickUseCase) PutTick(ctx context.Context, contenderID domain.Contender
 
 	return existingTick, nil
 }
+
+func (uc *TickUseCase) removeTick(
+	ctx context.Context,
+	contestID domain.ContestID,
+	contenderID domain.ContenderID,
+	role domain.AuthRole,
+	tick domain.Tick,
+	idempotencyKey string,
+) error {
+	authentication, err := uc.Authorizer.GetAuthentication(ctx)
+	if err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	tx, err := uc.Repo.Begin()
+	if err != nil {
+		return errors.Wrap(err, 0)
+	}
+	defer tx.Rollback()
+
+	if err := uc.claimIdempotencyKey(ctx, tx, contenderID, idempotencyKey); err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	err = uc.Repo.DeleteTick(ctx, tx, tick.ID)
+	if err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	revision := newTickRevision(tick, contenderID, domain.TickRevisionActionDelete, role, authentication)
+	revision.IdempotencyKey = idempotencyKey
+
+	_, err = uc.Repo.StoreTickRevision(ctx, tx, revision)
+	if err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	uc.EventBroker.Dispatch(ctx, contestID, domain.AscentDeregisteredEvent{
+		TickID:      tick.ID,
+		ContenderID: contenderID,
+		ProblemID:   tick.ProblemID,
+	})
+
+	return nil
+}
+
+func (uc *TickUseCase) GetTickRevisions(ctx context.Context, contenderID domain.ContenderID, problemID domain.ProblemID) ([]domain.TickRevision, error) {
+	contender, err := uc.Repo.GetContender(ctx, nil, contenderID)
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	if _, err := uc.Authorizer.HasOwnership(ctx, contender.Ownership); err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	var revisions []domain.TickRevision
+
+	if problemID == 0 {
+		revisions, err = uc.Repo.GetTickRevisionsByContender(ctx, nil, contenderID)
+	} else {
+		revisions, err = uc.Repo.GetTickRevisionsByContenderAndProblem(ctx, nil, contenderID, problemID)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, 0)
+	}
+
+	return revisions, nil
+}
+
+func newTickRevision(tick domain.Tick, contenderID domain.ContenderID, action domain.TickRevisionAction, role domain.AuthRole, authentication domain.Authentication) domain.TickRevision {
+	return domain.TickRevision{
+		ID:            0,
+		ContestID:     tick.ContestID,
+		ContenderID:   contenderID,
+		ProblemID:     tick.ProblemID,
+		TickID:        tick.ID,
+		Action:        action,
+		Timestamp:     tick.Timestamp,
+		RecordedAt:    time.Now(),
+		ActorRole:     role,
+		ActorUsername: authentication.Username,
+		Zone1:         tick.Zone1,
+		AttemptsZone1: tick.AttemptsZone1,
+		Zone2:         tick.Zone2,
+		AttemptsZone2: tick.AttemptsZone2,
+		Top:           tick.Top,
+		AttemptsTop:   tick.AttemptsTop,
+	}
+}
+
+// claimIdempotencyKey locks the contender so that concurrent syncs of the same
+// operation are serialized, and fails if the operation has already been applied.
+func (uc *TickUseCase) claimIdempotencyKey(ctx context.Context, tx domain.Transaction, contenderID domain.ContenderID, idempotencyKey string) error {
+	if idempotencyKey == "" {
+		return nil
+	}
+
+	if _, err := uc.Repo.GetContenderVersionForUpdate(ctx, tx, contenderID); err != nil {
+		return errors.Wrap(err, 0)
+	}
+
+	_, err := uc.Repo.GetTickRevisionByIdempotencyKey(ctx, tx, contenderID, idempotencyKey)
+	switch {
+	case err == nil:
+		return errors.New(errDuplicateTickOperation)
+	case errors.Is(err, domain.ErrNotFound):
+		return nil
+	default:
+		return errors.Wrap(err, 0)
+	}
+}
+
+func isTickOperationRejection(err error) bool {
+	return slices.ContainsFunc([]error{
+		domain.ErrInvalidData,
+		domain.ErrNotFound,
+		domain.ErrNotAllowed,
+		domain.ErrContestNotStarted,
+		domain.ErrContestEnded,
+		domain.ErrProblemNotInContest,
+		domain.ErrProblemNotAvailable,
+	}, func(target error) bool {
+		return errors.Is(err, target)
+	})
+}
@@ -123,7 +123,7 @@ func TestGetTicksByContest(t *testing.T) {
 		}
 
 		mockedRepo.
-			On("GetTicksByContest", mock.Anything, mock.Anything, fakedContestID).
+			On("GetTicksByContestFiltered", mock.Anything, mock.Anything, fakedContestID, domain.TickFilter{}, domain.PageRequest{}).
 			Return(fakedTicks, nil)
 
 		mockedAuthorizer.
@@ -135,10 +135,11 @@ func TestGetTicksByContest(t *testing.T) {
 			Authorizer: mockedAuthorizer,
 		}
 
-		ticks, err := ucase.GetTicksByContest(context.Background(), fakedContestID)
+		ticks, err := ucase.GetTicksByContest(context.Background(), fakedContestID, domain.TickFilter{}, domain.PageRequest{})
 
 		require.NoError(t, err)
-		assert.Equal(t, fakedTicks, ticks)
+		assert.Equal(t, fakedTicks, ticks.Items)
+		assert.Zero(t, ticks.Next)
 
 		mockedRepo.AssertExpectations(t)
 		mockedAuthorizer.AssertExpectations(t)
@@ -156,10 +157,122 @@ func TestGetTicksByContest(t *testing.T) {
 			Authorizer: mockedAuthorizer,
 		}
 
-		ticks, err := ucase.GetTicksByContest(context.Background(), fakedContestID)
+		ticks, err := ucase.GetTicksByContest(context.Background(), fakedContestID, domain.TickFilter{}, domain.PageRequest{})
 
 		assert.ErrorIs(t, err, domain.ErrNoOwnership)
-		assert.Nil(t, ticks)
+		assert.Nil(t, ticks.Items)
+
+		mockedRepo.AssertExpectations(t)
+		mockedAuthorizer.AssertExpectations(t)
+	})
+}
+
+func TestGetTickRevisions(t *testing.T) {
+	fakedContenderID := testutils.RandomResourceID[domain.ContenderID]()
+	fakedProblemID := testutils.RandomResourceID[domain.ProblemID]()
+	fakedOwnership := domain.OwnershipData{
+		OrganizerID: testutils.RandomResourceID[domain.OrganizerID](),
+		ContenderID: &fakedContenderID,
+	}
+
+	makeMocks := func() (*repositoryMock, *authorizerMock) {
+		mockedRepo := new(repositoryMock)
+		mockedAuthorizer := new(authorizerMock)
+
+		mockedRepo.
+			On("GetContender", mock.Anything, mock.Anything, fakedContenderID).
+			Return(domain.Contender{
+				ID:        fakedContenderID,
+				Ownership: fakedOwnership,
+			}, nil)
+
+		return mockedRepo, mockedAuthorizer
+	}
+
+	t.Run("AllProblems", func(t *testing.T) {
+		mockedRepo, mockedAuthorizer := makeMocks()
+
+		fakedRevisions := []domain.TickRevision{
+			{
+				ID:     testutils.RandomResourceID[domain.TickRevisionID](),
+				Action: domain.TickRevisionActionPut,
+			},
+			{
+				ID:     testutils.RandomResourceID[domain.TickRevisionID](),
+				Action: domain.TickRevisionActionDelete,
+			},
+		}
+
+		mockedAuthorizer.
+			On("HasOwnership", mock.Anything, fakedOwnership).
+			Return(domain.ContenderRole, nil)
+
+		mockedRepo.
+			On("GetTickRevisionsByContender", mock.Anything, nil, fakedContenderID).
+			Return(fakedRevisions, nil)
+
+		ucase := usecases.TickUseCase{
+			Repo:       mockedRepo,
+			Authorizer: mockedAuthorizer,
+		}
+
+		revisions, err := ucase.GetTickRevisions(context.Background(), fakedContenderID, 0)
+
+		require.NoError(t, err)
+		assert.Equal(t, fakedRevisions, revisions)
+
+		mockedRepo.AssertExpectations(t)
+		mockedAuthorizer.AssertExpectations(t)
+	})
+
+	t.Run("SingleProblem", func(t *testing.T) {
+		mockedRepo, mockedAuthorizer := makeMocks()
+
+		fakedRevisions := []domain.TickRevision{
+			{
+				ID:        testutils.RandomResourceID[domain.TickRevisionID](),
+				ProblemID: fakedProblemID,
+			},
+		}
+
+		mockedAuthorizer.
+			On("HasOwnership", mock.Anything, fakedOwnership).
+			Return(domain.OrganizerRole, nil)
+
+		mockedRepo.
+			On("GetTickRevisionsByContenderAndProblem", mock.Anything, nil, fakedContenderID, fakedProblemID).
+			Return(fakedRevisions, nil)
+
+		ucase := usecases.TickUseCase{
+			Repo:       mockedRepo,
+			Authorizer: mockedAuthorizer,
+		}
+
+		revisions, err := ucase.GetTickRevisions(context.Background(), fakedContenderID, fakedProblemID)
+
+		require.NoError(t, err)
+		assert.Equal(t, fakedRevisions, revisions)
+
+		mockedRepo.AssertExpectations(t)
+		mockedAuthorizer.AssertExpectations(t)
+	})
+
+	t.Run("BadCredentials", func(t *testing.T) {
+		mockedRepo, mockedAuthorizer := makeMocks()
+
+		mockedAuthorizer.
+			On("HasOwnership", mock.Anything, fakedOwnership).
+			Return(domain.NilRole, domain.ErrNoOwnership)
+
+		ucase := usecases.TickUseCase{
+			Repo:       mockedRepo,
+			Authorizer: mockedAuthorizer,
+		}
+
+		revisions, err := ucase.GetTickRevisions(context.Background(), fakedContenderID, 0)
+
+		assert.ErrorIs(t, err, domain.ErrNoOwnership)
+		assert.Nil(t, revisions)
 
 		mockedRepo.AssertExpectations(t)
 		mockedAuthorizer.AssertExpectations(t)
@@ -216,6 +329,7 @@ func TestPutTick(t *testing.T) {
 	t.Run("HappyPath", func(t *testing.T) {
 		mockedRepo, mockedEventBroker := makeMocks(time.Now(), time.Now())
 		mockedAuthorizer := new(authorizerMock)
+		mockedTx := new(transactionMock)
 
 		fakedTickID := testutils.RandomResourceID[domain.TickID]()
 
@@ -223,6 +337,10 @@ func TestPutTick(t *testing.T) {
 			On("HasOwnership", mock.Anything, fakedOwnership).
 			Return(domain.ContenderRole, nil)
 
+		mockedAuthorizer.
+			On("GetAuthentication", mock.Anything).
+			Return(domain.Authentication{Regcode: "ABCD1234"}, nil)
+
 		mockedRepo.
 			On("GetTickByContenderAndProblem", mock.Anything, nil, fakedContenderID, fakedProblemID).
 			Return(domain.Tick{}, domain.ErrNotFound)
@@ -235,7 +353,14 @@ func TestPutTick(t *testing.T) {
 			}, nil)
 
 		mockedRepo.
-			On("StoreTick", mock.Anything, nil, mock.MatchedBy(func(tick domain.Tick) bool {
+			On("Begin").
+			Return(mockedTx, nil)
+
+		mockedTx.On("Commit").Return(nil)
+		mockedTx.On("Rollback").Return()
+
+		mockedRepo.
+			On("StoreTick", mock.Anything, mockedTx, mock.MatchedBy(func(tick domain.Tick) bool {
 				tick.Timestamp = time.Time{}
 
 				expected := domain.Tick{
@@ -266,7 +391,32 @@ func TestPutTick(t *testing.T) {
 				AttemptsZone2: 3,
 			}, nil)
 
-		mockedEventBroker.On("Dispatch", fakedContestID, domain.AscentRegisteredEvent{
+		mockedRepo.
+			On("StoreTickRevision", mock.Anything, mockedTx, mock.MatchedBy(func(revision domain.TickRevision) bool {
+				recordedAt := revision.RecordedAt
+				revision.RecordedAt = time.Time{}
+
+				expected := domain.TickRevision{
+					ContestID:     fakedContestID,
+					ContenderID:   fakedContenderID,
+					ProblemID:     fakedProblemID,
+					TickID:        fakedTickID,
+					Action:        domain.TickRevisionActionPut,
+					Timestamp:     now,
+					ActorRole:     domain.ContenderRole,
+					Top:           true,
+					AttemptsTop:   5,
+					Zone1:         true,
+					AttemptsZone1: 2,
+					Zone2:         true,
+					AttemptsZone2: 3,
+				}
+
+				return time.Since(recordedAt) < time.Second && revision == expected
+			})).
+			Return(domain.TickRevision{}, nil)
+
+		mockedEventBroker.On("Dispatch", mock.Anything, fakedContestID, domain.AscentRegisteredEvent{
 			TickID:        fakedTickID,
 			Timestamp:     now,
 			ContenderID:   fakedContenderID,
@@ -309,10 +459,79 @@ func TestPutTick(t *testing.T) {
 		assert.Equal(t, 3, tick.AttemptsZone2)
 
 		mockedRepo.AssertExpectations(t)
+		mockedTx.AssertExpectations(t)
 		mockedEventBroker.AssertExpectations(t)
 		mockedAuthorizer.AssertExpectations(t)
 	})
 
+	t.Run("RollbackWhenWithinFails", func(t *testing.T) {
+		mockedRepo, mockedEventBroker := makeMocks(time.Now(), time.Now())
+		mockedAuthorizer := new(authorizerMock)
+		mockedTx := new(transactionMock)
+
+		mockedAuthorizer.
+			On("HasOwnership", mock.Anything, fakedOwnership).
+			Return(domain.OrganizerRole, nil)
+
+		mockedAuthorizer.
+			On("GetAuthentication", mock.Anything).
+			Return(domain.Authentication{Username: "judge@example.com"}, nil)
+
+		mockedRepo.
+			On("GetTickByContenderAndProblem", mock.Anything, nil, fakedContenderID, fakedProblemID).
+			Return(domain.Tick{}, domain.ErrNotFound)
+
+		mockedRepo.
+			On("GetProblem", mock.Anything, mock.Anything, fakedProblemID).
+			Return(domain.Problem{
+				ID:        fakedProblemID,
+				ContestID: fakedContestID,
+			}, nil)
+
+		mockedRepo.
+			On("Begin").
+			Return(mockedTx, nil)
+
+		mockedTx.On("Rollback").Return()
+
+		mockedRepo.
+			On("StoreTick", mock.Anything, mockedTx, mock.Anything).
+			Return(domain.Tick{}, nil)
+
+		mockedRepo.
+			On("StoreTickRevision", mock.Anything, mockedTx, mock.Anything).
+			Return(domain.TickRevision{}, nil)
+
+		ucase := usecases.TickUseCase{
+			Repo:        mockedRepo,
+			Authorizer:  mockedAuthorizer,
+			EventBroker: mockedEventBroker,
+		}
+
+		var withinTx domain.Transaction
+
+		_, err := ucase.PutTickWithin(context.Background(), fakedContenderID, domain.Tick{
+			ProblemID:     fakedProblemID,
+			Top:           true,
+			AttemptsTop:   5,
+			Zone1:         true,
+			AttemptsZone1: 2,
+			Zone2:         true,
+			AttemptsZone2: 3,
+		}, func(ctx context.Context, tx domain.Transaction) error {
+			withinTx = tx
+			return errMock
+		})
+
+		require.ErrorIs(t, err, errMock)
+		assert.Equal(t, mockedTx, withinTx)
+
+		mockedRepo.AssertExpectations(t)
+		mockedTx.AssertExpectations(t)
+		mockedTx.AssertNotCalled(t, "Commit")
+		mockedEventBroker.AssertNotCalled(t, "Dispatch", mock.Anything, mock.Anything, mock.Anything)
+	})
+
 	t.Run("CannotRegisterAscentBeforeContestStart", func(t *testing.T) {
 		mockedRepo, mockedEventBroker := makeMocks(time.Now().Add(time.Minute), time.Now().Add(time.Hour))
 		mockedAuthorizer := new(authorizerMock)
@@ -415,9 +634,47 @@ func TestPutTick(t *testing.T) {
 		mockedAuthorizer.AssertExpectations(t)
 	})
 
+	t.Run("ProblemNotAvailableToCompClass", func(t *testing.T) {
+		mockedRepo, mockedEventBroker := makeMocks(time.Now(), time.Now())
+		mockedAuthorizer := new(authorizerMock)
+
+		fakedOtherProblemID := fakedProblemID + 1
+
+		mockedAuthorizer.
+			On("HasOwnership", mock.Anything, fakedOwnership).
+			Return(domain.ContenderRole, nil)
+
+		mockedRepo.
+			On("GetProblem", mock.Anything, mock.Anything, fakedOtherProblemID).
+			Return(domain.Problem{
+				ID:           fakedOtherProblemID,
+				ContestID:    fakedContestID,
+				CompClassIDs: []domain.CompClassID{fakedCompClassID + 1},
+			}, nil)
+
+		ucase := usecases.TickUseCase{
+			Repo:       mockedRepo,
+			Authorizer: mockedAuthorizer,
+		}
+
+		tick, err := ucase.PutTick(context.Background(), fakedContenderID, domain.Tick{
+			ProblemID:   fakedOtherProblemID,
+			Top:         true,
+			AttemptsTop: 1,
+		})
+
+		assert.ErrorIs(t, err, domain.ErrProblemNotAvailable)
+		assert.Empty(t, tick)
+
+		mockedRepo.AssertExpectations(t)
+		mockedEventBroker.AssertExpectations(t)
+		mockedAuthorizer.AssertExpectations(t)
+	})
+
 	t.Run("OrganizerCanRegisterAscentAfterGracePeriod", func(t *testing.T) {
 		mockedRepo, mockedEventBroker := makeMocks(time.Now().Add(-1*time.Hour), time.Now().Add(-1*gracePeriod))
 		mockedAuthorizer := new(authorizerMock)
+		mockedTx := new(transactionMock)
 
 		fakedTickID := testutils.RandomResourceID[domain.TickID]()
 
@@ -425,6 +682,10 @@ func TestPutTick(t *testing.T) {
 			On("HasOwnership", mock.Anything, fakedOwnership).
 			Return(domain.OrganizerRole, nil)
 
+		mockedAuthorizer.
+			On("GetAuthentication", mock.Anything).
+			Return(domain.Authentication{Username: "judge@example.com"}, nil)
+
 		mockedRepo.
 			On("GetTickByContenderAndProblem", mock.Anything, nil, fakedContenderID, fakedProblemID).
 			Return(domain.Tick{}, domain.ErrNotFound)
@@ -437,7 +698,14 @@ func TestPutTick(t *testing.T) {
 			}, nil)
 
 		mockedRepo.
-			On("StoreTick", mock.Anything, nil, mock.MatchedBy(func(tick domain.Tick) bool {
+			On("Begin").
+			Return(mockedTx, nil)
+
+		mockedTx.On("Commit").Return(nil)
+		mockedTx.On("Rollback").Return()
+
+		mockedRepo.
+			On("StoreTick", mock.Anything, mockedTx, mock.MatchedBy(func(tick domain.Tick) bool {
 				tick.Timestamp = time.Time{}
 
 				expected := domain.Tick{
@@ -468,7 +736,16 @@ func TestPutTick(t *testing.T) {
 				AttemptsZone2: 3,
 			}, nil)
 
-		mockedEventBroker.On("Dispatch", fakedContestID, domain.AscentRegisteredEvent{
+		mockedRepo.
+			On("StoreTickRevision", mock.Anything, mockedTx, mock.MatchedBy(func(revision domain.TickRevision) bool {
+				return revision.TickID == fakedTickID &&
+					revision.Action == domain.TickRevisionActionPut &&
+					revision.ActorRole == domain.OrganizerRole &&
+					revision.ActorUsername == "judge@example.com"
+			})).
+			Return(domain.TickRevision{}, nil)
+
+		mockedEventBroker.On("Dispatch", mock.Anything, fakedContestID, domain.AscentRegisteredEvent{
 			TickID:        fakedTickID,
 			Timestamp:     now,
 			ContenderID:   fakedContenderID,
@@ -501,6 +778,7 @@ func TestPutTick(t *testing.T) {
 		assert.NotEmpty(t, tick)
 
 		mockedRepo.AssertExpectations(t)
+		mockedTx.AssertExpectations(t)
 		mockedEventBroker.AssertExpectations(t)
 		mockedAuthorizer.AssertExpectations(t)
 	})
@@ -580,6 +858,7 @@ func TestPutTick(t *testing.T) {
 			now := time.Now()
 			mockedRepo, mockedEventBroker := makeMocks(now.Add(-time.Hour), now.Add(time.Hour))
 			mockedAuthorizer := new(authorizerMock)
+			mockedTx := new(transactionMock)
 
 			fakedTickID := testutils.RandomResourceID[domain.TickID]()
 
@@ -599,6 +878,10 @@ func TestPutTick(t *testing.T) {
 				On("HasOwnership", mock.Anything, fakedOwnership).
 				Return(domain.ContenderRole, nil)
 
+			mockedAuthorizer.
+				On("GetAuthentication", mock.Anything).
+				Return(domain.Authentication{Regcode: "ABCD1234"}, nil)
+
 			mockedRepo.
 				On("GetProblem", mock.Anything, nil, fakedProblemID).
 				Return(domain.Problem{
@@ -607,7 +890,14 @@ func TestPutTick(t *testing.T) {
 				}, nil)
 
 			mockedRepo.
-				On("StoreTick", mock.Anything, nil, domain.Tick{
+				On("Begin").
+				Return(mockedTx, nil)
+
+			mockedTx.On("Commit").Return(nil)
+			mockedTx.On("Rollback").Return()
+
+			mockedRepo.
+				On("StoreTick", mock.Anything, mockedTx, domain.Tick{
 					ID:            fakedTickID,
 					Ownership:     fakedOwnership,
 					Timestamp:     now,
@@ -634,8 +924,27 @@ func TestPutTick(t *testing.T) {
 					AttemptsZone2: 2,
 				}, nil)
 
+			mockedRepo.
+				On("StoreTickRevision", mock.Anything, mockedTx, domain.TickRevision{
+					ContestID:     fakedContestID,
+					ContenderID:   fakedContenderID,
+					ProblemID:     fakedProblemID,
+					TickID:        fakedTickID,
+					Action:        domain.TickRevisionActionPut,
+					Timestamp:     now,
+					RecordedAt:    time.Now(),
+					ActorRole:     domain.ContenderRole,
+					Top:           true,
+					AttemptsTop:   3,
+					Zone1:         true,
+					AttemptsZone1: 1,
+					Zone2:         true,
+					AttemptsZone2: 2,
+				}).
+				Return(domain.TickRevision{}, nil)
+
 			mockedEventBroker.
-				On("Dispatch", fakedContestID, domain.AscentRegisteredEvent{
+				On("Dispatch", mock.Anything, fakedContestID, domain.AscentRegisteredEvent{
 					TickID:        fakedTickID,
 					Timestamp:     now,
 					ContenderID:   fakedContenderID,
@@ -678,6 +987,7 @@ func TestPutTick(t *testing.T) {
 			assert.Equal(t, now, updatedTick.Timestamp)
 
 			mockedRepo.AssertExpectations(t)
+			mockedTx.AssertExpectations(t)
 			mockedAuthorizer.AssertExpectations(t)
 			mockedEventBroker.AssertExpectations(t)
 		})
@@ -743,16 +1053,40 @@ func TestDeleteTick(t *testing.T) {
 	t.Run("HappyPath", func(t *testing.T) {
 		mockedRepo, mockedEventBroker := makeMocks(time.Now())
 		mockedAuthorizer := new(authorizerMock)
+		mockedTx := new(transactionMock)
 
 		mockedAuthorizer.
 			On("HasOwnership", mock.Anything, fakedOwnership).
 			Return(domain.ContenderRole, nil)
 
+		mockedAuthorizer.
+			On("GetAuthentication", mock.Anything).
+			Return(domain.Authentication{Regcode: "ABCD1234"}, nil)
+
+		mockedRepo.
+			On("Begin").
+			Return(mockedTx, nil)
+
+		mockedTx.On("Commit").Return(nil)
+		mockedTx.On("Rollback").Return()
+
 		mockedRepo.
-			On("DeleteTick", mock.Anything, mock.Anything, fakedTickID).
+			On("DeleteTick", mock.Anything, mockedTx, fakedTickID).
 			Return(nil)
 
-		mockedEventBroker.On("Dispatch", fakedContestID, domain.AscentDeregisteredEvent{
+		mockedRepo.
+			On("StoreTickRevision", mock.Anything, mockedTx, mock.MatchedBy(func(revision domain.TickRevision) bool {
+				return revision.TickID == fakedTickID &&
+					revision.ContenderID == fakedContenderID &&
+					revision.ProblemID == fakedProblemID &&
+					revision.Action == domain.TickRevisionActionDelete &&
+					revision.ActorRole == domain.ContenderRole &&
+					revision.ActorUsername == "" &&
+					time.Since(revision.Timestamp) < time.Minute
+			})).
+			Return(domain.TickRevision{}, nil)
+
+		mockedEventBroker.On("Dispatch", mock.Anything, fakedContestID, domain.AscentDeregisteredEvent{
 			TickID:      fakedTickID,
 			ContenderID: fakedContenderID,
 			ProblemID:   fakedProblemID,
@@ -769,6 +1103,7 @@ func TestDeleteTick(t *testing.T) {
 		require.NoError(t, err)
 
 		mockedRepo.AssertExpectations(t)
+		mockedTx.AssertExpectations(t)
 		mockedEventBroker.AssertExpectations(t)
 		mockedAuthorizer.AssertExpectations(t)
 	})
@@ -798,16 +1133,40 @@ func TestDeleteTick(t *testing.T) {
 	t.Run("OrganizerCanDeregisterAscentAfterGracePeriod", func(t *testing.T) {
 		mockedRepo, mockedEventBroker := makeMocks(time.Now().Add(-1 * gracePeriod))
 		mockedAuthorizer := new(authorizerMock)
+		mockedTx := new(transactionMock)
 
 		mockedAuthorizer.
 			On("HasOwnership", mock.Anything, fakedOwnership).
 			Return(domain.OrganizerRole, nil)
 
+		mockedAuthorizer.
+			On("GetAuthentication", mock.Anything).
+			Return(domain.Authentication{Username: "judge@example.com"}, nil)
+
+		mockedRepo.
+			On("Begin").
+			Return(mockedTx, nil)
+
+		mockedTx.On("Commit").Return(nil)
+		mockedTx.On("Rollback").Return()
+
 		mockedRepo.
-			On("DeleteTick", mock.Anything, mock.Anything, fakedTickID).
+			On("DeleteTick", mock.Anything, mockedTx, fakedTickID).
 			Return(nil)
 
-		mockedEventBroker.On("Dispatch", fakedContestID, domain.AscentDeregisteredEvent{
+		mockedRepo.
+			On("StoreTickRevision", mock.Anything, mockedTx, mock.MatchedBy(func(revision domain.TickRevision) bool {
+				return revision.TickID == fakedTickID &&
+					revision.ContenderID == fakedContenderID &&
+					revision.ProblemID == fakedProblemID &&
+					revision.Action == domain.TickRevisionActionDelete &&
+					revision.ActorRole == domain.OrganizerRole &&
+					revision.ActorUsername == "judge@example.com" &&
+					time.Since(revision.Timestamp) < time.Minute
+			})).
+			Return(domain.TickRevision{}, nil)
+
+		mockedEventBroker.On("Dispatch", mock.Anything, fakedContestID, domain.AscentDeregisteredEvent{
 			TickID:      fakedTickID,
 			ContenderID: fakedContenderID,
 			ProblemID:   fakedProblemID,
@@ -824,6 +1183,7 @@ func TestDeleteTick(t *testing.T) {
 		require.NoError(t, err)
 
 		mockedRepo.AssertExpectations(t)
+		mockedTx.AssertExpectations(t)
 		mockedEventBroker.AssertExpectations(t)
 		mockedAuthorizer.AssertExpectations(t)
 	})
@@ -856,3 +1216,470 @@ func TestDeleteTick(t *testing.T) {
 		mockedAuthorizer.AssertExpectations(t)
 	})
 }
+
+func TestSyncTicks(t *testing.T) {
+	fakedContenderID := testutils.RandomResourceID[domain.ContenderID]()
+	fakedContestID := testutils.RandomResourceID[domain.ContestID]()
+	fakedCompClassID := testutils.RandomResourceID[domain.CompClassID]()
+	fakedProblemID := testutils.RandomResourceID[domain.ProblemID]()
+
+	gracePeriod := 15 * time.Minute
+
+	fakedOwnership := domain.OwnershipData{
+		OrganizerID: testutils.RandomResourceID[domain.OrganizerID](),
+		ContenderID: &fakedContenderID,
+	}
+
+	makeMocks := func(timeBegin, timeEnd time.Time) (*repositoryMock, *authorizerMock) {
+		mockedRepo := new(repositoryMock)
+		mockedAuthorizer := new(authorizerMock)
+
+		mockedRepo.
+			On("GetContender", mock.Anything, nil, fakedContenderID).
+			Return(domain.Contender{
+				ID:          fakedContenderID,
+				Ownership:   fakedOwnership,
+				ContestID:   fakedContestID,
+				CompClassID: fakedCompClassID,
+			}, nil)
+
+		mockedRepo.
+			On("GetContest", mock.Anything, nil, fakedContestID).
+			Return(domain.Contest{
+				ID:          fakedContestID,
+				GracePeriod: gracePeriod,
+			}, nil)
+
+		mockedRepo.
+			On("GetCompClass", mock.Anything, nil, fakedCompClassID).
+			Return(domain.CompClass{
+				ID:        fakedCompClassID,
+				TimeBegin: timeBegin,
+				TimeEnd:   timeEnd,
+			}, nil)
+
+		mockedAuthorizer.
+			On("HasOwnership", mock.Anything, fakedOwnership).
+			Return(domain.ContenderRole, nil)
+
+		return mockedRepo, mockedAuthorizer
+	}
+
+	makeOperation := func(key string, timestamp time.Time) domain.TickOperation {
+		return domain.TickOperation{
+			IdempotencyKey: key,
+			Action:         domain.TickRevisionActionPut,
+			Timestamp:      timestamp,
+			ProblemID:      fakedProblemID,
+			Zone1:          true,
+			AttemptsZone1:  1,
+			Zone2:          true,
+			AttemptsZone2:  1,
+			Top:            true,
+			AttemptsTop:    2,
+		}
+	}
+
+	t.Run("AppliedWithinTolerance", func(t *testing.T) {
+		synctest.Test(t, func(t *testing.T) {
+			now := time.Now()
+			mockedRepo, mockedAuthorizer := makeMocks(now.Add(-time.Hour), now.Add(-gracePeriod-5*time.Minute))
+			mockedEventBroker := new(eventBrokerMock)
+			mockedTx := new(transactionMock)
+
+			fakedTickID := testutils.RandomResourceID[domain.TickID]()
+			clientTimestamp := now.Add(-gracePeriod - 10*time.Minute)
+
+			mockedAuthorizer.
+				On("GetAuthentication", mock.Anything).
+				Return(domain.Authentication{Regcode: "ABCD1234"}, nil)
+
+			mockedRepo.
+				On("GetTickRevisionByIdempotencyKey", mock.Anything, nil, fakedContenderID, "op-1").
+				Return(domain.TickRevision{}, domain.ErrNotFound)
+
+			mockedRepo.
+				On("GetTickByContenderAndProblem", mock.Anything, nil, fakedContenderID, fakedProblemID).
+				Return(domain.Tick{}, domain.ErrNotFound)
+
+			mockedRepo.
+				On("GetTickRevisionsByContenderAndProblem", mock.Anything, nil, fakedContenderID, fakedProblemID).
+				Return([]domain.TickRevision{}, nil)
+
+			mockedRepo.
+				On("GetProblem", mock.Anything, nil, fakedProblemID).
+				Return(domain.Problem{
+					ID:        fakedProblemID,
+					ContestID: fakedContestID,
+				}, nil)
+
+			mockedRepo.
+				On("Begin").
+				Return(mockedTx, nil)
+
+			mockedTx.On("Commit").Return(nil)
+			mockedTx.On("Rollback").Return()
+
+			mockedRepo.
+				On("GetContenderVersionForUpdate", mock.Anything, mockedTx, fakedContenderID).
+				Return(1, nil)
+
+			mockedRepo.
+				On("GetTickRevisionByIdempotencyKey", mock.Anything, mockedTx, fakedContenderID, "op-1").
+				Return(domain.TickRevision{}, domain.ErrNotFound)
+
+			expectedTick := domain.Tick{
+				ID:            fakedTickID,
+				Ownership:     fakedOwnership,
+				Timestamp:     clientTimestamp,
+				ContestID:     fakedContestID,
+				ProblemID:     fakedProblemID,
+				Zone1:         true,
+				AttemptsZone1: 1,
+				Zone2:         true,
+				AttemptsZone2: 1,
+				Top:           true,
+				AttemptsTop:   2,
+			}
+
+			mockedRepo.
+				On("StoreTick", mock.Anything, mockedTx, domain.Tick{
+					Ownership:     fakedOwnership,
+					Timestamp:     clientTimestamp,
+					ContestID:     fakedContestID,
+					ProblemID:     fakedProblemID,
+					Zone1:         true,
+					AttemptsZone1: 1,
+					Zone2:         true,
+					AttemptsZone2: 1,
+					Top:           true,
+					AttemptsTop:   2,
+				}).
+				Return(expectedTick, nil)
+
+			mockedRepo.
+				On("StoreTickRevision", mock.Anything, mockedTx, domain.TickRevision{
+					ContestID:      fakedContestID,
+					ContenderID:    fakedContenderID,
+					ProblemID:      fakedProblemID,
+					TickID:         fakedTickID,
+					Action:         domain.TickRevisionActionPut,
+					Timestamp:      clientTimestamp,
+					RecordedAt:     time.Now(),
+					ActorRole:      domain.ContenderRole,
+					IdempotencyKey: "op-1",
+					Zone1:          true,
+					AttemptsZone1:  1,
+					Zone2:          true,
+					AttemptsZone2:  1,
+					Top:            true,
+					AttemptsTop:    2,
+				}).
+				Return(domain.TickRevision{}, nil)
+
+			mockedEventBroker.On("Dispatch", mock.Anything, fakedContestID, domain.AscentRegisteredEvent{
+				TickID:        fakedTickID,
+				Timestamp:     clientTimestamp,
+				ContenderID:   fakedContenderID,
+				ProblemID:     fakedProblemID,
+				Zone1:         true,
+				AttemptsZone1: 1,
+				Zone2:         true,
+				AttemptsZone2: 1,
+				Top:           true,
+				AttemptsTop:   2,
+			}).Return()
+
+			ucase := usecases.TickUseCase{
+				Repo:        mockedRepo,
+				Authorizer:  mockedAuthorizer,
+				EventBroker: mockedEventBroker,
+			}
+
+			results, err := ucase.SyncTicks(context.Background(), fakedContenderID, []domain.TickOperation{
+				makeOperation("op-1", clientTimestamp),
+			})
+
+			require.NoError(t, err)
+			require.Len(t, results, 1)
+			assert.Equal(t, domain.TickOperationStatusApplied, results[0].Status)
+			assert.Equal(t, "op-1", results[0].IdempotencyKey)
+			assert.Equal(t, &expectedTick, results[0].Tick)
+
+			mockedRepo.AssertExpectations(t)
+			mockedTx.AssertExpectations(t)
+			mockedEventBroker.AssertExpectations(t)
+			mockedAuthorizer.AssertExpectations(t)
+		})
+	})
+
+	t.Run("Duplicate", func(t *testing.T) {
+		mockedRepo, mockedAuthorizer := makeMocks(time.Now().Add(-time.Hour), time.Now().Add(time.Hour))
+
+		mockedRepo.
+			On("GetTickRevisionByIdempotencyKey", mock.Anything, nil, fakedContenderID, "op-1").
+			Return(domain.TickRevision{IdempotencyKey: "op-1"}, nil)
+
+		ucase := usecases.TickUseCase{
+			Repo:       mockedRepo,
+			Authorizer: mockedAuthorizer,
+		}
+
+		results, err := ucase.SyncTicks(context.Background(), fakedContenderID, []domain.TickOperation{
+			makeOperation("op-1", time.Now()),
+		})
+
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.Equal(t, domain.TickOperationStatusDuplicate, results[0].Status)
+
+		mockedRepo.AssertExpectations(t)
+		mockedAuthorizer.AssertExpectations(t)
+	})
+
+	t.Run("DuplicateWithinTransaction", func(t *testing.T) {
+		mockedRepo, mockedAuthorizer := makeMocks(time.Now().Add(-time.Hour), time.Now().Add(time.Hour))
+		mockedTx := new(transactionMock)
+
+		mockedAuthorizer.
+			On("GetAuthentication", mock.Anything).
+			Return(domain.Authentication{Regcode: "ABCD1234"}, nil)
+
+		mockedRepo.
+			On("GetTickRevisionByIdempotencyKey", mock.Anything, nil, fakedContenderID, "op-1").
+			Return(domain.TickRevision{}, domain.ErrNotFound)
+
+		mockedRepo.
+			On("GetTickByContenderAndProblem", mock.Anything, nil, fakedContenderID, fakedProblemID).
+			Return(domain.Tick{}, domain.ErrNotFound)
+
+		mockedRepo.
+			On("GetTickRevisionsByContenderAndProblem", mock.Anything, nil, fakedContenderID, fakedProblemID).
+			Return([]domain.TickRevision{}, nil)
+
+		mockedRepo.
+			On("GetProblem", mock.Anything, nil, fakedProblemID).
+			Return(domain.Problem{
+				ID:        fakedProblemID,
+				ContestID: fakedContestID,
+			}, nil)
+
+		mockedRepo.
+			On("Begin").
+			Return(mockedTx, nil)
+
+		mockedTx.On("Rollback").Return()
+
+		mockedRepo.
+			On("GetContenderVersionForUpdate", mock.Anything, mockedTx, fakedContenderID).
+			Return(1, nil)
+
+		mockedRepo.
+			On("GetTickRevisionByIdempotencyKey", mock.Anything, mockedTx, fakedContenderID, "op-1").
+			Return(domain.TickRevision{IdempotencyKey: "op-1"}, nil)
+
+		ucase := usecases.TickUseCase{
+			Repo:       mockedRepo,
+			Authorizer: mockedAuthorizer,
+		}
+
+		results, err := ucase.SyncTicks(context.Background(), fakedContenderID, []domain.TickOperation{
+			makeOperation("op-1", time.Now()),
+		})
+
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.Equal(t, domain.TickOperationStatusDuplicate, results[0].Status)
+		assert.Nil(t, results[0].Tick)
+
+		mockedRepo.AssertExpectations(t)
+		mockedTx.AssertExpectations(t)
+		mockedAuthorizer.AssertExpectations(t)
+	})
+
+	t.Run("ConflictWithOrganizerEdit", func(t *testing.T) {
+		mockedRepo, mockedAuthorizer := makeMocks(time.Now().Add(-time.Hour), time.Now().Add(time.Hour))
+
+		fakedTick := domain.Tick{
+			ID:          testutils.RandomResourceID[domain.TickID](),
+			ContestID:   fakedContestID,
+			ProblemID:   fakedProblemID,
+			Zone1:       true,
+			AttemptsTop: 999,
+		}
+
+		mockedRepo.
+			On("GetTickRevisionByIdempotencyKey", mock.Anything, nil, fakedContenderID, "op-1").
+			Return(domain.TickRevision{}, domain.ErrNotFound)
+
+		mockedRepo.
+			On("GetTickByContenderAndProblem", mock.Anything, nil, fakedContenderID, fakedProblemID).
+			Return(fakedTick, nil)
+
+		mockedRepo.
+			On("GetTickRevisionsByContenderAndProblem", mock.Anything, nil, fakedContenderID, fakedProblemID).
+			Return([]domain.TickRevision{
+				{
+					Timestamp:  time.Now().Add(-2 * time.Minute),
+					RecordedAt: time.Now().Add(-2 * time.Minute),
+					ActorRole:  domain.ContenderRole,
+				},
+				{
+					Timestamp:  time.Now().Add(-10 * time.Minute),
+					RecordedAt: time.Now().Add(-time.Minute),
+					ActorRole:  domain.OrganizerRole,
+				},
+			}, nil)
+
+		ucase := usecases.TickUseCase{
+			Repo:       mockedRepo,
+			Authorizer: mockedAuthorizer,
+		}
+
+		results, err := ucase.SyncTicks(context.Background(), fakedContenderID, []domain.TickOperation{
+			makeOperation("op-1", time.Now().Add(-5*time.Minute)),
+		})
+
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.Equal(t, domain.TickOperationStatusConflict, results[0].Status)
+		assert.Equal(t, &fakedTick, results[0].Tick)
+
+		mockedRepo.AssertExpectations(t)
+		mockedAuthorizer.AssertExpectations(t)
+	})
+
+	t.Run("ConflictWithEarlierOrganizerEdit", func(t *testing.T) {
+		mockedRepo, mockedAuthorizer := makeMocks(time.Now().Add(-time.Hour), time.Now().Add(time.Hour))
+
+		fakedTick := domain.Tick{
+			ID:          testutils.RandomResourceID[domain.TickID](),
+			ContestID:   fakedContestID,
+			ProblemID:   fakedProblemID,
+			Zone1:       true,
+			AttemptsTop: 999,
+		}
+
+		mockedRepo.
+			On("GetTickRevisionByIdempotencyKey", mock.Anything, nil, fakedContenderID, "op-1").
+			Return(domain.TickRevision{}, domain.ErrNotFound)
+
+		mockedRepo.
+			On("GetTickByContenderAndProblem", mock.Anything, nil, fakedContenderID, fakedProblemID).
+			Return(fakedTick, nil)
+
+		mockedRepo.
+			On("GetTickRevisionsByContenderAndProblem", mock.Anything, nil, fakedContenderID, fakedProblemID).
+			Return([]domain.TickRevision{
+				{
+					Timestamp:  time.Now().Add(-10 * time.Minute),
+					RecordedAt: time.Now().Add(-3 * time.Minute),
+					ActorRole:  domain.OrganizerRole,
+				},
+				{
+					Timestamp:  time.Now().Add(-time.Minute),
+					RecordedAt: time.Now().Add(-time.Minute),
+					ActorRole:  domain.ContenderRole,
+				},
+			}, nil)
+
+		ucase := usecases.TickUseCase{
+			Repo:       mockedRepo,
+			Authorizer: mockedAuthorizer,
+		}
+
+		results, err := ucase.SyncTicks(context.Background(), fakedContenderID, []domain.TickOperation{
+			makeOperation("op-1", time.Now().Add(-5*time.Minute)),
+		})
+
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.Equal(t, domain.TickOperationStatusConflict, results[0].Status)
+		assert.Equal(t, &fakedTick, results[0].Tick)
+
+		mockedRepo.AssertExpectations(t)
+		mockedAuthorizer.AssertExpectations(t)
+	})
+
+	t.Run("RejectedAfterGracePeriod", func(t *testing.T) {
+		mockedRepo, mockedAuthorizer := makeMocks(time.Now().Add(-2*time.Hour), time.Now().Add(-time.Hour))
+
+		mockedRepo.
+			On("GetTickRevisionByIdempotencyKey", mock.Anything, nil, fakedContenderID, "op-1").
+			Return(domain.TickRevision{}, domain.ErrNotFound)
+
+		ucase := usecases.TickUseCase{
+			Repo:       mockedRepo,
+			Authorizer: mockedAuthorizer,
+		}
+
+		results, err := ucase.SyncTicks(context.Background(), fakedContenderID, []domain.TickOperation{
+			makeOperation("op-1", time.Now().Add(-30*time.Minute)),
+		})
+
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.Equal(t, domain.TickOperationStatusRejected, results[0].Status)
+		assert.NotEmpty(t, results[0].Error)
+
+		mockedRepo.AssertExpectations(t)
+		mockedAuthorizer.AssertExpectations(t)
+	})
+
+	t.Run("RejectedTimestampInFuture", func(t *testing.T) {
+		mockedRepo, mockedAuthorizer := makeMocks(time.Now().Add(-time.Hour), time.Now().Add(time.Hour))
+
+		mockedRepo.
+			On("GetTickRevisionByIdempotencyKey", mock.Anything, nil, fakedContenderID, "op-1").
+			Return(domain.TickRevision{}, domain.ErrNotFound)
+
+		ucase := usecases.TickUseCase{
+			Repo:       mockedRepo,
+			Authorizer: mockedAuthorizer,
+		}
+
+		results, err := ucase.SyncTicks(context.Background(), fakedContenderID, []domain.TickOperation{
+			makeOperation("op-1", time.Now().Add(time.Hour)),
+		})
+
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.Equal(t, domain.TickOperationStatusRejected, results[0].Status)
+
+		mockedRepo.AssertExpectations(t)
+		mockedAuthorizer.AssertExpectations(t)
+	})
+
+	t.Run("RejectedInvalidOperation", func(t *testing.T) {
+		mockedRepo, mockedAuthorizer := makeMocks(time.Now().Add(-time.Hour), time.Now().Add(time.Hour))
+
+		ucase := usecases.TickUseCase{
+			Repo:       mockedRepo,
+			Authorizer: mockedAuthorizer,
+		}
+
+		results, err := ucase.SyncTicks(context.Background(), fakedContenderID, []domain.TickOperation{
+			makeOperation("", time.Now()),
+		})
+
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.Equal(t, domain.TickOperationStatusRejected, results[0].Status)
+
+		mockedRepo.AssertExpectations(t)
+		mockedAuthorizer.AssertExpectations(t)
+	})
+
+	t.Run("TooManyOperations", func(t *testing.T) {
+		mockedRepo, mockedAuthorizer := makeMocks(time.Now().Add(-time.Hour), time.Now().Add(time.Hour))
+
+		ucase := usecases.TickUseCase{
+			Repo:       mockedRepo,
+			Authorizer: mockedAuthorizer,
+		}
+
+		_, err := ucase.SyncTicks(context.Background(), fakedContenderID, make([]domain.TickOperation, 101))
+
+		assert.ErrorIs(t, err, domain.ErrLimitExceeded)
+	})
+}
@@ -11,6 +11,7 @@ type userUseCaseRepository interface {
 	domain.Transactor
 
 	GetUserByUsername(ctx context.Context, tx domain.Transaction, username string) (domain.User, error)
+	StoreUser(ctx context.Context, tx domain.Transaction, user domain.User) (domain.User, error)
 	GetUsersByOrganizer(ctx context.Context, tx domain.Transaction, organizerID domain.OrganizerID) ([]domain.User, error)
 	GetAllOrganizers(ctx context.Context, tx domain.Transaction) ([]domain.Organizer, error)
 	GetOrganizer(ctx context.Context, tx domain.Transaction, organizerID domain.OrganizerID) (domain.Organizer, error)
@@ -67,3 +68,32 @@ func (uc *UserUseCase) GetUsersByOrganizer(ctx context.Context, organizerID doma
 
 	return users, nil
 }
+
+func (uc *UserUseCase) SetAdmin(ctx context.Context, username string, admin bool) (domain.User, error) {
+	role, err := uc.Authorizer.HasOwnership(ctx, domain.OwnershipData{})
+	if err != nil {
+		return domain.User{}, errors.Wrap(err, 0)
+	}
+
+	if role != domain.AdminRole {
+		return domain.User{}, errors.Wrap(domain.ErrNotAuthorized, 0)
+	}
+
+	user, err := uc.Repo.GetUserByUsername(ctx, nil, username)
+	if err != nil {
+		return domain.User{}, errors.Wrap(err, 0)
+	}
+
+	user.Admin = admin
+
+	if _, err := uc.Repo.StoreUser(ctx, nil, user); err != nil {
+		return domain.User{}, errors.Wrap(err, 0)
+	}
+
+	user, err = uc.Repo.GetUserByUsername(ctx, nil, username)
+	if err != nil {
+		return domain.User{}, errors.Wrap(err, 0)
+	}
+
+	return user, nil
+}
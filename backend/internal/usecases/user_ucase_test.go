@@ -119,3 +119,89 @@ func TestGetUsersByOrganizer(t *testing.T) {
 		mockedRepo.AssertExpectations(t)
 	})
 }
+
+func TestSetAdmin(t *testing.T) {
+	fakedUserID := testutils.RandomResourceID[domain.UserID]()
+
+	t.Run("ExistingUser", func(t *testing.T) {
+		mockedRepo := new(repositoryMock)
+		mockedAuthorizer := new(authorizerMock)
+
+		mockedAuthorizer.
+			On("HasOwnership", mock.Anything, domain.OwnershipData{}).
+			Return(domain.AdminRole, nil)
+
+		mockedRepo.
+			On("GetUserByUsername", mock.Anything, nil, "john").
+			Return(domain.User{ID: fakedUserID, Username: "john"}, nil).
+			Once()
+
+		mockedRepo.
+			On("StoreUser", mock.Anything, nil, domain.User{ID: fakedUserID, Username: "john", Admin: true}).
+			Return(domain.User{ID: fakedUserID, Username: "john", Admin: true}, nil)
+
+		mockedRepo.
+			On("GetUserByUsername", mock.Anything, nil, "john").
+			Return(domain.User{ID: fakedUserID, Username: "john", Admin: true}, nil).
+			Once()
+
+		ucase := usecases.UserUseCase{
+			Repo:       mockedRepo,
+			Authorizer: mockedAuthorizer,
+		}
+
+		user, err := ucase.SetAdmin(context.Background(), "john", true)
+
+		require.NoError(t, err)
+		assert.Equal(t, domain.User{ID: fakedUserID, Username: "john", Admin: true}, user)
+
+		mockedRepo.AssertExpectations(t)
+		mockedAuthorizer.AssertExpectations(t)
+	})
+
+	t.Run("MissingUser", func(t *testing.T) {
+		mockedRepo := new(repositoryMock)
+		mockedAuthorizer := new(authorizerMock)
+
+		mockedAuthorizer.
+			On("HasOwnership", mock.Anything, domain.OwnershipData{}).
+			Return(domain.AdminRole, nil)
+
+		mockedRepo.
+			On("GetUserByUsername", mock.Anything, nil, "john").
+			Return(domain.User{}, domain.ErrNotFound)
+
+		ucase := usecases.UserUseCase{
+			Repo:       mockedRepo,
+			Authorizer: mockedAuthorizer,
+		}
+
+		_, err := ucase.SetAdmin(context.Background(), "john", true)
+
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+
+		mockedRepo.AssertExpectations(t)
+		mockedAuthorizer.AssertExpectations(t)
+	})
+
+	t.Run("NotAuthorized", func(t *testing.T) {
+		mockedRepo := new(repositoryMock)
+		mockedAuthorizer := new(authorizerMock)
+
+		mockedAuthorizer.
+			On("HasOwnership", mock.Anything, domain.OwnershipData{}).
+			Return(domain.OrganizerRole, nil)
+
+		ucase := usecases.UserUseCase{
+			Repo:       mockedRepo,
+			Authorizer: mockedAuthorizer,
+		}
+
+		_, err := ucase.SetAdmin(context.Background(), "john", true)
+
+		assert.ErrorIs(t, err, domain.ErrNotAuthorized)
+
+		mockedRepo.AssertExpectations(t)
+		mockedAuthorizer.AssertExpectations(t)
+	})
+}
@@ -14,16 +14,14 @@ type CompClassValidator struct {
 }
 
 func (v CompClassValidator) Validate(compClass domain.CompClass) error {
-	switch {
-	case len(strings.TrimSpace(compClass.Name)) < 1:
-		fallthrough
-	case compClass.TimeEnd.Before(compClass.TimeBegin):
-		fallthrough
-	case compClass.TimeEnd.Sub(compClass.TimeBegin) > 31*24*time.Hour:
-		return errors.Errorf("%w: %w", domain.ErrInvalidData, errCompClassConstraintViolation)
-	}
-
-	return nil
+	violations := newViolations(errCompClassConstraintViolation)
+
+	violations.check(len(strings.TrimSpace(compClass.Name)) < 1, "name", "must not be empty")
+	violations.check(compClass.TimeEnd.Before(compClass.TimeBegin), "timeEnd", "must not be before start time")
+	violations.check(compClass.TimeEnd.Sub(compClass.TimeBegin) > 31*24*time.Hour, "timeEnd", "must be within 31 days of start time")
+	violations.check(compClass.Capacity < 0 || compClass.Capacity > 500, "capacity", "must be between 0 and 500")
+
+	return violations.err()
 }
 
 func (v CompClassValidator) IsValidationError(err error) bool {
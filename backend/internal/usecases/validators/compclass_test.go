@@ -57,4 +57,15 @@ func TestCompClassValidator(t *testing.T) {
 		assert.ErrorIs(t, err, domain.ErrInvalidData)
 		assert.True(t, validator.IsValidationError(err))
 	})
+	t.Run("InvalidCapacity", func(t *testing.T) {
+		for _, capacity := range []int{-1, 501} {
+			compClass := validCompClass()
+			compClass.Capacity = capacity
+
+			err := validator.Validate(compClass)
+
+			assert.ErrorIs(t, err, domain.ErrInvalidData)
+			assert.True(t, validator.IsValidationError(err))
+		}
+	})
 }
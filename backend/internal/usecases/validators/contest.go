@@ -38,22 +38,17 @@ type ContestValidator struct {
 }
 
 func (v ContestValidator) Validate(contest domain.Contest) error {
-	switch {
-	case len(strings.TrimSpace(contest.Name)) < 1:
-		fallthrough
-	case len(contest.Country) != 2 || !validCountryCodes[contest.Country]:
-		fallthrough
-	case contest.Finalists < 0 || contest.Finalists > 65536:
-		fallthrough
-	case contest.QualifyingProblems < 0 || contest.QualifyingProblems > 65536:
-		fallthrough
-	case contest.GracePeriod < 0 || contest.GracePeriod > time.Hour:
-		fallthrough
-	case !isValidNameRetentionTime(contest.NameRetentionTime):
-		return errors.Errorf("%w: %w", domain.ErrInvalidData, errContestConstraintViolation)
-	}
+	violations := newViolations(errContestConstraintViolation)
+
+	violations.check(len(strings.TrimSpace(contest.Name)) < 1, "name", "must not be empty")
+	violations.check(len(contest.Country) != 2 || !validCountryCodes[contest.Country], "country", "must be a valid country code")
+	violations.check(contest.Finalists < 0 || contest.Finalists > 65536, "finalists", "must be between 0 and 65536")
+	violations.check(contest.QualifyingProblems < 0 || contest.QualifyingProblems > 65536, "qualifyingProblems", "must be between 0 and 65536")
+	violations.check(contest.GracePeriod < 0 || contest.GracePeriod > time.Hour, "gracePeriod", "must be between 0 and 1 hour")
+	violations.check(contest.ScoreboardFreeze < 0 || contest.ScoreboardFreeze > 24*time.Hour, "scoreboardFreeze", "must be between 0 and 24 hours")
+	violations.check(!isValidNameRetentionTime(contest.NameRetentionTime), "nameRetentionTime", "must be between 14 and 90 days")
 
-	return nil
+	return violations.err()
 }
 
 func (v ContestValidator) IsValidationError(err error) bool {
@@ -0,0 +1,44 @@
+package validators
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/climblive/platform/backend/internal/domain"
+	"github.com/go-errors/errors"
+)
+
+var (
+	errTickDisputeConstraintViolation = errors.New("constraint violation")
+	maxTickDisputeTextLength          = 1024
+)
+
+type TickDisputeValidator struct {
+}
+
+func (v TickDisputeValidator) Validate(dispute domain.TickDispute) error {
+	violations := newViolations(errTickDisputeConstraintViolation)
+
+	violations.check(len(strings.TrimSpace(dispute.Comment)) < 1 || utf8.RuneCountInString(dispute.Comment) > maxTickDisputeTextLength, "comment", "must be between 1 and 1024 characters")
+	violations.check(utf8.RuneCountInString(dispute.Response) > maxTickDisputeTextLength, "response", "must be at most 1024 characters")
+
+	checkTick(violations, domain.Tick{
+		ID:            0,
+		Ownership:     dispute.Ownership,
+		Timestamp:     dispute.Created,
+		ContestID:     dispute.ContestID,
+		ProblemID:     dispute.ProblemID,
+		Zone1:         dispute.Zone1,
+		AttemptsZone1: dispute.AttemptsZone1,
+		Zone2:         dispute.Zone2,
+		AttemptsZone2: dispute.AttemptsZone2,
+		Top:           dispute.Top,
+		AttemptsTop:   dispute.AttemptsTop,
+	})
+
+	return violations.err()
+}
+
+func (v TickDisputeValidator) IsValidationError(err error) bool {
+	return errors.Is(err, errTickDisputeConstraintViolation) || TickValidator{}.IsValidationError(err)
+}
@@ -0,0 +1,72 @@
+package validators_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/climblive/platform/backend/internal/domain"
+	"github.com/climblive/platform/backend/internal/usecases/validators"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTickDisputeValidator(t *testing.T) {
+	validator := validators.TickDisputeValidator{}
+
+	validDispute := func() domain.TickDispute {
+		return domain.TickDispute{
+			Status:        domain.TickDisputeStatusOpen,
+			Comment:       "The judge missed my zone",
+			Zone1:         true,
+			AttemptsZone1: 2,
+			Zone2:         false,
+			AttemptsZone2: 2,
+			Top:           false,
+			AttemptsTop:   2,
+		}
+	}
+
+	t.Run("ValidData", func(t *testing.T) {
+		err := validator.Validate(validDispute())
+		assert.NoError(t, err)
+	})
+
+	t.Run("EmptyComment", func(t *testing.T) {
+		dispute := validDispute()
+		dispute.Comment = whitespaceCharacters
+
+		err := validator.Validate(dispute)
+
+		assert.ErrorIs(t, err, domain.ErrInvalidData)
+		assert.True(t, validator.IsValidationError(err))
+	})
+
+	t.Run("CommentTooLong", func(t *testing.T) {
+		dispute := validDispute()
+		dispute.Comment = strings.Repeat("ö", 1025)
+
+		err := validator.Validate(dispute)
+
+		assert.ErrorIs(t, err, domain.ErrInvalidData)
+		assert.True(t, validator.IsValidationError(err))
+	})
+
+	t.Run("ResponseTooLong", func(t *testing.T) {
+		dispute := validDispute()
+		dispute.Response = strings.Repeat("a", 1025)
+
+		err := validator.Validate(dispute)
+
+		assert.ErrorIs(t, err, domain.ErrInvalidData)
+		assert.True(t, validator.IsValidationError(err))
+	})
+
+	t.Run("InvalidProposedTick", func(t *testing.T) {
+		dispute := validDispute()
+		dispute.Top = true
+
+		err := validator.Validate(dispute)
+
+		assert.ErrorIs(t, err, domain.ErrInvalidData)
+		assert.True(t, validator.IsValidationError(err))
+	})
+}
@@ -2,6 +2,8 @@ package validators
 
 import (
 	"regexp"
+	"slices"
+	"unicode/utf8"
 
 	"github.com/climblive/platform/backend/internal/domain"
 	"github.com/go-errors/errors"
@@ -11,40 +13,40 @@ var (
 	errProblemConstraintViolation = errors.New("constraint violation")
 	validHexColor                 = regexp.MustCompile(`^#([0-9a-fA-F]{3}){1,2}$`)
 	maxAllowedPointValue          = 2_147_483_647
+	maxTagsPerProblem             = 10
+	maxTagLength                  = 32
+	maxSectorLength               = 32
 )
 
 type ProblemValidator struct {
 }
 
 func (v ProblemValidator) Validate(problem domain.Problem) error {
-	switch {
-	case problem.Number < 0:
-		fallthrough
-	case !validHexColor.MatchString(problem.HoldColorPrimary):
-		fallthrough
-	case len(problem.HoldColorSecondary) > 0 && !validHexColor.MatchString(problem.HoldColorSecondary):
-		fallthrough
-	case problem.PointsTop < 0:
-		fallthrough
-	case problem.FlashBonus < 0:
-		fallthrough
-	case problem.PointsZone1 < 0:
-		fallthrough
-	case problem.PointsZone2 < 0:
-		fallthrough
-	case problem.PointsTop > maxAllowedPointValue:
-		fallthrough
-	case problem.FlashBonus > maxAllowedPointValue:
-		fallthrough
-	case problem.PointsZone1 > maxAllowedPointValue:
-		fallthrough
-	case problem.PointsZone2 > maxAllowedPointValue:
-		fallthrough
-	case problem.Zone2Enabled && !problem.Zone1Enabled:
-		return errors.Errorf("%w: %w", domain.ErrInvalidData, errProblemConstraintViolation)
-	}
-
-	return nil
+	violations := newViolations(errProblemConstraintViolation)
+
+	violations.check(problem.Number < 0, "number", "must not be negative")
+	violations.check(!validHexColor.MatchString(problem.HoldColorPrimary), "holdColorPrimary", "must be a hex color")
+	violations.check(len(problem.HoldColorSecondary) > 0 && !validHexColor.MatchString(problem.HoldColorSecondary), "holdColorSecondary", "must be a hex color")
+	violations.check(invalidPointValue(problem.PointsTop), "pointsTop", "must be a non-negative 32-bit integer")
+	violations.check(invalidPointValue(problem.FlashBonus), "flashBonus", "must be a non-negative 32-bit integer")
+	violations.check(invalidPointValue(problem.PointsZone1), "pointsZone1", "must be a non-negative 32-bit integer")
+	violations.check(invalidPointValue(problem.PointsZone2), "pointsZone2", "must be a non-negative 32-bit integer")
+	violations.check(problem.Zone2Enabled && !problem.Zone1Enabled, "zone2Enabled", "requires zone 1 to be enabled")
+	violations.check(utf8.RuneCountInString(problem.Sector) > maxSectorLength, "sector", "must be at most 32 characters")
+	violations.check(len(problem.Tags) > maxTagsPerProblem, "tags", "must contain at most 10 tags")
+	violations.check(slices.ContainsFunc(problem.Tags, invalidTag), "tags", "must each be between 1 and 32 characters")
+
+	return violations.err()
+}
+
+func invalidPointValue(points int) bool {
+	return points < 0 || points > maxAllowedPointValue
+}
+
+func invalidTag(tag string) bool {
+	length := utf8.RuneCountInString(tag)
+
+	return length == 0 || length > maxTagLength
 }
 
 func (v ProblemValidator) IsValidationError(err error) bool {
@@ -1,6 +1,8 @@
 package validators_test
 
 import (
+	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/climblive/platform/backend/internal/domain"
@@ -19,6 +21,8 @@ func TestProblemValidator(t *testing.T) {
 			HoldColorPrimary:   "#ffffff",
 			HoldColorSecondary: "#000000",
 			Description:        "First boulder",
+			Sector:             "Cave",
+			Tags:               []string{"crimpy", "overhang"},
 			Zone1Enabled:       true,
 			Zone2Enabled:       true,
 			ProblemValue: domain.ProblemValue{
@@ -155,4 +159,37 @@ func TestProblemValidator(t *testing.T) {
 		assert.ErrorIs(t, err, domain.ErrInvalidData)
 		assert.True(t, validator.IsValidationError(err))
 	})
+
+	t.Run("SectorTooLong", func(t *testing.T) {
+		problem := validProblem()
+		problem.Sector = strings.Repeat("x", 33)
+
+		err := validator.Validate(problem)
+
+		assert.ErrorIs(t, err, domain.ErrInvalidData)
+		assert.True(t, validator.IsValidationError(err))
+	})
+
+	t.Run("TooManyTags", func(t *testing.T) {
+		problem := validProblem()
+		problem.Tags = make([]string, 11)
+		for i := range problem.Tags {
+			problem.Tags[i] = fmt.Sprintf("tag%d", i)
+		}
+
+		err := validator.Validate(problem)
+
+		assert.ErrorIs(t, err, domain.ErrInvalidData)
+		assert.True(t, validator.IsValidationError(err))
+	})
+
+	t.Run("TagTooLong", func(t *testing.T) {
+		problem := validProblem()
+		problem.Tags = []string{strings.Repeat("x", 33)}
+
+		err := validator.Validate(problem)
+
+		assert.ErrorIs(t, err, domain.ErrInvalidData)
+		assert.True(t, validator.IsValidationError(err))
+	})
 }
@@ -0,0 +1,48 @@
+package validators
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/climblive/platform/backend/internal/domain"
+	"github.com/go-errors/errors"
+)
+
+var (
+	errRaffleConstraintViolation = errors.New("constraint violation")
+	maxRaffleMinTicks            = 100
+	maxRafflePrizeQuantity       = 100
+)
+
+type RaffleValidator struct {
+}
+
+func (v RaffleValidator) Validate(raffle domain.Raffle) error {
+	violations := newViolations(errRaffleConstraintViolation)
+
+	violations.check(raffle.Rules.CompClassID < 0, "rules.compClassId", "must not be negative")
+	violations.check(raffle.Rules.MinTicks < 0 || raffle.Rules.MinTicks > maxRaffleMinTicks, "rules.minTicks", "must be between 0 and 100")
+	violations.check(!raffle.Rules.Weighting.Valid(), "rules.weighting", "must be a supported weighting")
+
+	return violations.err()
+}
+
+func (v RaffleValidator) IsValidationError(err error) bool {
+	return errors.Is(err, errRaffleConstraintViolation)
+}
+
+type RafflePrizeValidator struct {
+}
+
+func (v RafflePrizeValidator) Validate(prize domain.RafflePrize) error {
+	violations := newViolations(errRaffleConstraintViolation)
+
+	violations.check(len(strings.TrimSpace(prize.Name)) < 1 || utf8.RuneCountInString(prize.Name) > 64, "name", "must be between 1 and 64 characters")
+	violations.check(prize.Quantity < 1 || prize.Quantity > maxRafflePrizeQuantity, "quantity", "must be between 1 and 100")
+
+	return violations.err()
+}
+
+func (v RafflePrizeValidator) IsValidationError(err error) bool {
+	return errors.Is(err, errRaffleConstraintViolation)
+}
@@ -0,0 +1,90 @@
+package validators_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/climblive/platform/backend/internal/domain"
+	"github.com/climblive/platform/backend/internal/usecases/validators"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRaffleValidator(t *testing.T) {
+	validator := validators.RaffleValidator{}
+
+	validRaffle := func() domain.Raffle {
+		return domain.Raffle{
+			Rules: domain.RaffleRules{
+				CompClassID: 1,
+				MinTicks:    5,
+				Weighting:   domain.RaffleWeightingTops,
+			},
+		}
+	}
+
+	t.Run("ValidData", func(t *testing.T) {
+		assert.NoError(t, validator.Validate(validRaffle()))
+		assert.NoError(t, validator.Validate(domain.Raffle{}))
+	})
+
+	t.Run("InvalidMinTicks", func(t *testing.T) {
+		for _, minTicks := range []int{-1, 101} {
+			raffle := validRaffle()
+			raffle.Rules.MinTicks = minTicks
+
+			err := validator.Validate(raffle)
+
+			assert.ErrorIs(t, err, domain.ErrInvalidData)
+			assert.True(t, validator.IsValidationError(err))
+		}
+	})
+
+	t.Run("InvalidWeighting", func(t *testing.T) {
+		raffle := validRaffle()
+		raffle.Rules.Weighting = "zones"
+
+		err := validator.Validate(raffle)
+
+		assert.ErrorIs(t, err, domain.ErrInvalidData)
+		assert.True(t, validator.IsValidationError(err))
+	})
+}
+
+func TestRafflePrizeValidator(t *testing.T) {
+	validator := validators.RafflePrizeValidator{}
+
+	validPrize := func() domain.RafflePrize {
+		return domain.RafflePrize{
+			Name:     "Chalk bag",
+			Quantity: 3,
+		}
+	}
+
+	t.Run("ValidData", func(t *testing.T) {
+		assert.NoError(t, validator.Validate(validPrize()))
+	})
+
+	t.Run("InvalidName", func(t *testing.T) {
+		for _, name := range []string{whitespaceCharacters, strings.Repeat("x", 65)} {
+			prize := validPrize()
+			prize.Name = name
+
+			err := validator.Validate(prize)
+
+			assert.ErrorIs(t, err, domain.ErrInvalidData)
+			assert.True(t, validator.IsValidationError(err))
+		}
+	})
+
+	t.Run("InvalidQuantity", func(t *testing.T) {
+		for _, quantity := range []int{0, -1, 101} {
+			prize := validPrize()
+			prize.Quantity = quantity
+
+			err := validator.Validate(prize)
+
+			assert.ErrorIs(t, err, domain.ErrInvalidData)
+			assert.True(t, validator.IsValidationError(err))
+		}
+	})
+}
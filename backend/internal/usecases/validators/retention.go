@@ -0,0 +1,31 @@
+package validators
+
+import (
+	"time"
+
+	"github.com/climblive/platform/backend/internal/domain"
+	"github.com/go-errors/errors"
+)
+
+var (
+	errRetentionPolicyConstraintViolation = errors.New("constraint violation")
+	minRetentionPeriod                    = 24 * time.Hour
+	maxRetentionPeriod                    = 10 * 365 * 24 * time.Hour
+)
+
+type RetentionPolicyValidator struct {
+}
+
+func (v RetentionPolicyValidator) Validate(policy domain.RetentionPolicy) error {
+	violations := newViolations(errRetentionPolicyConstraintViolation)
+
+	violations.check(!policy.Target.Valid(), "target", "must be a supported target")
+	violations.check(policy.RetentionPeriod < minRetentionPeriod || policy.RetentionPeriod > maxRetentionPeriod, "retentionPeriod", "must be between 1 day and 10 years")
+	violations.check(policy.RetentionPeriod%time.Minute != 0, "retentionPeriod", "must be a whole number of minutes")
+
+	return violations.err()
+}
+
+func (v RetentionPolicyValidator) IsValidationError(err error) bool {
+	return errors.Is(err, errRetentionPolicyConstraintViolation)
+}
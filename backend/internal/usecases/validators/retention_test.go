@@ -0,0 +1,51 @@
+package validators_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/climblive/platform/backend/internal/domain"
+	"github.com/climblive/platform/backend/internal/usecases/validators"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetentionPolicyValidator(t *testing.T) {
+	validator := validators.RetentionPolicyValidator{}
+
+	validPolicy := func() domain.RetentionPolicy {
+		return domain.RetentionPolicy{
+			Target:          domain.RetentionPolicyTargetContenders,
+			RetentionPeriod: 180 * 24 * time.Hour,
+			Enabled:         true,
+		}
+	}
+
+	t.Run("ValidData", func(t *testing.T) {
+		err := validator.Validate(validPolicy())
+		assert.NoError(t, err)
+	})
+
+	t.Run("InvalidTarget", func(t *testing.T) {
+		for _, target := range []domain.RetentionPolicyTarget{"", "everything"} {
+			policy := validPolicy()
+			policy.Target = target
+
+			err := validator.Validate(policy)
+
+			assert.ErrorIs(t, err, domain.ErrInvalidData)
+			assert.True(t, validator.IsValidationError(err))
+		}
+	})
+
+	t.Run("InvalidRetentionPeriod", func(t *testing.T) {
+		for _, period := range []time.Duration{0, -time.Hour, 23 * time.Hour, 11 * 365 * 24 * time.Hour, 48*time.Hour + time.Second} {
+			policy := validPolicy()
+			policy.RetentionPeriod = period
+
+			err := validator.Validate(policy)
+
+			assert.ErrorIs(t, err, domain.ErrInvalidData)
+			assert.True(t, validator.IsValidationError(err))
+		}
+	})
+}
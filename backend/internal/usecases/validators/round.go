@@ -0,0 +1,33 @@
+package validators
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/climblive/platform/backend/internal/domain"
+	"github.com/go-errors/errors"
+)
+
+var errRoundConstraintViolation = errors.New("constraint violation")
+
+type RoundValidator struct {
+}
+
+func (v RoundValidator) Validate(round domain.Round) error {
+	violations := newViolations(errRoundConstraintViolation)
+
+	violations.check(len(strings.TrimSpace(round.Name)) < 1 || utf8.RuneCountInString(round.Name) > 32, "name", "must be between 1 and 32 characters")
+	violations.check(round.Number < 1 || round.Number > 10, "number", "must be between 1 and 10")
+	violations.check(round.Finalists < 0 || round.Finalists > 65536, "finalists", "must be between 0 and 65536")
+	violations.check(round.QualifyingProblems < 0 || round.QualifyingProblems > 65536, "qualifyingProblems", "must be between 0 and 65536")
+	violations.check(round.TimeBegin.IsZero() != round.TimeEnd.IsZero(), "timeEnd", "must be set together with start time")
+	violations.check(round.TimeEnd.Before(round.TimeBegin), "timeEnd", "must not be before start time")
+	violations.check(round.TimeEnd.Sub(round.TimeBegin) > 31*24*time.Hour, "timeEnd", "must be within 31 days of start time")
+
+	return violations.err()
+}
+
+func (v RoundValidator) IsValidationError(err error) bool {
+	return errors.Is(err, errRoundConstraintViolation)
+}
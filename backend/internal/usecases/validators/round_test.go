@@ -0,0 +1,122 @@
+package validators_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/climblive/platform/backend/internal/domain"
+	"github.com/climblive/platform/backend/internal/usecases/validators"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRoundValidator(t *testing.T) {
+	validator := validators.RoundValidator{}
+
+	validRound := func() domain.Round {
+		return domain.Round{
+			Number:             1,
+			Name:               "Final",
+			QualifyingProblems: 4,
+			Finalists:          6,
+		}
+	}
+
+	t.Run("ValidData", func(t *testing.T) {
+		err := validator.Validate(validRound())
+		assert.NoError(t, err)
+	})
+
+	t.Run("EmptyName", func(t *testing.T) {
+		round := validRound()
+		round.Name = whitespaceCharacters
+
+		err := validator.Validate(round)
+
+		assert.ErrorIs(t, err, domain.ErrInvalidData)
+		assert.True(t, validator.IsValidationError(err))
+	})
+
+	t.Run("NameTooLong", func(t *testing.T) {
+		round := validRound()
+		round.Name = strings.Repeat("x", 33)
+
+		err := validator.Validate(round)
+
+		assert.ErrorIs(t, err, domain.ErrInvalidData)
+		assert.True(t, validator.IsValidationError(err))
+	})
+
+	t.Run("InvalidNumber", func(t *testing.T) {
+		for _, number := range []int{0, -1, 11} {
+			round := validRound()
+			round.Number = number
+
+			err := validator.Validate(round)
+
+			assert.ErrorIs(t, err, domain.ErrInvalidData)
+			assert.True(t, validator.IsValidationError(err))
+		}
+	})
+
+	t.Run("NegativeFinalists", func(t *testing.T) {
+		round := validRound()
+		round.Finalists = -1
+
+		err := validator.Validate(round)
+
+		assert.ErrorIs(t, err, domain.ErrInvalidData)
+		assert.True(t, validator.IsValidationError(err))
+	})
+
+	t.Run("NegativeQualifyingProblems", func(t *testing.T) {
+		round := validRound()
+		round.QualifyingProblems = -1
+
+		err := validator.Validate(round)
+
+		assert.ErrorIs(t, err, domain.ErrInvalidData)
+		assert.True(t, validator.IsValidationError(err))
+	})
+	t.Run("TimeWindow", func(t *testing.T) {
+		now := time.Now()
+
+		round := validRound()
+		round.TimeBegin = now
+		round.TimeEnd = now.Add(time.Hour)
+
+		assert.NoError(t, validator.Validate(round))
+	})
+
+	t.Run("PartialTimeWindow", func(t *testing.T) {
+		round := validRound()
+		round.TimeBegin = time.Now()
+
+		err := validator.Validate(round)
+
+		assert.ErrorIs(t, err, domain.ErrInvalidData)
+		assert.True(t, validator.IsValidationError(err))
+	})
+
+	t.Run("TimeEndBeforeTimeBegin", func(t *testing.T) {
+		round := validRound()
+		round.TimeBegin = time.Now()
+		round.TimeEnd = round.TimeBegin.Add(-time.Nanosecond)
+
+		err := validator.Validate(round)
+
+		assert.ErrorIs(t, err, domain.ErrInvalidData)
+		assert.True(t, validator.IsValidationError(err))
+	})
+
+	t.Run("TimeWindowTooLong", func(t *testing.T) {
+		round := validRound()
+		round.TimeBegin = time.Now()
+		round.TimeEnd = round.TimeBegin.Add(31*24*time.Hour + time.Nanosecond)
+
+		err := validator.Validate(round)
+
+		assert.ErrorIs(t, err, domain.ErrInvalidData)
+		assert.True(t, validator.IsValidationError(err))
+	})
+}
@@ -0,0 +1,27 @@
+package validators
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/climblive/platform/backend/internal/domain"
+	"github.com/go-errors/errors"
+)
+
+var errTeamConstraintViolation = errors.New("constraint violation")
+
+type TeamValidator struct {
+}
+
+func (v TeamValidator) Validate(team domain.Team) error {
+	violations := newViolations(errTeamConstraintViolation)
+
+	violations.check(len(strings.TrimSpace(team.Name)) < 1 || utf8.RuneCountInString(team.Name) > 32, "name", "must be between 1 and 32 characters")
+	violations.check(team.CountedMembers < 1 || team.CountedMembers > 100, "countedMembers", "must be between 1 and 100")
+
+	return violations.err()
+}
+
+func (v TeamValidator) IsValidationError(err error) bool {
+	return errors.Is(err, errTeamConstraintViolation)
+}
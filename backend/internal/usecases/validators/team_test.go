@@ -0,0 +1,74 @@
+package validators_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/climblive/platform/backend/internal/domain"
+	"github.com/climblive/platform/backend/internal/usecases/validators"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTeamValidator(t *testing.T) {
+	validator := validators.TeamValidator{}
+
+	validTeam := func() domain.Team {
+		return domain.Team{
+			Name:           "Boulder Buddies",
+			CountedMembers: 3,
+		}
+	}
+
+	t.Run("ValidData", func(t *testing.T) {
+		err := validator.Validate(validTeam())
+		assert.NoError(t, err)
+	})
+
+	t.Run("EmptyName", func(t *testing.T) {
+		team := validTeam()
+		team.Name = whitespaceCharacters
+
+		err := validator.Validate(team)
+
+		assert.ErrorIs(t, err, domain.ErrInvalidData)
+		assert.True(t, validator.IsValidationError(err))
+	})
+
+	t.Run("NameTooLong", func(t *testing.T) {
+		team := validTeam()
+		team.Name = strings.Repeat("x", 33)
+
+		err := validator.Validate(team)
+
+		assert.ErrorIs(t, err, domain.ErrInvalidData)
+		assert.True(t, validator.IsValidationError(err))
+	})
+
+	t.Run("InvalidCountedMembers", func(t *testing.T) {
+		for _, countedMembers := range []int{0, -1, 101} {
+			team := validTeam()
+			team.CountedMembers = countedMembers
+
+			err := validator.Validate(team)
+
+			assert.ErrorIs(t, err, domain.ErrInvalidData)
+			assert.True(t, validator.IsValidationError(err))
+		}
+	})
+	t.Run("ReportsViolatedFields", func(t *testing.T) {
+		team := validTeam()
+		team.Name = ""
+		team.CountedMembers = 0
+
+		err := validator.Validate(team)
+
+		var validationErr domain.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+
+		assert.Equal(t, []domain.FieldViolation{
+			{Field: "name", Message: "must be between 1 and 32 characters"},
+			{Field: "countedMembers", Message: "must be between 1 and 100"},
+		}, validationErr.Violations)
+	})
+}
@@ -1,32 +1,71 @@
 package validators
 
 import (
+	"strings"
+	"unicode/utf8"
+
 	"github.com/climblive/platform/backend/internal/domain"
 	"github.com/go-errors/errors"
 )
 
-var errTickConstraintViolation = errors.New("constraint violation")
+var (
+	errTickConstraintViolation = errors.New("constraint violation")
+	maxIdempotencyKeyLength    = 64
+)
 
 type TickValidator struct {
 }
 
 func (v TickValidator) Validate(tick domain.Tick) error {
-	switch {
-	case tick.AttemptsZone1 > tick.AttemptsZone2:
-		fallthrough
-	case tick.AttemptsZone2 > tick.AttemptsTop:
-		fallthrough
-	case tick.Top && !tick.Zone1:
-		fallthrough
-	case tick.Top && !tick.Zone2:
-		fallthrough
-	case tick.Zone2 && !tick.Zone1:
-		return errors.Errorf("%w: %w", domain.ErrInvalidData, errTickConstraintViolation)
-	}
+	violations := newViolations(errTickConstraintViolation)
 
-	return nil
+	checkTick(violations, tick)
+
+	return violations.err()
+}
+
+func checkTick(violations *violations, tick domain.Tick) {
+	violations.check(tick.AttemptsZone1 > tick.AttemptsZone2, "attemptsZone1", "must not exceed attempts for zone 2")
+	violations.check(tick.AttemptsZone2 > tick.AttemptsTop, "attemptsZone2", "must not exceed attempts for top")
+	violations.check(tick.Top && !tick.Zone1, "zone1", "must be reached when topped")
+	violations.check(tick.Top && !tick.Zone2, "zone2", "must be reached when topped")
+	violations.check(tick.Zone2 && !tick.Zone1, "zone1", "must be reached when zone 2 is reached")
 }
 
 func (v TickValidator) IsValidationError(err error) bool {
 	return errors.Is(err, errTickConstraintViolation)
 }
+
+type TickOperationValidator struct {
+}
+
+func (v TickOperationValidator) Validate(operation domain.TickOperation) error {
+	violations := newViolations(errTickConstraintViolation)
+
+	violations.check(len(strings.TrimSpace(operation.IdempotencyKey)) < 1 || utf8.RuneCountInString(operation.IdempotencyKey) > maxIdempotencyKeyLength, "idempotencyKey", "must be between 1 and 64 characters")
+	violations.check(!operation.Action.Valid(), "action", "must be a supported action")
+	violations.check(operation.Timestamp.IsZero(), "timestamp", "must be set")
+	violations.check(operation.ProblemID < 1, "problemId", "must be set")
+
+	if operation.Action != domain.TickRevisionActionDelete {
+		checkTick(violations, domain.Tick{
+			ID:            0,
+			Ownership:     domain.OwnershipData{},
+			Timestamp:     operation.Timestamp,
+			ContestID:     0,
+			ProblemID:     operation.ProblemID,
+			Zone1:         operation.Zone1,
+			AttemptsZone1: operation.AttemptsZone1,
+			Zone2:         operation.Zone2,
+			AttemptsZone2: operation.AttemptsZone2,
+			Top:           operation.Top,
+			AttemptsTop:   operation.AttemptsTop,
+		})
+	}
+
+	return violations.err()
+}
+
+func (v TickOperationValidator) IsValidationError(err error) bool {
+	return errors.Is(err, errTickConstraintViolation)
+}
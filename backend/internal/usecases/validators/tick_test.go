@@ -1,6 +1,7 @@
 package validators_test
 
 import (
+	"strings"
 	"testing"
 	"time"
 
@@ -79,3 +80,55 @@ func TestTickValidator(t *testing.T) {
 		assert.True(t, validator.IsValidationError(err))
 	})
 }
+
+func TestTickOperationValidator(t *testing.T) {
+	validator := validators.TickOperationValidator{}
+
+	validOperation := func() domain.TickOperation {
+		return domain.TickOperation{
+			IdempotencyKey: "0b6c8a9e-8f7e-4d55-9a43-3c1a3d0e6b21",
+			Action:         domain.TickRevisionActionPut,
+			Timestamp:      time.Now(),
+			ProblemID:      domain.ProblemID(1),
+			Zone1:          true,
+			AttemptsZone1:  1,
+			Zone2:          true,
+			AttemptsZone2:  1,
+			Top:            true,
+			AttemptsTop:    2,
+		}
+	}
+
+	t.Run("ValidData", func(t *testing.T) {
+		err := validator.Validate(validOperation())
+		assert.NoError(t, err)
+	})
+
+	t.Run("DeleteIgnoresTickValues", func(t *testing.T) {
+		operation := validOperation()
+		operation.Action = domain.TickRevisionActionDelete
+		operation.Zone1 = false
+
+		err := validator.Validate(operation)
+		assert.NoError(t, err)
+	})
+
+	t.Run("InvalidData", func(t *testing.T) {
+		for _, mutate := range []func(*domain.TickOperation){
+			func(operation *domain.TickOperation) { operation.IdempotencyKey = whitespaceCharacters },
+			func(operation *domain.TickOperation) { operation.IdempotencyKey = strings.Repeat("k", 65) },
+			func(operation *domain.TickOperation) { operation.Action = "patch" },
+			func(operation *domain.TickOperation) { operation.Timestamp = time.Time{} },
+			func(operation *domain.TickOperation) { operation.ProblemID = 0 },
+			func(operation *domain.TickOperation) { operation.Zone1 = false },
+		} {
+			operation := validOperation()
+			mutate(&operation)
+
+			err := validator.Validate(operation)
+
+			assert.ErrorIs(t, err, domain.ErrInvalidData)
+			assert.True(t, validator.IsValidationError(err))
+		}
+	})
+}
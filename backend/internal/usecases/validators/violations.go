@@ -0,0 +1,35 @@
+package validators
+
+import (
+	"github.com/climblive/platform/backend/internal/domain"
+	"github.com/go-errors/errors"
+)
+
+type violations struct {
+	sentinel error
+	fields   []domain.FieldViolation
+}
+
+func newViolations(sentinel error) *violations {
+	return &violations{
+		sentinel: sentinel,
+		fields:   nil,
+	}
+}
+
+func (v *violations) check(invalid bool, field, message string) {
+	if invalid {
+		v.fields = append(v.fields, domain.FieldViolation{
+			Field:   field,
+			Message: message,
+		})
+	}
+}
+
+func (v *violations) err() error {
+	if len(v.fields) == 0 {
+		return nil
+	}
+
+	return errors.Errorf("%w: %w: %w", domain.ErrInvalidData, v.sentinel, domain.ValidationError{Violations: v.fields})
+}
@@ -33,6 +33,8 @@ import { tickSchema } from "./models/tick";
 import { userSchema } from "./models/user";
 import { getApiUrl } from "./utils/config";
 
+const maxPageSize = 1000;
+
 interface ApiCredentialsProvider {
   getAuthHeaders(): RawAxiosRequestHeaders;
 }
@@ -93,6 +95,32 @@ export class ApiClient {
     this.credentialsProvider = credentialsProvider;
   };
 
+  private getAllPages = async <T extends z.ZodType>(
+    endpoint: string,
+    schema: T,
+  ) => {
+    const items: z.infer<T>[] = [];
+    let query = `?limit=${maxPageSize}`;
+
+    for (;;) {
+      const result = await this.axiosInstance.get(endpoint + query, {
+        headers: this.credentialsProvider?.getAuthHeaders(),
+      });
+
+      items.push(...z.array(schema).parse(result.data));
+
+      const next = /<(\?[^>]*)>;\s*rel="next"/.exec(
+        result.headers["link"] ?? "",
+      );
+
+      if (!next) {
+        return items;
+      }
+
+      query = next[1];
+    }
+  };
+
   getSelf = async () => {
     const endpoint = "/users/self";
 
@@ -124,11 +152,7 @@ export class ApiClient {
   getContendersByContest = async (contestId: number) => {
     const endpoint = `/contests/${contestId}/contenders`;
 
-    const result = await this.axiosInstance.get(endpoint, {
-      headers: this.credentialsProvider?.getAuthHeaders(),
-    });
-
-    return z.array(contenderSchema).parse(result.data);
+    return this.getAllPages(endpoint, contenderSchema);
   };
 
   patchContender = async (id: number, patch: ContenderPatch) => {
@@ -175,11 +199,7 @@ export class ApiClient {
   getAllContests = async () => {
     const endpoint = `/contests`;
 
-    const result = await this.axiosInstance.get(endpoint, {
-      headers: this.credentialsProvider?.getAuthHeaders(),
-    });
-
-    return z.array(contestSchema).parse(result.data);
+    return this.getAllPages(endpoint, contestSchema);
   };
 
   createContest = async (organizerId: number, template: ContestTemplate) => {
@@ -357,11 +377,7 @@ export class ApiClient {
   getTicksByContest = async (contestId: number) => {
     const endpoint = `/contests/${contestId}/ticks`;
 
-    const result = await this.axiosInstance.get(endpoint, {
-      headers: this.credentialsProvider?.getAuthHeaders(),
-    });
-
-    return z.array(tickSchema).parse(result.data);
+    return this.getAllPages(endpoint, tickSchema);
   };
 
   putTick = async (
@@ -4,28 +4,44 @@
 // source: id.go
 
 export type ResourceID = number; /* int32 */
+export type AuditEntryID = ResourceID;
 export type CompClassID = ResourceID;
 export type ContenderID = ResourceID;
 export type ContestID = ResourceID;
 export type OrganizerID = ResourceID;
 export type ProblemID = ResourceID;
 export type RaffleID = ResourceID;
+export type RafflePrizeID = ResourceID;
 export type RaffleWinnerID = ResourceID;
+export type RetentionPolicyID = ResourceID;
+export type RetentionPolicyExecutionID = ResourceID;
+export type RoundID = ResourceID;
 export type SeriesID = ResourceID;
 export type UserID = ResourceID;
+export type TeamID = ResourceID;
 export type TickID = ResourceID;
+export type TickRevisionID = ResourceID;
+export type TickDisputeID = ResourceID;
 export type OrganizerInviteID = string;
 export type ResourceIDType =
+  | AuditEntryID
   | CompClassID
   | ContenderID
   | ContestID
   | OrganizerID
   | ProblemID
   | RaffleID
+  | RafflePrizeID
   | RaffleWinnerID
+  | RetentionPolicyID
+  | RetentionPolicyExecutionID
+  | RoundID
   | SeriesID
   | UserID
-  | TickID;
+  | TeamID
+  | TickID
+  | TickRevisionID
+  | TickDisputeID;
 export type ScoreEngineInstanceID = string;
 
 //////////
@@ -35,6 +51,15 @@ export type ColorRGB = string;
 export interface OwnershipData {
   organizerId: OrganizerID;
 }
+export type AuditAction = string;
+export const AuditActionContenderErased: AuditAction = "CONTENDER_ERASED";
+export interface AuditEntry {
+  id: AuditEntryID;
+  contestId: ContestID;
+  contenderId?: ContenderID;
+  action: AuditAction;
+  timestamp: Date;
+}
 export interface CompClass {
   id: CompClassID;
   contestId: ContestID;
@@ -42,23 +67,27 @@ export interface CompClass {
   description?: string;
   timeBegin: Date;
   timeEnd: Date;
+  capacity?: number /* int */;
 }
 export interface CompClassTemplate {
   name: string;
   description?: string;
   timeBegin: Date;
   timeEnd: Date;
+  capacity?: number /* int */;
 }
 export interface CompClassPatch {
   name?: string;
   description?: string;
   timeBegin?: Date;
   timeEnd?: Date;
+  capacity?: number;
 }
 export interface Contender {
   id: ContenderID;
   contestId: ContestID;
   compClassId?: CompClassID;
+  teamId?: TeamID;
   registrationCode: string;
   name?: string;
   entered?: Date;
@@ -66,14 +95,25 @@ export interface Contender {
   disqualified: boolean;
   scrubbedAt?: Date;
   scrubBefore?: Date;
+  waitlistCompClassId?: CompClassID;
+  waitlistedAt?: Date;
   score?: Score;
 }
 export interface ContenderPatch {
   compClassId?: CompClassID;
+  teamId?: TeamID;
   name?: string;
   withdrawnFromFinals?: boolean;
   disqualified?: boolean;
 }
+export interface ContenderDataExport {
+  contender: Contender;
+  ticks: Tick[];
+  tickRevisions: TickRevision[];
+  tickDisputes: TickDispute[];
+  raffleWins: RaffleWinner[];
+  exportedAt: Date;
+}
 export interface Contest {
   id: ContestID;
   ownership: OwnershipData;
@@ -88,6 +128,9 @@ export interface Contest {
   info?: string;
   gracePeriod: number;
   nameRetentionTime: number;
+  scoreboardFreeze: number;
+  scoreboardRevealedAt?: Date;
+  selfRegistration: boolean;
   timeBegin?: Date;
   timeEnd?: Date;
   created: Date;
@@ -104,6 +147,7 @@ export interface ContestTemplate {
   info?: string;
   gracePeriod: number;
   nameRetentionTime: number;
+  scoreboardFreeze: number;
 }
 export interface ContestPatch {
   location?: string;
@@ -115,6 +159,12 @@ export interface ContestPatch {
   finalists?: number;
   info?: string;
   gracePeriod?: number;
+  scoreboardFreeze?: number;
+  selfRegistration?: boolean;
+}
+export interface SelfRegistration {
+  compClassId: CompClassID;
+  name: string;
 }
 export interface ContestTransferRequest {
   newOrganizerId: OrganizerID;
@@ -144,18 +194,26 @@ export interface ProblemValue {
 export interface Problem extends ProblemValue {
   id: ProblemID;
   contestId: ContestID;
+  roundId?: RoundID;
   number: number /* int */;
   holdColorPrimary: string;
   holdColorSecondary?: string;
   description?: string;
+  sector?: string;
+  tags: string[];
+  compClassIds: CompClassID[];
   zone1Enabled: boolean;
   zone2Enabled: boolean;
 }
 export interface ProblemTemplate extends ProblemValue {
+  roundId?: RoundID;
   number: number /* int */;
   holdColorPrimary: string;
   holdColorSecondary?: string;
   description?: string;
+  sector?: string;
+  tags?: string[];
+  compClassIds?: CompClassID[];
   zone1Enabled: boolean;
   zone2Enabled: boolean;
 }
@@ -164,6 +222,9 @@ export interface ProblemPatch {
   holdColorPrimary?: string;
   holdColorSecondary?: string;
   description?: string;
+  sector?: string;
+  tags?: string[];
+  compClassIds?: CompClassID[];
   zone1Enabled?: boolean;
   zone2Enabled?: boolean;
   pointsZone1?: number;
@@ -171,9 +232,62 @@ export interface ProblemPatch {
   pointsTop?: number;
   flashBonus?: number;
 }
+export interface ProblemStats {
+  problemId: ProblemID;
+  compClassId?: CompClassID;
+  attempts: number /* int */;
+  zone1s: number /* int */;
+  zone2s: number /* int */;
+  tops: number /* int */;
+  flashes: number /* int */;
+  averageAttemptsToTop: number /* float64 */;
+  topPercentage: number /* float64 */;
+}
+export type RaffleWeighting = string;
+export const RaffleWeightingNone: RaffleWeighting = "";
+export const RaffleWeightingTops: RaffleWeighting = "tops";
+export interface RaffleRules {
+  compClassId?: CompClassID;
+  minTicks?: number /* int */;
+  weighting?: RaffleWeighting;
+}
 export interface Raffle {
   id: RaffleID;
   contestId: ContestID;
+  rules: RaffleRules;
+  seed?: string;
+  seedCommitment?: string;
+  seedRevealedAt?: Date;
+}
+export interface RaffleTemplate {
+  rules: RaffleRules;
+  verifiable?: boolean;
+}
+export interface RaffleCandidate {
+  contenderId: ContenderID;
+  weight: number /* int64 */;
+}
+export interface RaffleDraw {
+  number: number /* int */;
+  candidates: RaffleCandidate[];
+  contenderId: ContenderID;
+}
+export interface RaffleVerification {
+  raffleId: RaffleID;
+  seed: string;
+  seedCommitment: string;
+  draws: RaffleDraw[];
+  verified: boolean;
+}
+export interface RafflePrize {
+  id: RafflePrizeID;
+  raffleId: RaffleID;
+  name: string;
+  quantity: number /* int */;
+}
+export interface RafflePrizeTemplate {
+  name: string;
+  quantity: number /* int */;
 }
 export interface RaffleWinner {
   id: RaffleWinnerID;
@@ -181,7 +295,79 @@ export interface RaffleWinner {
   contenderId: ContenderID;
   contenderName: string;
   contenderScrubbedAt?: Date;
+  prizeId?: RafflePrizeID;
+  prizeName?: string;
   timestamp: Date;
+  rules: RaffleRules;
+  claimedAt?: Date;
+  voidedAt?: Date;
+}
+export type RetentionPolicyTarget = string;
+export const RetentionPolicyTargetTicks: RetentionPolicyTarget = "ticks";
+export const RetentionPolicyTargetContenders: RetentionPolicyTarget = "contenders";
+export const RetentionPolicyTargetArchivedContests: RetentionPolicyTarget = "archivedContests";
+export interface RetentionPolicy {
+  id: RetentionPolicyID;
+  target: RetentionPolicyTarget;
+  retentionPeriod: number;
+  enabled: boolean;
+  created: Date;
+}
+export interface RetentionPolicyTemplate {
+  target: RetentionPolicyTarget;
+  retentionPeriod: number;
+  enabled: boolean;
+}
+export interface RetentionPolicyPatch {
+  retentionPeriod?: number;
+  enabled?: boolean;
+}
+export interface RetentionCandidate {
+  contestId: ContestID;
+  contestName: string;
+  deadline: Date;
+  contenders: number /* int */;
+  ticks: number /* int */;
+}
+export interface RetentionPolicyExecution {
+  id: RetentionPolicyExecutionID;
+  policyId: RetentionPolicyID;
+  timestamp: Date;
+  contests: number /* int */;
+  contenders: number /* int */;
+  ticks: number /* int */;
+  error?: string;
+}
+export interface Round {
+  id: RoundID;
+  contestId: ContestID;
+  number: number /* int */;
+  name: string;
+  qualifyingProblems: number /* int */;
+  finalists: number /* int */;
+  timeBegin?: Date;
+  timeEnd?: Date;
+}
+export interface RoundTemplate {
+  number: number /* int */;
+  name: string;
+  qualifyingProblems: number /* int */;
+  finalists: number /* int */;
+  timeBegin?: Date;
+  timeEnd?: Date;
+}
+export interface RoundPatch {
+  name?: string;
+  qualifyingProblems?: number;
+  finalists?: number;
+  timeBegin?: Date;
+  timeEnd?: Date;
+}
+export interface StartListEntry {
+  roundId: RoundID;
+  contenderId: ContenderID;
+  previousPlacement: number /* int */;
+  score?: Score;
 }
 export interface Score {
   timestamp: Date;
@@ -198,12 +384,40 @@ export interface Series {
 export interface ScoreboardEntry {
   contenderId: ContenderID;
   compClassId: CompClassID;
+  teamId?: TeamID;
   name: string;
   withdrawnFromFinals: boolean;
   disqualified: boolean;
   scrubbedAt?: Date;
   score?: Score;
 }
+export interface Team {
+  id: TeamID;
+  contestId: ContestID;
+  name: string;
+  countedMembers: number /* int */;
+}
+export interface TeamTemplate {
+  name: string;
+  countedMembers: number /* int */;
+}
+export interface TeamPatch {
+  name?: string;
+  countedMembers?: number;
+}
+export interface TeamScore {
+  timestamp: Date;
+  teamId: TeamID;
+  score: number /* int */;
+  placement: number /* int */;
+  rankOrder: number /* int */;
+}
+export interface TeamScoreboardEntry {
+  teamId: TeamID;
+  name: string;
+  members: ContenderID[];
+  score?: TeamScore;
+}
 export interface Tick {
   id: TickID;
   timestamp: Date;
@@ -215,6 +429,87 @@ export interface Tick {
   top: boolean;
   attemptsTop: number /* int */;
 }
+export type TickRevisionAction = string;
+export const TickRevisionActionPut: TickRevisionAction = "put";
+export const TickRevisionActionDelete: TickRevisionAction = "delete";
+export interface TickRevision {
+  id: TickRevisionID;
+  contestId: ContestID;
+  contenderId: ContenderID;
+  problemId: ProblemID;
+  tickId: TickID;
+  action: TickRevisionAction;
+  timestamp: Date;
+  recordedAt: Date;
+  actorRole: AuthRole;
+  actorUsername?: string;
+  idempotencyKey?: string;
+  zone1: boolean;
+  attemptsZone1: number /* int */;
+  zone2: boolean;
+  attemptsZone2: number /* int */;
+  top: boolean;
+  attemptsTop: number /* int */;
+}
+export interface TickOperation {
+  idempotencyKey: string;
+  action: TickRevisionAction;
+  timestamp: Date;
+  problemId: ProblemID;
+  zone1: boolean;
+  attemptsZone1: number /* int */;
+  zone2: boolean;
+  attemptsZone2: number /* int */;
+  top: boolean;
+  attemptsTop: number /* int */;
+}
+export type TickOperationStatus = string;
+export const TickOperationStatusApplied: TickOperationStatus = "applied";
+export const TickOperationStatusDuplicate: TickOperationStatus = "duplicate";
+export const TickOperationStatusConflict: TickOperationStatus = "conflict";
+export const TickOperationStatusRejected: TickOperationStatus = "rejected";
+export interface TickOperationResult {
+  idempotencyKey: string;
+  status: TickOperationStatus;
+  tick?: Tick;
+  error?: string;
+}
+export type TickDisputeStatus = string;
+export const TickDisputeStatusOpen: TickDisputeStatus = "open";
+export const TickDisputeStatusAccepted: TickDisputeStatus = "accepted";
+export const TickDisputeStatusRejected: TickDisputeStatus = "rejected";
+export interface TickDispute {
+  id: TickDisputeID;
+  contestId: ContestID;
+  contenderId: ContenderID;
+  problemId: ProblemID;
+  status: TickDisputeStatus;
+  comment: string;
+  response?: string;
+  zone1: boolean;
+  attemptsZone1: number /* int */;
+  zone2: boolean;
+  attemptsZone2: number /* int */;
+  top: boolean;
+  attemptsTop: number /* int */;
+  created: Date;
+  resolved?: Date;
+  resolvedBy?: string;
+}
+export interface TickDisputeTemplate {
+  problemId: ProblemID;
+  comment: string;
+  zone1: boolean;
+  attemptsZone1: number /* int */;
+  zone2: boolean;
+  attemptsZone2: number /* int */;
+  top: boolean;
+  attemptsTop: number /* int */;
+}
+export interface TickDisputeResolution {
+  status: TickDisputeStatus;
+  response: string;
+}
 export interface User {
   id: UserID;
   username: string;
@@ -234,6 +529,14 @@ export interface ContenderSwitchedClassEvent {
   contenderId: ContenderID;
   compClassId: CompClassID;
 }
+export interface ContenderPromotedFromWaitlistEvent {
+  contenderId: ContenderID;
+  compClassId: CompClassID;
+}
+export interface ContenderSwitchedTeamEvent {
+  contenderId: ContenderID;
+  teamId?: TeamID;
+}
 export interface ContenderWithdrewFromFinalsEvent {
   contenderId: ContenderID;
 }
@@ -265,20 +568,39 @@ export interface AscentDeregisteredEvent {
 }
 export interface ProblemAddedEvent extends ProblemValue {
   problemId: ProblemID;
+  roundId?: RoundID;
+  compClassIds?: CompClassID[];
 }
 export interface ProblemUpdatedEvent extends ProblemValue {
   problemId: ProblemID;
+  roundId?: RoundID;
+  compClassIds?: CompClassID[];
 }
 export interface ProblemDeletedEvent {
   problemId: ProblemID;
 }
+export interface TeamAddedEvent {
+  teamId: TeamID;
+  name: string;
+  countedMembers: number /* int */;
+}
+export interface TeamUpdatedEvent {
+  teamId: TeamID;
+  name: string;
+  countedMembers: number /* int */;
+}
+export interface TeamDeletedEvent {
+  teamId: TeamID;
+}
 export interface RulesUpdatedEvent {
+  roundId?: RoundID;
   qualifyingProblems: number /* int */;
   finalists: number /* int */;
 }
 export interface ContenderPublicInfoUpdatedEvent {
   contenderId: ContenderID;
   compClassId: CompClassID;
+  teamId?: TeamID;
   name: string;
   withdrawnFromFinals: boolean;
   disqualified: boolean;
@@ -292,14 +614,66 @@ export interface ContenderScoreUpdatedEvent {
   finalist: boolean;
   rankOrder: number /* int */;
 }
+export interface ContenderLiveScoreUpdatedEvent {
+  timestamp: Date;
+  contenderId: ContenderID;
+  score: number /* int */;
+  placement: number /* int */;
+  finalist: boolean;
+  rankOrder: number /* int */;
+}
+export interface RoundScoreUpdatedEvent {
+  roundId: RoundID;
+  timestamp: Date;
+  contenderId: ContenderID;
+  score: number /* int */;
+  placement: number /* int */;
+  finalist: boolean;
+  rankOrder: number /* int */;
+}
+export interface TeamScoreUpdatedEvent {
+  timestamp: Date;
+  teamId: TeamID;
+  score: number /* int */;
+  placement: number /* int */;
+  rankOrder: number /* int */;
+}
 export interface ScoreEngineStartedEvent {
   instanceId: ScoreEngineInstanceID;
 }
 export interface ScoreEngineStoppedEvent {
   instanceId: ScoreEngineInstanceID;
 }
+export interface ScoreboardRevealedEvent {
+  timestamp: Date;
+}
+export interface ScoreboardFreezeUpdatedEvent {
+  frozenFrom?: Date;
+}
+export interface TickDisputeOpenedEvent {
+  disputeId: TickDisputeID;
+  contenderId: ContenderID;
+  problemId: ProblemID;
+}
+export interface TickDisputeResolvedEvent {
+  disputeId: TickDisputeID;
+  contenderId: ContenderID;
+  problemId: ProblemID;
+  status: TickDisputeStatus;
+}
 export interface RaffleWinnerDrawnEvent {
   raffleId: RaffleID;
   contenderId: ContenderID;
+  prizeId?: RafflePrizeID;
+  prizeName?: string;
   timestamp: Date;
 }
+export interface RaffleWinnerUpdatedEvent {
+  raffleWinnerId: RaffleWinnerID;
+  raffleId: RaffleID;
+  contenderId: ContenderID;
+  prizeId?: RafflePrizeID;
+  prizeName?: string;
+  claimedAt?: Date;
+  voidedAt?: Date;
+}
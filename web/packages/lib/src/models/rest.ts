@@ -7,5 +7,37 @@ export interface CreateContendersArguments {
   number: number /* int */;
 }
 export interface StartScoreEngineArguments {
+  roundId?: number;
   terminatedBy: Date;
 }
+export type ErrorCode = string;
+export const ErrorCodeNotFound: ErrorCode = "NOT_FOUND";
+export const ErrorCodeArchived: ErrorCode = "ARCHIVED";
+export const ErrorCodeAllWinnersDrawn: ErrorCode = "ALL_WINNERS_DRAWN";
+export const ErrorCodeDuplicate: ErrorCode = "DUPLICATE";
+export const ErrorCodeLimitExceeded: ErrorCode = "LIMIT_EXCEEDED";
+export const ErrorCodeNotAuthenticated: ErrorCode = "NOT_AUTHENTICATED";
+export const ErrorCodeNotAuthorized: ErrorCode = "NOT_AUTHORIZED";
+export const ErrorCodeNoOwnership: ErrorCode = "NO_OWNERSHIP";
+export const ErrorCodeInsufficientRole: ErrorCode = "INSUFFICIENT_ROLE";
+export const ErrorCodeContestNotStarted: ErrorCode = "CONTEST_NOT_STARTED";
+export const ErrorCodeContestEnded: ErrorCode = "CONTEST_ENDED";
+export const ErrorCodeProblemNotAvailable: ErrorCode = "PROBLEM_NOT_AVAILABLE";
+export const ErrorCodeNotAllowed: ErrorCode = "NOT_ALLOWED";
+export const ErrorCodeInvalidData: ErrorCode = "INVALID_DATA";
+export const ErrorCodePreconditionFailed: ErrorCode = "PRECONDITION_FAILED";
+export const ErrorCodeIdempotencyKeyInUse: ErrorCode = "IDEMPOTENCY_KEY_IN_USE";
+export const ErrorCodeIdempotencyKeyReused: ErrorCode = "IDEMPOTENCY_KEY_REUSED";
+export const ErrorCodeInternal: ErrorCode = "INTERNAL_ERROR";
+export interface FieldError {
+  field: string;
+  message: string;
+}
+export interface ProblemDetails {
+  type: string;
+  title: string;
+  status: number /* int */;
+  detail: string;
+  code: ErrorCode;
+  errors?: FieldError[];
+}